@@ -100,6 +100,7 @@ import (
 	appupgradev1 "github.com/CoreumFoundation/coreum/v2/app/upgrade/v1"
 	appupgradev2 "github.com/CoreumFoundation/coreum/v2/app/upgrade/v2"
 	appupgradev2patch1 "github.com/CoreumFoundation/coreum/v2/app/upgrade/v2/v2patch1"
+	appupgradev3 "github.com/CoreumFoundation/coreum/v2/app/upgrade/v3"
 	"github.com/CoreumFoundation/coreum/v2/docs"
 	"github.com/CoreumFoundation/coreum/v2/pkg/config"
 	"github.com/CoreumFoundation/coreum/v2/pkg/config/constant"
@@ -118,11 +119,17 @@ import (
 	delaytypes "github.com/CoreumFoundation/coreum/v2/x/delay/types"
 	"github.com/CoreumFoundation/coreum/v2/x/deterministicgas"
 	deterministicgastypes "github.com/CoreumFoundation/coreum/v2/x/deterministicgas/types"
+	"github.com/CoreumFoundation/coreum/v2/x/dex"
+	dexkeeper "github.com/CoreumFoundation/coreum/v2/x/dex/keeper"
+	dextypes "github.com/CoreumFoundation/coreum/v2/x/dex/types"
 	"github.com/CoreumFoundation/coreum/v2/x/feemodel"
 	feemodelkeeper "github.com/CoreumFoundation/coreum/v2/x/feemodel/keeper"
 	feemodeltypes "github.com/CoreumFoundation/coreum/v2/x/feemodel/types"
 	"github.com/CoreumFoundation/coreum/v2/x/nft"
 	nftkeeper "github.com/CoreumFoundation/coreum/v2/x/nft/keeper"
+	"github.com/CoreumFoundation/coreum/v2/x/nfttransfer"
+	nfttransferkeeper "github.com/CoreumFoundation/coreum/v2/x/nfttransfer/keeper"
+	nfttransfertypes "github.com/CoreumFoundation/coreum/v2/x/nfttransfer/types"
 	wasmcustomhandler "github.com/CoreumFoundation/coreum/v2/x/wasm/handler"
 	"github.com/CoreumFoundation/coreum/v2/x/wbank"
 	wbankkeeper "github.com/CoreumFoundation/coreum/v2/x/wbank/keeper"
@@ -187,6 +194,8 @@ var (
 		assetnft.AppModuleBasic{},
 		customparams.AppModuleBasic{},
 		delay.AppModuleBasic{},
+		dex.AppModuleBasic{},
+		nfttransfer.AppModuleBasic{},
 	)
 
 	// module account permissions.
@@ -201,6 +210,7 @@ var (
 		wasm.ModuleName:                {authtypes.Burner},
 		assetfttypes.ModuleName:        {authtypes.Minter, authtypes.Burner},
 		assetnfttypes.ModuleName:       {authtypes.Burner},
+		dextypes.ModuleName:            nil,
 		nft.ModuleName:                 {}, // the line is required by the nft module to have the module account stored in the account keeper
 	}
 )
@@ -261,11 +271,14 @@ type App struct {
 	NFTKeeper          wnftkeeper.Wrapper
 	CustomParamsKeeper customparamskeeper.Keeper
 	DelayKeeper        delaykeeper.Keeper
+	DEXKeeper          dexkeeper.Keeper
+	NFTTransferKeeper  nfttransferkeeper.Keeper
 
 	// make scoped keepers public for test purposes
-	ScopedIBCKeeper      capabilitykeeper.ScopedKeeper
-	ScopedTransferKeeper capabilitykeeper.ScopedKeeper
-	ScopedWASMKeeper     capabilitykeeper.ScopedKeeper
+	ScopedIBCKeeper         capabilitykeeper.ScopedKeeper
+	ScopedTransferKeeper    capabilitykeeper.ScopedKeeper
+	ScopedWASMKeeper        capabilitykeeper.ScopedKeeper
+	ScopedNFTTransferKeeper capabilitykeeper.ScopedKeeper
 
 	// mm is the module manager
 	mm *module.Manager
@@ -307,9 +320,9 @@ func New(
 		distrtypes.StoreKey, slashingtypes.StoreKey, govtypes.StoreKey, paramstypes.StoreKey, upgradetypes.StoreKey,
 		feegrant.StoreKey, evidencetypes.StoreKey, capabilitytypes.StoreKey, wasm.StoreKey, feemodeltypes.StoreKey,
 		assetfttypes.StoreKey, assetnfttypes.StoreKey, nftkeeper.StoreKey, ibchost.StoreKey, ibctransfertypes.StoreKey,
-		delaytypes.StoreKey,
+		delaytypes.StoreKey, dextypes.StoreKey, nfttransfertypes.StoreKey,
 	)
-	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey, feemodeltypes.TransientStoreKey)
+	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey, feemodeltypes.TransientStoreKey, dextypes.TransientStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
 
 	app := &App{
@@ -335,6 +348,7 @@ func New(
 	app.ScopedIBCKeeper = app.CapabilityKeeper.ScopeToModule(ibchost.ModuleName)
 	app.ScopedTransferKeeper = app.CapabilityKeeper.ScopeToModule(ibctransfertypes.ModuleName)
 	app.ScopedWASMKeeper = app.CapabilityKeeper.ScopeToModule(wasm.ModuleName)
+	app.ScopedNFTTransferKeeper = app.CapabilityKeeper.ScopeToModule(nfttransfertypes.ModuleName)
 
 	// add keepers
 	app.AccountKeeper = authkeeper.NewAccountKeeper(
@@ -348,8 +362,15 @@ func New(
 	app.UpgradeKeeper = upgradekeeper.NewKeeper(skipUpgradeHeights, keys[upgradetypes.StoreKey], appCodec, homePath, app.BaseApp)
 
 	delayRouter := delaytypes.NewRouter()
-	app.DelayKeeper = delaykeeper.NewKeeper(appCodec, keys[delaytypes.StoreKey], delayRouter, app.interfaceRegistry)
+	app.DelayKeeper = delaykeeper.NewKeeper(
+		appCodec,
+		app.GetSubspace(delaytypes.ModuleName).WithKeyTable(paramstypes.NewKeyTable().RegisterParamSet(&delaytypes.Params{})),
+		keys[delaytypes.StoreKey],
+		delayRouter,
+		app.interfaceRegistry,
+	)
 
+	var stakingKeeper stakingkeeper.Keeper
 	originalBankKeeper := bankkeeper.NewBaseKeeper(appCodec, keys[banktypes.StoreKey], app.AccountKeeper, app.GetSubspace(banktypes.ModuleName), app.ModuleAccountAddrs())
 	app.AssetFTKeeper = assetftkeeper.NewKeeper(
 		appCodec,
@@ -358,18 +379,29 @@ func New(
 		// for the assetft we use the clear bank keeper without the assets integration to prevent cycling calls.
 		originalBankKeeper,
 		app.DelayKeeper,
+		// the distribution keeper is used only to credit the community pool, so it is fine to pass it before
+		// it is initialized.
+		&app.DistrKeeper,
 	)
 
 	err := delayRouter.RegisterHandler(&assetfttypes.DelayedTokenUpgradeV1{}, assetfttypes.NewTokenUpgradeV1Handler(app.AssetFTKeeper))
 	if err != nil {
 		panic(err)
 	}
+	err = delayRouter.RegisterHandler(&assetfttypes.DelayedMint{}, assetfttypes.NewScheduledMintHandler(app.AssetFTKeeper))
+	if err != nil {
+		panic(err)
+	}
+	err = delayRouter.RegisterHandler(&assetfttypes.DelayedUnfreeze{}, assetfttypes.NewScheduledUnfreezeHandler(app.AssetFTKeeper))
+	if err != nil {
+		panic(err)
+	}
 
 	app.BankKeeper = wbankkeeper.NewKeeper(
 		appCodec, keys[banktypes.StoreKey], app.AccountKeeper, app.GetSubspace(banktypes.ModuleName), app.ModuleAccountAddrs(), app.AssetFTKeeper,
 	)
 
-	stakingKeeper := stakingkeeper.NewKeeper(
+	stakingKeeper = stakingkeeper.NewKeeper(
 		appCodec, keys[stakingtypes.StoreKey], app.AccountKeeper, app.BankKeeper, app.GetSubspace(stakingtypes.ModuleName),
 	)
 	app.MintKeeper = mintkeeper.NewKeeper(
@@ -389,6 +421,17 @@ func New(
 
 	app.FeeGrantKeeper = feegrantkeeper.NewKeeper(appCodec, keys[feegrant.StoreKey], app.AccountKeeper)
 
+	app.DEXKeeper = dexkeeper.NewKeeper(
+		appCodec,
+		app.GetSubspace(dextypes.ModuleName).WithKeyTable(paramstypes.NewKeyTable().RegisterParamSet(&dextypes.Params{})),
+		keys[dextypes.StoreKey],
+		tkeys[dextypes.TransientStoreKey],
+		app.AccountKeeper,
+		// the wrapped bank keeper is used to apply the asset ft rules to the traded tokens
+		app.BankKeeper,
+		app.AssetFTKeeper,
+	)
+
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
 	app.StakingKeeper = *stakingKeeper.SetHooks(
@@ -412,8 +455,8 @@ func New(
 		app.GetSubspace(assetnfttypes.ModuleName).WithKeyTable(paramstypes.NewKeyTable().RegisterParamSet(&assetnfttypes.Params{})),
 		keys[assetnfttypes.StoreKey],
 		nftKeeper,
-		// for the assetnft we use the clear bank keeper without the assets integration because it interacts only with native token.
-		originalBankKeeper,
+		// the wrapped bank keeper is used because NFT payments might be done using fungible tokens
+		app.BankKeeper,
 	)
 
 	app.NFTKeeper = wnftkeeper.NewWrappedNFTKeeper(nftKeeper, app.AssetNFTKeeper)
@@ -425,6 +468,17 @@ func New(
 		app.AccountKeeper, app.BankKeeper, app.ScopedTransferKeeper,
 	)
 
+	app.NFTTransferKeeper = nfttransferkeeper.NewKeeper(
+		appCodec,
+		keys[nfttransfertypes.StoreKey],
+		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		&app.IBCKeeper.PortKeeper,
+		// the wrapped nft keeper is used to apply the asset nft rules to the transferred tokens
+		app.NFTKeeper,
+		app.ScopedNFTTransferKeeper,
+	)
+
 	// register the proposal types
 	govRouter := govtypes.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
@@ -492,6 +546,7 @@ func New(
 	ibcRouter := ibcporttypes.NewRouter()
 	ibcRouter.AddRoute(ibctransfertypes.ModuleName, wibctransfer.NewPurposeMiddleware(transfer.NewIBCModule(app.TransferKeeper.Keeper)))
 	ibcRouter.AddRoute(wasm.ModuleName, wasm.NewIBCHandler(app.WASMKeeper, app.IBCKeeper.ChannelKeeper, app.IBCKeeper.ChannelKeeper))
+	ibcRouter.AddRoute(nfttransfertypes.ModuleName, nfttransfer.NewIBCModule(app.NFTTransferKeeper))
 	app.IBCKeeper.SetRouter(ibcRouter)
 
 	/****  Module Options ****/
@@ -503,11 +558,19 @@ func New(
 	assetFTModule := assetft.NewAppModule(
 		appCodec,
 		app.AssetFTKeeper,
+		app.AccountKeeper,
 		app.BankKeeper.BaseKeeper,
 		app.ParamsKeeper,
 	)
-	assetNFTModule := assetnft.NewAppModule(appCodec, app.AssetNFTKeeper, app.NFTKeeper.Keeper, app.WASMKeeper)
-	feeModule := feemodel.NewAppModule(app.FeeModelKeeper)
+	assetNFTModule := assetnft.NewAppModule(
+		appCodec,
+		app.AssetNFTKeeper,
+		app.AccountKeeper,
+		app.BankKeeper.BaseKeeper,
+		app.NFTKeeper.Keeper,
+		app.WASMKeeper,
+	)
+	feeModule := feemodel.NewAppModule(app.FeeModelKeeper, app.ParamsKeeper)
 
 	wnftModule := wnft.NewAppModule(appCodec, app.NFTKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry)
 
@@ -515,6 +578,8 @@ func New(
 	wstakingModule := wstaking.NewAppModule(appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper, app.CustomParamsKeeper)
 
 	delayModule := delay.NewAppModule(app.DelayKeeper)
+	dexModule := dex.NewAppModule(appCodec, app.DEXKeeper)
+	nftTransferModule := nfttransfer.NewAppModule(appCodec, app.NFTTransferKeeper)
 
 	// NOTE: Any module instantiated in the module manager that is later modified
 	// must be passed by reference here.
@@ -548,6 +613,8 @@ func New(
 		wnftModule,
 		customParamsModule,
 		delayModule,
+		dexModule,
+		nftTransferModule,
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
@@ -580,6 +647,8 @@ func New(
 		assetnfttypes.ModuleName,
 		nft.ModuleName,
 		delaytypes.ModuleName,
+		dextypes.ModuleName,
+		nfttransfertypes.ModuleName,
 	)
 
 	app.mm.SetOrderEndBlockers(
@@ -608,6 +677,8 @@ func New(
 		assetnfttypes.ModuleName,
 		nft.ModuleName,
 		delaytypes.ModuleName,
+		dextypes.ModuleName,
+		nfttransfertypes.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -641,6 +712,8 @@ func New(
 		assetfttypes.ModuleName,
 		assetnfttypes.ModuleName,
 		delaytypes.ModuleName,
+		dextypes.ModuleName,
+		nfttransfertypes.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
@@ -669,10 +742,13 @@ func New(
 		wasm.NewAppModule(appCodec, &app.WASMKeeper, app.StakingKeeper, app.AccountKeeper, app.BankKeeper),
 		feeModule,
 		assetFTModule,
-		assetNFTModule,
+		// the nft genesis state must be generated before the asset nft one, which adds the classes to it
 		wnftModule,
+		assetNFTModule,
 		customParamsModule,
 		delayModule,
+		dexModule,
+		nftTransferModule,
 	)
 	app.sm.RegisterStoreDecoders()
 
@@ -693,6 +769,7 @@ func New(
 			SignModeHandler:        encodingConfig.TxConfig.SignModeHandler(),
 			FeegrantKeeper:         app.FeeGrantKeeper,
 			FeeModelKeeper:         app.FeeModelKeeper,
+			AssetFTKeeper:          app.AssetFTKeeper,
 			WasmTXCounterStoreKey:  keys[wasm.StoreKey],
 		},
 	)
@@ -718,6 +795,7 @@ func New(
 		appupgradev1.New(app.mm, app.configurator, ChosenNetwork, app.AssetNFTKeeper),
 		appupgradev2.New(app.mm, app.configurator),
 		appupgradev2patch1.New(app.mm, app.configurator),
+		appupgradev3.New(app.mm, app.configurator),
 	}
 
 	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
@@ -901,6 +979,8 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(customparamstypes.CustomParamsStaking)
 	paramsKeeper.Subspace(assetfttypes.ModuleName)
 	paramsKeeper.Subspace(assetnfttypes.ModuleName)
+	paramsKeeper.Subspace(delaytypes.ModuleName)
+	paramsKeeper.Subspace(dextypes.ModuleName)
 
 	return paramsKeeper
 }
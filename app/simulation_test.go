@@ -1,21 +1,40 @@
 package app_test
 
 import (
+	"bytes"
+	"fmt"
 	"os"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
 	simulationtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
 
 	"github.com/CoreumFoundation/coreum/v2/app"
 	"github.com/CoreumFoundation/coreum/v2/pkg/config"
 	"github.com/CoreumFoundation/coreum/v2/pkg/config/constant"
+	assetfttypes "github.com/CoreumFoundation/coreum/v2/x/asset/ft/types"
+	assetnfttypes "github.com/CoreumFoundation/coreum/v2/x/asset/nft/types"
+	nftkeeper "github.com/CoreumFoundation/coreum/v2/x/nft/keeper"
 )
 
 func init() {
 	simapp.GetSimulatorFlags()
+
+	network, err := config.NetworkConfigByChainID(constant.ChainIDDev)
+	if err != nil {
+		panic(err)
+	}
+	network.SetSDKConfig()
+	app.ChosenNetwork = network
 }
 
 // BenchmarkSimulation run the chain simulation
@@ -36,25 +55,7 @@ func BenchmarkSimulation(b *testing.B) {
 		require.NoError(b, err)
 	})
 
-	encoding := config.NewEncodingConfig(app.ModuleBasics)
-	network, err := config.NetworkConfigByChainID(constant.ChainIDDev)
-	if err != nil {
-		panic(err)
-	}
-	network.SetSDKConfig()
-
-	app.ChosenNetwork = network
-	simApp := app.New(
-		logger,
-		db,
-		nil,
-		true,
-		map[int64]bool{},
-		app.DefaultNodeHome,
-		0,
-		encoding,
-		simapp.EmptyAppOptions{},
-	)
+	simApp := newSimApp(b, logger, db)
 
 	// Run randomized simulations
 	_, simParams, simErr := simulation.SimulateFromSeed(
@@ -78,3 +79,141 @@ func BenchmarkSimulation(b *testing.B) {
 		simapp.PrintStats(db)
 	}
 }
+
+// TestAppImportExport runs the chain simulation, exports the genesis, imports it to the new app and compares the
+// stores of the asset modules.
+// Running:
+// `go test -run=^TestAppImportExport$ ./app -NumBlocks=50 -BlockSize=50 -Commit=true -Enabled=true`.
+func TestAppImportExport(t *testing.T) {
+	cfg, db, dir, logger, skip, err := simapp.SetupSimulation("goleveldb-app-sim", "Simulation")
+	if skip {
+		t.Skip("skipping application import/export simulation")
+	}
+	require.NoError(t, err, "simulation setup failed")
+
+	t.Cleanup(func() {
+		db.Close()
+		require.NoError(t, os.RemoveAll(dir))
+	})
+
+	simApp := newSimApp(t, logger, db)
+
+	_, simParams, simErr := simulation.SimulateFromSeed(
+		t,
+		os.Stdout,
+		simApp.GetBaseApp(),
+		simapp.AppStateFn(simApp.AppCodec(), simApp.SimulationManager()),
+		simulationtypes.RandomAccounts,
+		simapp.SimulationOperations(simApp, simApp.AppCodec(), cfg),
+		simApp.ModuleAccountAddrs(),
+		cfg,
+		simApp.AppCodec(),
+	)
+
+	// export state and simParams before the simulation error is checked
+	err = simapp.CheckExportSimulation(simApp, cfg, simParams)
+	require.NoError(t, err)
+	require.NoError(t, simErr)
+
+	fmt.Printf("exporting genesis...\n")
+
+	exported, err := simApp.ExportAppStateAndValidators(false, []string{})
+	require.NoError(t, err)
+
+	fmt.Printf("importing genesis...\n")
+
+	_, newDB, newDir, _, _, err := simapp.SetupSimulation("goleveldb-app-sim-2", "Simulation-2")
+	require.NoError(t, err, "simulation setup failed")
+
+	t.Cleanup(func() {
+		newDB.Close()
+		require.NoError(t, os.RemoveAll(newDir))
+	})
+
+	newApp := newSimApp(t, log.NewNopLogger(), newDB)
+
+	ctxA := simApp.NewContext(true, tmproto.Header{Height: simApp.LastBlockHeight()})
+	ctxB := newApp.NewContext(true, tmproto.Header{Height: simApp.LastBlockHeight()})
+	newApp.InitChainer(ctxB, abci.RequestInitChain{AppStateBytes: exported.AppState})
+
+	fmt.Printf("comparing stores...\n")
+
+	storeKeysPrefixes := []storeKeyPrefixes{
+		// the token upgrade statuses are not exported
+		{assetfttypes.StoreKey, [][]byte{assetfttypes.TokenUpgradeStatusesKeyPrefix}},
+		// the whitelisting of the burnt nfts is kept in the store but not exported
+		{assetnfttypes.StoreKey, [][]byte{assetnfttypes.NFTWhitelistingKeyPrefix}},
+		// the zero supply of the classes having all the nfts burnt is kept in the store but not exported
+		{nftkeeper.StoreKey, [][]byte{nftkeeper.ClassTotalSupply}},
+	}
+	for _, skp := range storeKeysPrefixes {
+		storeA := ctxA.KVStore(simApp.GetKey(skp.storeKey))
+		storeB := ctxB.KVStore(newApp.GetKey(skp.storeKey))
+
+		failedKVAs, failedKVBs := diffKVStores(storeA, storeB, skp.prefixesToSkip)
+		require.Equal(t, len(failedKVAs), len(failedKVBs), "unequal sets of key-values to compare")
+
+		fmt.Printf("compared %d different key/value pairs of %s stores\n", len(failedKVAs), skp.storeKey)
+		require.Empty(t, failedKVAs,
+			simapp.GetSimulationLog(skp.storeKey, simApp.SimulationManager().StoreDecoders, failedKVAs, failedKVBs))
+	}
+}
+
+// storeKeyPrefixes defines the store compared after the import and the prefixes skipped in the comparison.
+type storeKeyPrefixes struct {
+	storeKey       string
+	prefixesToSkip [][]byte
+}
+
+// diffKVStores returns the key/value pairs which differ in the stores. In contrast to sdk.DiffKVStores the pairs under
+// the skipped prefixes are removed before the comparison, so the stores might have the different number of them.
+func diffKVStores(a, b sdk.KVStore, prefixesToSkip [][]byte) ([]kv.Pair, []kv.Pair) {
+	pairsA := storePairs(a, prefixesToSkip)
+	pairsB := storePairs(b, prefixesToSkip)
+
+	var kvAs, kvBs []kv.Pair
+	for i := 0; i < len(pairsA) || i < len(pairsB); i++ {
+		var kvA, kvB kv.Pair
+		if i < len(pairsA) {
+			kvA = pairsA[i]
+		}
+		if i < len(pairsB) {
+			kvB = pairsB[i]
+		}
+		if !bytes.Equal(kvA.Key, kvB.Key) || !bytes.Equal(kvA.Value, kvB.Value) {
+			kvAs = append(kvAs, kvA)
+			kvBs = append(kvBs, kvB)
+		}
+	}
+	return kvAs, kvBs
+}
+
+func storePairs(store sdk.KVStore, prefixesToSkip [][]byte) []kv.Pair {
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	var pairs []kv.Pair
+	for ; iter.Valid(); iter.Next() {
+		if lo.ContainsBy(prefixesToSkip, func(prefix []byte) bool { return bytes.HasPrefix(iter.Key(), prefix) }) {
+			continue
+		}
+		pairs = append(pairs, kv.Pair{Key: iter.Key(), Value: iter.Value()})
+	}
+	return pairs
+}
+
+// newSimApp creates the app for the simulation. Each app gets its own temporary home directory, so the apps created
+// by the same test don't share the wasm cache.
+func newSimApp(tb testing.TB, logger log.Logger, db dbm.DB) *app.App {
+	return app.New(
+		logger,
+		db,
+		nil,
+		true,
+		map[int64]bool{},
+		tb.TempDir(),
+		0,
+		config.NewEncodingConfig(app.ModuleBasics),
+		simapp.EmptyAppOptions{},
+	)
+}
@@ -0,0 +1,31 @@
+package v3
+
+import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	"github.com/CoreumFoundation/coreum/v2/app/upgrade"
+	dextypes "github.com/CoreumFoundation/coreum/v2/x/dex/types"
+	nfttransfertypes "github.com/CoreumFoundation/coreum/v2/x/nfttransfer/types"
+)
+
+// Name defines the upgrade name.
+const Name = "v3"
+
+// New makes an upgrade handler for v3 upgrade.
+func New(mm *module.Manager, configurator module.Configurator) upgrade.Upgrade {
+	return upgrade.Upgrade{
+		Name: Name,
+		StoreUpgrades: storetypes.StoreUpgrades{
+			Added: []string{
+				dextypes.StoreKey,
+				nfttransfertypes.StoreKey,
+			},
+		},
+		Upgrade: func(ctx sdk.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+			return mm.RunMigrations(ctx, configurator, vm)
+		},
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/CoreumFoundation/coreum/v2/pkg/client"
 	"github.com/CoreumFoundation/coreum/v2/testutil/event"
 	assetfttypes "github.com/CoreumFoundation/coreum/v2/x/asset/ft/types"
+	delaytypes "github.com/CoreumFoundation/coreum/v2/x/delay/types"
 )
 
 // TestAssetFTQueryParams queries parameters of asset/ft module.
@@ -85,6 +86,97 @@ func TestAssetFTIssue(t *testing.T) {
 	requireT.Equal(chain.NewCoin(sdk.ZeroInt()).String(), resp.Balance.String())
 }
 
+// TestAssetFTUpdateMetadata tests metadata update functionality of fungible tokens.
+func TestAssetFTUpdateMetadata(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewCoreumTestingContext(t)
+
+	requireT := require.New(t)
+	issuer := chain.GenAccount()
+	randomAddr := chain.GenAccount()
+
+	chain.FundAccountWithOptions(ctx, t, issuer, integrationtests.BalancesOptions{
+		Messages: []sdk.Msg{
+			&assetfttypes.MsgIssue{},
+			&assetfttypes.MsgUpdateMetadata{},
+		},
+		Amount: chain.QueryAssetFTParams(ctx, t).IssueFee.Amount,
+	})
+	chain.FundAccountWithOptions(ctx, t, randomAddr, integrationtests.BalancesOptions{
+		Messages: []sdk.Msg{
+			&assetfttypes.MsgUpdateMetadata{},
+		},
+	})
+
+	issueMsg := &assetfttypes.MsgIssue{
+		Issuer:        issuer.String(),
+		Symbol:        "ABC",
+		Subunit:       "uabc",
+		Precision:     6,
+		Description:   "ABC Description",
+		InitialAmount: sdk.NewInt(1000),
+		URI:           "https://my-token-meta.invalid/1",
+		URIHash:       "e000624",
+	}
+	_, err := client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(issueMsg)),
+		issueMsg,
+	)
+	requireT.NoError(err)
+	denom := assetfttypes.BuildDenom(issueMsg.Subunit, issuer)
+
+	// try to update the metadata from the non-admin account
+	updateMsg := &assetfttypes.MsgUpdateMetadata{
+		Sender:      randomAddr.String(),
+		Denom:       denom,
+		Description: "New ABC Description",
+		URI:         "https://my-token-meta.invalid/2",
+		URIHash:     "e000625",
+	}
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(randomAddr),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(updateMsg)),
+		updateMsg,
+	)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// update the metadata by the admin
+	updateMsg.Sender = issuer.String()
+	res, err := client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(updateMsg)),
+		updateMsg,
+	)
+	requireT.NoError(err)
+	requireT.EqualValues(chain.GasLimitByMsgs(updateMsg), res.GasUsed)
+
+	metadataUpdatedEvts, err := event.FindTypedEvents[*assetfttypes.EventMetadataUpdated](res.Events)
+	requireT.NoError(err)
+	requireT.Equal(&assetfttypes.EventMetadataUpdated{
+		Denom:       denom,
+		Description: updateMsg.Description,
+		URI:         updateMsg.URI,
+		URIHash:     updateMsg.URIHash,
+	}, metadataUpdatedEvts[0])
+
+	ftClient := assetfttypes.NewQueryClient(chain.ClientContext)
+	tokenRes, err := ftClient.Token(ctx, &assetfttypes.QueryTokenRequest{Denom: denom})
+	requireT.NoError(err)
+	requireT.Equal(updateMsg.Description, tokenRes.Token.Description)
+	requireT.Equal(updateMsg.URI, tokenRes.Token.URI)
+	requireT.Equal(updateMsg.URIHash, tokenRes.Token.URIHash)
+
+	bankClient := banktypes.NewQueryClient(chain.ClientContext)
+	metadataRes, err := bankClient.DenomMetadata(ctx, &banktypes.QueryDenomMetadataRequest{Denom: denom})
+	requireT.NoError(err)
+	requireT.Equal(updateMsg.Description, metadataRes.Metadata.Description)
+}
+
 // TestAssetFTIssueFeeProposal tests proposal upgrading issue fee.
 func TestAssetFTIssueFeeProposal(t *testing.T) {
 	// This test can't be run together with other tests because it affects balances due to unexpected issue fee.
@@ -209,6 +301,7 @@ func TestAssetIssueAndQueryTokens(t *testing.T) {
 		BurnRate:           msg1.BurnRate,
 		SendCommissionRate: msg1.SendCommissionRate,
 		Version:            gotToken.Tokens[0].Version, // test should work with all versions
+		Admin:              issuer1.String(),
 	}, gotToken.Tokens[0])
 }
 
@@ -3085,3 +3178,871 @@ func assertCoinDistribution(ctx context.Context, clientCtx client.Context, t *te
 	requireT.NoError(err)
 	requireT.EqualValues(sdk.NewCoin(denom, sdk.NewInt(total)).String(), supply.Amount.String())
 }
+
+// TestAssetFTClawback tests clawback functionality of fungible tokens.
+func TestAssetFTClawback(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewCoreumTestingContext(t)
+
+	requireT := require.New(t)
+	issuer := chain.GenAccount()
+	recipient := chain.GenAccount()
+
+	chain.FundAccountWithOptions(ctx, t, issuer, integrationtests.BalancesOptions{
+		Messages: []sdk.Msg{
+			&assetfttypes.MsgIssue{},
+			&banktypes.MsgSend{},
+			&assetfttypes.MsgFreeze{},
+			&assetfttypes.MsgGloballyFreeze{},
+			&assetfttypes.MsgClawback{},
+			&assetfttypes.MsgClawback{},
+		},
+		Amount: chain.QueryAssetFTParams(ctx, t).IssueFee.Amount,
+	})
+	chain.FundAccountWithOptions(ctx, t, recipient, integrationtests.BalancesOptions{
+		Messages: []sdk.Msg{
+			&assetfttypes.MsgClawback{},
+		},
+	})
+
+	issueMsg := &assetfttypes.MsgIssue{
+		Issuer:        issuer.String(),
+		Symbol:        "ABC",
+		Subunit:       "uabc",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(1000),
+		Features: []assetfttypes.Feature{
+			assetfttypes.Feature_freezing,
+			assetfttypes.Feature_clawback,
+		},
+	}
+	_, err := client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(issueMsg)),
+		issueMsg,
+	)
+	requireT.NoError(err)
+	denom := assetfttypes.BuildDenom(issueMsg.Subunit, issuer)
+
+	sendMsg := &banktypes.MsgSend{
+		FromAddress: issuer.String(),
+		ToAddress:   recipient.String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(500))),
+	}
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+		sendMsg,
+	)
+	requireT.NoError(err)
+
+	// try to claw back from the non-admin account
+	clawbackMsg := &assetfttypes.MsgClawback{
+		Sender:  recipient.String(),
+		Account: issuer.String(),
+		Coin:    sdk.NewCoin(denom, sdk.NewInt(100)),
+	}
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(recipient),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(clawbackMsg)),
+		clawbackMsg,
+	)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// freeze the whole balance of the recipient
+	freezeMsg := &assetfttypes.MsgFreeze{
+		Sender:  issuer.String(),
+		Account: recipient.String(),
+		Coin:    sdk.NewCoin(denom, sdk.NewInt(500)),
+	}
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(freezeMsg)),
+		freezeMsg,
+	)
+	requireT.NoError(err)
+
+	// claw back the frozen tokens
+	clawbackMsg = &assetfttypes.MsgClawback{
+		Sender:  issuer.String(),
+		Account: recipient.String(),
+		Coin:    sdk.NewCoin(denom, sdk.NewInt(200)),
+	}
+	res, err := client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(clawbackMsg)),
+		clawbackMsg,
+	)
+	requireT.NoError(err)
+	requireT.EqualValues(chain.GasLimitByMsgs(clawbackMsg), res.GasUsed)
+
+	clawbackEvts, err := event.FindTypedEvents[*assetfttypes.EventClawback](res.Events)
+	requireT.NoError(err)
+	requireT.Equal(&assetfttypes.EventClawback{
+		Account: recipient.String(),
+		Admin:   issuer.String(),
+		Coin:    clawbackMsg.Coin,
+	}, clawbackEvts[0])
+
+	// freeze the token globally and claw back the rest
+	globalFreezeMsg := &assetfttypes.MsgGloballyFreeze{
+		Sender: issuer.String(),
+		Denom:  denom,
+	}
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(globalFreezeMsg)),
+		globalFreezeMsg,
+	)
+	requireT.NoError(err)
+
+	clawbackMsg.Coin = sdk.NewCoin(denom, sdk.NewInt(300))
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(clawbackMsg)),
+		clawbackMsg,
+	)
+	requireT.NoError(err)
+
+	bankClient := banktypes.NewQueryClient(chain.ClientContext)
+	balanceRes, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{Address: recipient.String(), Denom: denom})
+	requireT.NoError(err)
+	requireT.Equal(sdk.NewInt(0).String(), balanceRes.Balance.Amount.String())
+	balanceRes, err = bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{Address: issuer.String(), Denom: denom})
+	requireT.NoError(err)
+	requireT.Equal(sdk.NewInt(1000).String(), balanceRes.Balance.Amount.String())
+
+	// the frozen amount stays untouched
+	ftClient := assetfttypes.NewQueryClient(chain.ClientContext)
+	frozenRes, err := ftClient.FrozenBalance(ctx, &assetfttypes.QueryFrozenBalanceRequest{
+		Account: recipient.String(),
+		Denom:   denom,
+	})
+	requireT.NoError(err)
+	requireT.Equal(sdk.NewInt(500).String(), frozenRes.Balance.Amount.String())
+}
+
+// TestAssetFTScheduleMintAndUnfreeze tests scheduled mint and unfreeze functionality of fungible tokens.
+func TestAssetFTScheduleMintAndUnfreeze(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewCoreumTestingContext(t)
+
+	requireT := require.New(t)
+	issuer := chain.GenAccount()
+	recipient := chain.GenAccount()
+
+	chain.FundAccountWithOptions(ctx, t, issuer, integrationtests.BalancesOptions{
+		Messages: []sdk.Msg{
+			&assetfttypes.MsgIssue{},
+			&banktypes.MsgSend{},
+			&assetfttypes.MsgFreeze{},
+			&assetfttypes.MsgScheduleMint{},
+			&assetfttypes.MsgScheduleUnfreeze{},
+			&assetfttypes.MsgScheduleUnfreeze{},
+			&assetfttypes.MsgCancelScheduledAction{},
+		},
+		Amount: chain.QueryAssetFTParams(ctx, t).IssueFee.Amount,
+	})
+
+	issueMsg := &assetfttypes.MsgIssue{
+		Issuer:        issuer.String(),
+		Symbol:        "ABC",
+		Subunit:       "uabc",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(1000),
+		Features: []assetfttypes.Feature{
+			assetfttypes.Feature_minting,
+			assetfttypes.Feature_freezing,
+		},
+	}
+	_, err := client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(issueMsg)),
+		issueMsg,
+	)
+	requireT.NoError(err)
+	denom := assetfttypes.BuildDenom(issueMsg.Subunit, issuer)
+
+	sendMsg := &banktypes.MsgSend{
+		FromAddress: issuer.String(),
+		ToAddress:   recipient.String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(500))),
+	}
+	freezeMsg := &assetfttypes.MsgFreeze{
+		Sender:  issuer.String(),
+		Account: recipient.String(),
+		Coin:    sdk.NewCoin(denom, sdk.NewInt(500)),
+	}
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg, freezeMsg)),
+		sendMsg, freezeMsg,
+	)
+	requireT.NoError(err)
+
+	// schedule the mint and the unfreeze executed soon and the unfreeze executed in the far future
+	executionTime := time.Now().UTC().Add(10 * time.Second)
+	scheduleMintMsg := &assetfttypes.MsgScheduleMint{
+		Sender:        issuer.String(),
+		Coin:          sdk.NewCoin(denom, sdk.NewInt(100)),
+		ExecutionTime: executionTime,
+	}
+	scheduleUnfreezeMsg := &assetfttypes.MsgScheduleUnfreeze{
+		Sender:        issuer.String(),
+		Account:       recipient.String(),
+		Coin:          sdk.NewCoin(denom, sdk.NewInt(200)),
+		ExecutionTime: executionTime,
+	}
+	scheduleFarUnfreezeMsg := &assetfttypes.MsgScheduleUnfreeze{
+		Sender:        issuer.String(),
+		Account:       recipient.String(),
+		Coin:          sdk.NewCoin(denom, sdk.NewInt(300)),
+		ExecutionTime: executionTime.Add(time.Hour),
+	}
+	res, err := client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(scheduleMintMsg, scheduleUnfreezeMsg, scheduleFarUnfreezeMsg)),
+		scheduleMintMsg, scheduleUnfreezeMsg, scheduleFarUnfreezeMsg,
+	)
+	requireT.NoError(err)
+	requireT.EqualValues(chain.GasLimitByMsgs(scheduleMintMsg, scheduleUnfreezeMsg, scheduleFarUnfreezeMsg), res.GasUsed)
+
+	scheduledEvts, err := event.FindTypedEvents[*assetfttypes.EventActionScheduled](res.Events)
+	requireT.NoError(err)
+	requireT.Len(scheduledEvts, 3)
+
+	ftClient := assetfttypes.NewQueryClient(chain.ClientContext)
+	actionsRes, err := ftClient.ScheduledActions(ctx, &assetfttypes.QueryScheduledActionsRequest{
+		Account: recipient.String(),
+	})
+	requireT.NoError(err)
+	requireT.Len(actionsRes.ScheduledActions, 2)
+
+	// the scheduled actions are stored as delayed items
+	delayClient := delaytypes.NewQueryClient(chain.ClientContext)
+	delayedItemsRes, err := delayClient.DelayedItemsByID(ctx, &delaytypes.QueryDelayedItemsByIDRequest{
+		Id: assetfttypes.ScheduledActionDelayID(scheduledEvts[2].Id),
+	})
+	requireT.NoError(err)
+	requireT.Len(delayedItemsRes.DelayedItems, 1)
+	requireT.Equal(scheduleFarUnfreezeMsg.ExecutionTime.Unix(), delayedItemsRes.DelayedItems[0].ExecutionTime.Unix())
+
+	// cancel the far unfreeze
+	cancelMsg := &assetfttypes.MsgCancelScheduledAction{
+		Sender: issuer.String(),
+		Id:     scheduledEvts[2].Id,
+	}
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(cancelMsg)),
+		cancelMsg,
+	)
+	requireT.NoError(err)
+
+	// wait for the execution of the scheduled actions
+	time.Sleep(time.Until(executionTime) + 5*time.Second)
+
+	bankClient := banktypes.NewQueryClient(chain.ClientContext)
+	balanceRes, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{Address: issuer.String(), Denom: denom})
+	requireT.NoError(err)
+	requireT.Equal(sdk.NewInt(600).String(), balanceRes.Balance.Amount.String())
+
+	frozenRes, err := ftClient.FrozenBalance(ctx, &assetfttypes.QueryFrozenBalanceRequest{
+		Account: recipient.String(),
+		Denom:   denom,
+	})
+	requireT.NoError(err)
+	requireT.Equal(sdk.NewInt(300).String(), frozenRes.Balance.Amount.String())
+
+	_, err = delayClient.DelayedItemsByID(ctx, &delaytypes.QueryDelayedItemsByIDRequest{
+		Id: assetfttypes.ScheduledActionDelayID(scheduledEvts[2].Id),
+	})
+	requireT.Error(err)
+	requireT.Contains(err.Error(), sdkerrors.ErrNotFound.Error())
+
+	for _, account := range []sdk.AccAddress{issuer, recipient} {
+		actionsRes, err = ftClient.ScheduledActions(ctx, &assetfttypes.QueryScheduledActionsRequest{
+			Account: account.String(),
+		})
+		requireT.NoError(err)
+		requireT.Empty(actionsRes.ScheduledActions)
+	}
+}
+
+// TestAssetFTRateExemption tests that the burn rate and the send commission are not applied to the exempted accounts.
+func TestAssetFTRateExemption(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewCoreumTestingContext(t)
+
+	requireT := require.New(t)
+	issuer := chain.GenAccount()
+	user := chain.GenAccount()
+	exchange := chain.GenAccount()
+
+	chain.FundAccountWithOptions(ctx, t, issuer, integrationtests.BalancesOptions{
+		Messages: []sdk.Msg{
+			&assetfttypes.MsgIssue{},
+			&banktypes.MsgSend{},
+			&assetfttypes.MsgAddRateExemption{},
+			&assetfttypes.MsgRemoveRateExemption{},
+		},
+		Amount: chain.QueryAssetFTParams(ctx, t).IssueFee.Amount,
+	})
+	chain.FundAccountWithOptions(ctx, t, user, integrationtests.BalancesOptions{
+		Messages: []sdk.Msg{
+			&banktypes.MsgSend{},
+		},
+	})
+	chain.FundAccountWithOptions(ctx, t, exchange, integrationtests.BalancesOptions{
+		Messages: []sdk.Msg{
+			&banktypes.MsgSend{},
+			&banktypes.MsgSend{},
+		},
+	})
+
+	issueMsg := &assetfttypes.MsgIssue{
+		Issuer:             issuer.String(),
+		Symbol:             "ABC",
+		Subunit:            "uabc",
+		Precision:          6,
+		InitialAmount:      sdk.NewInt(1000),
+		BurnRate:           sdk.MustNewDecFromStr("0.1"),
+		SendCommissionRate: sdk.MustNewDecFromStr("0.2"),
+	}
+	_, err := client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(issueMsg)),
+		issueMsg,
+	)
+	requireT.NoError(err)
+	denom := assetfttypes.BuildDenom(issueMsg.Subunit, issuer)
+
+	sendMsg := &banktypes.MsgSend{
+		FromAddress: issuer.String(),
+		ToAddress:   user.String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(300))),
+	}
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+		sendMsg,
+	)
+	requireT.NoError(err)
+
+	addMsg := &assetfttypes.MsgAddRateExemption{
+		Sender:  issuer.String(),
+		Account: exchange.String(),
+		Denom:   denom,
+	}
+	res, err := client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(addMsg)),
+		addMsg,
+	)
+	requireT.NoError(err)
+	requireT.EqualValues(chain.GasLimitByMsgs(addMsg), res.GasUsed)
+
+	addedEvts, err := event.FindTypedEvents[*assetfttypes.EventRateExemptionAdded](res.Events)
+	requireT.NoError(err)
+	requireT.Equal(&assetfttypes.EventRateExemptionAdded{
+		Denom:   denom,
+		Account: exchange.String(),
+	}, addedEvts[0])
+
+	ftClient := assetfttypes.NewQueryClient(chain.ClientContext)
+	exemptionsRes, err := ftClient.RateExemptions(ctx, &assetfttypes.QueryRateExemptionsRequest{Denom: denom})
+	requireT.NoError(err)
+	requireT.Equal([]assetfttypes.RateExemption{{
+		Denom:   denom,
+		Account: exchange.String(),
+	}}, exemptionsRes.RateExemptions)
+
+	// the rates are not applied when sending to and from the exempted account
+	sendMsg = &banktypes.MsgSend{
+		FromAddress: user.String(),
+		ToAddress:   exchange.String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(200))),
+	}
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(user),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+		sendMsg,
+	)
+	requireT.NoError(err)
+
+	sendMsg = &banktypes.MsgSend{
+		FromAddress: exchange.String(),
+		ToAddress:   user.String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(50))),
+	}
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(exchange),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+		sendMsg,
+	)
+	requireT.NoError(err)
+
+	bankClient := banktypes.NewQueryClient(chain.ClientContext)
+	balanceRes, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{Address: user.String(), Denom: denom})
+	requireT.NoError(err)
+	requireT.Equal(sdk.NewInt(150).String(), balanceRes.Balance.Amount.String())
+	balanceRes, err = bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{Address: exchange.String(), Denom: denom})
+	requireT.NoError(err)
+	requireT.Equal(sdk.NewInt(150).String(), balanceRes.Balance.Amount.String())
+
+	// the rates are applied once the exemption is removed
+	removeMsg := &assetfttypes.MsgRemoveRateExemption{
+		Sender:  issuer.String(),
+		Account: exchange.String(),
+		Denom:   denom,
+	}
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(removeMsg)),
+		removeMsg,
+	)
+	requireT.NoError(err)
+
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(exchange),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+		sendMsg,
+	)
+	requireT.NoError(err)
+
+	balanceRes, err = bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{Address: exchange.String(), Denom: denom})
+	requireT.NoError(err)
+	requireT.Equal(sdk.NewInt(85).String(), balanceRes.Balance.Amount.String())
+
+	exemptionsRes, err = ftClient.RateExemptions(ctx, &assetfttypes.QueryRateExemptionsRequest{Denom: denom})
+	requireT.NoError(err)
+	requireT.Empty(exemptionsRes.RateExemptions)
+}
+
+// TestAssetFTRateRecipients checks that the send commission and the burn rate amount are distributed
+// between the rate recipients.
+func TestAssetFTRateRecipients(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewCoreumTestingContext(t)
+
+	requireT := require.New(t)
+	issuer := chain.GenAccount()
+	user := chain.GenAccount()
+	recipient := chain.GenAccount()
+	treasury := chain.GenAccount()
+	contract := chain.GenAccount()
+	pool := chain.GenAccount()
+
+	chain.FundAccountWithOptions(ctx, t, issuer, integrationtests.BalancesOptions{
+		Messages: []sdk.Msg{
+			&assetfttypes.MsgIssue{},
+			&banktypes.MsgSend{},
+			&assetfttypes.MsgUpdateRateRecipients{},
+		},
+		Amount: chain.QueryAssetFTParams(ctx, t).IssueFee.Amount,
+	})
+	chain.FundAccountWithOptions(ctx, t, user, integrationtests.BalancesOptions{
+		Messages: []sdk.Msg{
+			&banktypes.MsgSend{},
+			&banktypes.MsgSend{},
+		},
+	})
+
+	issueMsg := &assetfttypes.MsgIssue{
+		Issuer:             issuer.String(),
+		Symbol:             "ABC",
+		Subunit:            "uabc",
+		Precision:          6,
+		InitialAmount:      sdk.NewInt(1000),
+		BurnRate:           sdk.MustNewDecFromStr("0.1"),
+		SendCommissionRate: sdk.MustNewDecFromStr("0.2"),
+		SendCommissionRecipients: []assetfttypes.RateRecipient{
+			{Address: treasury.String(), Weight: 3},
+			{Address: contract.String(), Weight: 1},
+		},
+		BurnRateRecipients: []assetfttypes.RateRecipient{
+			{Address: pool.String(), Weight: 1},
+		},
+	}
+	_, err := client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(issueMsg)),
+		issueMsg,
+	)
+	requireT.NoError(err)
+	denom := assetfttypes.BuildDenom(issueMsg.Subunit, issuer)
+
+	ftClient := assetfttypes.NewQueryClient(chain.ClientContext)
+	tokenRes, err := ftClient.Token(ctx, &assetfttypes.QueryTokenRequest{Denom: denom})
+	requireT.NoError(err)
+	requireT.Equal(issueMsg.SendCommissionRecipients, tokenRes.Token.SendCommissionRecipients)
+	requireT.Equal(issueMsg.BurnRateRecipients, tokenRes.Token.BurnRateRecipients)
+
+	sendMsg := &banktypes.MsgSend{
+		FromAddress: issuer.String(),
+		ToAddress:   user.String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(300))),
+	}
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+		sendMsg,
+	)
+	requireT.NoError(err)
+
+	// the send commission is split between the recipients and the burn rate amount is redirected
+	sendMsg = &banktypes.MsgSend{
+		FromAddress: user.String(),
+		ToAddress:   recipient.String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100))),
+	}
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(user),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+		sendMsg,
+	)
+	requireT.NoError(err)
+
+	bankClient := banktypes.NewQueryClient(chain.ClientContext)
+	assertBalances := func(expected map[string]int64) {
+		for address, amount := range expected {
+			balanceRes, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{Address: address, Denom: denom})
+			requireT.NoError(err)
+			requireT.Equal(sdk.NewInt(amount).String(), balanceRes.Balance.Amount.String(), address)
+		}
+	}
+	assertBalances(map[string]int64{
+		user.String():      170,
+		recipient.String(): 100,
+		treasury.String():  15,
+		contract.String():  5,
+		pool.String():      10,
+		issuer.String():    700,
+	})
+
+	// the send commission goes to the admin again once the recipients are cleared
+	updateMsg := &assetfttypes.MsgUpdateRateRecipients{
+		Sender:             issuer.String(),
+		Denom:              denom,
+		BurnRateRecipients: issueMsg.BurnRateRecipients,
+	}
+	res, err := client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(updateMsg)),
+		updateMsg,
+	)
+	requireT.NoError(err)
+	requireT.EqualValues(chain.GasLimitByMsgs(updateMsg), res.GasUsed)
+
+	updatedEvts, err := event.FindTypedEvents[*assetfttypes.EventRateRecipientsUpdated](res.Events)
+	requireT.NoError(err)
+	requireT.Len(updatedEvts, 1)
+	requireT.Equal(denom, updatedEvts[0].Denom)
+	requireT.Empty(updatedEvts[0].SendCommissionRecipients)
+	requireT.Equal(issueMsg.BurnRateRecipients, updatedEvts[0].BurnRateRecipients)
+
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(user),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+		sendMsg,
+	)
+	requireT.NoError(err)
+
+	assertBalances(map[string]int64{
+		user.String():      40,
+		recipient.String(): 200,
+		treasury.String():  15,
+		contract.String():  5,
+		pool.String():      20,
+		issuer.String():    720,
+	})
+}
+
+// TestAssetFTTransferLimits checks that the transfer limits are applied to the amounts sent by the accounts.
+func TestAssetFTTransferLimits(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewCoreumTestingContext(t)
+
+	requireT := require.New(t)
+	issuer := chain.GenAccount()
+	user := chain.GenAccount()
+	recipient := chain.GenAccount()
+
+	chain.FundAccountWithOptions(ctx, t, issuer, integrationtests.BalancesOptions{
+		Messages: []sdk.Msg{
+			&assetfttypes.MsgIssue{},
+			&assetfttypes.MsgSetTransferLimits{},
+			&banktypes.MsgSend{},
+		},
+		Amount: chain.QueryAssetFTParams(ctx, t).IssueFee.Amount,
+	})
+	chain.FundAccountWithOptions(ctx, t, user, integrationtests.BalancesOptions{
+		Messages: []sdk.Msg{
+			&assetfttypes.MsgSetTransferLimits{},
+			&banktypes.MsgSend{},
+			&banktypes.MsgSend{},
+			&banktypes.MsgSend{},
+		},
+	})
+
+	issueMsg := &assetfttypes.MsgIssue{
+		Issuer:        issuer.String(),
+		Symbol:        "ABC",
+		Subunit:       "uabc",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(1000),
+		Features: []assetfttypes.Feature{
+			assetfttypes.Feature_transfer_limiting,
+		},
+	}
+	_, err := client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(issueMsg)),
+		issueMsg,
+	)
+	requireT.NoError(err)
+	denom := assetfttypes.BuildDenom(issueMsg.Subunit, issuer)
+
+	// only admin can set the limits
+	setLimitsMsg := &assetfttypes.MsgSetTransferLimits{
+		Sender:                user.String(),
+		Denom:                 denom,
+		MaxTransferAmount:     sdk.NewInt(60),
+		MaxAccountDailyAmount: sdk.NewInt(100),
+		MaxTokenDailyAmount:   sdk.ZeroInt(),
+	}
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(user),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(setLimitsMsg)),
+		setLimitsMsg,
+	)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	setLimitsMsg.Sender = issuer.String()
+	res, err := client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(setLimitsMsg)),
+		setLimitsMsg,
+	)
+	requireT.NoError(err)
+	requireT.EqualValues(chain.GasLimitByMsgs(setLimitsMsg), res.GasUsed)
+
+	setEvts, err := event.FindTypedEvents[*assetfttypes.EventTransferLimitsSet](res.Events)
+	requireT.NoError(err)
+	requireT.Len(setEvts, 1)
+	requireT.Equal(denom, setEvts[0].Denom)
+	requireT.Equal(setLimitsMsg.MaxAccountDailyAmount.String(), setEvts[0].MaxAccountDailyAmount.String())
+
+	// the admin is not limited
+	sendMsg := &banktypes.MsgSend{
+		FromAddress: issuer.String(),
+		ToAddress:   user.String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(300))),
+	}
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+		sendMsg,
+	)
+	requireT.NoError(err)
+
+	// single transfer can't exceed the max transfer amount
+	sendMsg = &banktypes.MsgSend{
+		FromAddress: user.String(),
+		ToAddress:   recipient.String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(61))),
+	}
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(user),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+		sendMsg,
+	)
+	requireT.True(assetfttypes.ErrTransferLimitExceeded.Is(err))
+
+	sendMsg.Amount = sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(60)))
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(user),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+		sendMsg,
+	)
+	requireT.NoError(err)
+
+	// the account daily amount is reached
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(user),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+		sendMsg,
+	)
+	requireT.True(assetfttypes.ErrTransferLimitExceeded.Is(err))
+
+	ftClient := assetfttypes.NewQueryClient(chain.ClientContext)
+	limitsRes, err := ftClient.TransferLimits(ctx, &assetfttypes.QueryTransferLimitsRequest{Denom: denom})
+	requireT.NoError(err)
+	requireT.Equal(setLimitsMsg.MaxTransferAmount.String(), limitsRes.TransferLimits.MaxTransferAmount.String())
+	requireT.Equal(setLimitsMsg.MaxAccountDailyAmount.String(), limitsRes.TransferLimits.MaxAccountDailyAmount.String())
+	requireT.Equal("0", limitsRes.TransferLimits.MaxTokenDailyAmount.String())
+	requireT.Equal("0", limitsRes.TokenDailyVolume.String())
+
+	volumeRes, err := ftClient.AccountTransferVolume(ctx, &assetfttypes.QueryAccountTransferVolumeRequest{
+		Account: user.String(),
+		Denom:   denom,
+	})
+	requireT.NoError(err)
+	requireT.Equal("60", volumeRes.DailyVolume.String())
+}
+
+// TestAssetFTMaxSupply tests the max supply and the supply lock of the token.
+func TestAssetFTMaxSupply(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewCoreumTestingContext(t)
+
+	requireT := require.New(t)
+	issuer := chain.GenAccount()
+	user := chain.GenAccount()
+
+	chain.FundAccountWithOptions(ctx, t, issuer, integrationtests.BalancesOptions{
+		Messages: []sdk.Msg{
+			&assetfttypes.MsgIssue{},
+			&assetfttypes.MsgMint{},
+			&assetfttypes.MsgMint{},
+			&assetfttypes.MsgLockSupply{},
+			&assetfttypes.MsgMint{},
+		},
+		Amount: chain.QueryAssetFTParams(ctx, t).IssueFee.Amount,
+	})
+	chain.FundAccountWithOptions(ctx, t, user, integrationtests.BalancesOptions{
+		Messages: []sdk.Msg{
+			&assetfttypes.MsgLockSupply{},
+		},
+	})
+
+	issueMsg := &assetfttypes.MsgIssue{
+		Issuer:        issuer.String(),
+		Symbol:        "ABC",
+		Subunit:       "uabc",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(1000),
+		MaxSupply:     sdk.NewInt(1500),
+		Features: []assetfttypes.Feature{
+			assetfttypes.Feature_minting,
+		},
+	}
+	_, err := client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(issueMsg)),
+		issueMsg,
+	)
+	requireT.NoError(err)
+	denom := assetfttypes.BuildDenom(issueMsg.Subunit, issuer)
+
+	ftClient := assetfttypes.NewQueryClient(chain.ClientContext)
+	tokenRes, err := ftClient.Token(ctx, &assetfttypes.QueryTokenRequest{Denom: denom})
+	requireT.NoError(err)
+	requireT.Equal(issueMsg.MaxSupply.String(), tokenRes.Token.MaxSupply.String())
+	requireT.NotNil(tokenRes.RemainingMintableAmount)
+	requireT.Equal("500", tokenRes.RemainingMintableAmount.String())
+
+	// mint above the max supply
+	mintMsg := &assetfttypes.MsgMint{
+		Sender: issuer.String(),
+		Coin:   sdk.NewCoin(denom, sdk.NewInt(501)),
+	}
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(mintMsg)),
+		mintMsg,
+	)
+	requireT.True(assetfttypes.ErrMaxSupplyExceeded.Is(err))
+
+	mintMsg.Coin = sdk.NewCoin(denom, sdk.NewInt(200))
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(mintMsg)),
+		mintMsg,
+	)
+	requireT.NoError(err)
+
+	// only admin can lock the supply
+	lockMsg := &assetfttypes.MsgLockSupply{
+		Sender: user.String(),
+		Denom:  denom,
+	}
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(user),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(lockMsg)),
+		lockMsg,
+	)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	lockMsg.Sender = issuer.String()
+	res, err := client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(lockMsg)),
+		lockMsg,
+	)
+	requireT.NoError(err)
+	requireT.EqualValues(chain.GasLimitByMsgs(lockMsg), res.GasUsed)
+
+	lockedEvts, err := event.FindTypedEvents[*assetfttypes.EventSupplyLocked](res.Events)
+	requireT.NoError(err)
+	requireT.Len(lockedEvts, 1)
+	requireT.Equal(denom, lockedEvts[0].Denom)
+	requireT.Equal("1200", lockedEvts[0].Supply.String())
+
+	tokenRes, err = ftClient.Token(ctx, &assetfttypes.QueryTokenRequest{Denom: denom})
+	requireT.NoError(err)
+	requireT.True(tokenRes.Token.SupplyLocked)
+	requireT.NotNil(tokenRes.RemainingMintableAmount)
+	requireT.Equal("0", tokenRes.RemainingMintableAmount.String())
+
+	// minting is not possible after the lock
+	mintMsg.Coin = sdk.NewCoin(denom, sdk.NewInt(1))
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(mintMsg)),
+		mintMsg,
+	)
+	requireT.True(assetfttypes.ErrSupplyLocked.Is(err))
+}
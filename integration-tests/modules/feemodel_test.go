@@ -7,6 +7,10 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 	"github.com/stretchr/testify/assert"
@@ -15,6 +19,7 @@ import (
 
 	integrationtests "github.com/CoreumFoundation/coreum/v2/integration-tests"
 	"github.com/CoreumFoundation/coreum/v2/pkg/client"
+	assetfttypes "github.com/CoreumFoundation/coreum/v2/x/asset/ft/types"
 	feemodeltypes "github.com/CoreumFoundation/coreum/v2/x/feemodel/types"
 )
 
@@ -62,6 +67,97 @@ func TestFeeModelQueryingGasPriceRecommendation(t *testing.T) {
 	requireT.LessOrEqual(res.GetMed().Amount.MustFloat64(), res.GetHigh().Amount.MustFloat64())
 }
 
+// TestFeeModelQueryingGasPriceHistory checks that the gas price history of the latest blocks is queried correctly.
+func TestFeeModelQueryingGasPriceHistory(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewCoreumTestingContext(t)
+	requireT := require.New(t)
+
+	feemodelClient := feemodeltypes.NewQueryClient(chain.ClientContext)
+	res, err := feemodelClient.GasPriceHistory(ctx, &feemodeltypes.QueryGasPriceHistoryRequest{
+		Pagination: &query.PageRequest{
+			Limit:   10,
+			Reverse: true,
+		},
+	})
+	requireT.NoError(err)
+	requireT.Len(res.Entries, 10)
+
+	model := feemodeltypes.NewModel(getFeemodelParams(ctx, t, chain.ClientContext))
+	for i, entry := range res.Entries {
+		if i > 0 {
+			requireT.Equal(res.Entries[i-1].Height-1, entry.Height)
+		}
+		requireT.Equal(chain.ChainSettings.Denom, entry.MinGasPrice.Denom)
+		requireT.True(entry.MinGasPrice.Amount.GTE(model.CalculateGasPriceWithMaxDiscount()))
+		requireT.True(entry.MinGasPrice.Amount.LTE(model.CalculateMaxGasPrice()))
+		requireT.GreaterOrEqual(entry.TrackedGas, int64(0))
+		requireT.GreaterOrEqual(entry.ShortEMAGas, int64(0))
+		requireT.GreaterOrEqual(entry.LongEMAGas, int64(0))
+	}
+}
+
+// TestFeeModelGasPriceForecast checks that the gas price forecast is queried correctly and used to broadcast
+// the transaction.
+func TestFeeModelGasPriceForecast(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewCoreumTestingContext(t)
+	requireT := require.New(t)
+
+	feemodelClient := feemodeltypes.NewQueryClient(chain.ClientContext)
+	minGasPriceRes, err := feemodelClient.MinGasPrice(ctx, &feemodeltypes.QueryMinGasPriceRequest{})
+	requireT.NoError(err)
+
+	res, err := feemodelClient.GasPriceForecast(ctx, &feemodeltypes.QueryGasPriceForecastRequest{
+		AfterBlocks:  10,
+		Price:        minGasPriceRes.MinGasPrice.Amount.String(),
+		WithinBlocks: 5,
+	})
+	requireT.NoError(err)
+	requireT.NotEmpty(res.Percentiles)
+	requireT.Len(res.AcceptancePercentiles, len(res.Percentiles))
+
+	model := feemodeltypes.NewModel(getFeemodelParams(ctx, t, chain.ClientContext))
+	for i, percentile := range res.Percentiles {
+		if i > 0 {
+			requireT.True(percentile.MinGasPrice.Amount.GTE(res.Percentiles[i-1].MinGasPrice.Amount))
+		}
+		requireT.Equal(chain.ChainSettings.Denom, percentile.MinGasPrice.Denom)
+		requireT.True(percentile.MinGasPrice.Amount.GTE(model.CalculateGasPriceWithMaxDiscount()))
+		requireT.True(percentile.MinGasPrice.Amount.LTE(model.CalculateMaxGasPrice()))
+	}
+	requireT.NotNil(res.AcceptanceProbability)
+	requireT.False(res.AcceptanceProbability.IsNegative())
+	requireT.True(res.AcceptanceProbability.LTE(sdk.OneDec()))
+
+	// broadcast the transaction using the gas price picked for the urgency
+	sender := chain.GenAccount()
+	sendMsg := &banktypes.MsgSend{
+		FromAddress: sender.String(),
+		ToAddress:   sender.String(),
+		Amount:      sdk.NewCoins(chain.NewCoin(sdk.NewInt(1))),
+	}
+	clientCtx := chain.ClientContext.
+		WithFromAddress(sender).
+		WithGasPriceUrgency(client.GasPriceUrgencyHigh)
+	gasPrice, err := client.GetGasPriceForUrgency(ctx, clientCtx, client.GasPriceUrgencyHigh)
+	requireT.NoError(err)
+	requireT.Equal(chain.ChainSettings.Denom, gasPrice.Denom)
+	requireT.True(gasPrice.Amount.GTE(minGasPriceRes.MinGasPrice.Amount))
+
+	gas := chain.GasLimitByMsgs(sendMsg)
+	chain.FundAccountWithOptions(ctx, t, sender, integrationtests.BalancesOptions{
+		Messages: []sdk.Msg{sendMsg},
+		// the forecast might go up until the transaction is broadcast
+		Amount: gasPrice.Amount.MulInt64(int64(gas)).Ceil().TruncateInt().MulRaw(2).AddRaw(1),
+	})
+
+	_, err = client.BroadcastTx(ctx, clientCtx, chain.TxFactory().WithGas(gas), sendMsg)
+	requireT.NoError(err)
+}
+
 // TestFeeModelProposalParamChange checks that feemodel param change proposal works correctly.
 func TestFeeModelProposalParamChange(t *testing.T) {
 	t.Parallel()
@@ -138,6 +234,249 @@ func TestFeeModelProposalParamChange(t *testing.T) {
 	requireT.Equal(feeModelParams.String(), feeModelParamsRes.Params.Model.String())
 }
 
+// TestFeeModelAlternateFeeDenom checks that the fee might be paid in the alternate fee denom approved by the governance.
+func TestFeeModelAlternateFeeDenom(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewCoreumTestingContext(t)
+
+	requireT := require.New(t)
+	feeModelClient := feemodeltypes.NewQueryClient(chain.ClientContext)
+	bankClient := banktypes.NewQueryClient(chain.ClientContext)
+
+	issuer := chain.GenAccount()
+	chain.FundAccountWithOptions(ctx, t, issuer, integrationtests.BalancesOptions{
+		Messages: []sdk.Msg{
+			&assetfttypes.MsgIssue{},
+			&assetfttypes.MsgIssue{},
+		},
+		Amount: chain.QueryAssetFTParams(ctx, t).IssueFee.Amount.MulRaw(2),
+	})
+
+	issueMsg := &assetfttypes.MsgIssue{
+		Issuer:        issuer.String(),
+		Symbol:        "FEE",
+		Subunit:       "ufee",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(100_000_000),
+	}
+	// the token with features can't be used to pay the fee even if it is approved
+	issueFreezableMsg := &assetfttypes.MsgIssue{
+		Issuer:        issuer.String(),
+		Symbol:        "FREEZABLE",
+		Subunit:       "ufreezable",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(100_000_000),
+		Features:      []assetfttypes.Feature{assetfttypes.Feature_freezing},
+	}
+	_, err := client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(issueMsg, issueFreezableMsg)),
+		issueMsg, issueFreezableMsg,
+	)
+	requireT.NoError(err)
+	denom := assetfttypes.BuildDenom(issueMsg.Subunit, issuer)
+	freezableDenom := assetfttypes.BuildDenom(issueFreezableMsg.Subunit, issuer)
+
+	msg := &banktypes.MsgSend{
+		FromAddress: issuer.String(),
+		ToAddress:   issuer.String(),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin(denom, 1)),
+	}
+
+	// the fee paid in the denom is rejected before it is approved
+	conversionRate := sdk.MustNewDecFromStr("0.5")
+	gasPrice := chain.ChainSettings.GasPrice.Quo(conversionRate)
+	_, err = client.BroadcastTx(ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().
+			WithGas(chain.GasLimitByMsgs(msg)).
+			WithGasPrices(sdk.NewDecCoinFromDec(denom, gasPrice).String()),
+		msg)
+	requireT.Error(err)
+	requireT.True(sdkerrors.ErrInvalidCoins.Is(err))
+
+	// approve the denom
+	proposer := chain.GenAccount()
+	proposerBalance, err := chain.Governance.ComputeProposerBalance(ctx)
+	requireT.NoError(err)
+	chain.Faucet.FundAccounts(ctx, t, integrationtests.NewFundedAccount(proposer, proposerBalance))
+
+	feeModelParamsRes, err := feeModelClient.Params(ctx, &feemodeltypes.QueryParamsRequest{})
+	requireT.NoError(err)
+	feeDenoms := append(feeModelParamsRes.Params.FeeDenoms, feemodeltypes.FeeDenom{
+		Denom:          denom,
+		ConversionRate: conversionRate,
+	}, feemodeltypes.FeeDenom{
+		Denom:          freezableDenom,
+		ConversionRate: conversionRate,
+	})
+	feeDenomsStr, err := tmjson.Marshal(feeDenoms)
+	requireT.NoError(err)
+	proposalMsg, err := chain.Governance.NewMsgSubmitProposal(
+		ctx, proposer, paramproposal.NewParameterChangeProposal("Approve fee denom", "-",
+			[]paramproposal.ParamChange{
+				paramproposal.NewParamChange(
+					feemodeltypes.ModuleName, string(feemodeltypes.KeyFeeDenoms), string(feeDenomsStr),
+				),
+			},
+		))
+	requireT.NoError(err)
+	proposalID, err := chain.Governance.Propose(ctx, t, proposalMsg)
+	requireT.NoError(err)
+	requireT.NoError(chain.Governance.VoteAll(ctx, govtypes.OptionYes, proposalID))
+	finalStatus, err := chain.Governance.WaitForVotingToFinalize(ctx, proposalID)
+	requireT.NoError(err)
+	requireT.Equal(govtypes.StatusPassed, finalStatus)
+
+	feeModelParamsRes, err = feeModelClient.Params(ctx, &feemodeltypes.QueryParamsRequest{})
+	requireT.NoError(err)
+	rate, ok := feeModelParamsRes.Params.FeeDenomConversionRate(denom)
+	requireT.True(ok)
+	requireT.Equal(conversionRate.String(), rate.String())
+
+	_, err = client.BroadcastTx(ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().
+			WithGas(chain.GasLimitByMsgs(msg)).
+			WithGasPrices(sdk.NewDecCoinFromDec(freezableDenom, gasPrice).String()),
+		msg)
+	requireT.Error(err)
+	requireT.True(sdkerrors.ErrInvalidCoins.Is(err))
+
+	// the fee lower than required after the conversion is rejected
+	_, err = client.BroadcastTx(ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().
+			WithGas(chain.GasLimitByMsgs(msg)).
+			WithGasPrices(sdk.NewDecCoinFromDec(denom, chain.ChainSettings.GasPrice.QuoInt64(4)).String()),
+		msg)
+	requireT.Error(err)
+	requireT.True(sdkerrors.ErrInsufficientFee.Is(err))
+
+	// the fee paid in the approved denom is accepted
+	balanceBefore, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{
+		Address: issuer.String(),
+		Denom:   denom,
+	})
+	requireT.NoError(err)
+
+	txf := chain.TxFactory().
+		WithGas(chain.GasLimitByMsgs(msg)).
+		WithGasPrices(sdk.NewDecCoinFromDec(denom, gasPrice).String())
+	_, err = client.BroadcastTx(ctx, chain.ClientContext.WithFromAddress(issuer), txf, msg)
+	requireT.NoError(err)
+
+	balanceAfter, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{
+		Address: issuer.String(),
+		Denom:   denom,
+	})
+	requireT.NoError(err)
+	expectedFee := gasPrice.MulInt64(int64(txf.Gas())).Ceil().TruncateInt()
+	requireT.Equal(balanceBefore.Balance.Amount.Sub(expectedFee).String(), balanceAfter.Balance.Amount.String())
+}
+
+// TestFeeModelMsgGasPriceMultiplier checks that the gas price multiplier of the message type is applied.
+func TestFeeModelMsgGasPriceMultiplier(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewCoreumTestingContext(t)
+
+	requireT := require.New(t)
+	feeModelClient := feemodeltypes.NewQueryClient(chain.ClientContext)
+
+	sender := chain.GenAccount()
+	setWithdrawAddressMsg := &distributiontypes.MsgSetWithdrawAddress{
+		DelegatorAddress: sender.String(),
+		WithdrawAddress:  sender.String(),
+	}
+	sendMsg := &banktypes.MsgSend{
+		FromAddress: sender.String(),
+		ToAddress:   sender.String(),
+		Amount:      sdk.NewCoins(chain.NewCoin(sdk.NewInt(1))),
+	}
+	chain.FundAccountWithOptions(ctx, t, sender, integrationtests.BalancesOptions{
+		Messages: []sdk.Msg{
+			setWithdrawAddressMsg,
+			sendMsg,
+		},
+		Amount: sdk.NewInt(1),
+	})
+
+	// discount the message type
+	msgURL := sdk.MsgTypeURL(setWithdrawAddressMsg)
+	multiplier := sdk.MustNewDecFromStr("0.5")
+
+	proposer := chain.GenAccount()
+	proposerBalance, err := chain.Governance.ComputeProposerBalance(ctx)
+	requireT.NoError(err)
+	chain.Faucet.FundAccounts(ctx, t, integrationtests.NewFundedAccount(proposer, proposerBalance))
+
+	multipliersStr, err := tmjson.Marshal([]feemodeltypes.MsgGasPriceMultiplier{
+		{
+			MsgURL:     msgURL,
+			Multiplier: multiplier,
+		},
+	})
+	requireT.NoError(err)
+	proposalMsg, err := chain.Governance.NewMsgSubmitProposal(
+		ctx, proposer, paramproposal.NewParameterChangeProposal("Discount MsgSetWithdrawAddress", "-",
+			[]paramproposal.ParamChange{
+				paramproposal.NewParamChange(
+					feemodeltypes.ModuleName, string(feemodeltypes.KeyMsgGasPriceMultipliers), string(multipliersStr),
+				),
+			},
+		))
+	requireT.NoError(err)
+	proposalID, err := chain.Governance.Propose(ctx, t, proposalMsg)
+	requireT.NoError(err)
+	requireT.NoError(chain.Governance.VoteAll(ctx, govtypes.OptionYes, proposalID))
+	finalStatus, err := chain.Governance.WaitForVotingToFinalize(ctx, proposalID)
+	requireT.NoError(err)
+	requireT.Equal(govtypes.StatusPassed, finalStatus)
+
+	msgMinGasPriceRes, err := feeModelClient.MsgMinGasPrice(ctx, &feemodeltypes.QueryMsgMinGasPriceRequest{
+		MsgURL: msgURL,
+	})
+	requireT.NoError(err)
+	requireT.Equal(multiplier.String(), msgMinGasPriceRes.Multiplier.String())
+
+	feeModel := getFeemodelParams(ctx, t, chain.ClientContext)
+	discountedGasPrice := chain.ChainSettings.GasPrice.Mul(multiplier)
+	// the gas price lower than the min gas price of not discounted message
+	lowGasPrice := feeModel.InitialGasPrice.Mul(sdk.OneDec().Sub(feeModel.MaxDiscount)).Mul(multiplier)
+
+	// not discounted message still requires the full gas price
+	_, err = client.BroadcastTx(ctx,
+		chain.ClientContext.WithFromAddress(sender),
+		chain.TxFactory().
+			WithGas(chain.GasLimitByMsgs(sendMsg)).
+			WithGasPrices(chain.NewDecCoin(lowGasPrice).String()),
+		sendMsg)
+	requireT.Error(err)
+	requireT.True(sdkerrors.ErrInsufficientFee.Is(err))
+
+	// the highest multiplier is applied if the tx contains both messages
+	_, err = client.BroadcastTx(ctx,
+		chain.ClientContext.WithFromAddress(sender),
+		chain.TxFactory().
+			WithGas(chain.GasLimitByMsgs(setWithdrawAddressMsg, sendMsg)).
+			WithGasPrices(chain.NewDecCoin(lowGasPrice).String()),
+		setWithdrawAddressMsg, sendMsg)
+	requireT.Error(err)
+	requireT.True(sdkerrors.ErrInsufficientFee.Is(err))
+
+	// discounted message is accepted with the discounted gas price
+	_, err = client.BroadcastTx(ctx,
+		chain.ClientContext.WithFromAddress(sender),
+		chain.TxFactory().
+			WithGas(chain.GasLimitByMsgs(setWithdrawAddressMsg)).
+			WithGasPrices(chain.NewDecCoin(discountedGasPrice).String()),
+		setWithdrawAddressMsg)
+	requireT.NoError(err)
+}
+
 func marshalParamChangeProposal(requireT *require.Assertions, modelParams feemodeltypes.ModelParams) string {
 	str, err := tmjson.Marshal(modelParams)
 	requireT.NoError(err)
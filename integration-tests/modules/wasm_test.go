@@ -780,6 +780,7 @@ func TestWASMFungibleTokenInContract(t *testing.T) {
 		BurnRate:           burnRate,
 		SendCommissionRate: sendCommissionRate,
 		Version:            assetfttypes.CurrentTokenVersion, // test should work with any token version
+		Admin:              contractAddr,
 	}
 	requireT.Equal(
 		expectedToken, tokenRes.Token,
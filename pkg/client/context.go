@@ -53,10 +53,28 @@ type TimeoutConfig struct {
 	TxNextBlocksPollInterval time.Duration
 }
 
+// GasPriceUrgency defines how fast the transaction should be accepted by the network. If set and the gas price is not
+// set explicitly, the gas price is picked from the gas price forecast and multiplied by the gas price adjustment.
+type GasPriceUrgency int
+
+// Gas price urgency levels.
+const (
+	// GasPriceUrgencyNone disables the forecast, the current minimum gas price multiplied by the gas price adjustment
+	// is used.
+	GasPriceUrgencyNone GasPriceUrgency = iota
+	// GasPriceUrgencyLow picks the gas price accepted within the next blocks with the probability of 50%.
+	GasPriceUrgencyLow
+	// GasPriceUrgencyMedium picks the gas price accepted within the next blocks with the probability of 75%.
+	GasPriceUrgencyMedium
+	// GasPriceUrgencyHigh picks the gas price accepted within the next blocks with the probability of 90%.
+	GasPriceUrgencyHigh
+)
+
 // GasConfig is the part of context config holding gas parameters.
 type GasConfig struct {
 	GasAdjustment      float64
 	GasPriceAdjustment sdk.Dec
+	GasPriceUrgency    GasPriceUrgency
 }
 
 // DefaultContextConfig returns default context config.
@@ -123,6 +141,11 @@ func (c Context) GasPriceAdjustment() sdk.Dec {
 	return c.config.GasConfig.GasPriceAdjustment
 }
 
+// GasPriceUrgency returns gas price urgency.
+func (c Context) GasPriceUrgency() GasPriceUrgency {
+	return c.config.GasConfig.GasPriceUrgency
+}
+
 // WithGasAdjustment returns context with new gas adjustment.
 func (c Context) WithGasAdjustment(adj float64) Context {
 	c.config.GasConfig.GasAdjustment = adj
@@ -135,6 +158,12 @@ func (c Context) WithGasPriceAdjustment(adj sdk.Dec) Context {
 	return c
 }
 
+// WithGasPriceUrgency returns context with new gas price urgency.
+func (c Context) WithGasPriceUrgency(urgency GasPriceUrgency) Context {
+	c.config.GasConfig.GasPriceUrgency = urgency
+	return c
+}
+
 // WithRPCClient returns a copy of the context with an updated RPC client
 // instance.
 func (c Context) WithRPCClient(client rpcclient.Client) Context {
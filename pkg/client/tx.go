@@ -32,6 +32,18 @@ import (
 	feemodeltypes "github.com/CoreumFoundation/coreum/v2/x/feemodel/types"
 )
 
+// gasPriceForecastBlocks is the number of blocks within which the transaction should be accepted if the gas price
+// urgency is set.
+const gasPriceForecastBlocks = 10
+
+// gasPriceUrgencyPercentiles are the acceptance percentiles of the gas price forecast picked for the gas price urgency
+// levels.
+var gasPriceUrgencyPercentiles = map[GasPriceUrgency]uint32{
+	GasPriceUrgencyLow:    50,
+	GasPriceUrgencyMedium: 75,
+	GasPriceUrgencyHigh:   90,
+}
+
 // Factory is a re-export of the cosmos sdk tx.Factory type, to make usage of this package more convenient.
 // It will help users by removing the need to import tx package from cosmos sdk and help avoid package name collision.
 type Factory = tx.Factory
@@ -56,14 +68,25 @@ func BroadcastTx(ctx context.Context, clientCtx Context, txf Factory, msgs ...sd
 		return nil, err
 	}
 
-	if txf.SimulateAndExecute() {
-		gasPrice, err := GetGasPrice(ctx, clientCtx)
+	switch urgency := clientCtx.GasPriceUrgency(); {
+	case urgency != GasPriceUrgencyNone && txf.GasPrices().IsZero() && txf.Fees().IsZero():
+		// the urgency is taken into account only if the gas price or the fee is not set explicitly
+		gasPrice, err := GetGasPriceForUrgency(ctx, clientCtx, urgency, msgs...)
+		if err != nil {
+			return nil, err
+		}
+		gasPrice.Amount = gasPrice.Amount.Mul(clientCtx.GasPriceAdjustment())
+		txf = txf.WithGasPrices(gasPrice.String())
+	case txf.SimulateAndExecute():
+		gasPrice, err := GetGasPrice(ctx, clientCtx, msgs...)
 		if err != nil {
 			return nil, err
 		}
 		gasPrice.Amount = gasPrice.Amount.Mul(clientCtx.GasPriceAdjustment())
 		txf = txf.WithGasPrices(gasPrice.String())
+	}
 
+	if txf.SimulateAndExecute() {
 		_, adjusted, err := CalculateGas(ctx, clientCtx, txf, msgs...)
 		if err != nil {
 			return nil, err
@@ -312,10 +335,12 @@ func AwaitNextBlocks(
 	})
 }
 
-// GetGasPrice returns the current gas price of the chain.
+// GetGasPrice returns the current gas price of the chain. If the messages are passed, the gas price multiplier
+// applied by the chain to the transaction containing them is taken into account.
 func GetGasPrice(
 	ctx context.Context,
 	clientCtx Context,
+	msgs ...sdk.Msg,
 ) (sdk.DecCoin, error) {
 	feeQueryClient := feemodeltypes.NewQueryClient(clientCtx)
 	res, err := feeQueryClient.MinGasPrice(ctx, &feemodeltypes.QueryMinGasPriceRequest{})
@@ -323,7 +348,76 @@ func GetGasPrice(
 		return sdk.DecCoin{}, errors.WithStack(err)
 	}
 
-	return res.GetMinGasPrice(), nil
+	return applyGasPriceMultiplier(ctx, clientCtx, res.GetMinGasPrice(), msgs)
+}
+
+// GetGasPriceForUrgency returns the gas price accepted within the next blocks with the probability picked for
+// the urgency level, based on the gas price forecast. The price is never lower than the current gas price of the chain,
+// because otherwise the transaction is rejected by the mempool. If the messages are passed, the gas price multiplier applied
+// by the chain to the transaction containing them is taken into account.
+func GetGasPriceForUrgency(
+	ctx context.Context,
+	clientCtx Context,
+	urgency GasPriceUrgency,
+	msgs ...sdk.Msg,
+) (sdk.DecCoin, error) {
+	percentile, ok := gasPriceUrgencyPercentiles[urgency]
+	if !ok {
+		return sdk.DecCoin{}, errors.Errorf("unknown gas price urgency %d", urgency)
+	}
+
+	minGasPrice, err := GetGasPrice(ctx, clientCtx)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	feeQueryClient := feemodeltypes.NewQueryClient(clientCtx)
+	res, err := feeQueryClient.GasPriceForecast(ctx, &feemodeltypes.QueryGasPriceForecastRequest{
+		AfterBlocks:  gasPriceForecastBlocks,
+		WithinBlocks: gasPriceForecastBlocks,
+	})
+	if err != nil {
+		return sdk.DecCoin{}, errors.WithStack(err)
+	}
+
+	for _, forecast := range res.AcceptancePercentiles {
+		if forecast.Percentile != percentile {
+			continue
+		}
+		gasPrice := minGasPrice
+		if forecast.MinGasPrice.Amount.GT(minGasPrice.Amount) {
+			gasPrice = forecast.MinGasPrice
+		}
+		return applyGasPriceMultiplier(ctx, clientCtx, gasPrice, msgs)
+	}
+
+	return sdk.DecCoin{}, errors.Errorf("percentile %d is missing in the gas price forecast", percentile)
+}
+
+// applyGasPriceMultiplier applies the gas price multiplier set for the messages by the fee model params.
+func applyGasPriceMultiplier(
+	ctx context.Context,
+	clientCtx Context,
+	gasPrice sdk.DecCoin,
+	msgs []sdk.Msg,
+) (sdk.DecCoin, error) {
+	if len(msgs) == 0 {
+		return gasPrice, nil
+	}
+
+	feeQueryClient := feemodeltypes.NewQueryClient(clientCtx)
+	res, err := feeQueryClient.Params(ctx, &feemodeltypes.QueryParamsRequest{})
+	if err != nil {
+		return sdk.DecCoin{}, errors.WithStack(err)
+	}
+
+	multiplier, err := res.Params.MsgsGasPriceMultiplier(msgs)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+	gasPrice.Amount = gasPrice.Amount.Mul(multiplier)
+
+	return gasPrice, nil
 }
 
 func broadcastTxAsync(ctx context.Context, clientCtx Context, txBytes []byte) (*sdk.TxResponse, error) {
@@ -25,12 +25,19 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(CmdQueryToken())
 	cmd.AddCommand(CmdQueryTokens())
+	cmd.AddCommand(CmdQueryAdminTokens())
 	cmd.AddCommand(CmdTokenUpgradeStatuses())
 	cmd.AddCommand(CmdQueryBalance())
 	cmd.AddCommand(CmdQueryFrozenBalance())
 	cmd.AddCommand(CmdQueryFrozenBalances())
 	cmd.AddCommand(CmdQueryWhitelistedBalance())
 	cmd.AddCommand(CmdQueryWhitelistedBalances())
+	cmd.AddCommand(CmdQueryRoles())
+	cmd.AddCommand(CmdQueryAccountRoles())
+	cmd.AddCommand(CmdQueryRateExemptions())
+	cmd.AddCommand(CmdQueryTransferLimits())
+	cmd.AddCommand(CmdQueryTransferVolume())
+	cmd.AddCommand(CmdQueryScheduledActions())
 	cmd.AddCommand(CmdQueryParams())
 
 	return cmd
@@ -81,6 +88,51 @@ $ %[1]s query %s tokens [issuer]
 	return cmd
 }
 
+// CmdQueryAdminTokens returns the QueryAdminTokens cobra command.
+//
+//nolint:dupl // most code is identical, but reusing logic is not beneficial here.
+func CmdQueryAdminTokens() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "admin-tokens [admin]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query fungible tokens administered by an account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query fungible tokens administered by the account.
+
+Example:
+$ %[1]s query %s admin-tokens [admin]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			admin := args[0]
+			res, err := queryClient.AdminTokens(cmd.Context(), &types.QueryAdminTokensRequest{
+				Pagination: pageReq,
+				Admin:      admin,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "admin tokens")
+
+	return cmd
+}
+
 // CmdQueryToken returns the QueryToken cobra command.
 func CmdQueryToken() *cobra.Command {
 	cmd := &cobra.Command{
@@ -357,6 +409,201 @@ $ %[1]s query %s whitelisted-balance [account] [denom]
 	return cmd
 }
 
+// CmdQueryRoles returns the QueryRoles cobra command.
+func CmdQueryRoles() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "roles [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query fungible token role grants",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the roles granted for the fungible token.
+
+Example:
+$ %[1]s query %s roles [denom]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			denom := args[0]
+			res, err := queryClient.Roles(cmd.Context(), &types.QueryRolesRequest{
+				Denom:      denom,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "roles")
+
+	return cmd
+}
+
+// CmdQueryAccountRoles returns the QueryAccountRoles cobra command.
+func CmdQueryAccountRoles() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-roles [denom] [account]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query fungible token roles of an account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the roles of the fungible token granted to the account.
+
+Example:
+$ %[1]s query %s account-roles [denom] [account]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			denom := args[0]
+			account := args[1]
+			res, err := queryClient.AccountRoles(cmd.Context(), &types.QueryAccountRolesRequest{
+				Denom:   denom,
+				Account: account,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryRateExemptions returns the QueryRateExemptions cobra command.
+func CmdQueryRateExemptions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rate-exemptions [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query fungible token rate exemptions",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the accounts exempted from the burn rate and the send commission of the fungible token.
+
+Example:
+$ %[1]s query %s rate-exemptions [denom]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			denom := args[0]
+			res, err := queryClient.RateExemptions(cmd.Context(), &types.QueryRateExemptionsRequest{
+				Denom:      denom,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "rate-exemptions")
+
+	return cmd
+}
+
+// CmdQueryTransferLimits returns the QueryTransferLimits cobra command.
+func CmdQueryTransferLimits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-limits [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query fungible token transfer limits",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the transfer limits of the fungible token and the amount sent by all the accounts within the rolling 24 hours window.
+
+Example:
+$ %s query %s transfer-limits [denom]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TransferLimits(cmd.Context(), &types.QueryTransferLimitsRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryTransferVolume returns the QueryAccountTransferVolume cobra command.
+func CmdQueryTransferVolume() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-volume [account] [denom]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the amount of the fungible token sent by the account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the amount of the fungible token sent by the account within the rolling 24 hours window.
+
+Example:
+$ %s query %s transfer-volume [account] [denom]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AccountTransferVolume(cmd.Context(), &types.QueryAccountTransferVolumeRequest{
+				Account: args[0],
+				Denom:   args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // CmdQueryParams implements a command to fetch assetft parameters.
 func CmdQueryParams() *cobra.Command {
 	cmd := &cobra.Command{
@@ -393,3 +640,48 @@ $ %[1]s query %s params
 
 	return cmd
 }
+
+// CmdQueryScheduledActions returns the QueryScheduledActions cobra command.
+//
+//nolint:dupl // most code is identical, but reusing logic is not beneficial here.
+func CmdQueryScheduledActions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scheduled-actions [account]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query fungible token actions scheduled for an account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the pending fungible token actions scheduled for the account.
+
+Example:
+$ %[1]s query %s scheduled-actions [account]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			account := args[0]
+			res, err := queryClient.ScheduledActions(cmd.Context(), &types.QueryScheduledActionsRequest{
+				Account:    account,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "scheduled actions")
+
+	return cmd
+}
@@ -48,9 +48,41 @@ func TestQueryTokens(t *testing.T) {
 	expectedToken.Denom = denom
 	expectedToken.Issuer = testNetwork.Validators[0].Address.String()
 	expectedToken.Version = types.CurrentTokenVersion
+	expectedToken.Admin = testNetwork.Validators[0].Address.String()
+	expectedToken.SendCommissionRecipients = []types.RateRecipient{}
+	expectedToken.BurnRateRecipients = []types.RateRecipient{}
+	expectedToken.MaxSupply = sdk.ZeroInt()
 	requireT.Equal(expectedToken, resp.Tokens[0])
 }
 
+func TestQueryAdminTokens(t *testing.T) {
+	requireT := require.New(t)
+
+	testNetwork := network.New(t)
+
+	admin := testNetwork.Validators[0].Address
+
+	token := types.Token{
+		Symbol:      "btc" + uuid.NewString()[:4],
+		Subunit:     "satoshi" + uuid.NewString()[:4],
+		Precision:   8,
+		Description: "description",
+	}
+
+	ctx := testNetwork.Validators[0].ClientCtx
+
+	denom := issue(requireT, ctx, token, sdk.NewInt(100), testNetwork)
+
+	buf, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryAdminTokens(), []string{admin.String(), "--output", "json"})
+	requireT.NoError(err)
+
+	var resp types.QueryAdminTokensResponse
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
+	requireT.Len(resp.Tokens, 1)
+	requireT.Equal(denom, resp.Tokens[0].Denom)
+	requireT.Equal(admin.String(), resp.Tokens[0].Admin)
+}
+
 func TestQueryToken(t *testing.T) {
 	requireT := require.New(t)
 
@@ -62,10 +94,12 @@ func TestQueryToken(t *testing.T) {
 		Precision:   8,
 		Description: "description",
 		Features: []types.Feature{
+			types.Feature_minting,
 			types.Feature_whitelisting,
 		},
 		BurnRate:           sdk.MustNewDecFromStr("0.1"),
 		SendCommissionRate: sdk.MustNewDecFromStr("0.2"),
+		MaxSupply:          sdk.NewInt(1000),
 	}
 	ctx := testNetwork.Validators[0].ClientCtx
 
@@ -82,7 +116,12 @@ func TestQueryToken(t *testing.T) {
 	expectedToken.Denom = denom
 	expectedToken.Issuer = testNetwork.Validators[0].Address.String()
 	expectedToken.Version = types.CurrentTokenVersion
+	expectedToken.Admin = testNetwork.Validators[0].Address.String()
+	expectedToken.SendCommissionRecipients = []types.RateRecipient{}
+	expectedToken.BurnRateRecipients = []types.RateRecipient{}
 	requireT.Equal(expectedToken, resp.Token)
+	requireT.NotNil(resp.RemainingMintableAmount)
+	requireT.Equal(sdk.NewInt(900).String(), resp.RemainingMintableAmount.String())
 
 	// query balance
 	var respBalance types.QueryBalanceResponse
@@ -5,6 +5,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -21,10 +22,16 @@ import (
 
 // Flags defined on transactions.
 const (
-	FeaturesFlag           = "features"
-	BurnRateFlag           = "burn-rate"
-	SendCommissionRateFlag = "send-commission-rate"
-	IBCEnabledFlag         = "ibc-enabled"
+	FeaturesFlag                 = "features"
+	BurnRateFlag                 = "burn-rate"
+	SendCommissionRateFlag       = "send-commission-rate"
+	IBCEnabledFlag               = "ibc-enabled"
+	MintCapFlag                  = "mint-cap"
+	URIFlag                      = "uri"
+	URIHashFlag                  = "uri-hash"
+	SendCommissionRecipientsFlag = "send-commission-recipients"
+	BurnRateRecipientsFlag       = "burn-rate-recipients"
+	MaxSupplyFlag                = "max-supply"
 )
 
 // GetTxCmd returns the transaction commands for this module.
@@ -47,6 +54,21 @@ func GetTxCmd() *cobra.Command {
 		CmdTxGloballyUnfreeze(),
 		CmdTxSetWhitelistedLimit(),
 		CmdTxUpgradeV1(),
+		CmdTxTransferAdmin(),
+		CmdTxClearAdmin(),
+		CmdTxGrantRole(),
+		CmdTxRevokeRole(),
+		CmdTxUpdateMetadata(),
+		CmdTxClawback(),
+		CmdTxScheduleMint(),
+		CmdTxScheduleUnfreeze(),
+		CmdTxCancelScheduledAction(),
+		CmdTxRetryScheduledAction(),
+		CmdTxAddRateExemption(),
+		CmdTxRemoveRateExemption(),
+		CmdTxUpdateRateRecipients(),
+		CmdTxSetTransferLimits(),
+		CmdTxLockSupply(),
 	)
 
 	return cmd
@@ -62,7 +84,7 @@ func CmdTxIssue() *cobra.Command {
 	}
 	sort.Strings(allowedFeatures)
 	cmd := &cobra.Command{
-		Use:   "issue [symbol] [subunit] [precision] [initial_amount] [description] --from [issuer] --features=" + strings.Join(allowedFeatures, ",") + " --burn-rate=0.12 --send-commission-rate=0.2",
+		Use:   "issue [symbol] [subunit] [precision] [initial_amount] [description] --from [issuer] --features=" + strings.Join(allowedFeatures, ",") + " --burn-rate=0.12 --send-commission-rate=0.2 --uri=https://my-token-meta.invalid/1 --uri-hash=e000624",
 		Args:  cobra.ExactArgs(5),
 		Short: "Issue new fungible token",
 		Long: strings.TrimSpace(
@@ -137,16 +159,49 @@ $ %s tx %s issue WBTC wsatoshi 8 100000 "Wrapped Bitcoin Token" --from [issuer]
 			}
 			description := args[4]
 
+			uri, err := cmd.Flags().GetString(URIFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			uriHash, err := cmd.Flags().GetString(URIHashFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sendCommissionRecipients, burnRateRecipients, err := getRateRecipients(cmd)
+			if err != nil {
+				return err
+			}
+
+			// the max supply is left unset if it wasn't provided, so the sign bytes stay the same as before
+			var maxSupply sdk.Int
+			maxSupplyStr, err := cmd.Flags().GetString(MaxSupplyFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			if len(maxSupplyStr) > 0 {
+				var ok bool
+				maxSupply, ok = sdk.NewIntFromString(maxSupplyStr)
+				if !ok {
+					return errors.Errorf("invalid max-supply")
+				}
+			}
+
 			msg := &types.MsgIssue{
-				Issuer:             issuer.String(),
-				Symbol:             symbol,
-				Subunit:            subunit,
-				Precision:          uint32(precision),
-				InitialAmount:      initialAmount,
-				Description:        description,
-				Features:           features,
-				BurnRate:           burnRate,
-				SendCommissionRate: sendCommissionRate,
+				Issuer:                   issuer.String(),
+				Symbol:                   symbol,
+				Subunit:                  subunit,
+				Precision:                uint32(precision),
+				InitialAmount:            initialAmount,
+				Description:              description,
+				Features:                 features,
+				BurnRate:                 burnRate,
+				SendCommissionRate:       sendCommissionRate,
+				URI:                      uri,
+				URIHash:                  uriHash,
+				SendCommissionRecipients: sendCommissionRecipients,
+				BurnRateRecipients:       burnRateRecipients,
+				MaxSupply:                maxSupply,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
@@ -155,6 +210,10 @@ $ %s tx %s issue WBTC wsatoshi 8 100000 "Wrapped Bitcoin Token" --from [issuer]
 	cmd.Flags().StringSlice(FeaturesFlag, []string{}, "Features to be enabled on fungible token. e.g --features="+strings.Join(allowedFeatures, ","))
 	cmd.Flags().String(BurnRateFlag, "0", "Indicates the rate at which coins will be burnt on top of the sent amount in every send action. Must be between 0 and 1.")
 	cmd.Flags().String(SendCommissionRateFlag, "0", "Indicates the rate at which coins will be sent to the issuer on top of the sent amount in every send action. Must be between 0 and 1.")
+	cmd.Flags().String(URIFlag, "", "Token URI.")
+	cmd.Flags().String(URIHashFlag, "", "Token URI hash.")
+	cmd.Flags().String(MaxSupplyFlag, "", "The maximum supply of the token, the amount minted can never exceed it. Zero or empty means there is no limit.")
+	addRateRecipientsFlags(cmd)
 
 	flags.AddTxFlagsToCmd(cmd)
 
@@ -495,3 +554,714 @@ $ %s tx %s upgrade-v1 ABC-%s --%s=true --from [sender]
 
 	return cmd
 }
+
+// CmdTxTransferAdmin returns TransferAdmin cobra command.
+func CmdTxTransferAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-admin [account_address] [denom] --from [admin]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Transfer the admin rights of the fungible token to another account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Transfer the admin rights of the fungible token to another account.
+
+Example:
+$ %s tx %s transfer-admin [account_address] ABC-%s --from [admin]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			account := args[0]
+			denom := args[1]
+
+			msg := &types.MsgTransferAdmin{
+				Sender:  sender.String(),
+				Account: account,
+				Denom:   denom,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxClearAdmin returns ClearAdmin cobra command.
+func CmdTxClearAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clear-admin [denom] --from [admin]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Remove the admin of the fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Remove the admin of the fungible token, so nobody is able to perform the privileged operations anymore.
+This is a one-time operation!!! Once executed, it can never be reverted.
+
+Example:
+$ %s tx %s clear-admin ABC-%s --from [admin]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			denom := args[0]
+
+			msg := &types.MsgClearAdmin{
+				Sender: sender.String(),
+				Denom:  denom,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxGrantRole returns GrantRole cobra command.
+func CmdTxGrantRole() *cobra.Command {
+	allowedRoles := allowedRoleNames()
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("grant-role [account_address] [denom] [role] --from [admin] --%s=1000", MintCapFlag),
+		Args:  cobra.ExactArgs(3),
+		Short: "Grant the role of the fungible token to the account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Grant the role of the fungible token to the account. Allowed roles: %s.
+The mint cap limits the amount the minter is allowed to mint, zero means there is no limit.
+
+Example:
+$ %s tx %s grant-role [account_address] ABC-%s minter --from [admin] --%s=1000
+`,
+				strings.Join(allowedRoles, ", "), version.AppName, types.ModuleName, constant.AddressSampleTest, MintCapFlag,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			account := args[0]
+			denom := args[1]
+			role, err := parseRole(args[2])
+			if err != nil {
+				return err
+			}
+
+			mintCapStr, err := cmd.Flags().GetString(MintCapFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			mintCap := sdk.ZeroInt()
+			if len(mintCapStr) > 0 {
+				var ok bool
+				mintCap, ok = sdk.NewIntFromString(mintCapStr)
+				if !ok {
+					return errors.Errorf("invalid mint-cap")
+				}
+			}
+
+			msg := &types.MsgGrantRole{
+				Sender:  sender.String(),
+				Account: account,
+				Denom:   denom,
+				Role:    role,
+				MintCap: mintCap,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(MintCapFlag, "0", "Indicates the amount the minter is allowed to mint, zero means there is no limit. Allowed for the minter role only.")
+
+	return cmd
+}
+
+// CmdTxRevokeRole returns RevokeRole cobra command.
+func CmdTxRevokeRole() *cobra.Command {
+	allowedRoles := allowedRoleNames()
+	cmd := &cobra.Command{
+		Use:   "revoke-role [account_address] [denom] [role] --from [admin]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Revoke the role of the fungible token from the account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Revoke the role of the fungible token from the account. Allowed roles: %s.
+
+Example:
+$ %s tx %s revoke-role [account_address] ABC-%s minter --from [admin]
+`,
+				strings.Join(allowedRoles, ", "), version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			account := args[0]
+			denom := args[1]
+			role, err := parseRole(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRevokeRole{
+				Sender:  sender.String(),
+				Account: account,
+				Denom:   denom,
+				Role:    role,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxUpdateMetadata returns UpdateMetadata cobra command.
+func CmdTxUpdateMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-metadata [denom] [description] [uri] [uri_hash] --from [admin]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Update the description, URI and URI hash of the fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Update the description, URI and URI hash of the fungible token. Empty values clear the existing ones.
+
+Example:
+$ %s tx %s update-metadata ABC-%s "Wrapped Bitcoin Token" https://my-token-meta.invalid/1 e000624 --from [admin]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			denom := args[0]
+			description := args[1]
+			uri := args[2]
+			uriHash := args[3]
+
+			msg := &types.MsgUpdateMetadata{
+				Sender:      sender.String(),
+				Denom:       denom,
+				Description: description,
+				URI:         uri,
+				URIHash:     uriHash,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxClawback returns Clawback cobra command.
+func CmdTxClawback() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clawback [account_address] [amount] --from [admin]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Move the fungible tokens from the account back to the admin",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Move the fungible tokens from the account back to the admin. The frozen balance of the account and the global freeze of the token are ignored.
+
+Example:
+$ %s tx %s clawback [account_address] 100000ABC-%s --from [admin]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			account := args[0]
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid amount")
+			}
+
+			msg := &types.MsgClawback{
+				Sender:  sender.String(),
+				Account: account,
+				Coin:    amount,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxScheduleMint returns ScheduleMint cobra command.
+func CmdTxScheduleMint() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule-mint [amount] [execution_time] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Schedule minting of the fungible tokens",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Schedule minting of the fungible tokens at the execution time provided in RFC3339 format.
+
+Example:
+$ %s tx %s schedule-mint 100000ABC-%s 2030-01-02T15:04:05Z --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid amount")
+			}
+
+			executionTime, err := time.Parse(time.RFC3339, args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid execution time")
+			}
+
+			msg := &types.MsgScheduleMint{
+				Sender:        sender.String(),
+				Coin:          amount,
+				ExecutionTime: executionTime,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxScheduleUnfreeze returns ScheduleUnfreeze cobra command.
+func CmdTxScheduleUnfreeze() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule-unfreeze [account_address] [amount] [execution_time] --from [sender]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Schedule unfreezing of a portion of the frozen fungible tokens",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Schedule unfreezing of a portion of the frozen fungible tokens at the execution time provided in RFC3339 format.
+
+Example:
+$ %s tx %s schedule-unfreeze [account_address] 100000ABC-%s 2030-01-02T15:04:05Z --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			account := args[0]
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid amount")
+			}
+
+			executionTime, err := time.Parse(time.RFC3339, args[2])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid execution time")
+			}
+
+			msg := &types.MsgScheduleUnfreeze{
+				Sender:        sender.String(),
+				Account:       account,
+				Coin:          amount,
+				ExecutionTime: executionTime,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxCancelScheduledAction returns CancelScheduledAction cobra command.
+func CmdTxCancelScheduledAction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-scheduled-action [id] --from [sender]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Cancel the pending scheduled action",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel the pending scheduled action, it might be done by the sender of the action or by the admin of the token.
+
+Example:
+$ %s tx %s cancel-scheduled-action 1 --from [sender]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid id")
+			}
+
+			msg := &types.MsgCancelScheduledAction{
+				Sender: sender.String(),
+				Id:     id,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxRetryScheduledAction returns RetryScheduledAction cobra command.
+func CmdTxRetryScheduledAction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retry-scheduled-action [id] --from [sender]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Retry the scheduled action which execution failed",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Retry the scheduled action which execution failed, it might be done by the sender of the action or by the admin of the token.
+The action is executed again in the next block.
+
+Example:
+$ %s tx %s retry-scheduled-action 1 --from [sender]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid id")
+			}
+
+			msg := &types.MsgRetryScheduledAction{
+				Sender: sender.String(),
+				Id:     id,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func allowedRoleNames() []string {
+	allowedRoles := make([]string, 0, len(types.Role_name))
+	for _, n := range types.Role_name {
+		allowedRoles = append(allowedRoles, n)
+	}
+	sort.Strings(allowedRoles)
+	return allowedRoles
+}
+
+func parseRole(roleStr string) (types.Role, error) {
+	role, ok := types.Role_value[roleStr]
+	if !ok {
+		return 0, errors.Errorf("unknown role '%s', allowed roles: %s", roleStr, strings.Join(allowedRoleNames(), ", "))
+	}
+	return types.Role(role), nil
+}
+
+// CmdTxAddRateExemption returns AddRateExemption cobra command.
+func CmdTxAddRateExemption() *cobra.Command { //nolint:dupl // all CLI commands are similar.
+	cmd := &cobra.Command{
+		Use:   "add-rate-exemption [account_address] [denom] --from [admin]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Exempt the account from the burn rate and the send commission of the fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Exempt the account from the burn rate and the send commission of the fungible token.
+
+Example:
+$ %s tx %s add-rate-exemption [account_address] ABC-%s --from [admin]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			account := args[0]
+			denom := args[1]
+
+			msg := &types.MsgAddRateExemption{
+				Sender:  sender.String(),
+				Account: account,
+				Denom:   denom,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxRemoveRateExemption returns RemoveRateExemption cobra command.
+func CmdTxRemoveRateExemption() *cobra.Command { //nolint:dupl // all CLI commands are similar.
+	cmd := &cobra.Command{
+		Use:   "remove-rate-exemption [account_address] [denom] --from [admin]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Remove the exemption of the account from the burn rate and the send commission of the fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Remove the exemption of the account from the burn rate and the send commission of the fungible token.
+
+Example:
+$ %s tx %s remove-rate-exemption [account_address] ABC-%s --from [admin]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			account := args[0]
+			denom := args[1]
+
+			msg := &types.MsgRemoveRateExemption{
+				Sender:  sender.String(),
+				Account: account,
+				Denom:   denom,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxUpdateRateRecipients returns UpdateRateRecipients cobra command.
+func CmdTxUpdateRateRecipients() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-rate-recipients [denom] --from [admin] --send-commission-recipients=[address]:[weight],... --burn-rate-recipients=[address]:[weight],...",
+		Args:  cobra.ExactArgs(1),
+		Short: "Replace the recipients of the send commission and the burn rate of the fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Replace the recipients of the send commission and the burn rate of the fungible token. Omitted lists clear the existing ones.
+
+Example:
+$ %s tx %s update-rate-recipients ABC-%s --send-commission-recipients=[address1]:3,[address2]:1 --from [admin]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sendCommissionRecipients, burnRateRecipients, err := getRateRecipients(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUpdateRateRecipients{
+				Sender:                   clientCtx.GetFromAddress().String(),
+				Denom:                    args[0],
+				SendCommissionRecipients: sendCommissionRecipients,
+				BurnRateRecipients:       burnRateRecipients,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	addRateRecipientsFlags(cmd)
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func addRateRecipientsFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSlice(SendCommissionRecipientsFlag, []string{}, "Accounts receiving the send commission proportionally to their weights instead of the admin, e.g. --send-commission-recipients=[address1]:3,[address2]:1")
+	cmd.Flags().StringSlice(BurnRateRecipientsFlag, []string{}, "Accounts receiving the burn rate amount proportionally to their weights instead of burning it, e.g. --burn-rate-recipients=[address1]:3,[address2]:1")
+}
+
+func getRateRecipients(cmd *cobra.Command) ([]types.RateRecipient, []types.RateRecipient, error) {
+	sendCommissionRecipientsStrings, err := cmd.Flags().GetStringSlice(SendCommissionRecipientsFlag)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+	sendCommissionRecipients, err := parseRateRecipients(sendCommissionRecipientsStrings)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "invalid %s", SendCommissionRecipientsFlag)
+	}
+
+	burnRateRecipientsStrings, err := cmd.Flags().GetStringSlice(BurnRateRecipientsFlag)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+	burnRateRecipients, err := parseRateRecipients(burnRateRecipientsStrings)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "invalid %s", BurnRateRecipientsFlag)
+	}
+
+	return sendCommissionRecipients, burnRateRecipients, nil
+}
+
+func parseRateRecipients(recipientsStrings []string) ([]types.RateRecipient, error) {
+	recipients := make([]types.RateRecipient, 0, len(recipientsStrings))
+	for _, str := range recipientsStrings {
+		address, weightStr, ok := strings.Cut(str, ":")
+		if !ok {
+			return nil, errors.Errorf("recipient '%s' must be in the [address]:[weight] format", str)
+		}
+		weight, err := strconv.ParseUint(weightStr, 10, 32)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid weight of the recipient '%s'", str)
+		}
+		recipients = append(recipients, types.RateRecipient{
+			Address: address,
+			Weight:  uint32(weight),
+		})
+	}
+	return recipients, nil
+}
+
+// CmdTxSetTransferLimits returns SetTransferLimits cobra command.
+func CmdTxSetTransferLimits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-transfer-limits [denom] [max_transfer_amount] [max_account_daily_amount] [max_token_daily_amount] --from [admin]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Set the limits of the amounts of the fungible token sent by the accounts",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the limits of the amounts of the fungible token sent by the accounts. The daily limits are applied within the rolling 24 hours window. Zero means no limit, setting all the limits to zero removes them.
+
+Example:
+$ %s tx %s set-transfer-limits ABC-%s 1000 5000 100000 --from [admin]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			amounts := make([]sdk.Int, 0, 3)
+			for i, name := range []string{"max_transfer_amount", "max_account_daily_amount", "max_token_daily_amount"} {
+				amount, ok := sdk.NewIntFromString(args[i+1])
+				if !ok {
+					return errors.Errorf("invalid %s", name)
+				}
+				amounts = append(amounts, amount)
+			}
+
+			msg := &types.MsgSetTransferLimits{
+				Sender:                clientCtx.GetFromAddress().String(),
+				Denom:                 args[0],
+				MaxTransferAmount:     amounts[0],
+				MaxAccountDailyAmount: amounts[1],
+				MaxTokenDailyAmount:   amounts[2],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxLockSupply returns LockSupply cobra command.
+func CmdTxLockSupply() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lock-supply [denom] --from [admin]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Lock the supply of the fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Lock the supply of the fungible token, so no more tokens might be minted.
+This is a one-time operation!!! Once executed, it can never be reverted.
+
+Example:
+$ %s tx %s lock-supply ABC-%s --from [admin]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			msg := &types.MsgLockSupply{
+				Sender: clientCtx.GetFromAddress().String(),
+				Denom:  args[0],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -41,12 +42,21 @@ func TestIssue(t *testing.T) {
 		},
 		BurnRate:           sdk.MustNewDecFromStr("0.1"),
 		SendCommissionRate: sdk.MustNewDecFromStr("0.2"),
+		URI:                "https://my-token-meta.invalid/1",
+		URIHash:            "e000624",
 	}
 
 	ctx := testNetwork.Validators[0].ClientCtx
 	initialAmount := sdk.NewInt(100)
 	denom := issue(requireT, ctx, token, initialAmount, testNetwork)
 	requireT.Equal(types.BuildDenom(token.Subunit, testNetwork.Validators[0].Address), denom)
+
+	var resp types.QueryTokenResponse
+	buf, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryToken(), []string{denom, "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
+	requireT.Equal(token.URI, resp.Token.URI)
+	requireT.Equal(token.URIHash, resp.Token.URIHash)
 }
 
 func TestMintBurn(t *testing.T) {
@@ -320,6 +330,390 @@ func TestUpgradeV1(t *testing.T) {
 	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
 }
 
+func TestTransferAndClearAdmin(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	token := types.Token{
+		Symbol:      "btc" + uuid.NewString()[:4],
+		Subunit:     "satoshi" + uuid.NewString()[:4],
+		Precision:   8,
+		Description: "description",
+		Features: []types.Feature{
+			types.Feature_minting,
+		},
+	}
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	initialAmount := sdk.NewInt(777)
+	denom := issue(requireT, ctx, token, initialAmount, testNetwork)
+
+	admin := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	// transfer the admin
+	args := append([]string{admin.String(), denom, "--output", "json"}, txValidator1Args(testNetwork)...)
+	requireT.NoError(coreumclitestutil.ExecTestCLICmd(ctx, cli.CmdTxTransferAdmin(), args))
+
+	var resp types.QueryTokenResponse
+	buf, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryToken(), []string{denom, "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
+	requireT.Equal(admin.String(), resp.Token.Admin)
+
+	// previous admin is not able to clear the admin anymore
+	args = append([]string{denom, "--output", "json"}, txValidator1Args(testNetwork)...)
+	err = coreumclitestutil.ExecTestCLICmd(ctx, cli.CmdTxClearAdmin(), args)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// clear the admin of another token
+	token.Symbol = "btc" + uuid.NewString()[:4]
+	token.Subunit = "satoshi" + uuid.NewString()[:4]
+	denom = issue(requireT, ctx, token, initialAmount, testNetwork)
+
+	args = append([]string{denom, "--output", "json"}, txValidator1Args(testNetwork)...)
+	requireT.NoError(coreumclitestutil.ExecTestCLICmd(ctx, cli.CmdTxClearAdmin(), args))
+
+	buf, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryToken(), []string{denom, "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
+	requireT.Empty(resp.Token.Admin)
+}
+
+func TestGrantRevokeRoleAndQueryRoles(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	token := types.Token{
+		Symbol:      "btc" + uuid.NewString()[:4],
+		Subunit:     "satoshi" + uuid.NewString()[:4],
+		Precision:   8,
+		Description: "description",
+		Features: []types.Feature{
+			types.Feature_minting,
+		},
+	}
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	initialAmount := sdk.NewInt(777)
+	denom := issue(requireT, ctx, token, initialAmount, testNetwork)
+
+	minter := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	// grant the role
+	args := append([]string{minter.String(), denom, types.Role_minter.String(), "--mint-cap", "100", "--output", "json"},
+		txValidator1Args(testNetwork)...)
+	requireT.NoError(coreumclitestutil.ExecTestCLICmd(ctx, cli.CmdTxGrantRole(), args))
+
+	var rolesResp types.QueryRolesResponse
+	buf, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryRoles(), []string{denom, "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &rolesResp))
+	requireT.Equal([]types.RoleGrant{{
+		Denom:   denom,
+		Account: minter.String(),
+		Role:    types.Role_minter,
+		MintCap: sdk.NewInt(100),
+		Minted:  sdk.ZeroInt(),
+	}}, rolesResp.RoleGrants)
+
+	// revoke the role
+	args = append([]string{minter.String(), denom, types.Role_minter.String(), "--output", "json"},
+		txValidator1Args(testNetwork)...)
+	requireT.NoError(coreumclitestutil.ExecTestCLICmd(ctx, cli.CmdTxRevokeRole(), args))
+
+	var accountRolesResp types.QueryAccountRolesResponse
+	buf, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryAccountRoles(), []string{denom, minter.String(), "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &accountRolesResp))
+	requireT.Empty(accountRolesResp.RoleGrants)
+}
+
+func TestAddRemoveRateExemptionAndQueryRateExemptions(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	token := types.Token{
+		Symbol:      "btc" + uuid.NewString()[:4],
+		Subunit:     "satoshi" + uuid.NewString()[:4],
+		Precision:   8,
+		Description: "description",
+	}
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	initialAmount := sdk.NewInt(777)
+	denom := issue(requireT, ctx, token, initialAmount, testNetwork)
+
+	exchange := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	// add the exemption
+	args := append([]string{exchange.String(), denom, "--output", "json"}, txValidator1Args(testNetwork)...)
+	requireT.NoError(coreumclitestutil.ExecTestCLICmd(ctx, cli.CmdTxAddRateExemption(), args))
+
+	var resp types.QueryRateExemptionsResponse
+	buf, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryRateExemptions(), []string{denom, "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
+	requireT.Equal([]types.RateExemption{{
+		Denom:   denom,
+		Account: exchange.String(),
+	}}, resp.RateExemptions)
+
+	// remove the exemption
+	args = append([]string{exchange.String(), denom, "--output", "json"}, txValidator1Args(testNetwork)...)
+	requireT.NoError(coreumclitestutil.ExecTestCLICmd(ctx, cli.CmdTxRemoveRateExemption(), args))
+
+	buf, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryRateExemptions(), []string{denom, "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
+	requireT.Empty(resp.RateExemptions)
+}
+
+func TestIssueAndUpdateRateRecipients(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	treasury := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	contract := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	token := types.Token{
+		Symbol:             "btc" + uuid.NewString()[:4],
+		Subunit:            "satoshi" + uuid.NewString()[:4],
+		Precision:          8,
+		Description:        "description",
+		SendCommissionRate: sdk.MustNewDecFromStr("0.1"),
+		SendCommissionRecipients: []types.RateRecipient{
+			{Address: treasury.String(), Weight: 3},
+			{Address: contract.String(), Weight: 1},
+		},
+	}
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	initialAmount := sdk.NewInt(777)
+	denom := issue(requireT, ctx, token, initialAmount, testNetwork)
+
+	var resp types.QueryTokenResponse
+	buf, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryToken(), []string{denom, "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
+	requireT.Equal(token.SendCommissionRecipients, resp.Token.SendCommissionRecipients)
+	requireT.Empty(resp.Token.BurnRateRecipients)
+
+	// replace the recipients
+	args := append([]string{
+		denom,
+		fmt.Sprintf("--%s=%s:1", cli.BurnRateRecipientsFlag, treasury.String()),
+		"--output", "json",
+	}, txValidator1Args(testNetwork)...)
+	requireT.NoError(coreumclitestutil.ExecTestCLICmd(ctx, cli.CmdTxUpdateRateRecipients(), args))
+
+	buf, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryToken(), []string{denom, "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
+	requireT.Empty(resp.Token.SendCommissionRecipients)
+	requireT.Equal([]types.RateRecipient{{Address: treasury.String(), Weight: 1}}, resp.Token.BurnRateRecipients)
+}
+
+func TestSetAndQueryTransferLimits(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	token := types.Token{
+		Symbol:      "btc" + uuid.NewString()[:4],
+		Subunit:     "satoshi" + uuid.NewString()[:4],
+		Precision:   8,
+		Description: "description",
+		Features: []types.Feature{
+			types.Feature_transfer_limiting,
+		},
+	}
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	initialAmount := sdk.NewInt(777)
+	denom := issue(requireT, ctx, token, initialAmount, testNetwork)
+
+	// set the limits
+	args := append([]string{denom, "10", "0", "1000", "--output", "json"}, txValidator1Args(testNetwork)...)
+	requireT.NoError(coreumclitestutil.ExecTestCLICmd(ctx, cli.CmdTxSetTransferLimits(), args))
+
+	var limitsResp types.QueryTransferLimitsResponse
+	buf, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryTransferLimits(), []string{denom, "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &limitsResp))
+	requireT.Equal(denom, limitsResp.TransferLimits.Denom)
+	requireT.Equal("10", limitsResp.TransferLimits.MaxTransferAmount.String())
+	requireT.Equal("0", limitsResp.TransferLimits.MaxAccountDailyAmount.String())
+	requireT.Equal("1000", limitsResp.TransferLimits.MaxTokenDailyAmount.String())
+	requireT.Equal("0", limitsResp.TokenDailyVolume.String())
+
+	// the admin is not limited, so its volume is not tracked
+	var volumeResp types.QueryAccountTransferVolumeResponse
+	buf, err = clitestutil.ExecTestCLICmd(
+		ctx, cli.CmdQueryTransferVolume(), []string{testNetwork.Validators[0].Address.String(), denom, "--output", "json"},
+	)
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &volumeResp))
+	requireT.Equal("0", volumeResp.DailyVolume.String())
+}
+
+func TestIssueWithMaxSupplyAndLockSupply(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	token := types.Token{
+		Symbol:      "btc" + uuid.NewString()[:4],
+		Subunit:     "satoshi" + uuid.NewString()[:4],
+		Precision:   8,
+		Description: "description",
+		Features: []types.Feature{
+			types.Feature_minting,
+		},
+		MaxSupply: sdk.NewInt(1000),
+	}
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	initialAmount := sdk.NewInt(777)
+	denom := issue(requireT, ctx, token, initialAmount, testNetwork)
+
+	var tokenResp types.QueryTokenResponse
+	buf, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryToken(), []string{denom, "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &tokenResp))
+	requireT.Equal("1000", tokenResp.Token.MaxSupply.String())
+	requireT.False(tokenResp.Token.SupplyLocked)
+	requireT.Equal("223", tokenResp.RemainingMintableAmount.String())
+
+	// lock the supply
+	args := append([]string{denom, "--output", "json"}, txValidator1Args(testNetwork)...)
+	requireT.NoError(coreumclitestutil.ExecTestCLICmd(ctx, cli.CmdTxLockSupply(), args))
+
+	buf, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryToken(), []string{denom, "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &tokenResp))
+	requireT.True(tokenResp.Token.SupplyLocked)
+	requireT.Equal("0", tokenResp.RemainingMintableAmount.String())
+}
+
+func TestUpdateMetadata(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	token := types.Token{
+		Symbol:      "btc" + uuid.NewString()[:4],
+		Subunit:     "satoshi" + uuid.NewString()[:4],
+		Precision:   8,
+		Description: "description",
+	}
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	initialAmount := sdk.NewInt(777)
+	denom := issue(requireT, ctx, token, initialAmount, testNetwork)
+
+	// update the metadata
+	args := append([]string{denom, "new description", "https://my-token-meta.invalid/2", "e000625", "--output", "json"},
+		txValidator1Args(testNetwork)...)
+	requireT.NoError(coreumclitestutil.ExecTestCLICmd(ctx, cli.CmdTxUpdateMetadata(), args))
+
+	var resp types.QueryTokenResponse
+	buf, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryToken(), []string{denom, "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
+	requireT.Equal("new description", resp.Token.Description)
+	requireT.Equal("https://my-token-meta.invalid/2", resp.Token.URI)
+	requireT.Equal("e000625", resp.Token.URIHash)
+}
+
+func TestClawback(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	token := types.Token{
+		Symbol:      "btc" + uuid.NewString()[:4],
+		Subunit:     "satoshi" + uuid.NewString()[:4],
+		Precision:   8,
+		Description: "description",
+		Features: []types.Feature{
+			types.Feature_freezing,
+			types.Feature_clawback,
+		},
+	}
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	initialAmount := sdk.NewInt(777)
+	denom := issue(requireT, ctx, token, initialAmount, testNetwork)
+	account := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	// send the tokens to the account and freeze them
+	args := append([]string{
+		testNetwork.Validators[0].Address.String(), account.String(), sdk.NewInt64Coin(denom, 100).String(), "--output", "json",
+	}, txValidator1Args(testNetwork)...)
+	requireT.NoError(coreumclitestutil.ExecTestCLICmd(ctx, bankcli.NewSendTxCmd(), args))
+
+	args = append([]string{account.String(), sdk.NewInt64Coin(denom, 100).String(), "--output", "json"}, txValidator1Args(testNetwork)...)
+	requireT.NoError(coreumclitestutil.ExecTestCLICmd(ctx, cli.CmdTxFreeze(), args))
+
+	// claw back the frozen tokens
+	args = append([]string{account.String(), sdk.NewInt64Coin(denom, 60).String(), "--output", "json"}, txValidator1Args(testNetwork)...)
+	requireT.NoError(coreumclitestutil.ExecTestCLICmd(ctx, cli.CmdTxClawback(), args))
+
+	var resp types.QueryBalanceResponse
+	buf, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryBalance(), []string{account.String(), denom, "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
+	requireT.Equal(sdk.NewInt(40).String(), resp.Balance.String())
+	requireT.Equal(sdk.NewInt(100).String(), resp.Frozen.String())
+}
+
+func TestScheduleMintAndCancel(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	token := types.Token{
+		Symbol:      "btc" + uuid.NewString()[:4],
+		Subunit:     "satoshi" + uuid.NewString()[:4],
+		Precision:   8,
+		Description: "description",
+		Features: []types.Feature{
+			types.Feature_minting,
+		},
+	}
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	initialAmount := sdk.NewInt(777)
+	denom := issue(requireT, ctx, token, initialAmount, testNetwork)
+	issuer := testNetwork.Validators[0].Address
+
+	// schedule the mint
+	coinToMint := sdk.NewInt64Coin(denom, 100)
+	executionTime := time.Now().UTC().Add(time.Hour).Truncate(time.Second)
+	args := append([]string{coinToMint.String(), executionTime.Format(time.RFC3339), "--output", "json"},
+		txValidator1Args(testNetwork)...)
+	requireT.NoError(coreumclitestutil.ExecTestCLICmd(ctx, cli.CmdTxScheduleMint(), args))
+
+	var resp types.QueryScheduledActionsResponse
+	buf, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryScheduledActions(), []string{issuer.String(), "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
+	requireT.Len(resp.ScheduledActions, 1)
+	action := resp.ScheduledActions[0]
+	requireT.Equal(types.ScheduledAction{
+		Id:            action.Id,
+		Type:          types.ScheduledActionType_mint,
+		Sender:        issuer.String(),
+		Account:       issuer.String(),
+		Coin:          coinToMint,
+		ExecutionTime: executionTime,
+	}, action)
+
+	// cancel the scheduled mint
+	args = append([]string{fmt.Sprint(action.Id), "--output", "json"}, txValidator1Args(testNetwork)...)
+	requireT.NoError(coreumclitestutil.ExecTestCLICmd(ctx, cli.CmdTxCancelScheduledAction(), args))
+
+	buf, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryScheduledActions(), []string{issuer.String(), "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
+	requireT.Empty(resp.ScheduledActions)
+}
+
 func issue(requireT *require.Assertions, ctx client.Context, token types.Token, initialAmount sdk.Int, testNetwork *network.Network) string {
 	features := make([]string, 0, len(token.Features))
 	for _, feature := range token.Features {
@@ -339,6 +733,21 @@ func issue(requireT *require.Assertions, ctx client.Context, token types.Token,
 	if !token.SendCommissionRate.IsNil() {
 		args = append(args, fmt.Sprintf("--%s=%s", cli.SendCommissionRateFlag, token.SendCommissionRate.String()))
 	}
+	if token.URI != "" {
+		args = append(args, fmt.Sprintf("--%s=%s", cli.URIFlag, token.URI))
+	}
+	if token.URIHash != "" {
+		args = append(args, fmt.Sprintf("--%s=%s", cli.URIHashFlag, token.URIHash))
+	}
+	if len(token.SendCommissionRecipients) > 0 {
+		args = append(args, fmt.Sprintf("--%s=%s", cli.SendCommissionRecipientsFlag, rateRecipientsFlagValue(token.SendCommissionRecipients)))
+	}
+	if len(token.BurnRateRecipients) > 0 {
+		args = append(args, fmt.Sprintf("--%s=%s", cli.BurnRateRecipientsFlag, rateRecipientsFlagValue(token.BurnRateRecipients)))
+	}
+	if !token.MaxSupply.IsNil() {
+		args = append(args, fmt.Sprintf("--%s=%s", cli.MaxSupplyFlag, token.MaxSupply.String()))
+	}
 
 	args = append(args, txValidator1Args(testNetwork)...)
 	buf, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdTxIssue(), args)
@@ -363,6 +772,14 @@ func issue(requireT *require.Assertions, ctx client.Context, token types.Token,
 	return ""
 }
 
+func rateRecipientsFlagValue(recipients []types.RateRecipient) string {
+	values := make([]string, 0, len(recipients))
+	for _, recipient := range recipients {
+		values = append(values, fmt.Sprintf("%s:%d", recipient.Address, recipient.Weight))
+	}
+	return strings.Join(values, ",")
+}
+
 func txValidator1Args(testNetwork *network.Network) []string {
 	return []string{
 		fmt.Sprintf("--%s=%s", flags.FlagFrom, testNetwork.Validators[0].Address.String()),
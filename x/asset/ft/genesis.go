@@ -24,15 +24,25 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		}
 
 		definition := types.Definition{
-			Denom:              token.Denom,
-			Issuer:             token.Issuer,
-			Features:           token.Features,
-			BurnRate:           token.BurnRate,
-			SendCommissionRate: token.SendCommissionRate,
-			Version:            token.Version,
+			Denom:                    token.Denom,
+			Issuer:                   token.Issuer,
+			Features:                 token.Features,
+			BurnRate:                 token.BurnRate,
+			SendCommissionRate:       token.SendCommissionRate,
+			Version:                  token.Version,
+			Admin:                    token.Admin,
+			URI:                      token.URI,
+			URIHash:                  token.URIHash,
+			SendCommissionRecipients: token.SendCommissionRecipients,
+			BurnRateRecipients:       token.BurnRateRecipients,
+			MaxSupply:                token.MaxSupply,
+			SupplyLocked:             token.SupplyLocked,
 		}
 
 		k.SetDefinition(ctx, issuer, subunit, definition)
+		if token.Admin != "" {
+			k.SetAdminToken(ctx, sdk.MustAccAddressFromBech32(token.Admin), token.Denom)
+		}
 
 		err = k.SetSymbol(ctx, token.Symbol, issuer)
 		if err != nil {
@@ -61,6 +71,47 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		k.SetWhitelistedBalances(ctx, address, whitelistedBalance.Coins)
 	}
 
+	// Init role grants
+	for _, grant := range genState.RoleGrants {
+		if err := grant.Validate(); err != nil {
+			panic(err)
+		}
+		k.SetRoleGrant(ctx, grant)
+	}
+
+	// Init rate exemptions
+	for _, exemption := range genState.RateExemptions {
+		if err := exemption.Validate(); err != nil {
+			panic(err)
+		}
+		k.SetRateExemption(ctx, exemption)
+	}
+
+	// Init transfer limits
+	for _, limits := range genState.TransferLimits {
+		if err := limits.Validate(); err != nil {
+			panic(err)
+		}
+		k.SetTransferLimits(ctx, limits)
+	}
+
+	// Init transfer volumes
+	for _, volume := range genState.TransferVolumes {
+		if err := volume.Validate(); err != nil {
+			panic(err)
+		}
+		k.SetTransferVolume(ctx, volume)
+	}
+
+	// Init scheduled actions, the delayed items executing them are imported by the delay module
+	for _, action := range genState.ScheduledActions {
+		if err := action.Validate(); err != nil {
+			panic(err)
+		}
+		k.SetScheduledAction(ctx, action)
+	}
+	k.SetNextScheduledActionID(ctx, genState.NextScheduledActionId)
+
 	// Init pending version upgrades
 	if err := k.ImportPendingTokenUpgrades(ctx, genState.PendingTokenUpgrades); err != nil {
 		panic(err)
@@ -92,11 +143,66 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		panic(err)
 	}
 
+	// Export role grants
+	roleGrants := make([]types.RoleGrant, 0)
+	if err := k.IterateAllRoleGrants(ctx, func(grant types.RoleGrant) bool {
+		roleGrants = append(roleGrants, grant)
+		return false
+	}); err != nil {
+		panic(err)
+	}
+
+	// Export rate exemptions
+	rateExemptions := make([]types.RateExemption, 0)
+	if err := k.IterateAllRateExemptions(ctx, func(exemption types.RateExemption) bool {
+		rateExemptions = append(rateExemptions, exemption)
+		return false
+	}); err != nil {
+		panic(err)
+	}
+
+	// Export transfer limits
+	transferLimits := make([]types.TransferLimits, 0)
+	if err := k.IterateAllTransferLimits(ctx, func(limits types.TransferLimits) bool {
+		transferLimits = append(transferLimits, limits)
+		return false
+	}); err != nil {
+		panic(err)
+	}
+
+	// Export transfer volumes
+	transferVolumes := make([]types.TransferVolume, 0)
+	if err := k.IterateAllTransferVolumes(ctx, func(volume types.TransferVolume) bool {
+		transferVolumes = append(transferVolumes, volume)
+		return false
+	}); err != nil {
+		panic(err)
+	}
+
+	// Export scheduled actions
+	scheduledActions := make([]types.ScheduledAction, 0)
+	if err := k.IterateAllScheduledActions(ctx, func(action types.ScheduledAction) bool {
+		scheduledActions = append(scheduledActions, action)
+		return false
+	}); err != nil {
+		panic(err)
+	}
+	nextScheduledActionID, err := k.GetNextScheduledActionID(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return &types.GenesisState{
-		Params:               k.GetParams(ctx),
-		Tokens:               tokens,
-		FrozenBalances:       frozenBalances,
-		WhitelistedBalances:  whitelistedBalances,
-		PendingTokenUpgrades: pendingTokenUpgrades,
+		Params:                k.GetParams(ctx),
+		Tokens:                tokens,
+		FrozenBalances:        frozenBalances,
+		WhitelistedBalances:   whitelistedBalances,
+		PendingTokenUpgrades:  pendingTokenUpgrades,
+		RoleGrants:            roleGrants,
+		ScheduledActions:      scheduledActions,
+		NextScheduledActionId: nextScheduledActionID,
+		RateExemptions:        rateExemptions,
+		TransferLimits:        transferLimits,
+		TransferVolumes:       transferVolumes,
 	}
 }
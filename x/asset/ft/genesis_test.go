@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"math/rand"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/crypto/ed25519"
@@ -43,13 +45,36 @@ func TestInitAndExportGenesis(t *testing.T) {
 			Features: []types.Feature{
 				types.Feature_freezing,
 				types.Feature_whitelisting,
+				types.Feature_transfer_limiting,
 			},
-			Version: i,
+			Version:   i,
+			Admin:     issuer.String(),
+			URI:       fmt.Sprintf("https://my-token-meta.invalid/%d", i),
+			URIHash:   fmt.Sprintf("e00062%d", i),
+			MaxSupply: sdk.NewInt(int64(i) * 1000),
+		}
+		// Lock the supply of some Tokens.
+		if i%4 == 1 {
+			token.SupplyLocked = true
 		}
 		// Globally freeze some Tokens.
 		if i%2 == 0 {
 			token.GloballyFrozen = true
 		}
+		// Route the rates of some Tokens to the recipients.
+		if i%2 == 1 {
+			token.SendCommissionRecipients = []types.RateRecipient{
+				{Address: sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(), Weight: 3},
+				{Address: sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(), Weight: 1},
+			}
+			token.BurnRateRecipients = []types.RateRecipient{
+				{Address: sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(), Weight: 1},
+			}
+		}
+		// Clear admin of some Tokens.
+		if i%3 == 0 {
+			token.Admin = ""
+		}
 		tokens = append(tokens, token)
 		requireT.NoError(ftKeeper.SetDenomMetadata(ctx, token.Denom, token.Symbol, token.Description, token.Precision))
 		if i == 0 {
@@ -88,12 +113,103 @@ func TestInitAndExportGenesis(t *testing.T) {
 			})
 	}
 
+	// role grants
+	var roleGrants []types.RoleGrant
+	for i := 0; i < 5; i++ {
+		addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+		roleGrants = append(roleGrants,
+			types.RoleGrant{
+				Denom:   tokens[1].Denom,
+				Account: addr.String(),
+				Role:    types.Role_freezer,
+				MintCap: sdk.ZeroInt(),
+				Minted:  sdk.ZeroInt(),
+			},
+			types.RoleGrant{
+				Denom:   tokens[2].Denom,
+				Account: addr.String(),
+				Role:    types.Role_whitelister,
+				MintCap: sdk.ZeroInt(),
+				Minted:  sdk.ZeroInt(),
+			})
+	}
+
+	// scheduled actions
+	var scheduledActions []types.ScheduledAction
+	for i := 0; i < 5; i++ {
+		addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+		scheduledActions = append(scheduledActions,
+			types.ScheduledAction{
+				Id:            uint64(2 * i),
+				Type:          types.ScheduledActionType_mint,
+				Sender:        issuer.String(),
+				Account:       issuer.String(),
+				Coin:          sdk.NewCoin(tokens[0].Denom, sdk.NewInt(int64(rand.Int31n(1000)+1))),
+				ExecutionTime: time.Date(2030, 1, i+1, 0, 0, 0, 0, time.UTC),
+			},
+			types.ScheduledAction{
+				Id:            uint64(2*i + 1),
+				Type:          types.ScheduledActionType_unfreeze,
+				Sender:        issuer.String(),
+				Account:       addr.String(),
+				Coin:          sdk.NewCoin(tokens[1].Denom, sdk.NewInt(int64(rand.Int31n(1000)+1))),
+				ExecutionTime: time.Date(2030, 1, i+1, 0, 0, 0, 0, time.UTC),
+			})
+	}
+
+	// rate exemptions
+	var rateExemptions []types.RateExemption
+	for i := 0; i < 5; i++ {
+		addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+		rateExemptions = append(rateExemptions, types.RateExemption{
+			Denom:   tokens[i%2].Denom,
+			Account: addr.String(),
+		})
+	}
+
+	// transfer limits
+	var transferLimits []types.TransferLimits
+	for i := 0; i < 3; i++ {
+		transferLimits = append(transferLimits, types.TransferLimits{
+			Denom:                 tokens[i].Denom,
+			MaxTransferAmount:     sdk.NewInt(int64(rand.Int31n(1000) + 1)),
+			MaxAccountDailyAmount: sdk.NewInt(int64(rand.Int31n(10000) + 1)),
+			MaxTokenDailyAmount:   sdk.ZeroInt(),
+		})
+	}
+
+	// transfer volumes
+	var transferVolumes []types.TransferVolume
+	for i := 0; i < 3; i++ {
+		addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+		buckets := []types.TransferVolumeBucket{
+			{Hour: 1000, Amount: sdk.NewInt(int64(rand.Int31n(1000) + 1))},
+			{Hour: 1010, Amount: sdk.NewInt(int64(rand.Int31n(1000) + 1))},
+		}
+		transferVolumes = append(transferVolumes,
+			types.TransferVolume{
+				Denom:   tokens[i].Denom,
+				Account: addr.String(),
+				Buckets: buckets,
+			},
+			types.TransferVolume{
+				Denom:   tokens[i].Denom,
+				Buckets: buckets,
+			})
+	}
+
 	genState := types.GenesisState{
-		Params:               types.DefaultParams(),
-		Tokens:               tokens,
-		FrozenBalances:       frozenBalances,
-		WhitelistedBalances:  whitelistedBalances,
-		PendingTokenUpgrades: pendingTokenUpgrades,
+		Params:                types.DefaultParams(),
+		Tokens:                tokens,
+		FrozenBalances:        frozenBalances,
+		WhitelistedBalances:   whitelistedBalances,
+		PendingTokenUpgrades:  pendingTokenUpgrades,
+		RoleGrants:            roleGrants,
+		ScheduledActions:      scheduledActions,
+		NextScheduledActionId: 20,
+		RateExemptions:        rateExemptions,
+		TransferLimits:        transferLimits,
+		TransferVolumes:       transferVolumes,
 	}
 
 	// init the keeper
@@ -140,6 +256,46 @@ func TestInitAndExportGenesis(t *testing.T) {
 		assertT.EqualValues(balance.Coins.String(), coins.String())
 	}
 
+	// role grants
+	for _, grant := range roleGrants {
+		account, err := sdk.AccAddressFromBech32(grant.Account)
+		requireT.NoError(err)
+		grants, err := ftKeeper.GetAccountRoleGrants(ctx, grant.Denom, account)
+		requireT.NoError(err)
+		assertT.EqualValues([]types.RoleGrant{grant}, grants)
+	}
+
+	// scheduled actions
+	for _, action := range scheduledActions {
+		account, err := sdk.AccAddressFromBech32(action.Account)
+		requireT.NoError(err)
+		actions, _, err := ftKeeper.GetAccountScheduledActions(ctx, account, &query.PageRequest{})
+		requireT.NoError(err)
+		assertT.Contains(actions, action)
+	}
+	nextScheduledActionID, err := ftKeeper.GetNextScheduledActionID(ctx)
+	requireT.NoError(err)
+	assertT.EqualValues(20, nextScheduledActionID)
+
+	// rate exemptions
+	for _, token := range tokens[:2] {
+		exemptions, _, err := ftKeeper.GetRateExemptions(ctx, token.Denom, &query.PageRequest{})
+		requireT.NoError(err)
+		assertT.Subset(rateExemptions, exemptions)
+	}
+
+	// transfer limits
+	for _, limits := range transferLimits {
+		storedLimits, err := ftKeeper.GetTransferLimits(ctx, limits.Denom)
+		requireT.NoError(err)
+		assertT.EqualValues(limits, storedLimits)
+	}
+
+	// admin index
+	adminTokens, _, err := ftKeeper.GetAdminTokens(ctx, issuer, &query.PageRequest{})
+	requireT.NoError(err)
+	assertT.Len(adminTokens, 3)
+
 	// check that export is equal import
 	exportedGenState := ft.ExportGenesis(ctx, ftKeeper)
 
@@ -148,4 +304,10 @@ func TestInitAndExportGenesis(t *testing.T) {
 	assertT.ElementsMatch(genState.PendingTokenUpgrades, exportedGenState.PendingTokenUpgrades)
 	assertT.ElementsMatch(genState.FrozenBalances, exportedGenState.FrozenBalances)
 	assertT.ElementsMatch(genState.WhitelistedBalances, exportedGenState.WhitelistedBalances)
+	assertT.ElementsMatch(genState.RoleGrants, exportedGenState.RoleGrants)
+	assertT.ElementsMatch(genState.ScheduledActions, exportedGenState.ScheduledActions)
+	assertT.Equal(genState.NextScheduledActionId, exportedGenState.NextScheduledActionId)
+	assertT.ElementsMatch(genState.RateExemptions, exportedGenState.RateExemptions)
+	assertT.ElementsMatch(genState.TransferLimits, exportedGenState.TransferLimits)
+	assertT.ElementsMatch(genState.TransferVolumes, exportedGenState.TransferVolumes)
 }
@@ -7,6 +7,7 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/CoreumFoundation/coreum/v2/x/asset/ft/types"
+	dextypes "github.com/CoreumFoundation/coreum/v2/x/dex/types"
 	wibctransfertypes "github.com/CoreumFoundation/coreum/v2/x/wibctransfer/types"
 )
 
@@ -68,26 +69,20 @@ func (k Keeper) applyRules(ctx sdk.Context, inputs, outputs groupedByDenomAccoun
 
 		outOps := outputs[denom]
 
-		burnShares := k.CalculateRateShares(ctx, def.BurnRate, def.Issuer, inOps, outOps)
-
-		if err := iterateMapDeterministic(burnShares, func(account string, amount sdk.Int) error {
-			return k.burnIfSpendable(ctx, sdk.MustAccAddressFromBech32(account), def, amount)
-		}); err != nil {
-			return err
-		}
-
-		commissionShares := k.CalculateRateShares(ctx, def.SendCommissionRate, def.Issuer, inOps, outOps)
-		issuer := sdk.MustAccAddressFromBech32(def.Issuer)
-
-		if err := iterateMapDeterministic(commissionShares, func(account string, amount sdk.Int) error {
-			coins := sdk.NewCoins(sdk.NewCoin(def.Denom, amount))
-			return k.bankKeeper.SendCoins(ctx, sdk.MustAccAddressFromBech32(account), issuer, coins)
-		}); err != nil {
+		if err := k.chargeRates(ctx, def, inOps, outOps); err != nil {
 			return err
 		}
 
+		// The transfer limits are consumed here instead of isCoinSpendable, because isCoinSpendable is also used
+		// to check the rates paid on top of the transfer, which must not be counted. Every transfer, including
+		// the funds locked by the dex order, passes this point once per sender, so the dex lock is limited
+		// the same way as the bank send, while the settlement and the unlock are skipped by isTransferLimited.
 		if err := iterateMapDeterministic(inOps, func(account string, amount sdk.Int) error {
-			return k.isCoinSpendable(ctx, sdk.MustAccAddressFromBech32(account), def, amount)
+			addr := sdk.MustAccAddressFromBech32(account)
+			if err := k.isCoinSpendable(ctx, addr, def, amount); err != nil {
+				return err
+			}
+			return k.consumeTransferLimits(ctx, addr, def, amount)
 		}); err != nil {
 			return err
 		}
@@ -98,10 +93,40 @@ func (k Keeper) applyRules(ctx sdk.Context, inputs, outputs groupedByDenomAccoun
 	})
 }
 
-func nonIssuerSum(ops accountOperationMap, issuer string) sdk.Int {
+func (k Keeper) chargeRates(ctx sdk.Context, def types.Definition, inOps, outOps accountOperationMap) error {
+	burnShares := k.CalculateRateShares(ctx, def.BurnRate, def, inOps, outOps)
+
+	// The burn rate amount is sent to the burn rate recipients instead of burning it if they are set.
+	if err := iterateMapDeterministic(burnShares, func(account string, amount sdk.Int) error {
+		if len(def.BurnRateRecipients) > 0 {
+			return k.distributeIfSpendable(ctx, sdk.MustAccAddressFromBech32(account), def, amount, def.BurnRateRecipients)
+		}
+		return k.burnIfSpendable(ctx, sdk.MustAccAddressFromBech32(account), def, amount)
+	}); err != nil {
+		return err
+	}
+
+	// The send commission is sent to the send commission recipients if they are set, otherwise it is sent
+	// to the admin, and it is burnt if the admin has been cleared.
+	commissionShares := k.CalculateRateShares(ctx, def.SendCommissionRate, def, inOps, outOps)
+	return iterateMapDeterministic(commissionShares, func(account string, amount sdk.Int) error {
+		if len(def.SendCommissionRecipients) > 0 {
+			return k.distributeIfSpendable(
+				ctx, sdk.MustAccAddressFromBech32(account), def, amount, def.SendCommissionRecipients,
+			)
+		}
+		if def.Admin == "" {
+			return k.burnIfSpendable(ctx, sdk.MustAccAddressFromBech32(account), def, amount)
+		}
+		coins := sdk.NewCoins(sdk.NewCoin(def.Denom, amount))
+		return k.bankKeeper.SendCoins(ctx, sdk.MustAccAddressFromBech32(account), sdk.MustAccAddressFromBech32(def.Admin), coins)
+	})
+}
+
+func nonExemptedSum(ops accountOperationMap, isExempted func(account string) bool) sdk.Int {
 	sum := sdk.ZeroInt()
 	for account, amount := range ops {
-		if account != issuer {
+		if !isExempted(account) {
 			sum = sum.Add(amount)
 		}
 	}
@@ -109,7 +134,13 @@ func nonIssuerSum(ops accountOperationMap, issuer string) sdk.Int {
 }
 
 // CalculateRateShares calculates how the burn or commission share amount should be split between different parties.
-func (k Keeper) CalculateRateShares(ctx sdk.Context, rate sdk.Dec, issuer string, inOps, outOps accountOperationMap) map[string]sdk.Int {
+// The admin and the accounts exempted by the admin neither pay the rates nor are charged for when receiving the tokens.
+func (k Keeper) CalculateRateShares(
+	ctx sdk.Context,
+	rate sdk.Dec,
+	def types.Definition,
+	inOps, outOps accountOperationMap,
+) map[string]sdk.Int {
 	// We decided that rates should not be charged on incoming IBC transfers.
 	// According to our current protocol, it cannot be done because sender pays the rates, meaning that escrow address
 	// would be charged leading to breaking the IBC mechanics.
@@ -131,8 +162,15 @@ func (k Keeper) CalculateRateShares(ctx sdk.Context, rate sdk.Dec, issuer string
 		return nil
 	}
 
+	// Funds locked by the dex order are not charged when they are sent to or from the dex module. The rates are
+	// charged from the order owner only for the executed amount, when the order is settled.
+	if dextypes.IsPurposeLock(ctx) || dextypes.IsPurposeSettle(ctx) || dextypes.IsPurposeUnlock(ctx) {
+		return nil
+	}
+
 	// Since burning & send commission are not applied when sending to/from token issuer we can't simply apply original burn rate or send commission rate when bank multisend with issuer in inputs or outputs.
 	// To recalculate new adjusted amount we split whole "commission" between all non-issuer senders proportionally to amount they send.
+	// The accounts exempted from the rates are treated the same way as the issuer.
 
 	// Examples
 	// burn_rate: 10%
@@ -157,8 +195,9 @@ func (k Keeper) CalculateRateShares(ctx sdk.Context, rate sdk.Dec, issuer string
 		return nil
 	}
 
-	inputSumNonIssuer := nonIssuerSum(inOps, issuer)
-	outputSumNonIssuer := nonIssuerSum(outOps, issuer)
+	isExempted := k.rateExemptionChecker(ctx, def)
+	inputSumNonIssuer := nonExemptedSum(inOps, isExempted)
+	outputSumNonIssuer := nonExemptedSum(outOps, isExempted)
 
 	minNonIssuer := inputSumNonIssuer
 	if outputSumNonIssuer.LT(minNonIssuer) {
@@ -171,8 +210,8 @@ func (k Keeper) CalculateRateShares(ctx sdk.Context, rate sdk.Dec, issuer string
 
 	shares := make(accountOperationMap, 0)
 	for account, amount := range inOps {
-		// if sender is issuer or IBC escrow
-		if account == issuer {
+		// if sender is issuer or exempted from the rates
+		if isExempted(account) {
 			continue
 		}
 		// in order to reduce precision errors, we first multiply all sdk.Ints, and then multiply sdk.Decs, and then divide
@@ -183,6 +222,23 @@ func (k Keeper) CalculateRateShares(ctx sdk.Context, rate sdk.Dec, issuer string
 	return shares
 }
 
+// rateExemptionChecker returns the function checking if the account is exempted from the rates of the token.
+// The store is read once per account.
+func (k Keeper) rateExemptionChecker(ctx sdk.Context, def types.Definition) func(account string) bool {
+	exempted := make(map[string]bool)
+	return func(account string) bool {
+		if def.Admin != "" && account == def.Admin {
+			return true
+		}
+		isExempted, ok := exempted[account]
+		if !ok {
+			isExempted = k.isRateExempted(ctx, def.Denom, sdk.MustAccAddressFromBech32(account))
+			exempted[account] = isExempted
+		}
+		return isExempted
+	}
+}
+
 func sortedKeys[V any](m map[string]V) []string {
 	keys := make([]string, len(m))
 	i := 0
@@ -11,7 +11,8 @@ import (
 
 	"github.com/CoreumFoundation/coreum/v2/pkg/config"
 	"github.com/CoreumFoundation/coreum/v2/pkg/config/constant"
-	assetftkeeper "github.com/CoreumFoundation/coreum/v2/x/asset/ft/keeper"
+	"github.com/CoreumFoundation/coreum/v2/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/v2/x/asset/ft/types"
 	wibctransfertypes "github.com/CoreumFoundation/coreum/v2/x/wibctransfer/types"
 )
 
@@ -33,8 +34,14 @@ func TestCalculateRateShares(t *testing.T) {
 		accounts = append(accounts, genAccount())
 	}
 	issuer := genAccount()
+	exempted := genAccount()
 	dummyAddress := genAccount()
-	assetFTKeeper := assetftkeeper.NewKeeper(nil, nil, nil, nil, nil)
+	def := types.Definition{
+		Denom: types.BuildDenom("def", sdk.MustAccAddressFromBech32(issuer)),
+		Admin: issuer,
+	}
+	testApp := simapp.New()
+	assetFTKeeper := testApp.AssetFTKeeper
 	pow10 := func(ex int64) sdk.Int {
 		return sdk.NewIntFromBigInt(big.NewInt(0).Exp(big.NewInt(10), big.NewInt(ex), nil))
 	}
@@ -314,19 +321,76 @@ func TestCalculateRateShares(t *testing.T) {
 			ibcDirection: wibctransfertypes.PurposeIn,
 			shares:       map[string]sdk.Int{},
 		},
+		{
+			name: "exempted_sender_one_receiver",
+			rate: "0.5",
+			senders: map[string]sdk.Int{
+				exempted: sdk.NewInt(10),
+			},
+			receivers: map[string]sdk.Int{
+				accounts[0]: sdk.NewInt(10),
+			},
+			shares: map[string]sdk.Int{},
+		},
+		{
+			name: "one_sender_exempted_receiver",
+			rate: "0.5",
+			senders: map[string]sdk.Int{
+				accounts[0]: sdk.NewInt(10),
+			},
+			receivers: map[string]sdk.Int{
+				exempted: sdk.NewInt(10),
+			},
+			shares: map[string]sdk.Int{},
+		},
+		{
+			name: "exempted_and_regular_senders_one_receiver",
+			rate: "0.5",
+			senders: map[string]sdk.Int{
+				exempted:    sdk.NewInt(10),
+				accounts[0]: sdk.NewInt(10),
+			},
+			receivers: map[string]sdk.Int{
+				accounts[1]: sdk.NewInt(20),
+			},
+			shares: map[string]sdk.Int{
+				accounts[0]: sdk.NewInt(5),
+			},
+		},
+		{
+			name: "two_senders_exempted_and_regular_receivers",
+			rate: "0.5",
+			senders: map[string]sdk.Int{
+				accounts[0]: sdk.NewInt(10),
+				accounts[1]: sdk.NewInt(10),
+			},
+			receivers: map[string]sdk.Int{
+				exempted:    sdk.NewInt(10),
+				accounts[2]: sdk.NewInt(10),
+			},
+			shares: map[string]sdk.Int{
+				accounts[0]: sdk.NewInt(3),
+				accounts[1]: sdk.NewInt(3),
+			},
+		},
 	}
 
 	for _, tc := range testCases {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
 			assertT := assert.New(t)
-			ctx := sdk.NewContext(nil, tmproto.Header{}, false, nil)
+			ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+			assetFTKeeper.SetRateExemption(ctx, types.RateExemption{
+				Denom:   def.Denom,
+				Account: exempted,
+			})
 
 			if tc.ibcDirection != "" {
 				ctx = wibctransfertypes.WithPurpose(ctx, tc.ibcDirection)
 			}
 
-			shares := assetFTKeeper.CalculateRateShares(ctx, sdk.MustNewDecFromStr(tc.rate), issuer, tc.senders, tc.receivers)
+			shares := assetFTKeeper.CalculateRateShares(ctx, sdk.MustNewDecFromStr(tc.rate), def, tc.senders, tc.receivers)
+			assertT.Len(shares, len(tc.shares))
 			for account, share := range shares {
 				assertT.EqualValues(tc.shares[account].String(), share.String())
 			}
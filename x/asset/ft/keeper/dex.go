@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CoreumFoundation/coreum/v2/x/asset/ft/types"
+)
+
+// ValidateLockedCoinSpendable checks that the amount might be spent from the funds locked by the dex order of the
+// account. The locked funds are kept on the dex module account, so they are treated as a part of the account balance
+// and the freezing rules are applied the same way as if they were never sent from the account.
+func (k Keeper) ValidateLockedCoinSpendable(ctx sdk.Context, addr sdk.AccAddress, locked sdk.Coin, amount sdk.Int) error {
+	def, err := k.GetDefinition(ctx, locked.Denom)
+	if types.ErrInvalidDenom.Is(err) || types.ErrTokenNotFound.Is(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if def.IsAdmin(addr) || !def.IsFeatureEnabled(types.Feature_freezing) {
+		return nil
+	}
+
+	if k.isGloballyFrozen(ctx, def.Denom) {
+		return sdkerrors.Wrapf(types.ErrGloballyFrozen, "%s is globally frozen", def.Denom)
+	}
+
+	balance := k.bankKeeper.GetBalance(ctx, addr, def.Denom).Add(locked)
+	frozenBalance := k.GetFrozenBalance(ctx, addr, def.Denom)
+	if balance.Amount.Sub(frozenBalance.Amount).LT(amount) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "%s is not available, balance including locked funds %s, frozen %s",
+			sdk.NewCoin(def.Denom, amount), balance, frozenBalance)
+	}
+	return nil
+}
+
+// ChargeLockedCoinRates charges the burn rate and the send commission for the amount sent from the funds locked
+// by the dex order of the owner to the recipient. The rates are paid from the owner's balance, the same way
+// as if the amount was sent by the owner directly.
+func (k Keeper) ChargeLockedCoinRates(ctx sdk.Context, owner, recipient sdk.AccAddress, coin sdk.Coin) error {
+	def, err := k.GetDefinition(ctx, coin.Denom)
+	if types.ErrInvalidDenom.Is(err) || types.ErrTokenNotFound.Is(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return k.chargeRates(
+		ctx,
+		def,
+		accountOperationMap{owner.String(): coin.Amount},
+		accountOperationMap{recipient.String(): coin.Amount},
+	)
+}
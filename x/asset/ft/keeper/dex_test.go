@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/v2/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/v2/x/asset/ft/types"
+)
+
+func TestKeeper_ValidateLockedCoinSpendable(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	owner := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(1000),
+		Features:      []types.Feature{types.Feature_freezing},
+	})
+	requireT.NoError(err)
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, owner, sdk.NewCoins(sdk.NewInt64Coin(denom, 20))))
+
+	locked := sdk.NewInt64Coin(denom, 100)
+
+	// not frozen funds might be spent
+	requireT.NoError(ftKeeper.ValidateLockedCoinSpendable(ctx, owner, locked, sdk.NewInt(100)))
+
+	// frozen amount is covered by the balance and the locked funds together
+	requireT.NoError(ftKeeper.Freeze(ctx, issuer, owner, sdk.NewInt64Coin(denom, 50)))
+	requireT.NoError(ftKeeper.ValidateLockedCoinSpendable(ctx, owner, locked, sdk.NewInt(70)))
+	err = ftKeeper.ValidateLockedCoinSpendable(ctx, owner, locked, sdk.NewInt(71))
+	requireT.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	// global freeze blocks the locked funds
+	requireT.NoError(ftKeeper.Unfreeze(ctx, issuer, owner, sdk.NewInt64Coin(denom, 50)))
+	requireT.NoError(ftKeeper.GloballyFreeze(ctx, issuer, denom))
+	err = ftKeeper.ValidateLockedCoinSpendable(ctx, owner, locked, sdk.NewInt(1))
+	requireT.ErrorIs(err, types.ErrGloballyFrozen)
+
+	// admin funds are never frozen
+	requireT.NoError(ftKeeper.ValidateLockedCoinSpendable(ctx, issuer, locked, sdk.NewInt(100)))
+
+	// other denoms are not validated
+	requireT.NoError(ftKeeper.ValidateLockedCoinSpendable(ctx, owner, sdk.NewInt64Coin("denom", 1), sdk.NewInt(1)))
+}
+
+func TestKeeper_ChargeLockedCoinRates(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	owner := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:             issuer,
+		Symbol:             "DEF",
+		Subunit:            "def",
+		Precision:          6,
+		InitialAmount:      sdk.NewInt(1000),
+		BurnRate:           sdk.MustNewDecFromStr("0.1"),
+		SendCommissionRate: sdk.MustNewDecFromStr("0.2"),
+	})
+	requireT.NoError(err)
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, owner, sdk.NewCoins(sdk.NewInt64Coin(denom, 50))))
+
+	// rates are paid from the balance of the owner
+	requireT.NoError(ftKeeper.ChargeLockedCoinRates(ctx, owner, recipient, sdk.NewInt64Coin(denom, 100)))
+	requireT.Equal("20", bankKeeper.GetBalance(ctx, owner, denom).Amount.String())
+	requireT.Equal("970", bankKeeper.GetBalance(ctx, issuer, denom).Amount.String())
+	requireT.True(bankKeeper.GetBalance(ctx, recipient, denom).IsZero())
+
+	// rates are not charged when sending to the admin
+	requireT.NoError(ftKeeper.ChargeLockedCoinRates(ctx, owner, issuer, sdk.NewInt64Coin(denom, 100)))
+	requireT.Equal("20", bankKeeper.GetBalance(ctx, owner, denom).Amount.String())
+
+	// the owner must be able to pay the rates
+	err = ftKeeper.ChargeLockedCoinRates(ctx, owner, recipient, sdk.NewInt64Coin(denom, 100))
+	requireT.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	// other denoms are not charged
+	requireT.NoError(ftKeeper.ChargeLockedCoinRates(ctx, owner, recipient, sdk.NewInt64Coin("denom", 100)))
+}
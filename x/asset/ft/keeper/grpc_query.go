@@ -16,12 +16,29 @@ var _ types.QueryServer = QueryService{}
 type QueryKeeper interface {
 	GetParams(ctx sdk.Context) types.Params
 	GetIssuerTokens(ctx sdk.Context, issuer sdk.AccAddress, pagination *query.PageRequest) ([]types.Token, *query.PageResponse, error)
+	GetAdminTokens(ctx sdk.Context, admin sdk.AccAddress, pagination *query.PageRequest) ([]types.Token, *query.PageResponse, error)
 	GetToken(ctx sdk.Context, denom string) (types.Token, error)
+	GetRemainingMintableAmount(ctx sdk.Context, denom string) (sdk.Int, bool, error)
 	GetTokenUpgradeStatuses(ctx sdk.Context, denom string) types.TokenUpgradeStatuses
+	GetRoleGrants(ctx sdk.Context, denom string, pagination *query.PageRequest) ([]types.RoleGrant, *query.PageResponse, error)
+	GetAccountRoleGrants(ctx sdk.Context, denom string, account sdk.AccAddress) ([]types.RoleGrant, error)
+	GetRateExemptions(
+		ctx sdk.Context,
+		denom string,
+		pagination *query.PageRequest,
+	) ([]types.RateExemption, *query.PageResponse, error)
+	GetAccountScheduledActions(
+		ctx sdk.Context,
+		account sdk.AccAddress,
+		pagination *query.PageRequest,
+	) ([]types.ScheduledAction, *query.PageResponse, error)
 	GetFrozenBalances(ctx sdk.Context, addr sdk.AccAddress, pagination *query.PageRequest) (sdk.Coins, *query.PageResponse, error)
 	GetFrozenBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	GetWhitelistedBalances(ctx sdk.Context, addr sdk.AccAddress, pagination *query.PageRequest) (sdk.Coins, *query.PageResponse, error)
 	GetWhitelistedBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetTransferLimits(ctx sdk.Context, denom string) (types.TransferLimits, error)
+	GetTokenDailyVolume(ctx sdk.Context, denom string) (sdk.Int, error)
+	GetAccountDailyVolume(ctx sdk.Context, denom string, account sdk.AccAddress) (sdk.Int, error)
 }
 
 // BankKeeper represents required methods of bank keeper.
@@ -68,16 +85,43 @@ func (qs QueryService) Tokens(ctx context.Context, req *types.QueryTokensRequest
 	}, nil
 }
 
+// AdminTokens returns the fungible tokens administered by the account.
+func (qs QueryService) AdminTokens(ctx context.Context, req *types.QueryAdminTokensRequest) (*types.QueryAdminTokensResponse, error) {
+	admin, err := sdk.AccAddressFromBech32(req.Admin)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "admin is required and must be valid account address")
+	}
+	tokens, pageRes, err := qs.keeper.GetAdminTokens(sdk.UnwrapSDKContext(ctx), admin, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAdminTokensResponse{
+		Pagination: pageRes,
+		Tokens:     tokens,
+	}, nil
+}
+
 // Token queries an fungible token.
 func (qs QueryService) Token(ctx context.Context, req *types.QueryTokenRequest) (*types.QueryTokenResponse, error) {
-	token, err := qs.keeper.GetToken(sdk.UnwrapSDKContext(ctx), req.GetDenom())
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	token, err := qs.keeper.GetToken(sdkCtx, req.GetDenom())
 	if err != nil {
 		return nil, err
 	}
 
-	return &types.QueryTokenResponse{
+	res := &types.QueryTokenResponse{
 		Token: token,
-	}, nil
+	}
+	remaining, limited, err := qs.keeper.GetRemainingMintableAmount(sdkCtx, req.GetDenom())
+	if err != nil {
+		return nil, err
+	}
+	if limited {
+		res.RemainingMintableAmount = &remaining
+	}
+
+	return res, nil
 }
 
 // TokenUpgradeStatuses returns the token upgrade statuses of a specified denom.
@@ -89,6 +133,73 @@ func (qs QueryService) TokenUpgradeStatuses(ctx context.Context, req *types.Quer
 	}, nil
 }
 
+// Roles returns the roles granted for the token.
+func (qs QueryService) Roles(ctx context.Context, req *types.QueryRolesRequest) (*types.QueryRolesResponse, error) {
+	grants, pageRes, err := qs.keeper.GetRoleGrants(sdk.UnwrapSDKContext(ctx), req.GetDenom(), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRolesResponse{
+		Pagination: pageRes,
+		RoleGrants: grants,
+	}, nil
+}
+
+// RateExemptions returns the accounts exempted from the burn rate and the send commission of the token.
+func (qs QueryService) RateExemptions(
+	ctx context.Context,
+	req *types.QueryRateExemptionsRequest,
+) (*types.QueryRateExemptionsResponse, error) {
+	exemptions, pageRes, err := qs.keeper.GetRateExemptions(sdk.UnwrapSDKContext(ctx), req.GetDenom(), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRateExemptionsResponse{
+		Pagination:     pageRes,
+		RateExemptions: exemptions,
+	}, nil
+}
+
+// ScheduledActions returns the pending actions scheduled for the account.
+func (qs QueryService) ScheduledActions(
+	ctx context.Context,
+	req *types.QueryScheduledActionsRequest,
+) (*types.QueryScheduledActionsResponse, error) {
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	actions, pageRes, err := qs.keeper.GetAccountScheduledActions(sdk.UnwrapSDKContext(ctx), account, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryScheduledActionsResponse{
+		Pagination:       pageRes,
+		ScheduledActions: actions,
+	}, nil
+}
+
+// AccountRoles returns the roles of the token granted to the account.
+func (qs QueryService) AccountRoles(ctx context.Context, req *types.QueryAccountRolesRequest) (*types.QueryAccountRolesResponse, error) {
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	grants, err := qs.keeper.GetAccountRoleGrants(sdk.UnwrapSDKContext(ctx), req.GetDenom(), account)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAccountRolesResponse{
+		RoleGrants: grants,
+	}, nil
+}
+
 // Balance returns balance of the denom for the account.
 func (qs QueryService) Balance(goCtx context.Context, req *types.QueryBalanceRequest) (*types.QueryBalanceResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -171,3 +282,46 @@ func (qs QueryService) WhitelistedBalance(goCtx context.Context, req *types.Quer
 		Balance: balance,
 	}, nil
 }
+
+// TransferLimits returns the transfer limits of the token and the amount sent by all the accounts within the rolling
+// window.
+func (qs QueryService) TransferLimits(
+	ctx context.Context,
+	req *types.QueryTransferLimitsRequest,
+) (*types.QueryTransferLimitsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	limits, err := qs.keeper.GetTransferLimits(sdkCtx, req.GetDenom())
+	if err != nil {
+		return nil, err
+	}
+
+	volume, err := qs.keeper.GetTokenDailyVolume(sdkCtx, req.GetDenom())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTransferLimitsResponse{
+		TransferLimits:   limits,
+		TokenDailyVolume: volume,
+	}, nil
+}
+
+// AccountTransferVolume returns the amount of the token sent by the account within the rolling window.
+func (qs QueryService) AccountTransferVolume(
+	ctx context.Context,
+	req *types.QueryAccountTransferVolumeRequest,
+) (*types.QueryAccountTransferVolumeResponse, error) {
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	volume, err := qs.keeper.GetAccountDailyVolume(sdk.UnwrapSDKContext(ctx), req.GetDenom(), account)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAccountTransferVolumeResponse{
+		DailyVolume: volume,
+	}, nil
+}
@@ -15,6 +15,14 @@ const (
 	WhitelistingInvariantName = "whitelisting"
 	// BankMetadataExistsInvariantName is bank metadata exist name.
 	BankMetadataExistsInvariantName = "bank-metadata-exist"
+	// AdminIndexInvariantName is admin index invariant name.
+	AdminIndexInvariantName = "admin-index"
+	// RateExemptionInvariantName is rate exemption invariant name.
+	RateExemptionInvariantName = "rate-exemption"
+	// TransferLimitsInvariantName is transfer limits invariant name.
+	TransferLimitsInvariantName = "transfer-limits"
+	// MaxSupplyInvariantName is max supply invariant name.
+	MaxSupplyInvariantName = "max-supply"
 )
 
 // RegisterInvariants registers the bank module invariants.
@@ -22,6 +30,10 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 	ir.RegisterRoute(types.ModuleName, FreezingInvariantName, FreezingInvariant(k))
 	ir.RegisterRoute(types.ModuleName, WhitelistingInvariantName, WhitelistingInvariant(k))
 	ir.RegisterRoute(types.ModuleName, BankMetadataExistsInvariantName, BankMetadataExistInvariant(k))
+	ir.RegisterRoute(types.ModuleName, AdminIndexInvariantName, AdminIndexInvariant(k))
+	ir.RegisterRoute(types.ModuleName, RateExemptionInvariantName, RateExemptionInvariant(k))
+	ir.RegisterRoute(types.ModuleName, TransferLimitsInvariantName, TransferLimitsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, MaxSupplyInvariantName, MaxSupplyInvariant(k))
 }
 
 // FreezingInvariant checks that all accounts in the application have non-negative frozen balances.
@@ -103,6 +115,209 @@ func BankMetadataExistInvariant(k Keeper) sdk.Invariant {
 	}
 }
 
+// AdminIndexInvariant checks that each token is indexed by its admin, and that the index doesn't contain
+// the tokens administered by other accounts.
+func AdminIndexInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		admins := make(map[string]string)
+		err := k.IterateAllDefinitions(ctx, func(definition types.Definition) (bool, error) {
+			if definition.Admin != "" {
+				admins[definition.Denom] = definition.Admin
+			}
+			return false, nil
+		})
+		if err != nil {
+			// impossible
+			panic(err)
+		}
+
+		err = k.IterateAllAdminTokens(ctx, func(admin sdk.AccAddress, denom string) bool {
+			definitionAdmin, ok := admins[denom]
+			if !ok || definitionAdmin != admin.String() {
+				count++
+				msg += fmt.Sprintf("\t%s denom is indexed for %s, but its admin is %q\n", denom, admin, definitionAdmin)
+				return false
+			}
+			delete(admins, denom)
+			return false
+		})
+		if err != nil {
+			count++
+			msg += fmt.Sprintf("can't iterate over admin index %s\n", err)
+		}
+
+		for denom, admin := range admins {
+			count++
+			msg += fmt.Sprintf("\t%s denom is not indexed for its admin %s\n", denom, admin)
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, AdminIndexInvariantName,
+			fmt.Sprintf("number of invalid admin index entries %d\n%s", count, msg),
+		), count != 0
+	}
+}
+
+// RateExemptionInvariant checks that the rate exemptions are valid and belong to the existing tokens.
+func RateExemptionInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		definitions := make(map[string]bool)
+		err := k.IterateAllRateExemptions(ctx, func(exemption types.RateExemption) bool {
+			if err := exemption.Validate(); err != nil {
+				count++
+				msg += fmt.Sprintf("\tinvalid rate exemption of account %s for %s denom: %s\n",
+					exemption.Account, exemption.Denom, err)
+				return false
+			}
+			exists, ok := definitions[exemption.Denom]
+			if !ok {
+				_, err := k.GetDefinition(ctx, exemption.Denom)
+				exists = err == nil
+				definitions[exemption.Denom] = exists
+			}
+			if !exists {
+				count++
+				msg += fmt.Sprintf("\t%s denom of the rate exemption of account %s doesn't exist\n",
+					exemption.Denom, exemption.Account)
+			}
+			return false
+		})
+		if err != nil {
+			count++
+			msg += fmt.Sprintf("can't iterate over rate exemptions %s\n", err)
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, RateExemptionInvariantName,
+			fmt.Sprintf("number of invalid rate exemptions %d\n%s", count, msg),
+		), count != 0
+	}
+}
+
+// TransferLimitsInvariant checks that the transfer limits and the tracked transfer volumes are valid and belong
+// to the existing tokens with the transfer limiting feature enabled.
+func TransferLimitsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		definitions := make(map[string]types.Definition)
+		getDefinition := func(denom string) (types.Definition, bool) {
+			definition, ok := definitions[denom]
+			if !ok {
+				var err error
+				definition, err = k.GetDefinition(ctx, denom)
+				if err != nil {
+					return types.Definition{}, false
+				}
+				definitions[denom] = definition
+			}
+			return definition, true
+		}
+
+		err := k.IterateAllTransferLimits(ctx, func(limits types.TransferLimits) bool {
+			if err := limits.Validate(); err != nil {
+				count++
+				msg += fmt.Sprintf("\tinvalid transfer limits for %s denom: %s\n", limits.Denom, err)
+				return false
+			}
+			if !limits.IsSet() {
+				count++
+				msg += fmt.Sprintf("\tnone of the transfer limits is set for %s denom\n", limits.Denom)
+			}
+			definition, exists := getDefinition(limits.Denom)
+			if !exists {
+				count++
+				msg += fmt.Sprintf("\t%s denom of the transfer limits doesn't exist\n", limits.Denom)
+				return false
+			}
+			if !definition.IsFeatureEnabled(types.Feature_transfer_limiting) {
+				count++
+				msg += fmt.Sprintf("\tfeature %s is disabled, but transfer limits are set for %s denom\n",
+					types.Feature_transfer_limiting.String(), limits.Denom)
+			}
+			return false
+		})
+		if err != nil {
+			count++
+			msg += fmt.Sprintf("can't iterate over transfer limits %s\n", err)
+		}
+
+		err = k.IterateAllTransferVolumes(ctx, func(volume types.TransferVolume) bool {
+			if err := volume.Validate(); err != nil {
+				count++
+				msg += fmt.Sprintf("\tinvalid transfer volume of account %q for %s denom: %s\n",
+					volume.Account, volume.Denom, err)
+				return false
+			}
+			if _, exists := getDefinition(volume.Denom); !exists {
+				count++
+				msg += fmt.Sprintf("\t%s denom of the transfer volume of account %q doesn't exist\n",
+					volume.Denom, volume.Account)
+			}
+			return false
+		})
+		if err != nil {
+			count++
+			msg += fmt.Sprintf("can't iterate over transfer volumes %s\n", err)
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, TransferLimitsInvariantName,
+			fmt.Sprintf("number of invalid transfer limits and volumes %d\n%s", count, msg),
+		), count != 0
+	}
+}
+
+// MaxSupplyInvariant checks that the supply of each token doesn't exceed its max supply.
+func MaxSupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		err := k.IterateAllDefinitions(ctx, func(definition types.Definition) (bool, error) {
+			if !definition.MaxSupply.IsNil() && definition.MaxSupply.IsNegative() {
+				count++
+				msg += fmt.Sprintf("\t%s denom has a negative max supply %s\n", definition.Denom, definition.MaxSupply)
+				return false, nil
+			}
+			if !definition.IsSupplyCapped() {
+				return false, nil
+			}
+			supply := k.bankKeeper.GetSupply(ctx, definition.Denom)
+			if supply.Amount.GT(definition.MaxSupply) {
+				count++
+				msg += fmt.Sprintf("\t%s denom supply %s exceeds the max supply %s\n",
+					definition.Denom, supply.Amount, definition.MaxSupply)
+			}
+			return false, nil
+		})
+		if err != nil {
+			// impossible
+			panic(err)
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, MaxSupplyInvariantName,
+			fmt.Sprintf("number of tokens exceeding the max supply %d\n%s", count, msg),
+		), count != 0
+	}
+}
+
 func applyFeatureBalanceInvariant(
 	ctx sdk.Context,
 	k Keeper,
@@ -202,3 +202,194 @@ func TestBankMetadataExistInvariant(t *testing.T) {
 	_, isBroken = keeper.BankMetadataExistInvariant(ftKeeper)(ctx)
 	requireT.True(isBroken)
 }
+
+func TestAdminIndexInvariant(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	ftKeeper := testApp.AssetFTKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	admin := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	settings := types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     1,
+		InitialAmount: sdk.NewInt(1000),
+	}
+
+	denom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+	requireT.NoError(ftKeeper.TransferAdmin(ctx, issuer, admin, denom))
+
+	// check that current state is valid
+	_, isBroken := keeper.AdminIndexInvariant(ftKeeper)(ctx)
+	requireT.False(isBroken)
+
+	def, err := ftKeeper.GetDefinition(ctx, denom)
+	requireT.NoError(err)
+	requireT.NoError(ftKeeper.ClearAdmin(ctx, admin, denom))
+	_, isBroken = keeper.AdminIndexInvariant(ftKeeper)(ctx)
+	requireT.False(isBroken)
+	clearedDef, err := ftKeeper.GetDefinition(ctx, denom)
+	requireT.NoError(err)
+
+	// break the index by restoring the admin in the definition only
+	ftKeeper.SetDefinition(ctx, issuer, settings.Subunit, def)
+	_, isBroken = keeper.AdminIndexInvariant(ftKeeper)(ctx)
+	requireT.True(isBroken)
+
+	// make the state valid
+	ftKeeper.SetDefinition(ctx, issuer, settings.Subunit, clearedDef)
+	_, isBroken = keeper.AdminIndexInvariant(ftKeeper)(ctx)
+	requireT.False(isBroken)
+
+	// break the index by indexing the token without admin
+	ftKeeper.SetAdminToken(ctx, issuer, denom)
+	_, isBroken = keeper.AdminIndexInvariant(ftKeeper)(ctx)
+	requireT.True(isBroken)
+}
+
+func TestRateExemptionInvariant(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	ftKeeper := testApp.AssetFTKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	account := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	settings := types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     1,
+		InitialAmount: sdk.NewInt(1000),
+		BurnRate:      sdk.MustNewDecFromStr("0.1"),
+	}
+
+	denom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+	requireT.NoError(ftKeeper.AddRateExemption(ctx, issuer, account, denom))
+
+	// check that current state is valid
+	_, isBroken := keeper.RateExemptionInvariant(ftKeeper)(ctx)
+	requireT.False(isBroken)
+
+	// break the state by exempting the account for non-existing token
+	ftKeeper.SetRateExemption(ctx, types.RateExemption{
+		Denom:   types.BuildDenom("nonexisting", issuer),
+		Account: account.String(),
+	})
+	_, isBroken = keeper.RateExemptionInvariant(ftKeeper)(ctx)
+	requireT.True(isBroken)
+}
+
+func TestTransferLimitsInvariant(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	ftKeeper := testApp.AssetFTKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	account := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	settings := types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     1,
+		InitialAmount: sdk.NewInt(1000),
+		Features:      []types.Feature{types.Feature_transfer_limiting},
+	}
+
+	denom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+	requireT.NoError(ftKeeper.UpdateTransferLimits(ctx, issuer, types.TransferLimits{
+		Denom:                 denom,
+		MaxTransferAmount:     sdk.ZeroInt(),
+		MaxAccountDailyAmount: sdk.NewInt(100),
+		MaxTokenDailyAmount:   sdk.ZeroInt(),
+	}))
+	requireT.NoError(testApp.BankKeeper.SendCoins(ctx, issuer, account, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+	requireT.NoError(testApp.BankKeeper.SendCoins(ctx, account, issuer, sdk.NewCoins(sdk.NewInt64Coin(denom, 10))))
+
+	// check that current state is valid
+	_, isBroken := keeper.TransferLimitsInvariant(ftKeeper)(ctx)
+	requireT.False(isBroken)
+
+	// break the state by setting the limits of the token with the feature disabled
+	settings.Subunit = "abc"
+	settings.Symbol = "ABC"
+	settings.Features = nil
+	denom2, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+	ftKeeper.SetTransferLimits(ctx, types.TransferLimits{
+		Denom:                 denom2,
+		MaxTransferAmount:     sdk.NewInt(1),
+		MaxAccountDailyAmount: sdk.ZeroInt(),
+		MaxTokenDailyAmount:   sdk.ZeroInt(),
+	})
+	_, isBroken = keeper.TransferLimitsInvariant(ftKeeper)(ctx)
+	requireT.True(isBroken)
+	ftKeeper.SetTransferLimits(ctx, types.TransferLimits{
+		Denom:                 denom2,
+		MaxTransferAmount:     sdk.ZeroInt(),
+		MaxAccountDailyAmount: sdk.ZeroInt(),
+		MaxTokenDailyAmount:   sdk.ZeroInt(),
+	})
+	_, isBroken = keeper.TransferLimitsInvariant(ftKeeper)(ctx)
+	requireT.False(isBroken)
+
+	// break the state by tracking the volume of non-existing token
+	ftKeeper.SetTransferVolume(ctx, types.TransferVolume{
+		Denom:   types.BuildDenom("nonexisting", issuer),
+		Account: account.String(),
+		Buckets: []types.TransferVolumeBucket{{Hour: 1, Amount: sdk.NewInt(1)}},
+	})
+	_, isBroken = keeper.TransferLimitsInvariant(ftKeeper)(ctx)
+	requireT.True(isBroken)
+}
+
+func TestMaxSupplyInvariant(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	ftKeeper := testApp.AssetFTKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	settings := types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     1,
+		InitialAmount: sdk.NewInt(1000),
+		MaxSupply:     sdk.NewInt(2000),
+		Features:      []types.Feature{types.Feature_minting},
+	}
+
+	denom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+	requireT.NoError(ftKeeper.Mint(ctx, issuer, sdk.NewInt64Coin(denom, 1000)))
+
+	// check that current state is valid
+	_, isBroken := keeper.MaxSupplyInvariant(ftKeeper)(ctx)
+	requireT.False(isBroken)
+
+	// break the state by setting the max supply below the current supply
+	def, err := ftKeeper.GetDefinition(ctx, denom)
+	requireT.NoError(err)
+	def.MaxSupply = sdk.NewInt(1999)
+	ftKeeper.SetDefinition(ctx, issuer, settings.Subunit, def)
+	_, isBroken = keeper.MaxSupplyInvariant(ftKeeper)(ctx)
+	requireT.True(isBroken)
+
+	// break the state by setting negative max supply
+	def.MaxSupply = sdk.NewInt(-1)
+	ftKeeper.SetDefinition(ctx, issuer, settings.Subunit, def)
+	_, isBroken = keeper.MaxSupplyInvariant(ftKeeper)(ctx)
+	requireT.True(isBroken)
+}
@@ -15,6 +15,7 @@ import (
 
 	"github.com/CoreumFoundation/coreum/v2/x/asset"
 	"github.com/CoreumFoundation/coreum/v2/x/asset/ft/types"
+	dextypes "github.com/CoreumFoundation/coreum/v2/x/dex/types"
 	wibctransfertypes "github.com/CoreumFoundation/coreum/v2/x/wibctransfer/types"
 )
 
@@ -31,6 +32,7 @@ type Keeper struct {
 	storeKey      sdk.StoreKey
 	bankKeeper    types.BankKeeper
 	delayKeeper   types.DelayKeeper
+	distrKeeper   types.DistributionKeeper
 }
 
 // NewKeeper creates a new instance of the Keeper.
@@ -40,6 +42,7 @@ func NewKeeper(
 	storeKey sdk.StoreKey,
 	bankKeeper types.BankKeeper,
 	delayKeeper types.DelayKeeper,
+	distrKeeper types.DistributionKeeper,
 ) Keeper {
 	return Keeper{
 		cdc:           cdc,
@@ -47,6 +50,7 @@ func NewKeeper(
 		storeKey:      storeKey,
 		bankKeeper:    bankKeeper,
 		delayKeeper:   delayKeeper,
+		distrKeeper:   distrKeeper,
 	}
 }
 
@@ -92,6 +96,23 @@ func (k Keeper) GetIssuerTokens(ctx sdk.Context, issuer sdk.AccAddress, paginati
 	return tokens, pageResponse, nil
 }
 
+// GetAdminTokens returns fungible tokens administered by the account.
+// Tokens are indexed by the current admin, so the token is returned for the account it has been transferred to,
+// and it is not returned for anyone once the admin is cleared.
+func (k Keeper) GetAdminTokens(ctx sdk.Context, admin sdk.AccAddress, pagination *query.PageRequest) ([]types.Token, *query.PageResponse, error) {
+	definitions, pageResponse, err := k.getAdminDefinitions(ctx, admin, pagination)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tokens, err := k.getTokensByDefinitions(ctx, definitions)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return tokens, pageResponse, nil
+}
+
 // IterateAllDefinitions iterates over all token definitions and applies the provided callback.
 // If true is returned from the callback, iteration is halted.
 func (k Keeper) IterateAllDefinitions(ctx sdk.Context, cb func(types.Definition) (bool, error)) error {
@@ -165,6 +186,12 @@ func (k Keeper) IssueVersioned(ctx sdk.Context, settings types.IssueSettings, ve
 	if err := types.ValidateSendCommissionRate(settings.SendCommissionRate); err != nil {
 		return "", err
 	}
+	if err := k.validateRateRecipients(settings.SendCommissionRecipients, settings.BurnRateRecipients); err != nil {
+		return "", err
+	}
+	if err := types.ValidateMaxSupply(settings.MaxSupply, settings.InitialAmount); err != nil {
+		return "", err
+	}
 
 	err := types.ValidateSymbol(settings.Symbol)
 	if err != nil {
@@ -193,12 +220,18 @@ func (k Keeper) IssueVersioned(ctx sdk.Context, settings types.IssueSettings, ve
 	}
 
 	definition := types.Definition{
-		Denom:              denom,
-		Issuer:             settings.Issuer.String(),
-		Features:           settings.Features,
-		BurnRate:           settings.BurnRate,
-		SendCommissionRate: settings.SendCommissionRate,
-		Version:            version,
+		Denom:                    denom,
+		Issuer:                   settings.Issuer.String(),
+		Features:                 settings.Features,
+		BurnRate:                 settings.BurnRate,
+		SendCommissionRate:       settings.SendCommissionRate,
+		Version:                  version,
+		Admin:                    settings.Issuer.String(),
+		URI:                      settings.URI,
+		URIHash:                  settings.URIHash,
+		SendCommissionRecipients: settings.SendCommissionRecipients,
+		BurnRateRecipients:       settings.BurnRateRecipients,
+		MaxSupply:                settings.MaxSupply,
 	}
 
 	if err := k.SetDenomMetadata(ctx, denom, settings.Symbol, settings.Description, settings.Precision); err != nil {
@@ -206,22 +239,28 @@ func (k Keeper) IssueVersioned(ctx sdk.Context, settings types.IssueSettings, ve
 	}
 
 	k.SetDefinition(ctx, settings.Issuer, settings.Subunit, definition)
+	k.SetAdminToken(ctx, settings.Issuer, denom)
 
 	if err := k.mintIfReceivable(ctx, definition, settings.InitialAmount, settings.Issuer); err != nil {
 		return "", err
 	}
 
 	if err := ctx.EventManager().EmitTypedEvent(&types.EventIssued{
-		Denom:              denom,
-		Issuer:             settings.Issuer.String(),
-		Symbol:             settings.Symbol,
-		Subunit:            settings.Subunit,
-		Precision:          settings.Precision,
-		Description:        settings.Description,
-		InitialAmount:      settings.InitialAmount,
-		Features:           settings.Features,
-		BurnRate:           settings.BurnRate,
-		SendCommissionRate: settings.SendCommissionRate,
+		Denom:                    denom,
+		Issuer:                   settings.Issuer.String(),
+		Symbol:                   settings.Symbol,
+		Subunit:                  settings.Subunit,
+		Precision:                settings.Precision,
+		Description:              settings.Description,
+		InitialAmount:            settings.InitialAmount,
+		Features:                 settings.Features,
+		BurnRate:                 settings.BurnRate,
+		SendCommissionRate:       settings.SendCommissionRate,
+		URI:                      settings.URI,
+		URIHash:                  settings.URIHash,
+		SendCommissionRecipients: settings.SendCommissionRecipients,
+		BurnRateRecipients:       settings.BurnRateRecipients,
+		MaxSupply:                settings.MaxSupply,
 	}); err != nil {
 		return "", sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventIssued event: %s", err)
 	}
@@ -247,6 +286,86 @@ func (k Keeper) SetDefinition(ctx sdk.Context, issuer sdk.AccAddress, subunit st
 	ctx.KVStore(k.storeKey).Set(types.CreateTokenKey(issuer, subunit), k.cdc.MustMarshal(&definition))
 }
 
+// SetAdminToken indexes the fungible token by its admin.
+func (k Keeper) SetAdminToken(ctx sdk.Context, admin sdk.AccAddress, denom string) {
+	ctx.KVStore(k.storeKey).Set(types.CreateAdminTokenKey(admin, denom), asset.StoreTrue)
+}
+
+// IterateAllAdminTokens iterates over all the indexed fungible tokens of all the admins and applies the provided
+// callback. If true is returned from the callback, iteration is halted.
+func (k Keeper) IterateAllAdminTokens(ctx sdk.Context, cb func(admin sdk.AccAddress, denom string) bool) error {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.AdminTokensKeyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		admin, err := types.AddressFromBalancesStore(iterator.Key())
+		if err != nil {
+			return err
+		}
+		denom := string(iterator.Key()[1+len(admin):])
+		if cb(admin, denom) {
+			break
+		}
+	}
+	return nil
+}
+
+// TransferAdmin changes the admin of the fungible token.
+func (k Keeper) TransferAdmin(ctx sdk.Context, sender, addr sdk.AccAddress, denom string) error {
+	def, err := k.GetDefinition(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+
+	if !def.IsAdmin(sender) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only admin can transfer the administration of the token")
+	}
+
+	if err := k.setAdmin(ctx, def, addr); err != nil {
+		return err
+	}
+
+	if err = ctx.EventManager().EmitTypedEvent(&types.EventAdminTransferred{
+		Denom:         denom,
+		PreviousAdmin: sender.String(),
+		CurrentAdmin:  addr.String(),
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventAdminTransferred event: %s", err)
+	}
+
+	return nil
+}
+
+// ClearAdmin removes the admin of the fungible token, so nobody is able to perform the privileged operations anymore.
+// All the roles granted for the token are revoked too.
+func (k Keeper) ClearAdmin(ctx sdk.Context, sender sdk.AccAddress, denom string) error {
+	def, err := k.GetDefinition(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+
+	if !def.IsAdmin(sender) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only admin can clear the admin of the token")
+	}
+
+	if err := k.setAdmin(ctx, def, nil); err != nil {
+		return err
+	}
+
+	if err := k.revokeAllRoles(ctx, denom); err != nil {
+		return err
+	}
+
+	if err = ctx.EventManager().EmitTypedEvent(&types.EventAdminCleared{
+		Denom:         denom,
+		PreviousAdmin: sender.String(),
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventAdminCleared event: %s", err)
+	}
+
+	return nil
+}
+
 // SetDenomMetadata registers denom metadata on the bank keeper.
 func (k Keeper) SetDenomMetadata(ctx sdk.Context, denom, symbol, description string, precision uint32) error {
 	denomMetadata := banktypes.Metadata{
@@ -279,6 +398,48 @@ func (k Keeper) SetDenomMetadata(ctx sdk.Context, denom, symbol, description str
 	return nil
 }
 
+// UpdateMetadata updates the description, URI and URI hash of the fungible token. The description is stored in the
+// denom metadata of the bank module, so the metadata is rewritten.
+func (k Keeper) UpdateMetadata(ctx sdk.Context, sender sdk.AccAddress, denom, description, uri, uriHash string) error {
+	def, err := k.GetDefinition(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+
+	if !def.IsAdmin(sender) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only admin can update the metadata of the token")
+	}
+
+	denomMetadata, found := k.bankKeeper.GetDenomMetaData(ctx, denom)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrTokenNotFound, "metadata for %s denom not found", denom)
+	}
+	denomMetadata.Description = description
+	if err := denomMetadata.Validate(); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "failed to validate denom metadata: %s", err)
+	}
+	k.bankKeeper.SetDenomMetaData(ctx, denomMetadata)
+
+	subunit, issuer, err := types.DeconstructDenom(denom)
+	if err != nil {
+		return err
+	}
+	def.URI = uri
+	def.URIHash = uriHash
+	k.SetDefinition(ctx, issuer, subunit, def)
+
+	if err = ctx.EventManager().EmitTypedEvent(&types.EventMetadataUpdated{
+		Denom:       denom,
+		Description: description,
+		URI:         uri,
+		URIHash:     uriHash,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventMetadataUpdated event: %s", err)
+	}
+
+	return nil
+}
+
 // Mint mints new fungible token.
 func (k Keeper) Mint(ctx sdk.Context, sender sdk.AccAddress, coin sdk.Coin) error {
 	def, err := k.GetDefinition(ctx, coin.Denom)
@@ -286,10 +447,16 @@ func (k Keeper) Mint(ctx sdk.Context, sender sdk.AccAddress, coin sdk.Coin) erro
 		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", coin.Denom)
 	}
 
-	if err = def.CheckFeatureAllowed(sender, types.Feature_minting); err != nil {
+	if err = k.checkFeatureAllowed(ctx, sender, def, types.Feature_minting); err != nil {
 		return err
 	}
 
+	if !def.IsAdmin(sender) {
+		if err := k.consumeMintCap(ctx, sender, coin); err != nil {
+			return err
+		}
+	}
+
 	return k.mintIfReceivable(ctx, def, coin.Amount, sender)
 }
 
@@ -319,11 +486,11 @@ func (k Keeper) Freeze(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.Co
 		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", coin.Denom)
 	}
 
-	if def.IsIssuer(addr) {
-		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "issuer's balance can't be frozen")
+	if def.IsAdmin(addr) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "admin's balance can't be frozen")
 	}
 
-	if err = def.CheckFeatureAllowed(sender, types.Feature_freezing); err != nil {
+	if err = k.checkFeatureAllowed(ctx, sender, def, types.Feature_freezing); err != nil {
 		return err
 	}
 
@@ -355,7 +522,7 @@ func (k Keeper) Unfreeze(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.
 		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", coin.Denom)
 	}
 
-	if err = def.CheckFeatureAllowed(sender, types.Feature_freezing); err != nil {
+	if err = k.checkFeatureAllowed(ctx, sender, def, types.Feature_freezing); err != nil {
 		return err
 	}
 
@@ -391,7 +558,7 @@ func (k Keeper) GloballyFreeze(ctx sdk.Context, sender sdk.AccAddress, denom str
 		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
 	}
 
-	if err = def.CheckFeatureAllowed(sender, types.Feature_freezing); err != nil {
+	if err = k.checkFeatureAllowed(ctx, sender, def, types.Feature_freezing); err != nil {
 		return err
 	}
 
@@ -406,7 +573,7 @@ func (k Keeper) GloballyUnfreeze(ctx sdk.Context, sender sdk.AccAddress, denom s
 		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
 	}
 
-	if err = def.CheckFeatureAllowed(sender, types.Feature_freezing); err != nil {
+	if err = k.checkFeatureAllowed(ctx, sender, def, types.Feature_freezing); err != nil {
 		return err
 	}
 
@@ -414,6 +581,48 @@ func (k Keeper) GloballyUnfreeze(ctx sdk.Context, sender sdk.AccAddress, denom s
 	return nil
 }
 
+// Clawback moves the tokens from the account to the admin. The frozen balances of the account and the global freeze
+// of the token are ignored.
+func (k Keeper) Clawback(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.Coin) error {
+	if !coin.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "clawback amount should be positive")
+	}
+
+	def, err := k.GetDefinition(ctx, coin.Denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", coin.Denom)
+	}
+
+	if err = k.checkFeatureAllowed(ctx, sender, def, types.Feature_clawback); err != nil {
+		return err
+	}
+
+	if def.IsAdmin(addr) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "admin's balance can't be clawed back")
+	}
+
+	// The balances of the module accounts are managed by the modules, so taking them would break their accounting.
+	if k.bankKeeper.BlockedAddr(addr) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "balance of the module account %s can't be clawed back", addr)
+	}
+
+	// The bank keeper used by the module doesn't call the asset ft hooks, so neither the frozen balance nor the global
+	// freeze may block the clawback, and the rates are not applied.
+	if err := k.bankKeeper.SendCoins(ctx, addr, sender, sdk.NewCoins(coin)); err != nil {
+		return sdkerrors.Wrapf(err, "can't claw back %s from account %s", coin, addr)
+	}
+
+	if err = ctx.EventManager().EmitTypedEvent(&types.EventClawback{
+		Account: addr.String(),
+		Admin:   sender.String(),
+		Coin:    coin,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventClawback event: %s", err)
+	}
+
+	return nil
+}
+
 // GetAccountsFrozenBalances returns the frozen balance on all the account.
 func (k Keeper) GetAccountsFrozenBalances(ctx sdk.Context, pagination *query.PageRequest) ([]types.Balance, *query.PageResponse, error) {
 	return collectBalances(k.cdc, k.frozenBalancesStore(ctx), pagination)
@@ -464,11 +673,11 @@ func (k Keeper) SetWhitelistedBalance(ctx sdk.Context, sender, addr sdk.AccAddre
 		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", coin.Denom)
 	}
 
-	if def.IsIssuer(addr) {
-		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "issuer's balance can't be whitelisted")
+	if def.IsAdmin(addr) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "admin's balance can't be whitelisted")
 	}
 
-	if err = def.CheckFeatureAllowed(sender, types.Feature_whitelisting); err != nil {
+	if err = k.checkFeatureAllowed(ctx, sender, def, types.Feature_whitelisting); err != nil {
 		return err
 	}
 
@@ -522,6 +731,9 @@ func (k Keeper) mintIfReceivable(ctx sdk.Context, def types.Definition, amount s
 	if !amount.IsPositive() {
 		return nil
 	}
+	if err := k.checkMaxSupply(ctx, def, amount); err != nil {
+		return err
+	}
 	if err := k.isCoinReceivable(ctx, recipient, def, amount); err != nil {
 		return sdkerrors.Wrapf(err, "coins are not receivable")
 	}
@@ -577,7 +789,17 @@ func (k Keeper) isCoinSpendable(ctx sdk.Context, addr sdk.AccAddress, def types.
 		return nil
 	}
 
-	if !def.IsFeatureEnabled(types.Feature_freezing) || def.IsIssuer(addr) {
+	// Funds locked by the dex order are always refunded to the owner when the order is canceled or closed,
+	// otherwise they would be blocked on the dex module account forever.
+	if dextypes.IsPurposeUnlock(ctx) {
+		return nil
+	}
+
+	if def.IsAdmin(addr) {
+		return nil
+	}
+
+	if !def.IsFeatureEnabled(types.Feature_freezing) {
 		return nil
 	}
 
@@ -634,8 +856,15 @@ func (k Keeper) isCoinReceivable(ctx sdk.Context, addr sdk.AccAddress, def types
 		return nil
 	}
 
+	// The dex module account is always accepted as a receiver of funds locked by the order, and the owner always
+	// receives back the funds refunded by the order. Whitelisting is still checked when order is settled
+	// and the counterparty receives the funds.
+	if dextypes.IsPurposeLock(ctx) || dextypes.IsPurposeUnlock(ctx) {
+		return nil
+	}
+
 	if !def.IsFeatureEnabled(types.Feature_whitelisting) ||
-		def.IsIssuer(addr) {
+		def.IsAdmin(addr) {
 		return nil
 	}
 
@@ -677,6 +906,45 @@ func (k Keeper) getIssuerDefinitions(ctx sdk.Context, issuer sdk.AccAddress, pag
 	return k.getDefinitionsFromStore(prefix.NewStore(ctx.KVStore(k.storeKey), types.CreateIssuerTokensPrefix(issuer)), pagination)
 }
 
+func (k Keeper) getAdminDefinitions(ctx sdk.Context, admin sdk.AccAddress, pagination *query.PageRequest) ([]types.Definition, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CreateAdminTokensPrefix(admin))
+	definitions := make([]types.Definition, 0)
+	pageRes, err := query.Paginate(store, pagination, func(key, _ []byte) error {
+		definition, err := k.GetDefinition(ctx, string(key))
+		if err != nil {
+			return err
+		}
+		definitions = append(definitions, definition)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, sdkerrors.Wrapf(types.ErrInvalidInput, "failed to paginate: %s", err)
+	}
+
+	return definitions, pageRes, nil
+}
+
+// setAdmin stores the new admin of the token and moves the token in the admin index. The nil admin clears it.
+func (k Keeper) setAdmin(ctx sdk.Context, def types.Definition, admin sdk.AccAddress) error {
+	subunit, issuer, err := types.DeconstructDenom(def.Denom)
+	if err != nil {
+		return err
+	}
+
+	if def.Admin != "" {
+		ctx.KVStore(k.storeKey).Delete(types.CreateAdminTokenKey(sdk.MustAccAddressFromBech32(def.Admin), def.Denom))
+	}
+
+	def.Admin = ""
+	if admin != nil {
+		def.Admin = admin.String()
+		k.SetAdminToken(ctx, admin, def.Denom)
+	}
+	k.SetDefinition(ctx, issuer, subunit, def)
+
+	return nil
+}
+
 func (k Keeper) getTokenFullInfo(ctx sdk.Context, definition types.Definition) (types.Token, error) {
 	subunit, _, err := types.DeconstructDenom(definition.Denom)
 	if err != nil {
@@ -701,17 +969,24 @@ func (k Keeper) getTokenFullInfo(ctx sdk.Context, definition types.Definition) (
 	}
 
 	return types.Token{
-		Denom:              definition.Denom,
-		Issuer:             definition.Issuer,
-		Symbol:             metadata.Symbol,
-		Precision:          uint32(precision),
-		Subunit:            subunit,
-		Description:        metadata.Description,
-		Features:           definition.Features,
-		BurnRate:           definition.BurnRate,
-		SendCommissionRate: definition.SendCommissionRate,
-		GloballyFrozen:     k.isGloballyFrozen(ctx, definition.Denom),
-		Version:            definition.Version,
+		Denom:                    definition.Denom,
+		Issuer:                   definition.Issuer,
+		Symbol:                   metadata.Symbol,
+		Precision:                uint32(precision),
+		Subunit:                  subunit,
+		Description:              metadata.Description,
+		Features:                 definition.Features,
+		BurnRate:                 definition.BurnRate,
+		SendCommissionRate:       definition.SendCommissionRate,
+		GloballyFrozen:           k.isGloballyFrozen(ctx, definition.Denom),
+		Version:                  definition.Version,
+		Admin:                    definition.Admin,
+		URI:                      definition.URI,
+		URIHash:                  definition.URIHash,
+		SendCommissionRecipients: definition.SendCommissionRecipients,
+		BurnRateRecipients:       definition.BurnRateRecipients,
+		MaxSupply:                definition.MaxSupply,
+		SupplyLocked:             definition.SupplyLocked,
 	}, nil
 }
 
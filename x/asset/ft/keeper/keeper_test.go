@@ -10,6 +10,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -48,6 +49,9 @@ func TestKeeper_Issue(t *testing.T) {
 		Precision:     8,
 		InitialAmount: sdk.NewInt(777),
 		Features:      []types.Feature{types.Feature_freezing},
+		URI:           "https://my-token-meta.invalid/1",
+		URIHash:       "e000624",
+		MaxSupply:     sdk.NewInt(1000),
 	}
 
 	denom, err := ftKeeper.Issue(ctx, settings)
@@ -79,6 +83,10 @@ func TestKeeper_Issue(t *testing.T) {
 		BurnRate:           sdk.NewDec(0),
 		SendCommissionRate: sdk.NewDec(0),
 		Version:            types.CurrentTokenVersion,
+		Admin:              settings.Issuer.String(),
+		URI:                settings.URI,
+		URIHash:            settings.URIHash,
+		MaxSupply:          settings.MaxSupply,
 	}, gotToken)
 
 	// check the metadata
@@ -1571,6 +1579,196 @@ func TestKeeper_GetIssuerTokens(t *testing.T) {
 	requireT.Equal(numberOfTokens, len(tokens))
 }
 
+func TestKeeper_TransferAdmin(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+	ba := newBankAsserter(ctx, t, bankKeeper)
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	admin := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	settings := types.IssueSettings{
+		Issuer:             issuer,
+		Symbol:             "DEF",
+		Subunit:            "def",
+		Precision:          6,
+		InitialAmount:      sdk.NewInt(1000),
+		Features:           []types.Feature{types.Feature_minting, types.Feature_freezing},
+		SendCommissionRate: sdk.MustNewDecFromStr("0.1"),
+	}
+	denom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	// only admin can transfer the admin rights
+	err = ftKeeper.TransferAdmin(ctx, recipient, admin, denom)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	requireT.NoError(ftKeeper.TransferAdmin(ctx, issuer, admin, denom))
+
+	def, err := ftKeeper.GetDefinition(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal(issuer.String(), def.Issuer)
+	requireT.Equal(admin.String(), def.Admin)
+
+	// token is still listed for the issuer, but it is administered by the new admin only
+	tokens, _, err := ftKeeper.GetIssuerTokens(ctx, issuer, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Len(tokens, 1)
+	requireT.Equal(admin.String(), tokens[0].Admin)
+	tokens, _, err = ftKeeper.GetIssuerTokens(ctx, admin, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Empty(tokens)
+	tokens, _, err = ftKeeper.GetAdminTokens(ctx, issuer, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Empty(tokens)
+	tokens, _, err = ftKeeper.GetAdminTokens(ctx, admin, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Len(tokens, 1)
+	requireT.Equal(denom, tokens[0].Denom)
+
+	// previous admin is not allowed to perform privileged operations anymore
+	err = ftKeeper.Mint(ctx, issuer, sdk.NewInt64Coin(denom, 100))
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	err = ftKeeper.GloballyFreeze(ctx, issuer, denom)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	err = ftKeeper.TransferAdmin(ctx, issuer, recipient, denom)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// new admin is
+	requireT.NoError(ftKeeper.Mint(ctx, admin, sdk.NewInt64Coin(denom, 100)))
+	requireT.NoError(ftKeeper.Freeze(ctx, admin, issuer, sdk.NewInt64Coin(denom, 10)))
+	requireT.NoError(ftKeeper.Unfreeze(ctx, admin, issuer, sdk.NewInt64Coin(denom, 10)))
+
+	// send commission is charged from the previous admin and sent to the new one
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 500))))
+	ba.assertCoinDistribution(denom, map[*sdk.AccAddress]int64{
+		&issuer:    450,
+		&admin:     150,
+		&recipient: 500,
+	})
+}
+
+func TestKeeper_ClearAdmin(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+	ba := newBankAsserter(ctx, t, bankKeeper)
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	settings := types.IssueSettings{
+		Issuer:             issuer,
+		Symbol:             "DEF",
+		Subunit:            "def",
+		Precision:          6,
+		InitialAmount:      sdk.NewInt(1000),
+		Features:           []types.Feature{types.Feature_minting},
+		SendCommissionRate: sdk.MustNewDecFromStr("0.1"),
+	}
+	denom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	// only admin can clear the admin
+	err = ftKeeper.ClearAdmin(ctx, recipient, denom)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	requireT.NoError(ftKeeper.ClearAdmin(ctx, issuer, denom))
+
+	def, err := ftKeeper.GetDefinition(ctx, denom)
+	requireT.NoError(err)
+	requireT.Empty(def.Admin)
+
+	tokens, _, err := ftKeeper.GetIssuerTokens(ctx, issuer, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Len(tokens, 1)
+	tokens, _, err = ftKeeper.GetAdminTokens(ctx, issuer, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Empty(tokens)
+
+	// nobody is able to perform privileged operations anymore
+	err = ftKeeper.Mint(ctx, issuer, sdk.NewInt64Coin(denom, 100))
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	err = ftKeeper.ClearAdmin(ctx, issuer, denom)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	err = ftKeeper.TransferAdmin(ctx, issuer, recipient, denom)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// send commission is charged from the issuer too and burnt
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 500))))
+	ba.assertCoinDistribution(denom, map[*sdk.AccAddress]int64{
+		&issuer:    450,
+		&recipient: 500,
+	})
+}
+
+func TestKeeper_UpdateMetadata(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	admin := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	settings := types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     6,
+		Description:   "DEF Desc",
+		InitialAmount: sdk.NewInt(1000),
+		URI:           "https://my-token-meta.invalid/1",
+		URIHash:       "e000624",
+	}
+	denom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	// only admin can update the metadata
+	err = ftKeeper.UpdateMetadata(ctx, admin, denom, "new desc", "https://my-token-meta.invalid/2", "e000625")
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// token must exist
+	err = ftKeeper.UpdateMetadata(ctx, issuer, types.BuildDenom("nonexistent", issuer), "new desc", "", "")
+	requireT.ErrorIs(err, types.ErrTokenNotFound)
+
+	requireT.NoError(ftKeeper.UpdateMetadata(ctx, issuer, denom, "new desc", "https://my-token-meta.invalid/2", "e000625"))
+
+	token, err := ftKeeper.GetToken(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal("new desc", token.Description)
+	requireT.Equal("https://my-token-meta.invalid/2", token.URI)
+	requireT.Equal("e000625", token.URIHash)
+
+	// the bank metadata is rewritten, but the rest of it stays untouched
+	metadata, found := bankKeeper.GetDenomMetaData(ctx, denom)
+	requireT.True(found)
+	requireT.Equal("new desc", metadata.Description)
+	requireT.Equal(settings.Symbol, metadata.Symbol)
+	requireT.Len(metadata.DenomUnits, 2)
+
+	// empty values clear the metadata
+	requireT.NoError(ftKeeper.UpdateMetadata(ctx, issuer, denom, "", "", ""))
+	token, err = ftKeeper.GetToken(ctx, denom)
+	requireT.NoError(err)
+	requireT.Empty(token.Description)
+	requireT.Empty(token.URI)
+	requireT.Empty(token.URIHash)
+
+	// after the admin is transferred only the new admin can update the metadata
+	requireT.NoError(ftKeeper.TransferAdmin(ctx, issuer, admin, denom))
+	err = ftKeeper.UpdateMetadata(ctx, issuer, denom, "desc", "", "")
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	requireT.NoError(ftKeeper.UpdateMetadata(ctx, admin, denom, "desc", "", ""))
+}
+
 type bankAssertion struct {
 	t   require.TestingT
 	bk  wbankkeeper.BaseKeeperWrapper
@@ -1601,3 +1799,103 @@ func newBankAsserter(
 		ctx: ctx,
 	}
 }
+
+func TestKeeper_Clawback(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	randomAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	settings := types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(1000),
+		Features: []types.Feature{
+			types.Feature_freezing,
+			types.Feature_whitelisting,
+			types.Feature_clawback,
+		},
+		BurnRate:           sdk.MustNewDecFromStr("0.1"),
+		SendCommissionRate: sdk.MustNewDecFromStr("0.2"),
+	}
+	denom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	unclawbackableSettings := types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "ABC",
+		Subunit:       "abc",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(1000),
+	}
+	unclawbackableDenom, err := ftKeeper.Issue(ctx, unclawbackableSettings)
+	requireT.NoError(err)
+
+	requireT.NoError(ftKeeper.SetWhitelistedBalance(ctx, issuer, recipient, sdk.NewCoin(denom, sdk.NewInt(500))))
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, recipient, sdk.NewCoins(
+		sdk.NewCoin(denom, sdk.NewInt(400)),
+		sdk.NewCoin(unclawbackableDenom, sdk.NewInt(400)),
+	)))
+
+	// try to claw back the token with the clawback feature disabled
+	err = ftKeeper.Clawback(ctx, issuer, recipient, sdk.NewCoin(unclawbackableDenom, sdk.NewInt(100)))
+	requireT.ErrorIs(err, types.ErrFeatureDisabled)
+
+	// try to claw back non-existent denom
+	err = ftKeeper.Clawback(ctx, issuer, recipient, sdk.NewCoin(types.BuildDenom("nonexist", issuer), sdk.NewInt(100)))
+	requireT.ErrorIs(err, types.ErrTokenNotFound)
+
+	// try to claw back by non-admin
+	err = ftKeeper.Clawback(ctx, randomAddr, recipient, sdk.NewCoin(denom, sdk.NewInt(100)))
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// try to claw back from the admin
+	err = ftKeeper.Clawback(ctx, issuer, issuer, sdk.NewCoin(denom, sdk.NewInt(100)))
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// try to claw back from the module account
+	moduleAddr := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	err = ftKeeper.Clawback(ctx, issuer, moduleAddr, sdk.NewCoin(denom, sdk.NewInt(100)))
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// try to claw back more than the account holds
+	err = ftKeeper.Clawback(ctx, issuer, recipient, sdk.NewCoin(denom, sdk.NewInt(401)))
+	requireT.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	// freeze the balance and the token globally, clawback ignores both
+	requireT.NoError(ftKeeper.Freeze(ctx, issuer, recipient, sdk.NewCoin(denom, sdk.NewInt(400))))
+	requireT.NoError(ftKeeper.GloballyFreeze(ctx, issuer, denom))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	requireT.NoError(ftKeeper.Clawback(ctx, issuer, recipient, sdk.NewCoin(denom, sdk.NewInt(300))))
+
+	// neither the burn rate nor the send commission rate is applied
+	requireT.Equal(sdk.NewInt(100).String(), bankKeeper.GetBalance(ctx, recipient, denom).Amount.String())
+	requireT.Equal(sdk.NewInt(900).String(), bankKeeper.GetBalance(ctx, issuer, denom).Amount.String())
+
+	// the frozen amount stays untouched
+	requireT.Equal(sdk.NewInt(400).String(), ftKeeper.GetFrozenBalance(ctx, recipient, denom).Amount.String())
+
+	clawbackEvents, err := event.FindTypedEvents[*types.EventClawback](ctx.EventManager().Events().ToABCIEvents())
+	requireT.NoError(err)
+	requireT.Equal([]*types.EventClawback{{
+		Account: recipient.String(),
+		Admin:   issuer.String(),
+		Coin:    sdk.NewCoin(denom, sdk.NewInt(300)),
+	}}, clawbackEvents)
+
+	// after the admin is transferred only the new admin can claw back the tokens
+	requireT.NoError(ftKeeper.TransferAdmin(ctx, issuer, randomAddr, denom))
+	err = ftKeeper.Clawback(ctx, issuer, recipient, sdk.NewCoin(denom, sdk.NewInt(10)))
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	requireT.NoError(ftKeeper.Clawback(ctx, randomAddr, recipient, sdk.NewCoin(denom, sdk.NewInt(10))))
+	requireT.Equal(sdk.NewInt(10).String(), bankKeeper.GetBalance(ctx, randomAddr, denom).Amount.String())
+}
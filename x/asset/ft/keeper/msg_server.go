@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -22,6 +23,26 @@ type MsgKeeper interface {
 	GloballyUnfreeze(ctx sdk.Context, sender sdk.AccAddress, denom string) error
 	SetWhitelistedBalance(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.Coin) error
 	AddDelayedTokenUpgradeV1(ctx sdk.Context, sender sdk.AccAddress, denom string, ibcEnabled bool) error
+	TransferAdmin(ctx sdk.Context, sender, addr sdk.AccAddress, denom string) error
+	ClearAdmin(ctx sdk.Context, sender sdk.AccAddress, denom string) error
+	GrantRole(ctx sdk.Context, sender, account sdk.AccAddress, denom string, role types.Role, mintCap sdk.Int) error
+	RevokeRole(ctx sdk.Context, sender, account sdk.AccAddress, denom string, role types.Role) error
+	UpdateMetadata(ctx sdk.Context, sender sdk.AccAddress, denom, description, uri, uriHash string) error
+	Clawback(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.Coin) error
+	ScheduleMint(ctx sdk.Context, sender sdk.AccAddress, coin sdk.Coin, executionTime time.Time) error
+	ScheduleUnfreeze(ctx sdk.Context, sender, account sdk.AccAddress, coin sdk.Coin, executionTime time.Time) error
+	CancelScheduledAction(ctx sdk.Context, sender sdk.AccAddress, id uint64) error
+	RetryScheduledAction(ctx sdk.Context, sender sdk.AccAddress, id uint64) error
+	AddRateExemption(ctx sdk.Context, sender, account sdk.AccAddress, denom string) error
+	RemoveRateExemption(ctx sdk.Context, sender, account sdk.AccAddress, denom string) error
+	UpdateRateRecipients(
+		ctx sdk.Context,
+		sender sdk.AccAddress,
+		denom string,
+		sendCommissionRecipients, burnRateRecipients []types.RateRecipient,
+	) error
+	UpdateTransferLimits(ctx sdk.Context, sender sdk.AccAddress, limits types.TransferLimits) error
+	LockSupply(ctx sdk.Context, sender sdk.AccAddress, denom string) error
 }
 
 // MsgServer serves grpc tx requests for assets module.
@@ -43,15 +64,20 @@ func (ms MsgServer) Issue(ctx context.Context, req *types.MsgIssue) (*types.Empt
 		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid issuer in MsgIssue")
 	}
 	_, err = ms.keeper.Issue(sdk.UnwrapSDKContext(ctx), types.IssueSettings{
-		Issuer:             issuer,
-		Symbol:             req.Symbol,
-		Subunit:            req.Subunit,
-		Precision:          req.Precision,
-		Description:        req.Description,
-		InitialAmount:      req.InitialAmount,
-		Features:           req.Features,
-		BurnRate:           req.BurnRate,
-		SendCommissionRate: req.SendCommissionRate,
+		Issuer:                   issuer,
+		Symbol:                   req.Symbol,
+		Subunit:                  req.Subunit,
+		Precision:                req.Precision,
+		Description:              req.Description,
+		InitialAmount:            req.InitialAmount,
+		Features:                 req.Features,
+		BurnRate:                 req.BurnRate,
+		SendCommissionRate:       req.SendCommissionRate,
+		URI:                      req.URI,
+		URIHash:                  req.URIHash,
+		SendCommissionRecipients: req.SendCommissionRecipients,
+		BurnRateRecipients:       req.BurnRateRecipients,
+		MaxSupply:                req.MaxSupply,
 	})
 	if err != nil {
 		return nil, err
@@ -200,3 +226,294 @@ func (ms MsgServer) UpgradeTokenV1(goCtx context.Context, req *types.MsgUpgradeT
 
 	return &types.EmptyResponse{}, nil
 }
+
+// TransferAdmin changes the admin of the fungible token.
+func (ms MsgServer) TransferAdmin(goCtx context.Context, req *types.MsgTransferAdmin) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	err = ms.keeper.TransferAdmin(ctx, sender, account, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// ClearAdmin removes the admin of the fungible token.
+func (ms MsgServer) ClearAdmin(goCtx context.Context, req *types.MsgClearAdmin) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	err = ms.keeper.ClearAdmin(ctx, sender, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// GrantRole grants the role of the fungible token to the account.
+func (ms MsgServer) GrantRole(goCtx context.Context, req *types.MsgGrantRole) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	err = ms.keeper.GrantRole(ctx, sender, account, req.Denom, req.Role, req.MintCap)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// RevokeRole revokes the role of the fungible token from the account.
+func (ms MsgServer) RevokeRole(goCtx context.Context, req *types.MsgRevokeRole) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	err = ms.keeper.RevokeRole(ctx, sender, account, req.Denom, req.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// UpdateMetadata updates the metadata of the fungible token.
+func (ms MsgServer) UpdateMetadata(goCtx context.Context, req *types.MsgUpdateMetadata) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	err = ms.keeper.UpdateMetadata(ctx, sender, req.Denom, req.Description, req.URI, req.URIHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// Clawback moves the coins from the account back to the admin.
+func (ms MsgServer) Clawback(goCtx context.Context, req *types.MsgClawback) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	err = ms.keeper.Clawback(ctx, sender, account, req.Coin)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// ScheduleMint schedules the mint of the coins.
+func (ms MsgServer) ScheduleMint(goCtx context.Context, req *types.MsgScheduleMint) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	err = ms.keeper.ScheduleMint(ctx, sender, req.Coin, req.ExecutionTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// ScheduleUnfreeze schedules the unfreeze of the account's frozen balance.
+func (ms MsgServer) ScheduleUnfreeze(goCtx context.Context, req *types.MsgScheduleUnfreeze) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	err = ms.keeper.ScheduleUnfreeze(ctx, sender, account, req.Coin, req.ExecutionTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// CancelScheduledAction cancels the pending scheduled action.
+func (ms MsgServer) CancelScheduledAction(
+	goCtx context.Context,
+	req *types.MsgCancelScheduledAction,
+) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	err = ms.keeper.CancelScheduledAction(ctx, sender, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// RetryScheduledAction executes again the scheduled action which execution failed.
+func (ms MsgServer) RetryScheduledAction(
+	goCtx context.Context,
+	req *types.MsgRetryScheduledAction,
+) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	err = ms.keeper.RetryScheduledAction(ctx, sender, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// AddRateExemption exempts the account from the burn rate and the send commission of the fungible token.
+func (ms MsgServer) AddRateExemption(goCtx context.Context, req *types.MsgAddRateExemption) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	err = ms.keeper.AddRateExemption(ctx, sender, account, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// RemoveRateExemption removes the exemption of the account from the rates of the fungible token.
+func (ms MsgServer) RemoveRateExemption(goCtx context.Context, req *types.MsgRemoveRateExemption) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	err = ms.keeper.RemoveRateExemption(ctx, sender, account, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// UpdateRateRecipients replaces the recipients of the send commission and the burn rate of the fungible token.
+func (ms MsgServer) UpdateRateRecipients(
+	goCtx context.Context,
+	req *types.MsgUpdateRateRecipients,
+) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	err = ms.keeper.UpdateRateRecipients(ctx, sender, req.Denom, req.SendCommissionRecipients, req.BurnRateRecipients)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// SetTransferLimits sets the limits of the amounts of the fungible token sent by the accounts.
+func (ms MsgServer) SetTransferLimits(
+	goCtx context.Context,
+	req *types.MsgSetTransferLimits,
+) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	err = ms.keeper.UpdateTransferLimits(ctx, sender, types.TransferLimits{
+		Denom:                 req.Denom,
+		MaxTransferAmount:     req.MaxTransferAmount,
+		MaxAccountDailyAmount: req.MaxAccountDailyAmount,
+		MaxTokenDailyAmount:   req.MaxTokenDailyAmount,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// LockSupply locks the supply of the fungible token, so no more tokens might be minted.
+func (ms MsgServer) LockSupply(goCtx context.Context, req *types.MsgLockSupply) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if err := ms.keeper.LockSupply(ctx, sender, req.Denom); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
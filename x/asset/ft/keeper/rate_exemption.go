@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/CoreumFoundation/coreum/v2/x/asset/ft/types"
+)
+
+// AddRateExemption exempts the account from the burn rate and the send commission of the fungible token.
+func (k Keeper) AddRateExemption(ctx sdk.Context, sender, account sdk.AccAddress, denom string) error {
+	def, err := k.GetDefinition(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+
+	if !def.IsAdmin(sender) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only admin can add the rate exemptions of the token")
+	}
+
+	if def.IsAdmin(account) {
+		return sdkerrors.Wrap(types.ErrInvalidInput, "the admin is exempted from the rates anyway")
+	}
+
+	if k.isRateExempted(ctx, denom, account) {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "account %s is already exempted from the rates", account)
+	}
+
+	k.SetRateExemption(ctx, types.RateExemption{
+		Denom:   denom,
+		Account: account.String(),
+	})
+
+	if err = ctx.EventManager().EmitTypedEvent(&types.EventRateExemptionAdded{
+		Denom:   denom,
+		Account: account.String(),
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventRateExemptionAdded event: %s", err)
+	}
+
+	return nil
+}
+
+// RemoveRateExemption removes the exemption of the account from the burn rate and the send commission of the
+// fungible token.
+func (k Keeper) RemoveRateExemption(ctx sdk.Context, sender, account sdk.AccAddress, denom string) error {
+	def, err := k.GetDefinition(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+
+	if !def.IsAdmin(sender) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only admin can remove the rate exemptions of the token")
+	}
+
+	key := types.CreateRateExemptionKey(denom, account)
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(key) {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "account %s is not exempted from the rates", account)
+	}
+	store.Delete(key)
+
+	if err = ctx.EventManager().EmitTypedEvent(&types.EventRateExemptionRemoved{
+		Denom:   denom,
+		Account: account.String(),
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventRateExemptionRemoved event: %s", err)
+	}
+
+	return nil
+}
+
+// SetRateExemption stores the rate exemption.
+func (k Keeper) SetRateExemption(ctx sdk.Context, exemption types.RateExemption) {
+	account := sdk.MustAccAddressFromBech32(exemption.Account)
+	ctx.KVStore(k.storeKey).Set(types.CreateRateExemptionKey(exemption.Denom, account), k.cdc.MustMarshal(&exemption))
+}
+
+// GetRateExemptions returns the accounts exempted from the rates of the fungible token.
+func (k Keeper) GetRateExemptions(
+	ctx sdk.Context,
+	denom string,
+	pagination *query.PageRequest,
+) ([]types.RateExemption, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CreateRateExemptionsPrefix(denom))
+	exemptions := make([]types.RateExemption, 0)
+	pageRes, err := query.Paginate(store, pagination, func(_, value []byte) error {
+		var exemption types.RateExemption
+		if err := k.cdc.Unmarshal(value, &exemption); err != nil {
+			return err
+		}
+		exemptions = append(exemptions, exemption)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, sdkerrors.Wrapf(types.ErrInvalidInput, "failed to paginate: %s", err)
+	}
+
+	return exemptions, pageRes, nil
+}
+
+// IterateAllRateExemptions iterates over all the rate exemptions of all the tokens and applies the provided callback.
+// If true is returned from the callback, iteration is halted.
+func (k Keeper) IterateAllRateExemptions(ctx sdk.Context, cb func(types.RateExemption) bool) error {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.RateExemptionsKeyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var exemption types.RateExemption
+		if err := k.cdc.Unmarshal(iterator.Value(), &exemption); err != nil {
+			return err
+		}
+		if cb(exemption) {
+			break
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) isRateExempted(ctx sdk.Context, denom string, account sdk.AccAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(types.CreateRateExemptionKey(denom, account))
+}
@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/v2/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/v2/x/asset/ft/types"
+)
+
+func TestKeeper_AddRemoveRateExemption(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	ftKeeper := testApp.AssetFTKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	exchange := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	settings := types.IssueSettings{
+		Issuer:             issuer,
+		Symbol:             "DEF",
+		Subunit:            "def",
+		Precision:          6,
+		InitialAmount:      sdk.NewInt(1000),
+		BurnRate:           sdk.MustNewDecFromStr("0.1"),
+		SendCommissionRate: sdk.MustNewDecFromStr("0.2"),
+	}
+	denom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	// only admin can add the exemptions
+	err = ftKeeper.AddRateExemption(ctx, exchange, exchange, denom)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// admin can't be exempted
+	err = ftKeeper.AddRateExemption(ctx, issuer, issuer, denom)
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	// exemption can't be removed if it doesn't exist
+	err = ftKeeper.RemoveRateExemption(ctx, issuer, exchange, denom)
+	requireT.ErrorIs(err, sdkerrors.ErrNotFound)
+
+	requireT.NoError(ftKeeper.AddRateExemption(ctx, issuer, exchange, denom))
+
+	// exemption can't be added twice
+	err = ftKeeper.AddRateExemption(ctx, issuer, exchange, denom)
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	exemptions, _, err := ftKeeper.GetRateExemptions(ctx, denom, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Equal([]types.RateExemption{{
+		Denom:   denom,
+		Account: exchange.String(),
+	}}, exemptions)
+
+	// only admin can remove the exemptions
+	err = ftKeeper.RemoveRateExemption(ctx, exchange, exchange, denom)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	requireT.NoError(ftKeeper.RemoveRateExemption(ctx, issuer, exchange, denom))
+	exemptions, _, err = ftKeeper.GetRateExemptions(ctx, denom, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Empty(exemptions)
+}
+
+func TestKeeper_RateExemption(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	exchange := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	user := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	settings := types.IssueSettings{
+		Issuer:             issuer,
+		Symbol:             "DEF",
+		Subunit:            "def",
+		Precision:          6,
+		InitialAmount:      sdk.NewInt(1000),
+		BurnRate:           sdk.MustNewDecFromStr("0.1"),
+		SendCommissionRate: sdk.MustNewDecFromStr("0.2"),
+	}
+	denom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+	requireT.NoError(ftKeeper.AddRateExemption(ctx, issuer, exchange, denom))
+
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, user, sdk.NewCoins(sdk.NewInt64Coin(denom, 300))))
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, exchange, sdk.NewCoins(sdk.NewInt64Coin(denom, 300))))
+
+	// the rates are not charged when sending to the exempted account
+	requireT.NoError(bankKeeper.SendCoins(ctx, user, exchange, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+	requireT.Equal("200", bankKeeper.GetBalance(ctx, user, denom).Amount.String())
+	requireT.Equal("400", bankKeeper.GetBalance(ctx, exchange, denom).Amount.String())
+
+	// the rates are not charged when sending from the exempted account
+	requireT.NoError(bankKeeper.SendCoins(ctx, exchange, user, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+	requireT.Equal("300", bankKeeper.GetBalance(ctx, user, denom).Amount.String())
+	requireT.Equal("300", bankKeeper.GetBalance(ctx, exchange, denom).Amount.String())
+
+	// the rates are charged again once the exemption is removed
+	requireT.NoError(ftKeeper.RemoveRateExemption(ctx, issuer, exchange, denom))
+	requireT.NoError(bankKeeper.SendCoins(ctx, exchange, user, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+	requireT.Equal("400", bankKeeper.GetBalance(ctx, user, denom).Amount.String())
+	requireT.Equal("170", bankKeeper.GetBalance(ctx, exchange, denom).Amount.String())
+	requireT.Equal("420", bankKeeper.GetBalance(ctx, issuer, denom).Amount.String())
+}
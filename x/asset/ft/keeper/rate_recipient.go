@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/CoreumFoundation/coreum/v2/x/asset/ft/types"
+)
+
+// communityPoolAddress is the address of the distribution module account. The rate recipient with this address
+// funds the community pool.
+var communityPoolAddress = authtypes.NewModuleAddress(distrtypes.ModuleName)
+
+// UpdateRateRecipients replaces the recipients of the send commission and the burn rate of the fungible token.
+func (k Keeper) UpdateRateRecipients(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	denom string,
+	sendCommissionRecipients, burnRateRecipients []types.RateRecipient,
+) error {
+	def, err := k.GetDefinition(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+
+	if !def.IsAdmin(sender) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only admin can update the rate recipients of the token")
+	}
+
+	if err := k.validateRateRecipients(sendCommissionRecipients, burnRateRecipients); err != nil {
+		return err
+	}
+
+	subunit, issuer, err := types.DeconstructDenom(denom)
+	if err != nil {
+		return err
+	}
+	def.SendCommissionRecipients = sendCommissionRecipients
+	def.BurnRateRecipients = burnRateRecipients
+	k.SetDefinition(ctx, issuer, subunit, def)
+
+	if err = ctx.EventManager().EmitTypedEvent(&types.EventRateRecipientsUpdated{
+		Denom:                    denom,
+		SendCommissionRecipients: sendCommissionRecipients,
+		BurnRateRecipients:       burnRateRecipients,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventRateRecipientsUpdated event: %s", err)
+	}
+
+	return nil
+}
+
+func (k Keeper) validateRateRecipients(sendCommissionRecipients, burnRateRecipients []types.RateRecipient) error {
+	if err := types.ValidateSendCommissionRecipients(sendCommissionRecipients); err != nil {
+		return err
+	}
+	if err := types.ValidateBurnRateRecipients(burnRateRecipients); err != nil {
+		return err
+	}
+
+	// The balances of the module accounts are managed by the modules, so crediting them directly would break
+	// their accounting. The community pool is the exception, because it is funded through the distribution module.
+	for _, recipients := range [][]types.RateRecipient{sendCommissionRecipients, burnRateRecipients} {
+		for _, recipient := range recipients {
+			addr := sdk.MustAccAddressFromBech32(recipient.Address)
+			if k.bankKeeper.BlockedAddr(addr) && !addr.Equals(communityPoolAddress) {
+				return sdkerrors.Wrapf(
+					sdkerrors.ErrUnauthorized, "recipient %s is a blocked module account", recipient.Address,
+				)
+			}
+		}
+	}
+
+	return nil
+}
+
+// distributeIfSpendable sends the amount from the account to the recipients proportionally to their weights.
+// The share of the distribution module account is used to fund the community pool.
+func (k Keeper) distributeIfSpendable(
+	ctx sdk.Context,
+	account sdk.AccAddress,
+	def types.Definition,
+	amount sdk.Int,
+	recipients []types.RateRecipient,
+) error {
+	if err := k.isCoinSpendable(ctx, account, def, amount); err != nil {
+		return sdkerrors.Wrapf(err, "coins are not spendable")
+	}
+
+	shares := types.SplitByWeights(amount, recipients)
+	for i, recipient := range recipients {
+		if !shares[i].IsPositive() {
+			continue
+		}
+		recipientAddr := sdk.MustAccAddressFromBech32(recipient.Address)
+		if err := k.isCoinReceivable(ctx, recipientAddr, def, shares[i]); err != nil {
+			return sdkerrors.Wrapf(err, "coins are not receivable")
+		}
+
+		coins := sdk.NewCoins(sdk.NewCoin(def.Denom, shares[i]))
+		if recipientAddr.Equals(communityPoolAddress) {
+			if err := k.fundCommunityPool(ctx, account, coins); err != nil {
+				return sdkerrors.Wrapf(err, "can't fund community pool from account %s", account)
+			}
+			continue
+		}
+		if err := k.bankKeeper.SendCoins(ctx, account, recipientAddr, coins); err != nil {
+			return sdkerrors.Wrapf(err, "can't send coins from account %s to recipient %s", account, recipient.Address)
+		}
+	}
+
+	return nil
+}
+
+// fundCommunityPool sends the coins to the distribution module account and adds them to the community pool.
+// It does the same as FundCommunityPool of the distribution keeper, but the coins are sent by the bank keeper
+// without the assets integration, so the rules of the token are not applied to the transfer again.
+func (k Keeper) fundCommunityPool(ctx sdk.Context, sender sdk.AccAddress, coins sdk.Coins) error {
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, distrtypes.ModuleName, coins); err != nil {
+		return err
+	}
+
+	feePool := k.distrKeeper.GetFeePool(ctx)
+	feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(coins...)...)
+	k.distrKeeper.SetFeePool(ctx, feePool)
+
+	return nil
+}
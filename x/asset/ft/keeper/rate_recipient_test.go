@@ -0,0 +1,215 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/v2/testutil/event"
+	"github.com/CoreumFoundation/coreum/v2/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/v2/x/asset/ft/types"
+)
+
+func TestKeeper_UpdateRateRecipients(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	ftKeeper := testApp.AssetFTKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	treasury := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	moduleAddr := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+
+	// module accounts can't be the recipients
+	settings := types.IssueSettings{
+		Issuer:             issuer,
+		Symbol:             "DEF",
+		Subunit:            "def",
+		Precision:          6,
+		InitialAmount:      sdk.NewInt(1000),
+		SendCommissionRate: sdk.MustNewDecFromStr("0.2"),
+		SendCommissionRecipients: []types.RateRecipient{
+			{Address: moduleAddr.String(), Weight: 1},
+		},
+	}
+	_, err := ftKeeper.Issue(ctx, settings)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// except the community pool
+	settings.SendCommissionRecipients = []types.RateRecipient{
+		{Address: authtypes.NewModuleAddress(distrtypes.ModuleName).String(), Weight: 1},
+	}
+	_, err = ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	settings.Symbol = "ABC"
+	settings.Subunit = "abc"
+	settings.SendCommissionRecipients = []types.RateRecipient{
+		{Address: treasury.String(), Weight: 1},
+	}
+	denom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	token, err := ftKeeper.GetToken(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal(settings.SendCommissionRecipients, token.SendCommissionRecipients)
+	requireT.Empty(token.BurnRateRecipients)
+
+	// only admin can update the recipients
+	err = ftKeeper.UpdateRateRecipients(ctx, treasury, denom, nil, nil)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// module accounts can't be the recipients
+	err = ftKeeper.UpdateRateRecipients(ctx, issuer, denom, nil, []types.RateRecipient{
+		{Address: moduleAddr.String(), Weight: 1},
+	})
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// recipients must be valid
+	err = ftKeeper.UpdateRateRecipients(ctx, issuer, denom, nil, []types.RateRecipient{
+		{Address: treasury.String(), Weight: 0},
+	})
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	burnRateRecipients := []types.RateRecipient{
+		{Address: treasury.String(), Weight: 1},
+	}
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	requireT.NoError(ftKeeper.UpdateRateRecipients(ctx, issuer, denom, nil, burnRateRecipients))
+
+	token, err = ftKeeper.GetToken(ctx, denom)
+	requireT.NoError(err)
+	requireT.Empty(token.SendCommissionRecipients)
+	requireT.Equal(burnRateRecipients, token.BurnRateRecipients)
+
+	updatedEvents, err := event.FindTypedEvents[*types.EventRateRecipientsUpdated](ctx.EventManager().Events().ToABCIEvents())
+	requireT.NoError(err)
+	requireT.Len(updatedEvents, 1)
+	requireT.Equal(denom, updatedEvents[0].Denom)
+	requireT.Empty(updatedEvents[0].SendCommissionRecipients)
+	requireT.Equal(burnRateRecipients, updatedEvents[0].BurnRateRecipients)
+}
+
+func TestKeeper_RateRecipients(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	user := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	treasury := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	contract := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	pool := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	settings := types.IssueSettings{
+		Issuer:             issuer,
+		Symbol:             "DEF",
+		Subunit:            "def",
+		Precision:          6,
+		InitialAmount:      sdk.NewInt(1000),
+		BurnRate:           sdk.MustNewDecFromStr("0.1"),
+		SendCommissionRate: sdk.MustNewDecFromStr("0.2"),
+		SendCommissionRecipients: []types.RateRecipient{
+			{Address: treasury.String(), Weight: 3},
+			{Address: contract.String(), Weight: 1},
+		},
+		BurnRateRecipients: []types.RateRecipient{
+			{Address: pool.String(), Weight: 1},
+		},
+	}
+	denom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, user, sdk.NewCoins(sdk.NewInt64Coin(denom, 300))))
+
+	// the send commission is split between the recipients and the burn rate amount is redirected
+	requireT.NoError(bankKeeper.SendCoins(ctx, user, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+	requireT.Equal("170", bankKeeper.GetBalance(ctx, user, denom).Amount.String())
+	requireT.Equal("100", bankKeeper.GetBalance(ctx, recipient, denom).Amount.String())
+	requireT.Equal("15", bankKeeper.GetBalance(ctx, treasury, denom).Amount.String())
+	requireT.Equal("5", bankKeeper.GetBalance(ctx, contract, denom).Amount.String())
+	requireT.Equal("10", bankKeeper.GetBalance(ctx, pool, denom).Amount.String())
+	requireT.Equal("700", bankKeeper.GetBalance(ctx, issuer, denom).Amount.String())
+	requireT.Equal("1000", bankKeeper.GetSupply(ctx, denom).Amount.String())
+
+	// the rounding remainder goes to the first recipient
+	requireT.NoError(bankKeeper.SendCoins(ctx, user, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 15))))
+	requireT.Equal("150", bankKeeper.GetBalance(ctx, user, denom).Amount.String())
+	requireT.Equal("18", bankKeeper.GetBalance(ctx, treasury, denom).Amount.String())
+	requireT.Equal("5", bankKeeper.GetBalance(ctx, contract, denom).Amount.String())
+	requireT.Equal("12", bankKeeper.GetBalance(ctx, pool, denom).Amount.String())
+
+	// once the recipients are cleared the commission goes to the admin and the burn rate amount is burnt
+	requireT.NoError(ftKeeper.UpdateRateRecipients(ctx, issuer, denom, nil, nil))
+	requireT.NoError(bankKeeper.SendCoins(ctx, user, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+	requireT.Equal("20", bankKeeper.GetBalance(ctx, user, denom).Amount.String())
+	requireT.Equal("720", bankKeeper.GetBalance(ctx, issuer, denom).Amount.String())
+	requireT.Equal("18", bankKeeper.GetBalance(ctx, treasury, denom).Amount.String())
+	requireT.Equal("12", bankKeeper.GetBalance(ctx, pool, denom).Amount.String())
+	requireT.Equal("990", bankKeeper.GetSupply(ctx, denom).Amount.String())
+}
+
+func TestKeeper_RateRecipients_CommunityPoolAndWhitelisting(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	user := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	treasury := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	communityPool := authtypes.NewModuleAddress(distrtypes.ModuleName)
+
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:             issuer,
+		Symbol:             "DEF",
+		Subunit:            "def",
+		Precision:          6,
+		InitialAmount:      sdk.NewInt(1000),
+		Features:           []types.Feature{types.Feature_whitelisting},
+		BurnRate:           sdk.MustNewDecFromStr("0.1"),
+		SendCommissionRate: sdk.MustNewDecFromStr("0.2"),
+		SendCommissionRecipients: []types.RateRecipient{
+			{Address: treasury.String(), Weight: 1},
+		},
+		BurnRateRecipients: []types.RateRecipient{
+			{Address: communityPool.String(), Weight: 1},
+		},
+	})
+	requireT.NoError(err)
+
+	requireT.NoError(ftKeeper.SetWhitelistedBalance(ctx, issuer, user, sdk.NewInt64Coin(denom, 300)))
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, user, sdk.NewCoins(sdk.NewInt64Coin(denom, 300))))
+	requireT.NoError(ftKeeper.SetWhitelistedBalance(ctx, issuer, recipient, sdk.NewInt64Coin(denom, 100)))
+
+	// the recipients of the rates must be whitelisted
+	err = bankKeeper.SendCoins(ctx, user, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 100)))
+	requireT.ErrorIs(err, types.ErrWhitelistedLimitExceeded)
+	requireT.NoError(ftKeeper.SetWhitelistedBalance(ctx, issuer, treasury, sdk.NewInt64Coin(denom, 20)))
+	err = bankKeeper.SendCoins(ctx, user, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 100)))
+	requireT.ErrorIs(err, types.ErrWhitelistedLimitExceeded)
+	requireT.NoError(ftKeeper.SetWhitelistedBalance(ctx, issuer, communityPool, sdk.NewInt64Coin(denom, 10)))
+
+	// the burn rate amount funds the community pool
+	requireT.NoError(bankKeeper.SendCoins(ctx, user, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+	requireT.Equal("170", bankKeeper.GetBalance(ctx, user, denom).Amount.String())
+	requireT.Equal("20", bankKeeper.GetBalance(ctx, treasury, denom).Amount.String())
+	requireT.Equal("10", bankKeeper.GetBalance(ctx, communityPool, denom).Amount.String())
+	requireT.Equal(
+		sdk.NewDecCoins(sdk.NewInt64DecCoin(denom, 10)).String(),
+		testApp.DistrKeeper.GetFeePoolCommunityCoins(ctx).String(),
+	)
+}
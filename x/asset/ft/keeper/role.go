@@ -0,0 +1,268 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/CoreumFoundation/coreum/v2/x/asset/ft/types"
+)
+
+// GrantRole grants the role of the fungible token to the account.
+// The mint cap limits the amount the minter is allowed to mint, zero means there is no limit.
+func (k Keeper) GrantRole(
+	ctx sdk.Context,
+	sender, account sdk.AccAddress,
+	denom string,
+	role types.Role,
+	mintCap sdk.Int,
+) error {
+	if err := types.ValidateRole(role); err != nil {
+		return err
+	}
+
+	if err := types.ValidateMintCap(role, mintCap); err != nil {
+		return err
+	}
+
+	def, err := k.GetDefinition(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+
+	if !def.IsAdmin(sender) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only admin can grant the roles of the token")
+	}
+
+	if def.IsAdmin(account) {
+		return sdkerrors.Wrap(types.ErrInvalidInput, "the role can't be granted to the admin")
+	}
+
+	if !def.IsFeatureEnabled(role.Feature()) {
+		return sdkerrors.Wrapf(types.ErrFeatureDisabled, "feature %s is disabled", role.Feature().String())
+	}
+
+	if mintCap.IsNil() {
+		mintCap = sdk.ZeroInt()
+	}
+
+	// the amount minted under the existing grant is kept, so granting the role again only updates the mint cap
+	minted := sdk.ZeroInt()
+	grant, found, err := k.getRoleGrant(ctx, denom, account, role)
+	if err != nil {
+		return err
+	}
+	if found && !grant.Minted.IsNil() {
+		minted = grant.Minted
+	}
+
+	k.SetRoleGrant(ctx, types.RoleGrant{
+		Denom:   denom,
+		Account: account.String(),
+		Role:    role,
+		MintCap: mintCap,
+		Minted:  minted,
+	})
+
+	if err = ctx.EventManager().EmitTypedEvent(&types.EventRoleGranted{
+		Denom:   denom,
+		Account: account.String(),
+		Role:    role,
+		MintCap: mintCap,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventRoleGranted event: %s", err)
+	}
+
+	return nil
+}
+
+// RevokeRole revokes the role of the fungible token from the account.
+func (k Keeper) RevokeRole(ctx sdk.Context, sender, account sdk.AccAddress, denom string, role types.Role) error {
+	def, err := k.GetDefinition(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+
+	if !def.IsAdmin(sender) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only admin can revoke the roles of the token")
+	}
+
+	key := types.CreateRoleGrantKey(denom, account, role)
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(key) {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "account %s doesn't have the %s role", account, role)
+	}
+	store.Delete(key)
+
+	if err = ctx.EventManager().EmitTypedEvent(&types.EventRoleRevoked{
+		Denom:   denom,
+		Account: account.String(),
+		Role:    role,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventRoleRevoked event: %s", err)
+	}
+
+	return nil
+}
+
+// SetRoleGrant stores the role grant.
+func (k Keeper) SetRoleGrant(ctx sdk.Context, grant types.RoleGrant) {
+	account := sdk.MustAccAddressFromBech32(grant.Account)
+	ctx.KVStore(k.storeKey).Set(types.CreateRoleGrantKey(grant.Denom, account, grant.Role), k.cdc.MustMarshal(&grant))
+}
+
+// GetRoleGrants returns the roles granted for the fungible token.
+func (k Keeper) GetRoleGrants(
+	ctx sdk.Context,
+	denom string,
+	pagination *query.PageRequest,
+) ([]types.RoleGrant, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CreateRoleGrantsPrefix(denom))
+	grants := make([]types.RoleGrant, 0)
+	pageRes, err := query.Paginate(store, pagination, func(_, value []byte) error {
+		var grant types.RoleGrant
+		if err := k.cdc.Unmarshal(value, &grant); err != nil {
+			return err
+		}
+		grants = append(grants, grant)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, sdkerrors.Wrapf(types.ErrInvalidInput, "failed to paginate: %s", err)
+	}
+
+	return grants, pageRes, nil
+}
+
+// GetAccountRoleGrants returns the roles of the fungible token granted to the account.
+func (k Keeper) GetAccountRoleGrants(ctx sdk.Context, denom string, account sdk.AccAddress) ([]types.RoleGrant, error) {
+	grants := make([]types.RoleGrant, 0)
+	err := k.iterateRoleGrants(ctx, types.CreateAccountRoleGrantsPrefix(denom, account), func(grant types.RoleGrant) bool {
+		grants = append(grants, grant)
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return grants, nil
+}
+
+// IterateAllRoleGrants iterates over all the role grants of all the tokens and applies the provided callback.
+// If true is returned from the callback, iteration is halted.
+func (k Keeper) IterateAllRoleGrants(ctx sdk.Context, cb func(types.RoleGrant) bool) error {
+	return k.iterateRoleGrants(ctx, types.RoleGrantsKeyPrefix, cb)
+}
+
+// checkFeatureAllowed returns error if feature isn't allowed for the address. Contrary to the
+// Definition.CheckFeatureAllowed it takes the roles granted to the address into account.
+func (k Keeper) checkFeatureAllowed(
+	ctx sdk.Context,
+	addr sdk.AccAddress,
+	def types.Definition,
+	feature types.Feature,
+) error {
+	err := def.CheckFeatureAllowed(addr, feature)
+	if err == nil || !def.IsFeatureEnabled(feature) {
+		return err
+	}
+
+	role, exists := types.RoleForFeature(feature)
+	if !exists {
+		return err
+	}
+
+	_, found, getErr := k.getRoleGrant(ctx, def.Denom, addr, role)
+	if getErr != nil {
+		return getErr
+	}
+	if !found {
+		return err
+	}
+
+	return nil
+}
+
+// consumeMintCap records the amount minted by the minter and returns an error if it exceeds the mint cap.
+func (k Keeper) consumeMintCap(ctx sdk.Context, minter sdk.AccAddress, coin sdk.Coin) error {
+	grant, found, err := k.getRoleGrant(ctx, coin.Denom, minter, types.Role_minter)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "account %s doesn't have the minter role", minter)
+	}
+
+	minted := grant.Minted.Add(coin.Amount)
+	if grant.IsMintCapped() && minted.GT(grant.MintCap) {
+		return sdkerrors.Wrapf(types.ErrMintCapExceeded, "minting %s exceeds the mint cap, minted %s of %s",
+			coin, grant.Minted, grant.MintCap)
+	}
+
+	grant.Minted = minted
+	k.SetRoleGrant(ctx, grant)
+
+	return nil
+}
+
+// revokeAllRoles revokes all the roles granted for the fungible token.
+func (k Keeper) revokeAllRoles(ctx sdk.Context, denom string) error {
+	grants := make([]types.RoleGrant, 0)
+	if err := k.iterateRoleGrants(ctx, types.CreateRoleGrantsPrefix(denom), func(grant types.RoleGrant) bool {
+		grants = append(grants, grant)
+		return false
+	}); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, grant := range grants {
+		store.Delete(types.CreateRoleGrantKey(grant.Denom, sdk.MustAccAddressFromBech32(grant.Account), grant.Role))
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventRoleRevoked{
+			Denom:   grant.Denom,
+			Account: grant.Account,
+			Role:    grant.Role,
+		}); err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventRoleRevoked event: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) getRoleGrant(
+	ctx sdk.Context,
+	denom string,
+	account sdk.AccAddress,
+	role types.Role,
+) (types.RoleGrant, bool, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.CreateRoleGrantKey(denom, account, role))
+	if bz == nil {
+		return types.RoleGrant{}, false, nil
+	}
+
+	var grant types.RoleGrant
+	if err := k.cdc.Unmarshal(bz, &grant); err != nil {
+		return types.RoleGrant{}, false, err
+	}
+
+	return grant, true, nil
+}
+
+func (k Keeper) iterateRoleGrants(ctx sdk.Context, keyPrefix []byte, cb func(types.RoleGrant) bool) error {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var grant types.RoleGrant
+		if err := k.cdc.Unmarshal(iterator.Value(), &grant); err != nil {
+			return err
+		}
+		if cb(grant) {
+			break
+		}
+	}
+
+	return nil
+}
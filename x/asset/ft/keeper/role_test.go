@@ -0,0 +1,238 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/v2/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/v2/x/asset/ft/types"
+)
+
+func TestKeeper_GrantRevokeRole(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	ftKeeper := testApp.AssetFTKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	freezer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	settings := types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(1000),
+		Features:      []types.Feature{types.Feature_freezing},
+	}
+	denom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	// only admin can grant the roles
+	err = ftKeeper.GrantRole(ctx, freezer, freezer, denom, types.Role_freezer, sdk.ZeroInt())
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// role can't be granted to the admin
+	err = ftKeeper.GrantRole(ctx, issuer, issuer, denom, types.Role_freezer, sdk.ZeroInt())
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	// role can't be granted if the feature is disabled
+	err = ftKeeper.GrantRole(ctx, issuer, freezer, denom, types.Role_minter, sdk.ZeroInt())
+	requireT.ErrorIs(err, types.ErrFeatureDisabled)
+
+	// mint cap can't be set for the freezer
+	err = ftKeeper.GrantRole(ctx, issuer, freezer, denom, types.Role_freezer, sdk.NewInt(10))
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	// freezer isn't able to freeze before the role is granted
+	err = ftKeeper.Freeze(ctx, freezer, recipient, sdk.NewInt64Coin(denom, 10))
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	requireT.NoError(ftKeeper.GrantRole(ctx, issuer, freezer, denom, types.Role_freezer, sdk.ZeroInt()))
+
+	grants, _, err := ftKeeper.GetRoleGrants(ctx, denom, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Equal([]types.RoleGrant{{
+		Denom:   denom,
+		Account: freezer.String(),
+		Role:    types.Role_freezer,
+		MintCap: sdk.ZeroInt(),
+		Minted:  sdk.ZeroInt(),
+	}}, grants)
+	grants, err = ftKeeper.GetAccountRoleGrants(ctx, denom, freezer)
+	requireT.NoError(err)
+	requireT.Len(grants, 1)
+	grants, err = ftKeeper.GetAccountRoleGrants(ctx, denom, recipient)
+	requireT.NoError(err)
+	requireT.Empty(grants)
+
+	// freezer is able to freeze and unfreeze now
+	requireT.NoError(ftKeeper.Freeze(ctx, freezer, recipient, sdk.NewInt64Coin(denom, 10)))
+	requireT.NoError(ftKeeper.Unfreeze(ctx, freezer, recipient, sdk.NewInt64Coin(denom, 10)))
+	requireT.NoError(ftKeeper.GloballyFreeze(ctx, freezer, denom))
+	requireT.NoError(ftKeeper.GloballyUnfreeze(ctx, freezer, denom))
+
+	// but not to perform other privileged operations
+	err = ftKeeper.GrantRole(ctx, freezer, recipient, denom, types.Role_freezer, sdk.ZeroInt())
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	err = ftKeeper.TransferAdmin(ctx, freezer, recipient, denom)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// only admin can revoke the roles
+	err = ftKeeper.RevokeRole(ctx, freezer, freezer, denom, types.Role_freezer)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// role which isn't granted can't be revoked
+	err = ftKeeper.RevokeRole(ctx, issuer, recipient, denom, types.Role_freezer)
+	requireT.ErrorIs(err, sdkerrors.ErrNotFound)
+
+	requireT.NoError(ftKeeper.RevokeRole(ctx, issuer, freezer, denom, types.Role_freezer))
+
+	grants, _, err = ftKeeper.GetRoleGrants(ctx, denom, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Empty(grants)
+
+	err = ftKeeper.Freeze(ctx, freezer, recipient, sdk.NewInt64Coin(denom, 10))
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+}
+
+func TestKeeper_MinterRole(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	minter := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	settings := types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(1000),
+		Features:      []types.Feature{types.Feature_minting},
+	}
+	denom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	requireT.NoError(ftKeeper.GrantRole(ctx, issuer, minter, denom, types.Role_minter, sdk.NewInt(100)))
+
+	requireT.NoError(ftKeeper.Mint(ctx, minter, sdk.NewInt64Coin(denom, 60)))
+	requireT.Equal(sdk.NewInt64Coin(denom, 60).String(), bankKeeper.GetBalance(ctx, minter, denom).String())
+
+	// mint cap is exceeded
+	err = ftKeeper.Mint(ctx, minter, sdk.NewInt64Coin(denom, 41))
+	requireT.ErrorIs(err, types.ErrMintCapExceeded)
+
+	requireT.NoError(ftKeeper.Mint(ctx, minter, sdk.NewInt64Coin(denom, 40)))
+	grants, err := ftKeeper.GetAccountRoleGrants(ctx, denom, minter)
+	requireT.NoError(err)
+	requireT.Len(grants, 1)
+	requireT.Equal(sdk.NewInt(100).String(), grants[0].Minted.String())
+
+	err = ftKeeper.Mint(ctx, minter, sdk.NewInt64Coin(denom, 1))
+	requireT.ErrorIs(err, types.ErrMintCapExceeded)
+
+	// mint cap doesn't apply to the admin
+	requireT.NoError(ftKeeper.Mint(ctx, issuer, sdk.NewInt64Coin(denom, 1000)))
+
+	// granting the role again keeps the minted amount, so the cap can't be bypassed
+	requireT.NoError(ftKeeper.GrantRole(ctx, issuer, minter, denom, types.Role_minter, sdk.NewInt(150)))
+	grants, err = ftKeeper.GetAccountRoleGrants(ctx, denom, minter)
+	requireT.NoError(err)
+	requireT.Len(grants, 1)
+	requireT.Equal(sdk.NewInt(150).String(), grants[0].MintCap.String())
+	requireT.Equal(sdk.NewInt(100).String(), grants[0].Minted.String())
+
+	err = ftKeeper.Mint(ctx, minter, sdk.NewInt64Coin(denom, 51))
+	requireT.ErrorIs(err, types.ErrMintCapExceeded)
+	requireT.NoError(ftKeeper.Mint(ctx, minter, sdk.NewInt64Coin(denom, 50)))
+
+	// revoking the role resets the minted amount
+	requireT.NoError(ftKeeper.RevokeRole(ctx, issuer, minter, denom, types.Role_minter))
+	requireT.NoError(ftKeeper.GrantRole(ctx, issuer, minter, denom, types.Role_minter, sdk.NewInt(100)))
+	requireT.NoError(ftKeeper.Mint(ctx, minter, sdk.NewInt64Coin(denom, 100)))
+	requireT.Equal(sdk.NewInt64Coin(denom, 250).String(), bankKeeper.GetBalance(ctx, minter, denom).String())
+}
+
+func TestKeeper_WhitelisterRole(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	ftKeeper := testApp.AssetFTKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	whitelister := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	settings := types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(1000),
+		Features:      []types.Feature{types.Feature_whitelisting},
+	}
+	denom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	err = ftKeeper.SetWhitelistedBalance(ctx, whitelister, recipient, sdk.NewInt64Coin(denom, 10))
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	requireT.NoError(ftKeeper.GrantRole(ctx, issuer, whitelister, denom, types.Role_whitelister, sdk.ZeroInt()))
+	requireT.NoError(ftKeeper.SetWhitelistedBalance(ctx, whitelister, recipient, sdk.NewInt64Coin(denom, 10)))
+	requireT.Equal(
+		sdk.NewInt64Coin(denom, 10).String(),
+		ftKeeper.GetWhitelistedBalance(ctx, recipient, denom).String(),
+	)
+}
+
+func TestKeeper_ClearAdminRevokesRoles(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	ftKeeper := testApp.AssetFTKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	admin := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	account := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	settings := types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(1000),
+		Features:      []types.Feature{types.Feature_minting, types.Feature_freezing},
+	}
+	denom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	requireT.NoError(ftKeeper.GrantRole(ctx, issuer, account, denom, types.Role_minter, sdk.ZeroInt()))
+	requireT.NoError(ftKeeper.GrantRole(ctx, issuer, account, denom, types.Role_freezer, sdk.ZeroInt()))
+
+	// roles stay untouched when the admin is transferred
+	requireT.NoError(ftKeeper.TransferAdmin(ctx, issuer, admin, denom))
+	grants, err := ftKeeper.GetAccountRoleGrants(ctx, denom, account)
+	requireT.NoError(err)
+	requireT.Len(grants, 2)
+	requireT.NoError(ftKeeper.Mint(ctx, account, sdk.NewInt64Coin(denom, 10)))
+
+	// and revoked when the admin is cleared
+	requireT.NoError(ftKeeper.ClearAdmin(ctx, admin, denom))
+	grants, err = ftKeeper.GetAccountRoleGrants(ctx, denom, account)
+	requireT.NoError(err)
+	requireT.Empty(grants)
+
+	err = ftKeeper.Mint(ctx, account, sdk.NewInt64Coin(denom, 10))
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+}
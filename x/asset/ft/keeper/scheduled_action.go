@@ -0,0 +1,315 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/CoreumFoundation/coreum/v2/x/asset/ft/types"
+)
+
+// ScheduleMint schedules the mint of the coin executed at the execution time. The tokens are minted to the sender.
+func (k Keeper) ScheduleMint(ctx sdk.Context, sender sdk.AccAddress, coin sdk.Coin, executionTime time.Time) error {
+	if err := k.checkScheduledActionAllowed(ctx, sender, coin, executionTime, types.Feature_minting); err != nil {
+		return err
+	}
+
+	return k.addScheduledAction(ctx, types.ScheduledAction{
+		Type:          types.ScheduledActionType_mint,
+		Sender:        sender.String(),
+		Account:       sender.String(),
+		Coin:          coin,
+		ExecutionTime: executionTime,
+	})
+}
+
+// ScheduleUnfreeze schedules the unfreeze of the account's frozen balance executed at the execution time.
+func (k Keeper) ScheduleUnfreeze(
+	ctx sdk.Context,
+	sender, account sdk.AccAddress,
+	coin sdk.Coin,
+	executionTime time.Time,
+) error {
+	if err := k.checkScheduledActionAllowed(ctx, sender, coin, executionTime, types.Feature_freezing); err != nil {
+		return err
+	}
+
+	return k.addScheduledAction(ctx, types.ScheduledAction{
+		Type:          types.ScheduledActionType_unfreeze,
+		Sender:        sender.String(),
+		Account:       account.String(),
+		Coin:          coin,
+		ExecutionTime: executionTime,
+	})
+}
+
+// CancelScheduledAction cancels the pending or failed scheduled action. The action might be cancelled by the account
+// which scheduled it or by the admin of the token.
+func (k Keeper) CancelScheduledAction(ctx sdk.Context, sender sdk.AccAddress, id uint64) error {
+	action, err := k.getScheduledActionManagedBy(ctx, sender, id)
+	if err != nil {
+		return err
+	}
+
+	if err := k.delayKeeper.CancelDelayedExecution(ctx, types.ScheduledActionDelayID(id)); err != nil {
+		return err
+	}
+	k.removeScheduledAction(ctx, action)
+
+	if err = ctx.EventManager().EmitTypedEvent(&types.EventScheduledActionCancelled{
+		Id: id,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventScheduledActionCancelled event: %s", err)
+	}
+
+	return nil
+}
+
+// RetryScheduledAction schedules the failed action to be executed again in the next block. The action might be retried
+// by the account which scheduled it or by the admin of the token.
+func (k Keeper) RetryScheduledAction(ctx sdk.Context, sender sdk.AccAddress, id uint64) error {
+	if _, err := k.getScheduledActionManagedBy(ctx, sender, id); err != nil {
+		return err
+	}
+
+	if err := k.delayKeeper.RetryFailedExecution(ctx, types.ScheduledActionDelayID(id)); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventScheduledActionRetried{
+		Id: id,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventScheduledActionRetried event: %s", err)
+	}
+
+	return nil
+}
+
+// ExecuteScheduledMint mints the tokens scheduled by ScheduleMint.
+func (k Keeper) ExecuteScheduledMint(ctx sdk.Context, data *types.DelayedMint) error {
+	return k.executeScheduledAction(ctx, data.Id, func(ctx sdk.Context, action types.ScheduledAction) error {
+		return k.Mint(ctx, sdk.MustAccAddressFromBech32(action.Sender), action.Coin)
+	})
+}
+
+// ExecuteScheduledUnfreeze unfreezes the balance scheduled by ScheduleUnfreeze.
+func (k Keeper) ExecuteScheduledUnfreeze(ctx sdk.Context, data *types.DelayedUnfreeze) error {
+	return k.executeScheduledAction(ctx, data.Id, func(ctx sdk.Context, action types.ScheduledAction) error {
+		return k.Unfreeze(
+			ctx,
+			sdk.MustAccAddressFromBech32(action.Sender),
+			sdk.MustAccAddressFromBech32(action.Account),
+			action.Coin,
+		)
+	})
+}
+
+// GetScheduledAction returns the scheduled action.
+func (k Keeper) GetScheduledAction(ctx sdk.Context, id uint64) (types.ScheduledAction, bool, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.CreateScheduledActionKey(id))
+	if bz == nil {
+		return types.ScheduledAction{}, false, nil
+	}
+
+	var action types.ScheduledAction
+	if err := k.cdc.Unmarshal(bz, &action); err != nil {
+		return types.ScheduledAction{}, false, err
+	}
+
+	return action, true, nil
+}
+
+// GetAccountScheduledActions returns the pending actions scheduled for the account.
+func (k Keeper) GetAccountScheduledActions(
+	ctx sdk.Context,
+	account sdk.AccAddress,
+	pagination *query.PageRequest,
+) ([]types.ScheduledAction, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CreateAccountScheduledActionsPrefix(account))
+	actions := make([]types.ScheduledAction, 0)
+	pageRes, err := query.Paginate(store, pagination, func(key, _ []byte) error {
+		id, err := types.BytesToUint64(key)
+		if err != nil {
+			return err
+		}
+		action, found, err := k.GetScheduledAction(ctx, id)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return sdkerrors.Wrapf(types.ErrInvalidState, "indexed scheduled action %d doesn't exist", id)
+		}
+		actions = append(actions, action)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, sdkerrors.Wrapf(types.ErrInvalidInput, "failed to paginate: %s", err)
+	}
+
+	return actions, pageRes, nil
+}
+
+// IterateAllScheduledActions iterates over all the pending scheduled actions and applies the provided callback.
+// If true is returned from the callback, iteration is halted.
+func (k Keeper) IterateAllScheduledActions(ctx sdk.Context, cb func(types.ScheduledAction) bool) error {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduledActionsKeyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var action types.ScheduledAction
+		if err := k.cdc.Unmarshal(iterator.Value(), &action); err != nil {
+			return err
+		}
+		if cb(action) {
+			break
+		}
+	}
+
+	return nil
+}
+
+// SetScheduledAction stores the scheduled action and indexes it by the account.
+func (k Keeper) SetScheduledAction(ctx sdk.Context, action types.ScheduledAction) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.CreateScheduledActionKey(action.Id), k.cdc.MustMarshal(&action))
+	store.Set(
+		types.CreateAccountScheduledActionKey(sdk.MustAccAddressFromBech32(action.Account), action.Id),
+		[]byte{0x01},
+	)
+}
+
+// GetNextScheduledActionID returns the id assigned to the next scheduled action.
+func (k Keeper) GetNextScheduledActionID(ctx sdk.Context) (uint64, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.NextScheduledActionIDKey)
+	if bz == nil {
+		return 0, nil
+	}
+	return types.BytesToUint64(bz)
+}
+
+// SetNextScheduledActionID sets the id assigned to the next scheduled action.
+func (k Keeper) SetNextScheduledActionID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.NextScheduledActionIDKey, types.Uint64ToBytes(id))
+}
+
+func (k Keeper) checkScheduledActionAllowed(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	coin sdk.Coin,
+	executionTime time.Time,
+	feature types.Feature,
+) error {
+	if !coin.IsPositive() {
+		return sdkerrors.Wrap(types.ErrInvalidInput, "scheduled amount should be positive")
+	}
+
+	if !executionTime.After(ctx.BlockTime()) {
+		return sdkerrors.Wrap(types.ErrInvalidInput, "execution time must be in the future")
+	}
+
+	def, err := k.GetDefinition(ctx, coin.Denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", coin.Denom)
+	}
+
+	return k.checkFeatureAllowed(ctx, sender, def, feature)
+}
+
+func (k Keeper) addScheduledAction(ctx sdk.Context, action types.ScheduledAction) error {
+	id, err := k.GetNextScheduledActionID(ctx)
+	if err != nil {
+		return err
+	}
+	action.Id = id
+	k.SetNextScheduledActionID(ctx, action.Id+1)
+
+	var data codec.ProtoMarshaler
+	switch action.Type {
+	case types.ScheduledActionType_mint:
+		data = &types.DelayedMint{Id: action.Id}
+	case types.ScheduledActionType_unfreeze:
+		data = &types.DelayedUnfreeze{Id: action.Id}
+	default:
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "non-existing scheduled action type provided: %d", action.Type)
+	}
+
+	if err := k.delayKeeper.StoreDelayedExecution(
+		ctx, types.ScheduledActionDelayID(action.Id), data, action.ExecutionTime,
+	); err != nil {
+		return err
+	}
+	k.SetScheduledAction(ctx, action)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventActionScheduled{
+		Id:            action.Id,
+		Type:          action.Type,
+		Sender:        action.Sender,
+		Account:       action.Account,
+		Coin:          action.Coin,
+		ExecutionTime: action.ExecutionTime,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventActionScheduled event: %s", err)
+	}
+
+	return nil
+}
+
+// getScheduledActionManagedBy returns the scheduled action if the sender is the account which scheduled it or
+// the admin of the token.
+func (k Keeper) getScheduledActionManagedBy(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	id uint64,
+) (types.ScheduledAction, error) {
+	action, found, err := k.GetScheduledAction(ctx, id)
+	if err != nil {
+		return types.ScheduledAction{}, err
+	}
+	if !found {
+		return types.ScheduledAction{}, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "scheduled action %d doesn't exist", id)
+	}
+
+	def, err := k.GetDefinition(ctx, action.Coin.Denom)
+	if err != nil {
+		return types.ScheduledAction{}, sdkerrors.Wrapf(err, "not able to get token info for denom:%s", action.Coin.Denom)
+	}
+
+	if action.Sender != sender.String() && !def.IsAdmin(sender) {
+		return types.ScheduledAction{}, sdkerrors.Wrap(
+			sdkerrors.ErrUnauthorized, "only the sender of the scheduled action or the admin can manage it",
+		)
+	}
+
+	return action, nil
+}
+
+// executeScheduledAction removes the scheduled action and executes it. The action might be no longer executable,
+// e.g. when the sender lost the privileges. In that case the error is returned, so the delay module discards the
+// changes, including the removal, and moves the item to its failed items, from which the action might be retried
+// or cancelled.
+func (k Keeper) executeScheduledAction(
+	ctx sdk.Context,
+	id uint64,
+	execute func(ctx sdk.Context, action types.ScheduledAction) error,
+) error {
+	action, found, err := k.GetScheduledAction(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "scheduled action %d doesn't exist", id)
+	}
+	k.removeScheduledAction(ctx, action)
+
+	return execute(ctx, action)
+}
+
+func (k Keeper) removeScheduledAction(ctx sdk.Context, action types.ScheduledAction) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.CreateScheduledActionKey(action.Id))
+	store.Delete(types.CreateAccountScheduledActionKey(sdk.MustAccAddressFromBech32(action.Account), action.Id))
+}
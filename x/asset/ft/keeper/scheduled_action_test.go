@@ -0,0 +1,294 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/v2/testutil/event"
+	"github.com/CoreumFoundation/coreum/v2/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/v2/x/asset/ft/types"
+)
+
+func TestKeeper_ScheduleMint(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	blockTime := time.Date(2023, 3, 4, 5, 6, 7, 0, time.UTC)
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{Time: blockTime})
+	ftKeeper := testApp.AssetFTKeeper
+	delayKeeper := testApp.DelayKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	randomAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(1000),
+		Features:      []types.Feature{types.Feature_minting},
+	})
+	requireT.NoError(err)
+
+	unmintableDenom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "ABC",
+		Subunit:       "abc",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(1000),
+	})
+	requireT.NoError(err)
+
+	executionTime := blockTime.Add(time.Hour)
+
+	// try to schedule the mint of the token with the minting feature disabled
+	err = ftKeeper.ScheduleMint(ctx, issuer, sdk.NewCoin(unmintableDenom, sdk.NewInt(100)), executionTime)
+	requireT.ErrorIs(err, types.ErrFeatureDisabled)
+
+	// try to schedule the mint by non-admin
+	err = ftKeeper.ScheduleMint(ctx, randomAddr, sdk.NewCoin(denom, sdk.NewInt(100)), executionTime)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// try to schedule the mint in the past
+	err = ftKeeper.ScheduleMint(ctx, issuer, sdk.NewCoin(denom, sdk.NewInt(100)), blockTime)
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	requireT.NoError(ftKeeper.ScheduleMint(ctx, issuer, sdk.NewCoin(denom, sdk.NewInt(100)), executionTime))
+	requireT.NoError(ftKeeper.ScheduleMint(ctx, issuer, sdk.NewCoin(denom, sdk.NewInt(200)), executionTime.Add(time.Hour)))
+
+	scheduledEvents, err := event.FindTypedEvents[*types.EventActionScheduled](ctx.EventManager().Events().ToABCIEvents())
+	requireT.NoError(err)
+	requireT.Len(scheduledEvents, 2)
+	requireT.Equal(&types.EventActionScheduled{
+		Id:            0,
+		Type:          types.ScheduledActionType_mint,
+		Sender:        issuer.String(),
+		Account:       issuer.String(),
+		Coin:          sdk.NewCoin(denom, sdk.NewInt(100)),
+		ExecutionTime: executionTime,
+	}, scheduledEvents[0])
+
+	actions, _, err := ftKeeper.GetAccountScheduledActions(ctx, issuer, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Len(actions, 2)
+	requireT.EqualValues(1, actions[1].Id)
+
+	// nothing is minted before the execution time
+	requireT.NoError(delayKeeper.ExecuteDelayedItems(ctx))
+	requireT.Equal(sdk.NewInt(1000).String(), bankKeeper.GetBalance(ctx, issuer, denom).Amount.String())
+
+	ctx = ctx.WithBlockTime(executionTime)
+	requireT.NoError(delayKeeper.ExecuteDelayedItems(ctx))
+	requireT.Equal(sdk.NewInt(1100).String(), bankKeeper.GetBalance(ctx, issuer, denom).Amount.String())
+
+	actions, _, err = ftKeeper.GetAccountScheduledActions(ctx, issuer, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Len(actions, 1)
+	requireT.EqualValues(1, actions[0].Id)
+
+	// once the admin is transferred the mint scheduled by the previous admin fails
+	requireT.NoError(ftKeeper.TransferAdmin(ctx, issuer, randomAddr, denom))
+
+	ctx = ctx.WithBlockTime(executionTime.Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	requireT.NoError(delayKeeper.ExecuteDelayedItems(ctx))
+	requireT.Equal(sdk.NewInt(1100).String(), bankKeeper.GetBalance(ctx, issuer, denom).Amount.String())
+
+	// the failed action is kept by the delay module, so it might be retried
+	failedItems, err := delayKeeper.GetFailedDelayedItemsByID(ctx, types.ScheduledActionDelayID(1))
+	requireT.NoError(err)
+	requireT.Len(failedItems, 1)
+
+	actions, _, err = ftKeeper.GetAccountScheduledActions(ctx, issuer, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Len(actions, 1)
+	requireT.EqualValues(1, actions[0].Id)
+
+	// once the admin is transferred back, the retried action is executed
+	requireT.NoError(ftKeeper.TransferAdmin(ctx, randomAddr, issuer, denom))
+	requireT.NoError(ftKeeper.RetryScheduledAction(ctx, issuer, 1))
+
+	retriedEvents, err := event.FindTypedEvents[*types.EventScheduledActionRetried](
+		ctx.EventManager().Events().ToABCIEvents(),
+	)
+	requireT.NoError(err)
+	requireT.Equal([]*types.EventScheduledActionRetried{{Id: 1}}, retriedEvents)
+
+	requireT.NoError(delayKeeper.ExecuteDelayedItems(ctx))
+	requireT.Equal(sdk.NewInt(1300).String(), bankKeeper.GetBalance(ctx, issuer, denom).Amount.String())
+
+	actions, _, err = ftKeeper.GetAccountScheduledActions(ctx, issuer, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Empty(actions)
+
+	// the executed action can't be retried
+	err = ftKeeper.RetryScheduledAction(ctx, issuer, 1)
+	requireT.ErrorIs(err, sdkerrors.ErrNotFound)
+}
+
+func TestKeeper_ScheduleUnfreeze(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	blockTime := time.Date(2023, 3, 4, 5, 6, 7, 0, time.UTC)
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{Time: blockTime})
+	ftKeeper := testApp.AssetFTKeeper
+	delayKeeper := testApp.DelayKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	freezer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(1000),
+		Features:      []types.Feature{types.Feature_freezing},
+	})
+	requireT.NoError(err)
+
+	requireT.NoError(ftKeeper.Freeze(ctx, issuer, recipient, sdk.NewCoin(denom, sdk.NewInt(300))))
+
+	// try to schedule the unfreeze by the account without the freezer role
+	err = ftKeeper.ScheduleUnfreeze(ctx, freezer, recipient, sdk.NewCoin(denom, sdk.NewInt(100)), blockTime.Add(time.Hour))
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// unlock the frozen balance gradually, the freezer role is enough to do it
+	requireT.NoError(ftKeeper.GrantRole(ctx, issuer, freezer, denom, types.Role_freezer, sdk.ZeroInt()))
+	for i := 1; i <= 3; i++ {
+		requireT.NoError(ftKeeper.ScheduleUnfreeze(
+			ctx, freezer, recipient, sdk.NewCoin(denom, sdk.NewInt(100)), blockTime.Add(time.Duration(i)*time.Hour),
+		))
+	}
+
+	actions, _, err := ftKeeper.GetAccountScheduledActions(ctx, recipient, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Len(actions, 3)
+	requireT.Equal(types.ScheduledAction{
+		Id:            0,
+		Type:          types.ScheduledActionType_unfreeze,
+		Sender:        freezer.String(),
+		Account:       recipient.String(),
+		Coin:          sdk.NewCoin(denom, sdk.NewInt(100)),
+		ExecutionTime: blockTime.Add(time.Hour),
+	}, actions[0])
+
+	// the sender isn't affected by the action
+	actions, _, err = ftKeeper.GetAccountScheduledActions(ctx, freezer, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Empty(actions)
+
+	ctx = ctx.WithBlockTime(blockTime.Add(time.Hour))
+	requireT.NoError(delayKeeper.ExecuteDelayedItems(ctx))
+	requireT.Equal(sdk.NewInt(200).String(), ftKeeper.GetFrozenBalance(ctx, recipient, denom).Amount.String())
+
+	// the last unfreeze is cancelled by the admin
+	requireT.NoError(ftKeeper.CancelScheduledAction(ctx, issuer, 2))
+
+	ctx = ctx.WithBlockTime(blockTime.Add(3 * time.Hour))
+	requireT.NoError(delayKeeper.ExecuteDelayedItems(ctx))
+	requireT.Equal(sdk.NewInt(100).String(), ftKeeper.GetFrozenBalance(ctx, recipient, denom).Amount.String())
+
+	actions, _, err = ftKeeper.GetAccountScheduledActions(ctx, recipient, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Empty(actions)
+
+	delayedItems, err := delayKeeper.ExportDelayedItems(ctx)
+	requireT.NoError(err)
+	requireT.Empty(delayedItems)
+}
+
+func TestKeeper_CancelScheduledAction(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	blockTime := time.Date(2023, 3, 4, 5, 6, 7, 0, time.UTC)
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{Time: blockTime})
+	ftKeeper := testApp.AssetFTKeeper
+	delayKeeper := testApp.DelayKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	minter := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	randomAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(1000),
+		Features:      []types.Feature{types.Feature_minting},
+	})
+	requireT.NoError(err)
+
+	requireT.NoError(ftKeeper.GrantRole(ctx, issuer, minter, denom, types.Role_minter, sdk.ZeroInt()))
+	requireT.NoError(ftKeeper.ScheduleMint(ctx, minter, sdk.NewCoin(denom, sdk.NewInt(100)), blockTime.Add(time.Hour)))
+
+	// try to cancel non-existing action
+	err = ftKeeper.CancelScheduledAction(ctx, minter, 1)
+	requireT.ErrorIs(err, sdkerrors.ErrNotFound)
+
+	// try to cancel by the account which is neither the sender nor the admin
+	err = ftKeeper.CancelScheduledAction(ctx, randomAddr, 0)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	requireT.NoError(ftKeeper.CancelScheduledAction(ctx, minter, 0))
+
+	cancelledEvents, err := event.FindTypedEvents[*types.EventScheduledActionCancelled](
+		ctx.EventManager().Events().ToABCIEvents(),
+	)
+	requireT.NoError(err)
+	requireT.Equal([]*types.EventScheduledActionCancelled{{Id: 0}}, cancelledEvents)
+
+	_, found, err := ftKeeper.GetScheduledAction(ctx, 0)
+	requireT.NoError(err)
+	requireT.False(found)
+
+	delayedItems, err := delayKeeper.ExportDelayedItems(ctx)
+	requireT.NoError(err)
+	requireT.Empty(delayedItems)
+
+	// the cancelled action can't be cancelled again
+	err = ftKeeper.CancelScheduledAction(ctx, minter, 0)
+	requireT.ErrorIs(err, sdkerrors.ErrNotFound)
+
+	// the failed action might be cancelled too
+	requireT.NoError(ftKeeper.ScheduleMint(ctx, minter, sdk.NewCoin(denom, sdk.NewInt(100)), blockTime.Add(time.Hour)))
+	requireT.NoError(ftKeeper.RevokeRole(ctx, issuer, minter, denom, types.Role_minter))
+
+	// the pending action can't be retried
+	err = ftKeeper.RetryScheduledAction(ctx, minter, 1)
+	requireT.ErrorIs(err, sdkerrors.ErrNotFound)
+
+	ctx = ctx.WithBlockTime(blockTime.Add(time.Hour))
+	requireT.NoError(delayKeeper.ExecuteDelayedItems(ctx))
+
+	// try to retry by the account which is neither the sender nor the admin
+	err = ftKeeper.RetryScheduledAction(ctx, randomAddr, 1)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	failedItems, err := delayKeeper.GetFailedDelayedItemsByID(ctx, types.ScheduledActionDelayID(1))
+	requireT.NoError(err)
+	requireT.Len(failedItems, 1)
+
+	requireT.NoError(ftKeeper.CancelScheduledAction(ctx, issuer, 1))
+
+	_, err = delayKeeper.GetFailedDelayedItemsByID(ctx, types.ScheduledActionDelayID(1))
+	requireT.ErrorIs(err, sdkerrors.ErrNotFound)
+
+	_, found, err = ftKeeper.GetScheduledAction(ctx, 1)
+	requireT.NoError(err)
+	requireT.False(found)
+}
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CoreumFoundation/coreum/v2/x/asset/ft/types"
+)
+
+// LockSupply locks the supply of the fungible token, so no more tokens might be minted. The lock can't be removed.
+func (k Keeper) LockSupply(ctx sdk.Context, sender sdk.AccAddress, denom string) error {
+	def, err := k.GetDefinition(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+
+	if !def.IsAdmin(sender) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only admin can lock the supply of the token")
+	}
+
+	if def.SupplyLocked {
+		return sdkerrors.Wrapf(types.ErrSupplyLocked, "supply of %s is locked already", denom)
+	}
+
+	subunit, issuer, err := types.DeconstructDenom(denom)
+	if err != nil {
+		return err
+	}
+	def.SupplyLocked = true
+	k.SetDefinition(ctx, issuer, subunit, def)
+
+	if err = ctx.EventManager().EmitTypedEvent(&types.EventSupplyLocked{
+		Denom:  denom,
+		Supply: k.bankKeeper.GetSupply(ctx, denom).Amount,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventSupplyLocked event: %s", err)
+	}
+
+	return nil
+}
+
+// GetRemainingMintableAmount returns the amount of the fungible token which might still be minted. The second value
+// is false if the amount is not limited.
+func (k Keeper) GetRemainingMintableAmount(ctx sdk.Context, denom string) (sdk.Int, bool, error) {
+	def, err := k.GetDefinition(ctx, denom)
+	if err != nil {
+		return sdk.Int{}, false, sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+
+	if !def.IsFeatureEnabled(types.Feature_minting) || def.SupplyLocked {
+		return sdk.ZeroInt(), true, nil
+	}
+
+	if !def.IsSupplyCapped() {
+		return sdk.Int{}, false, nil
+	}
+
+	remaining := def.MaxSupply.Sub(k.bankKeeper.GetSupply(ctx, denom).Amount)
+	if remaining.IsNegative() {
+		return sdk.ZeroInt(), true, nil
+	}
+	return remaining, true, nil
+}
+
+// checkMaxSupply returns an error if minting the amount exceeds the max supply of the token or the supply is locked.
+func (k Keeper) checkMaxSupply(ctx sdk.Context, def types.Definition, amount sdk.Int) error {
+	if def.SupplyLocked {
+		return sdkerrors.Wrapf(types.ErrSupplyLocked, "supply of %s is locked", def.Denom)
+	}
+
+	if !def.IsSupplyCapped() {
+		return nil
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, def.Denom)
+	if supply.Amount.Add(amount).GT(def.MaxSupply) {
+		return sdkerrors.Wrapf(types.ErrMaxSupplyExceeded, "minting %s exceeds the max supply %s, current supply %s",
+			sdk.NewCoin(def.Denom, amount), sdk.NewCoin(def.Denom, def.MaxSupply), supply)
+	}
+
+	return nil
+}
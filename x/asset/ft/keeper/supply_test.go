@@ -0,0 +1,160 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/v2/testutil/event"
+	"github.com/CoreumFoundation/coreum/v2/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/v2/x/asset/ft/types"
+)
+
+func TestKeeper_MaxSupply(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	ftKeeper := testApp.AssetFTKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	// initial amount can't exceed the max supply
+	settings := types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(1001),
+		MaxSupply:     sdk.NewInt(1000),
+		Features:      []types.Feature{types.Feature_minting, types.Feature_burning},
+	}
+	_, err := ftKeeper.Issue(ctx, settings)
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	settings.InitialAmount = sdk.NewInt(600)
+	denom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	token, err := ftKeeper.GetToken(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal(sdk.NewInt(1000).String(), token.MaxSupply.String())
+	requireT.False(token.SupplyLocked)
+
+	remaining, limited, err := ftKeeper.GetRemainingMintableAmount(ctx, denom)
+	requireT.NoError(err)
+	requireT.True(limited)
+	requireT.Equal(sdk.NewInt(400).String(), remaining.String())
+
+	// mint above the max supply
+	err = ftKeeper.Mint(ctx, issuer, sdk.NewInt64Coin(denom, 401))
+	requireT.ErrorIs(err, types.ErrMaxSupplyExceeded)
+
+	// mint up to the max supply
+	requireT.NoError(ftKeeper.Mint(ctx, issuer, sdk.NewInt64Coin(denom, 400)))
+	requireT.Equal(sdk.NewInt(1000).String(), testApp.BankKeeper.GetSupply(ctx, denom).Amount.String())
+
+	remaining, limited, err = ftKeeper.GetRemainingMintableAmount(ctx, denom)
+	requireT.NoError(err)
+	requireT.True(limited)
+	requireT.True(remaining.IsZero())
+
+	err = ftKeeper.Mint(ctx, issuer, sdk.NewInt64Coin(denom, 1))
+	requireT.ErrorIs(err, types.ErrMaxSupplyExceeded)
+
+	// burning frees the room for minting
+	requireT.NoError(ftKeeper.Burn(ctx, issuer, sdk.NewInt64Coin(denom, 100)))
+	remaining, _, err = ftKeeper.GetRemainingMintableAmount(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal(sdk.NewInt(100).String(), remaining.String())
+	requireT.NoError(ftKeeper.Mint(ctx, issuer, sdk.NewInt64Coin(denom, 100)))
+
+	// token without the max supply is not limited
+	settings.Symbol = "ABC"
+	settings.Subunit = "abc"
+	settings.MaxSupply = sdk.ZeroInt()
+	denom2, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+	_, limited, err = ftKeeper.GetRemainingMintableAmount(ctx, denom2)
+	requireT.NoError(err)
+	requireT.False(limited)
+	requireT.NoError(ftKeeper.Mint(ctx, issuer, sdk.NewInt64Coin(denom2, 1_000_000)))
+
+	// nothing might be minted if minting is disabled
+	settings.Symbol = "GHI"
+	settings.Subunit = "ghi"
+	settings.Features = nil
+	denom3, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+	remaining, limited, err = ftKeeper.GetRemainingMintableAmount(ctx, denom3)
+	requireT.NoError(err)
+	requireT.True(limited)
+	requireT.True(remaining.IsZero())
+}
+
+func TestKeeper_LockSupply(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	ftKeeper := testApp.AssetFTKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	randomAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	settings := types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(1000),
+		Features:      []types.Feature{types.Feature_minting},
+	}
+	denom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+	requireT.NoError(ftKeeper.Mint(ctx, issuer, sdk.NewInt64Coin(denom, 100)))
+
+	// only admin can lock the supply
+	err = ftKeeper.LockSupply(ctx, randomAddr, denom)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	requireT.NoError(ftKeeper.LockSupply(ctx, issuer, denom))
+
+	lockedEvents, err := event.FindTypedEvents[*types.EventSupplyLocked](ctx.EventManager().Events().ToABCIEvents())
+	requireT.NoError(err)
+	requireT.Len(lockedEvents, 1)
+	requireT.Equal(denom, lockedEvents[0].Denom)
+	requireT.Equal(sdk.NewInt(1100).String(), lockedEvents[0].Supply.String())
+
+	token, err := ftKeeper.GetToken(ctx, denom)
+	requireT.NoError(err)
+	requireT.True(token.SupplyLocked)
+
+	remaining, limited, err := ftKeeper.GetRemainingMintableAmount(ctx, denom)
+	requireT.NoError(err)
+	requireT.True(limited)
+	requireT.True(remaining.IsZero())
+
+	// minting is not possible anymore
+	err = ftKeeper.Mint(ctx, issuer, sdk.NewInt64Coin(denom, 1))
+	requireT.ErrorIs(err, types.ErrSupplyLocked)
+
+	// the lock can't be applied twice
+	err = ftKeeper.LockSupply(ctx, issuer, denom)
+	requireT.ErrorIs(err, types.ErrSupplyLocked)
+
+	// token without admin can't be locked
+	requireT.NoError(ftKeeper.ClearAdmin(ctx, issuer, denom))
+	settings.Symbol = "ABC"
+	settings.Subunit = "abc"
+	denom2, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+	requireT.NoError(ftKeeper.ClearAdmin(ctx, issuer, denom2))
+	err = ftKeeper.LockSupply(ctx, issuer, denom2)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+}
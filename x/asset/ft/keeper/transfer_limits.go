@@ -0,0 +1,242 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CoreumFoundation/coreum/v2/x/asset/ft/types"
+	dextypes "github.com/CoreumFoundation/coreum/v2/x/dex/types"
+	wibctransfertypes "github.com/CoreumFoundation/coreum/v2/x/wibctransfer/types"
+)
+
+// UpdateTransferLimits sets the transfer limits of the fungible token. Setting all the limits to zero removes them.
+func (k Keeper) UpdateTransferLimits(ctx sdk.Context, sender sdk.AccAddress, limits types.TransferLimits) error {
+	def, err := k.GetDefinition(ctx, limits.Denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", limits.Denom)
+	}
+
+	if err := k.checkFeatureAllowed(ctx, sender, def, types.Feature_transfer_limiting); err != nil {
+		return err
+	}
+
+	if err := limits.Validate(); err != nil {
+		return err
+	}
+
+	k.SetTransferLimits(ctx, limits)
+
+	if err = ctx.EventManager().EmitTypedEvent(&types.EventTransferLimitsSet{
+		Denom:                 limits.Denom,
+		MaxTransferAmount:     limits.MaxTransferAmount,
+		MaxAccountDailyAmount: limits.MaxAccountDailyAmount,
+		MaxTokenDailyAmount:   limits.MaxTokenDailyAmount,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventTransferLimitsSet event: %s", err)
+	}
+
+	return nil
+}
+
+// GetTransferLimits returns the transfer limits of the fungible token. Zero limits are returned if they are not set.
+func (k Keeper) GetTransferLimits(ctx sdk.Context, denom string) (types.TransferLimits, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.CreateTransferLimitsKey(denom))
+	if bz == nil {
+		return types.TransferLimits{
+			Denom:                 denom,
+			MaxTransferAmount:     sdk.ZeroInt(),
+			MaxAccountDailyAmount: sdk.ZeroInt(),
+			MaxTokenDailyAmount:   sdk.ZeroInt(),
+		}, nil
+	}
+
+	var limits types.TransferLimits
+	if err := k.cdc.Unmarshal(bz, &limits); err != nil {
+		return types.TransferLimits{}, err
+	}
+	return limits, nil
+}
+
+// IterateAllTransferLimits iterates over the transfer limits of all the tokens and applies the provided callback.
+// If true is returned from the callback, iteration is halted.
+func (k Keeper) IterateAllTransferLimits(ctx sdk.Context, cb func(types.TransferLimits) bool) error {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.TransferLimitsKeyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var limits types.TransferLimits
+		if err := k.cdc.Unmarshal(iterator.Value(), &limits); err != nil {
+			return err
+		}
+		if cb(limits) {
+			break
+		}
+	}
+
+	return nil
+}
+
+// SetTransferVolume stores the amounts sent by the account or by all the accounts if the account is empty.
+func (k Keeper) SetTransferVolume(ctx sdk.Context, volume types.TransferVolume) {
+	key := k.transferVolumeKey(volume.Denom, volume.Account)
+	store := ctx.KVStore(k.storeKey)
+	if len(volume.Buckets) == 0 {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, k.cdc.MustMarshal(&volume))
+}
+
+// IterateAllTransferVolumes iterates over the transfer volumes of all the tokens and applies the provided callback.
+// If true is returned from the callback, iteration is halted.
+func (k Keeper) IterateAllTransferVolumes(ctx sdk.Context, cb func(types.TransferVolume) bool) error {
+	for _, keyPrefix := range [][]byte{types.TokenTransferVolumesKeyPrefix, types.AccountTransferVolumesKeyPrefix} {
+		if err := k.iterateTransferVolumes(ctx, keyPrefix, cb); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetAccountDailyVolume returns the amount sent by the account within the current rolling window.
+func (k Keeper) GetAccountDailyVolume(ctx sdk.Context, denom string, account sdk.AccAddress) (sdk.Int, error) {
+	volume, err := k.getTransferVolume(ctx, denom, account.String())
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	return volume.DailyVolume(types.TransferVolumeHour(ctx.BlockTime())), nil
+}
+
+// GetTokenDailyVolume returns the amount sent by all the accounts within the current rolling window.
+func (k Keeper) GetTokenDailyVolume(ctx sdk.Context, denom string) (sdk.Int, error) {
+	volume, err := k.getTransferVolume(ctx, denom, "")
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	return volume.DailyVolume(types.TransferVolumeHour(ctx.BlockTime())), nil
+}
+
+// SetTransferLimits stores the transfer limits of the fungible token. The limits are removed if none of them is set.
+func (k Keeper) SetTransferLimits(ctx sdk.Context, limits types.TransferLimits) {
+	key := types.CreateTransferLimitsKey(limits.Denom)
+	store := ctx.KVStore(k.storeKey)
+	if !limits.IsSet() {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, k.cdc.MustMarshal(&limits))
+}
+
+func (k Keeper) iterateTransferVolumes(
+	ctx sdk.Context,
+	keyPrefix []byte,
+	cb func(types.TransferVolume) bool,
+) error {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var volume types.TransferVolume
+		if err := k.cdc.Unmarshal(iterator.Value(), &volume); err != nil {
+			return err
+		}
+		if cb(volume) {
+			break
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) getTransferVolume(ctx sdk.Context, denom, account string) (types.TransferVolume, error) {
+	volume := types.TransferVolume{
+		Denom:   denom,
+		Account: account,
+	}
+	bz := ctx.KVStore(k.storeKey).Get(k.transferVolumeKey(denom, account))
+	if bz == nil {
+		return volume, nil
+	}
+	if err := k.cdc.Unmarshal(bz, &volume); err != nil {
+		return types.TransferVolume{}, err
+	}
+	return volume, nil
+}
+
+func (k Keeper) transferVolumeKey(denom, account string) []byte {
+	if account == "" {
+		return types.CreateTokenTransferVolumeKey(denom)
+	}
+	return types.CreateAccountTransferVolumeKey(denom, sdk.MustAccAddressFromBech32(account))
+}
+
+// consumeTransferLimits checks that sending the amount doesn't exceed the transfer limits of the token and records
+// the amount in the rolling window. It is called once per sender of the transfer with the amount sent to the
+// recipients, so the burn rate and the send commission charged on top of it are not counted.
+func (k Keeper) consumeTransferLimits(ctx sdk.Context, addr sdk.AccAddress, def types.Definition, amount sdk.Int) error {
+	if !k.isTransferLimited(ctx, addr, def) {
+		return nil
+	}
+
+	limits, err := k.GetTransferLimits(ctx, def.Denom)
+	if err != nil {
+		return err
+	}
+	if !limits.IsSet() {
+		return nil
+	}
+
+	if limits.MaxTransferAmount.IsPositive() && amount.GT(limits.MaxTransferAmount) {
+		return sdkerrors.Wrapf(types.ErrTransferLimitExceeded, "%s exceeds the max transfer amount %s",
+			sdk.NewCoin(def.Denom, amount), sdk.NewCoin(def.Denom, limits.MaxTransferAmount))
+	}
+
+	hour := types.TransferVolumeHour(ctx.BlockTime())
+	volumes := make([]types.TransferVolume, 0, 2)
+	for _, limit := range []struct {
+		account string
+		max     sdk.Int
+		name    string
+	}{
+		{account: addr.String(), max: limits.MaxAccountDailyAmount, name: "account"},
+		{account: "", max: limits.MaxTokenDailyAmount, name: "token"},
+	} {
+		if !limit.max.IsPositive() {
+			continue
+		}
+
+		volume, err := k.getTransferVolume(ctx, def.Denom, limit.account)
+		if err != nil {
+			return err
+		}
+		if volume.DailyVolume(hour).Add(amount).GT(limit.max) {
+			return sdkerrors.Wrapf(types.ErrTransferLimitExceeded, "%s exceeds the max %s daily amount %s",
+				sdk.NewCoin(def.Denom, amount), limit.name, sdk.NewCoin(def.Denom, limit.max))
+		}
+		volumes = append(volumes, volume)
+	}
+
+	// the volumes are stored once all the limits are checked, so the rejected amount is not recorded
+	for _, volume := range volumes {
+		volume.Add(hour, amount)
+		k.SetTransferVolume(ctx, volume)
+	}
+
+	return nil
+}
+
+func (k Keeper) isTransferLimited(ctx sdk.Context, addr sdk.AccAddress, def types.Definition) bool {
+	if !def.IsFeatureEnabled(types.Feature_transfer_limiting) || def.IsAdmin(addr) {
+		return false
+	}
+
+	// Funds coming back from the IBC escrow, refunded by IBC, settled or unlocked by the dex and sent by the module
+	// accounts are moved by the protocol and not by the holders, so they are not counted.
+	if wibctransfertypes.IsPurposeIn(ctx) || wibctransfertypes.IsPurposeAck(ctx) ||
+		wibctransfertypes.IsPurposeTimeout(ctx) || dextypes.IsPurposeSettle(ctx) || dextypes.IsPurposeUnlock(ctx) {
+		return false
+	}
+
+	return !k.bankKeeper.BlockedAddr(addr)
+}
@@ -0,0 +1,241 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/v2/testutil/event"
+	"github.com/CoreumFoundation/coreum/v2/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/v2/x/asset/ft/types"
+)
+
+func TestKeeper_UpdateTransferLimits(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	ftKeeper := testApp.AssetFTKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	account := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	settings := types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(1000),
+	}
+	unlimitedDenom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	settings.Symbol = "ABC"
+	settings.Subunit = "abc"
+	settings.Features = []types.Feature{types.Feature_transfer_limiting}
+	denom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	limits := types.TransferLimits{
+		Denom:                 unlimitedDenom,
+		MaxTransferAmount:     sdk.NewInt(10),
+		MaxAccountDailyAmount: sdk.NewInt(100),
+		MaxTokenDailyAmount:   sdk.ZeroInt(),
+	}
+
+	// the feature must be enabled
+	requireT.ErrorIs(ftKeeper.UpdateTransferLimits(ctx, issuer, limits), types.ErrFeatureDisabled)
+
+	// only admin can set the limits
+	limits.Denom = denom
+	requireT.ErrorIs(ftKeeper.UpdateTransferLimits(ctx, account, limits), sdkerrors.ErrUnauthorized)
+
+	// the limits must not be negative
+	limits.MaxTokenDailyAmount = sdk.NewInt(-1)
+	requireT.ErrorIs(ftKeeper.UpdateTransferLimits(ctx, issuer, limits), types.ErrInvalidInput)
+
+	limits.MaxTokenDailyAmount = sdk.ZeroInt()
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	requireT.NoError(ftKeeper.UpdateTransferLimits(ctx, issuer, limits))
+
+	storedLimits, err := ftKeeper.GetTransferLimits(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal(limits, storedLimits)
+
+	setEvents, err := event.FindTypedEvents[*types.EventTransferLimitsSet](ctx.EventManager().Events().ToABCIEvents())
+	requireT.NoError(err)
+	requireT.Len(setEvents, 1)
+	requireT.Equal(denom, setEvents[0].Denom)
+	requireT.Equal("10", setEvents[0].MaxTransferAmount.String())
+	requireT.Equal("100", setEvents[0].MaxAccountDailyAmount.String())
+	requireT.Equal("0", setEvents[0].MaxTokenDailyAmount.String())
+
+	// setting zero limits removes them
+	limits.MaxTransferAmount = sdk.ZeroInt()
+	limits.MaxAccountDailyAmount = sdk.ZeroInt()
+	requireT.NoError(ftKeeper.UpdateTransferLimits(ctx, issuer, limits))
+
+	var storedCount int
+	requireT.NoError(ftKeeper.IterateAllTransferLimits(ctx, func(types.TransferLimits) bool {
+		storedCount++
+		return false
+	}))
+	requireT.Zero(storedCount)
+}
+
+func TestKeeper_TransferLimits(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	blockTime := time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{Time: blockTime})
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	user1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	user2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	settings := types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(1000),
+		Features:      []types.Feature{types.Feature_burning, types.Feature_transfer_limiting},
+	}
+	denom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	// the admin is not limited
+	requireT.NoError(ftKeeper.UpdateTransferLimits(ctx, issuer, types.TransferLimits{
+		Denom:                 denom,
+		MaxTransferAmount:     sdk.NewInt(50),
+		MaxAccountDailyAmount: sdk.NewInt(80),
+		MaxTokenDailyAmount:   sdk.NewInt(120),
+	}))
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, user1, sdk.NewCoins(sdk.NewInt64Coin(denom, 300))))
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, user2, sdk.NewCoins(sdk.NewInt64Coin(denom, 300))))
+
+	tokenVolume, err := ftKeeper.GetTokenDailyVolume(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal("0", tokenVolume.String())
+
+	// single transfer can't exceed the max transfer amount
+	err = bankKeeper.SendCoins(ctx, user1, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 51)))
+	requireT.ErrorIs(err, types.ErrTransferLimitExceeded)
+
+	requireT.NoError(bankKeeper.SendCoins(ctx, user1, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 50))))
+	requireT.NoError(bankKeeper.SendCoins(ctx, user1, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 30))))
+
+	// the account daily amount is reached
+	err = bankKeeper.SendCoins(ctx, user1, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 1)))
+	requireT.ErrorIs(err, types.ErrTransferLimitExceeded)
+
+	accountVolume, err := ftKeeper.GetAccountDailyVolume(ctx, denom, user1)
+	requireT.NoError(err)
+	requireT.Equal("80", accountVolume.String())
+
+	// the token daily amount is shared by all the accounts
+	requireT.NoError(bankKeeper.SendCoins(ctx, user2, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 40))))
+	err = bankKeeper.SendCoins(ctx, user2, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 1)))
+	requireT.ErrorIs(err, types.ErrTransferLimitExceeded)
+
+	tokenVolume, err = ftKeeper.GetTokenDailyVolume(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal("120", tokenVolume.String())
+	accountVolume, err = ftKeeper.GetAccountDailyVolume(ctx, denom, user2)
+	requireT.NoError(err)
+	requireT.Equal("40", accountVolume.String())
+
+	// the amounts leave the rolling window after 24 hours
+	ctx = ctx.WithBlockTime(blockTime.Add(23 * time.Hour))
+	err = bankKeeper.SendCoins(ctx, user1, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 1)))
+	requireT.ErrorIs(err, types.ErrTransferLimitExceeded)
+
+	ctx = ctx.WithBlockTime(blockTime.Add(24 * time.Hour))
+	requireT.NoError(bankKeeper.SendCoins(ctx, user1, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 50))))
+
+	accountVolume, err = ftKeeper.GetAccountDailyVolume(ctx, denom, user1)
+	requireT.NoError(err)
+	requireT.Equal("50", accountVolume.String())
+	tokenVolume, err = ftKeeper.GetTokenDailyVolume(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal("50", tokenVolume.String())
+
+	// burning is not a transfer, so it is not limited
+	requireT.NoError(ftKeeper.Burn(ctx, user1, sdk.NewInt64Coin(denom, 31)))
+	accountVolume, err = ftKeeper.GetAccountDailyVolume(ctx, denom, user1)
+	requireT.NoError(err)
+	requireT.Equal("50", accountVolume.String())
+
+	// once the limits are removed the volumes are not tracked anymore
+	requireT.NoError(ftKeeper.UpdateTransferLimits(ctx, issuer, types.TransferLimits{
+		Denom:                 denom,
+		MaxTransferAmount:     sdk.ZeroInt(),
+		MaxAccountDailyAmount: sdk.ZeroInt(),
+		MaxTokenDailyAmount:   sdk.ZeroInt(),
+	}))
+	requireT.NoError(bankKeeper.SendCoins(ctx, user2, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 200))))
+	accountVolume, err = ftKeeper.GetAccountDailyVolume(ctx, denom, user2)
+	requireT.NoError(err)
+	requireT.Equal("0", accountVolume.String())
+}
+
+func TestKeeper_TransferLimitsWithRates(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{Time: time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)})
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	user := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:             issuer,
+		Symbol:             "DEF",
+		Subunit:            "def",
+		Precision:          6,
+		InitialAmount:      sdk.NewInt(1000),
+		Features:           []types.Feature{types.Feature_transfer_limiting},
+		BurnRate:           sdk.MustNewDecFromStr("0.1"),
+		SendCommissionRate: sdk.MustNewDecFromStr("0.2"),
+	})
+	requireT.NoError(err)
+
+	requireT.NoError(ftKeeper.UpdateTransferLimits(ctx, issuer, types.TransferLimits{
+		Denom:                 denom,
+		MaxTransferAmount:     sdk.NewInt(50),
+		MaxAccountDailyAmount: sdk.NewInt(100),
+		MaxTokenDailyAmount:   sdk.NewInt(100),
+	}))
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, user, sdk.NewCoins(sdk.NewInt64Coin(denom, 300))))
+
+	// the rates charged on top of the sent amount are neither counted nor checked against the max transfer amount
+	requireT.NoError(bankKeeper.SendCoins(ctx, user, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 50))))
+	requireT.Equal("235", bankKeeper.GetBalance(ctx, user, denom).Amount.String())
+
+	accountVolume, err := ftKeeper.GetAccountDailyVolume(ctx, denom, user)
+	requireT.NoError(err)
+	requireT.Equal("50", accountVolume.String())
+	tokenVolume, err := ftKeeper.GetTokenDailyVolume(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal("50", tokenVolume.String())
+
+	requireT.NoError(bankKeeper.SendCoins(ctx, user, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 50))))
+	accountVolume, err = ftKeeper.GetAccountDailyVolume(ctx, denom, user)
+	requireT.NoError(err)
+	requireT.Equal("100", accountVolume.String())
+
+	err = bankKeeper.SendCoins(ctx, user, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 1)))
+	requireT.ErrorIs(err, types.ErrTransferLimitExceeded)
+}
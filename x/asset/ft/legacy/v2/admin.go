@@ -0,0 +1,30 @@
+package v2
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CoreumFoundation/coreum/v2/x/asset/ft/types"
+)
+
+// FTKeeper represents ft keeper.
+type FTKeeper interface {
+	IterateAllDefinitions(ctx sdk.Context, cb func(types.Definition) (bool, error)) error
+	SetDefinition(ctx sdk.Context, issuer sdk.AccAddress, subunit string, definition types.Definition)
+	SetAdminToken(ctx sdk.Context, admin sdk.AccAddress, denom string)
+}
+
+// MigrateAdmin migrates asset ft definitions from v2 to v3.
+// It sets the issuer as the admin of each token, and indexes the token by the admin.
+func MigrateAdmin(ctx sdk.Context, keeper FTKeeper) error {
+	return keeper.IterateAllDefinitions(ctx, func(def types.Definition) (bool, error) {
+		subunit, issuer, err := types.DeconstructDenom(def.Denom)
+		if err != nil {
+			return false, err
+		}
+
+		def.Admin = issuer.String()
+		keeper.SetDefinition(ctx, issuer, subunit, def)
+		keeper.SetAdminToken(ctx, issuer, def.Denom)
+		return false, nil
+	})
+}
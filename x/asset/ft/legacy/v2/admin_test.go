@@ -0,0 +1,52 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/v2/testutil/simapp"
+	v2 "github.com/CoreumFoundation/coreum/v2/x/asset/ft/legacy/v2"
+	"github.com/CoreumFoundation/coreum/v2/x/asset/ft/types"
+)
+
+func TestMigrateAdmin(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+
+	keeper := testApp.AssetFTKeeper
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	settings := types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     1,
+		InitialAmount: sdk.NewInt(1000),
+	}
+	denom, err := keeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	// bring the token to the state it had before the admin was introduced
+	requireT.NoError(keeper.ClearAdmin(ctx, issuer, denom))
+	tokens, _, err := keeper.GetAdminTokens(ctx, issuer, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Empty(tokens)
+
+	requireT.NoError(v2.MigrateAdmin(ctx, keeper))
+
+	def, err := keeper.GetDefinition(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal(issuer.String(), def.Admin)
+
+	tokens, _, err = keeper.GetAdminTokens(ctx, issuer, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Len(tokens, 1)
+	requireT.Equal(denom, tokens[0].Denom)
+}
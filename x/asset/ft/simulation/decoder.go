@@ -0,0 +1,69 @@
+package simulation
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/CoreumFoundation/coreum/v2/x/asset/ft/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding asset ft type.
+func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.TokenKeyPrefix):
+			var definitionA, definitionB types.Definition
+			cdc.MustUnmarshal(kvA.Value, &definitionA)
+			cdc.MustUnmarshal(kvB.Value, &definitionB)
+			return fmt.Sprintf("%v\n%v", definitionA, definitionB)
+		case bytes.Equal(kvA.Key[:1], types.FrozenBalancesKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.WhitelistedBalancesKeyPrefix):
+			var coinA, coinB sdk.Coin
+			cdc.MustUnmarshal(kvA.Value, &coinA)
+			cdc.MustUnmarshal(kvB.Value, &coinB)
+			return fmt.Sprintf("%v\n%v", coinA, coinB)
+		case bytes.Equal(kvA.Key[:1], types.SymbolKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.GlobalFreezeKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.AdminTokensKeyPrefix):
+			return fmt.Sprintf("%v\n%v", kvA.Value, kvB.Value)
+		case bytes.Equal(kvA.Key[:1], types.PendingTokenUpgradeKeyPrefix):
+			versionA, _ := binary.Uvarint(kvA.Value)
+			versionB, _ := binary.Uvarint(kvB.Value)
+			return fmt.Sprintf("%v\n%v", versionA, versionB)
+		case bytes.Equal(kvA.Key[:1], types.TokenUpgradeStatusesKeyPrefix):
+			var statusesA, statusesB types.TokenUpgradeStatuses
+			cdc.MustUnmarshal(kvA.Value, &statusesA)
+			cdc.MustUnmarshal(kvB.Value, &statusesB)
+			return fmt.Sprintf("%v\n%v", statusesA, statusesB)
+		case bytes.Equal(kvA.Key[:1], types.RoleGrantsKeyPrefix):
+			var grantA, grantB types.RoleGrant
+			cdc.MustUnmarshal(kvA.Value, &grantA)
+			cdc.MustUnmarshal(kvB.Value, &grantB)
+			return fmt.Sprintf("%v\n%v", grantA, grantB)
+		case bytes.Equal(kvA.Key[:1], types.RateExemptionsKeyPrefix):
+			var exemptionA, exemptionB types.RateExemption
+			cdc.MustUnmarshal(kvA.Value, &exemptionA)
+			cdc.MustUnmarshal(kvB.Value, &exemptionB)
+			return fmt.Sprintf("%v\n%v", exemptionA, exemptionB)
+		case bytes.Equal(kvA.Key[:1], types.TransferLimitsKeyPrefix):
+			var limitsA, limitsB types.TransferLimits
+			cdc.MustUnmarshal(kvA.Value, &limitsA)
+			cdc.MustUnmarshal(kvB.Value, &limitsB)
+			return fmt.Sprintf("%v\n%v", limitsA, limitsB)
+		case bytes.Equal(kvA.Key[:1], types.AccountTransferVolumesKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.TokenTransferVolumesKeyPrefix):
+			var volumeA, volumeB types.TransferVolume
+			cdc.MustUnmarshal(kvA.Value, &volumeA)
+			cdc.MustUnmarshal(kvB.Value, &volumeB)
+			return fmt.Sprintf("%v\n%v", volumeA, volumeB)
+		default:
+			panic(fmt.Sprintf("invalid asset ft key %X", kvA.Key))
+		}
+	}
+}
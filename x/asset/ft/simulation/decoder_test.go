@@ -0,0 +1,141 @@
+package simulation_test
+
+import (
+	"encoding/binary"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v2/app"
+	"github.com/CoreumFoundation/coreum/v2/pkg/config"
+	"github.com/CoreumFoundation/coreum/v2/x/asset"
+	"github.com/CoreumFoundation/coreum/v2/x/asset/ft/simulation"
+	"github.com/CoreumFoundation/coreum/v2/x/asset/ft/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := config.NewEncodingConfig(app.ModuleBasics).Codec
+	dec := simulation.NewDecodeStore(cdc)
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	account := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	denom := types.BuildDenom("abc", issuer)
+
+	definition := types.Definition{
+		Denom:              denom,
+		Issuer:             issuer.String(),
+		Features:           []types.Feature{types.Feature_freezing},
+		BurnRate:           sdk.ZeroDec(),
+		SendCommissionRate: sdk.ZeroDec(),
+		Admin:              issuer.String(),
+	}
+	definitionBz, err := cdc.Marshal(&definition)
+	require.NoError(t, err)
+
+	coin := sdk.NewInt64Coin(denom, 10)
+	coinBz, err := cdc.Marshal(&coin)
+	require.NoError(t, err)
+
+	version := make([]byte, binary.MaxVarintLen32)
+	version = version[:binary.PutUvarint(version, 1)]
+
+	statuses := types.TokenUpgradeStatuses{
+		V1: &types.TokenUpgradeV1Status{
+			IbcEnabled: true,
+			StartTime:  time.Unix(1000, 0).UTC(),
+			EndTime:    time.Unix(2000, 0).UTC(),
+		},
+	}
+	statusesBz, err := cdc.Marshal(&statuses)
+	require.NoError(t, err)
+
+	grant := types.RoleGrant{
+		Denom:   denom,
+		Account: account.String(),
+		Role:    types.Role_freezer,
+		MintCap: sdk.ZeroInt(),
+		Minted:  sdk.ZeroInt(),
+	}
+	grantBz, err := cdc.Marshal(&grant)
+	require.NoError(t, err)
+
+	exemption := types.RateExemption{
+		Denom:   denom,
+		Account: account.String(),
+	}
+	exemptionBz, err := cdc.Marshal(&exemption)
+	require.NoError(t, err)
+
+	limits := types.TransferLimits{
+		Denom:                 denom,
+		MaxTransferAmount:     sdk.NewInt(10),
+		MaxAccountDailyAmount: sdk.NewInt(100),
+		MaxTokenDailyAmount:   sdk.ZeroInt(),
+	}
+	limitsBz, err := cdc.Marshal(&limits)
+	require.NoError(t, err)
+
+	volume := types.TransferVolume{
+		Denom:   denom,
+		Account: account.String(),
+		Buckets: []types.TransferVolumeBucket{
+			{Hour: 10, Amount: sdk.NewInt(5)},
+		},
+	}
+	volumeBz, err := cdc.Marshal(&volume)
+	require.NoError(t, err)
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.CreateTokenKey(issuer, "abc"), Value: definitionBz},
+			{Key: types.CreateSymbolKey(issuer, "ABC"), Value: asset.StoreTrue},
+			{Key: types.CreateFrozenBalancesKey(account), Value: coinBz},
+			{Key: types.CreateGlobalFreezeKey(denom), Value: asset.StoreTrue},
+			{Key: types.CreateWhitelistedBalancesKey(account), Value: coinBz},
+			{Key: types.CreatePendingTokenUpgradeKey(denom), Value: version},
+			{Key: types.CreateTokenUpgradeStatusesKey(denom), Value: statusesBz},
+			{Key: types.CreateAdminTokenKey(issuer, denom), Value: asset.StoreTrue},
+			{Key: types.CreateRoleGrantKey(denom, account, types.Role_freezer), Value: grantBz},
+			{Key: types.CreateRateExemptionKey(denom, account), Value: exemptionBz},
+			{Key: types.CreateTransferLimitsKey(denom), Value: limitsBz},
+			{Key: types.CreateAccountTransferVolumeKey(denom, account), Value: volumeBz},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectErr   bool
+		expectedLog string
+	}{
+		{"Token", false, fmt.Sprintf("%v\n%v", definition, definition)},
+		{"Symbol", false, fmt.Sprintf("%v\n%v", asset.StoreTrue, asset.StoreTrue)},
+		{"FrozenBalance", false, fmt.Sprintf("%v\n%v", coin, coin)},
+		{"GlobalFreeze", false, fmt.Sprintf("%v\n%v", asset.StoreTrue, asset.StoreTrue)},
+		{"WhitelistedBalance", false, fmt.Sprintf("%v\n%v", coin, coin)},
+		{"PendingTokenUpgrade", false, fmt.Sprintf("%v\n%v", 1, 1)},
+		{"TokenUpgradeStatuses", false, fmt.Sprintf("%v\n%v", statuses, statuses)},
+		{"AdminToken", false, fmt.Sprintf("%v\n%v", asset.StoreTrue, asset.StoreTrue)},
+		{"RoleGrant", false, fmt.Sprintf("%v\n%v", grant, grant)},
+		{"RateExemption", false, fmt.Sprintf("%v\n%v", exemption, exemption)},
+		{"TransferLimits", false, fmt.Sprintf("%v\n%v", limits, limits)},
+		{"TransferVolume", false, fmt.Sprintf("%v\n%v", volume, volume)},
+		{"other", true, ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.expectErr {
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			} else {
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
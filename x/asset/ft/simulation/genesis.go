@@ -0,0 +1,357 @@
+package simulation
+
+import (
+	"math/rand"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/samber/lo"
+
+	"github.com/CoreumFoundation/coreum/v2/x/asset/ft/types"
+)
+
+// Simulation parameter constants.
+const (
+	IssueFee                    = "issue_fee"
+	TokenUpgradeDecisionTimeout = "token_upgrade_decision_timeout"
+	TokenUpgradeGracePeriod     = "token_upgrade_grace_period"
+	Tokens                      = "tokens"
+)
+
+// genIssueFee returns randomized issue fee.
+func genIssueFee(r *rand.Rand, bondDenom string) sdk.Coin {
+	return sdk.NewInt64Coin(bondDenom, r.Int63n(1_000_000))
+}
+
+// genTokenUpgradeDecisionTimeout returns randomized token upgrade decision timeout. In half of the cases the upgrade
+// is not possible at all.
+func genTokenUpgradeDecisionTimeout(r *rand.Rand, genesisTime time.Time) time.Time {
+	if r.Intn(2) == 0 {
+		return types.DefaultTokenUpgradeDecisionTimeout
+	}
+	return genesisTime.Add(time.Duration(simtypes.RandIntBetween(r, 1, 24*365)) * time.Hour)
+}
+
+// genTokenUpgradeGracePeriod returns randomized token upgrade grace period.
+func genTokenUpgradeGracePeriod(r *rand.Rand) time.Duration {
+	return time.Duration(simtypes.RandIntBetween(r, 1, 24*14)) * time.Hour
+}
+
+// genTokens returns randomized tokens issued by the part of the accounts.
+func genTokens(r *rand.Rand, accounts []simtypes.Account) []types.Token {
+	tokens := make([]types.Token, 0, len(accounts))
+	for _, acc := range accounts {
+		if r.Intn(10) != 0 {
+			continue
+		}
+		token := genToken(r, acc.Address)
+		if r.Intn(3) == 0 {
+			token.SendCommissionRecipients = randRateRecipients(r, accounts)
+		}
+		if r.Intn(3) == 0 {
+			token.BurnRateRecipients = randRateRecipients(r, accounts)
+		}
+		tokens = append(tokens, token)
+	}
+	return tokens
+}
+
+// genToken returns the randomized token issued by the issuer.
+func genToken(r *rand.Rand, issuer sdk.AccAddress) types.Token {
+	subunit := randSubunit(r)
+	token := types.Token{
+		Denom:              types.BuildDenom(subunit, issuer),
+		Issuer:             issuer.String(),
+		Symbol:             randSymbol(r),
+		Subunit:            subunit,
+		Precision:          uint32(simtypes.RandIntBetween(r, 1, types.MaxPrecision+1)),
+		Description:        simtypes.RandStringOfLength(r, simtypes.RandIntBetween(r, 0, 50)),
+		Features:           randFeatures(r),
+		BurnRate:           randRate(r),
+		SendCommissionRate: randRate(r),
+		Version:            uint32(r.Intn(2)),
+		Admin:              issuer.String(),
+		URI:                randURI(r),
+		URIHash:            randURIHash(r),
+	}
+	if lo.Contains(token.Features, types.Feature_freezing) {
+		token.GloballyFrozen = r.Intn(5) == 0
+	}
+	if r.Intn(3) == 0 {
+		token.MaxSupply = simtypes.RandomAmount(r, maxSimAmount).AddRaw(1)
+	}
+	token.SupplyLocked = r.Intn(10) == 0
+	if r.Intn(10) == 0 {
+		token.Admin = ""
+	}
+	return token
+}
+
+// genFrozenBalances returns randomized frozen balances of the tokens with the freezing feature enabled.
+func genFrozenBalances(r *rand.Rand, accounts []simtypes.Account, tokens []types.Token) []types.Balance {
+	return genBalances(r, accounts, tokens, types.Feature_freezing)
+}
+
+// genWhitelistedBalances returns randomized whitelisted balances of the tokens with the whitelisting feature enabled.
+func genWhitelistedBalances(r *rand.Rand, accounts []simtypes.Account, tokens []types.Token) []types.Balance {
+	return genBalances(r, accounts, tokens, types.Feature_whitelisting)
+}
+
+// genBalances returns the balances of a few random accounts for each token having the feature enabled.
+func genBalances(
+	r *rand.Rand,
+	accounts []simtypes.Account,
+	tokens []types.Token,
+	feature types.Feature,
+) []types.Balance {
+	accountCoins := make(map[string]sdk.Coins)
+	for _, token := range tokens {
+		if !lo.Contains(token.Features, feature) {
+			continue
+		}
+		for i := r.Intn(4); i > 0; i-- {
+			acc, _ := simtypes.RandomAcc(r, accounts)
+			address := acc.Address.String()
+			if token.Issuer == address || token.Admin == address || !accountCoins[address].AmountOf(token.Denom).IsZero() {
+				continue
+			}
+			accountCoins[address] = accountCoins[address].Add(
+				sdk.NewCoin(token.Denom, simtypes.RandomAmount(r, sdk.NewInt(1_000_000_000)).AddRaw(1)),
+			)
+		}
+	}
+
+	// the balances are built in the order of the accounts to keep the genesis state deterministic
+	balances := make([]types.Balance, 0, len(accountCoins))
+	for _, acc := range accounts {
+		coins, exists := accountCoins[acc.Address.String()]
+		if !exists {
+			continue
+		}
+		balances = append(balances, types.Balance{
+			Address: acc.Address.String(),
+			Coins:   coins,
+		})
+		delete(accountCoins, acc.Address.String())
+	}
+	return balances
+}
+
+// genRoleGrants returns randomized roles granted for the tokens having an admin.
+func genRoleGrants(r *rand.Rand, accounts []simtypes.Account, tokens []types.Token) []types.RoleGrant {
+	grants := make([]types.RoleGrant, 0)
+	for _, token := range tokens {
+		if token.Admin == "" {
+			continue
+		}
+		for _, role := range []types.Role{types.Role_minter, types.Role_freezer, types.Role_whitelister} {
+			if !lo.Contains(token.Features, role.Feature()) || r.Intn(2) == 0 {
+				continue
+			}
+			acc, _ := simtypes.RandomAcc(r, accounts)
+			if acc.Address.String() == token.Admin {
+				continue
+			}
+			grants = append(grants, types.RoleGrant{
+				Denom:   token.Denom,
+				Account: acc.Address.String(),
+				Role:    role,
+				MintCap: randMintCap(r, role),
+				Minted:  sdk.ZeroInt(),
+			})
+		}
+	}
+	return grants
+}
+
+// genRateExemptions returns randomized rate exemptions for the tokens having an admin.
+func genRateExemptions(r *rand.Rand, accounts []simtypes.Account, tokens []types.Token) []types.RateExemption {
+	exemptions := make([]types.RateExemption, 0)
+	for _, token := range tokens {
+		if token.Admin == "" || r.Intn(2) == 0 {
+			continue
+		}
+		acc, _ := simtypes.RandomAcc(r, accounts)
+		if acc.Address.String() == token.Admin {
+			continue
+		}
+		exemptions = append(exemptions, types.RateExemption{
+			Denom:   token.Denom,
+			Account: acc.Address.String(),
+		})
+	}
+	return exemptions
+}
+
+// genTransferLimits returns randomized transfer limits for the tokens with the transfer limiting feature enabled.
+func genTransferLimits(r *rand.Rand, tokens []types.Token) []types.TransferLimits {
+	transferLimits := make([]types.TransferLimits, 0)
+	for _, token := range tokens {
+		if !lo.Contains(token.Features, types.Feature_transfer_limiting) || r.Intn(2) == 0 {
+			continue
+		}
+		limits := randTransferLimits(r, token.Denom)
+		if !limits.IsSet() {
+			continue
+		}
+		transferLimits = append(transferLimits, limits)
+	}
+	return transferLimits
+}
+
+// RandomizedGenState generates a random GenesisState for the asset ft module.
+func RandomizedGenState(simState *module.SimulationState) {
+	var issueFee sdk.Coin
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, IssueFee, &issueFee, simState.Rand,
+		func(r *rand.Rand) { issueFee = genIssueFee(r, sdk.DefaultBondDenom) },
+	)
+
+	var decisionTimeout time.Time
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, TokenUpgradeDecisionTimeout, &decisionTimeout, simState.Rand,
+		func(r *rand.Rand) { decisionTimeout = genTokenUpgradeDecisionTimeout(r, simState.GenTimestamp) },
+	)
+
+	var gracePeriod time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, TokenUpgradeGracePeriod, &gracePeriod, simState.Rand,
+		func(r *rand.Rand) { gracePeriod = genTokenUpgradeGracePeriod(r) },
+	)
+
+	var tokens []types.Token
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, Tokens, &tokens, simState.Rand,
+		func(r *rand.Rand) { tokens = genTokens(r, simState.Accounts) },
+	)
+
+	genState := &types.GenesisState{
+		Params: types.Params{
+			IssueFee:                    issueFee,
+			TokenUpgradeDecisionTimeout: decisionTimeout,
+			TokenUpgradeGracePeriod:     gracePeriod,
+		},
+		Tokens:              tokens,
+		FrozenBalances:      genFrozenBalances(simState.Rand, simState.Accounts, tokens),
+		WhitelistedBalances: genWhitelistedBalances(simState.Rand, simState.Accounts, tokens),
+		RoleGrants:          genRoleGrants(simState.Rand, simState.Accounts, tokens),
+		RateExemptions:      genRateExemptions(simState.Rand, simState.Accounts, tokens),
+		TransferLimits:      genTransferLimits(simState.Rand, tokens),
+	}
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genState)
+
+	// The token is not usable without the denom metadata stored by the bank module, so the metadata is added to the
+	// bank genesis state generated before.
+	addDenomMetadata(simState, tokens)
+}
+
+func addDenomMetadata(simState *module.SimulationState, tokens []types.Token) {
+	bankGenState := banktypes.DefaultGenesisState()
+	if bz, exists := simState.GenState[banktypes.ModuleName]; exists {
+		simState.Cdc.MustUnmarshalJSON(bz, bankGenState)
+	}
+	for _, token := range tokens {
+		bankGenState.DenomMetadata = append(bankGenState.DenomMetadata, banktypes.Metadata{
+			Name:        token.Symbol,
+			Symbol:      token.Symbol,
+			Description: token.Description,
+			DenomUnits: []*banktypes.DenomUnit{
+				{
+					Denom:    token.Denom,
+					Exponent: 0,
+				},
+				{
+					Denom:    token.Symbol,
+					Exponent: token.Precision,
+				},
+			},
+			Base:    token.Denom,
+			Display: token.Symbol,
+		})
+	}
+	simState.GenState[banktypes.ModuleName] = simState.Cdc.MustMarshalJSON(bankGenState)
+}
+
+func randSubunit(r *rand.Rand) string {
+	return "sim" + strings.ToLower(simtypes.RandStringOfLength(r, 10))
+}
+
+func randSymbol(r *rand.Rand) string {
+	return "SIM" + strings.ToUpper(simtypes.RandStringOfLength(r, 10))
+}
+
+func randFeatures(r *rand.Rand) []types.Feature {
+	features := make([]types.Feature, 0, len(types.Feature_name))
+	for i := 0; i < len(types.Feature_name); i++ {
+		if r.Intn(2) == 0 {
+			features = append(features, types.Feature(i))
+		}
+	}
+	return features
+}
+
+// randRate returns zero rate in half of the cases and the rate with at most 4 decimal places otherwise.
+func randRate(r *rand.Rand) sdk.Dec {
+	if r.Intn(2) == 0 {
+		return sdk.ZeroDec()
+	}
+	return sdk.NewDecWithPrec(int64(r.Intn(10_001)), 4)
+}
+
+// randURI returns empty URI in half of the cases.
+func randURI(r *rand.Rand) string {
+	if r.Intn(2) == 0 {
+		return ""
+	}
+	return "https://" + strings.ToLower(simtypes.RandStringOfLength(r, simtypes.RandIntBetween(r, 1, 50)))
+}
+
+func randURIHash(r *rand.Rand) string {
+	return simtypes.RandStringOfLength(r, simtypes.RandIntBetween(r, 0, types.MaxURIHashLength+1))
+}
+
+func randMintCap(r *rand.Rand, role types.Role) sdk.Int {
+	if role != types.Role_minter || r.Intn(2) == 0 {
+		return sdk.ZeroInt()
+	}
+	return simtypes.RandomAmount(r, sdk.NewInt(1_000_000_000)).AddRaw(1)
+}
+
+// randRateRecipients returns a few distinct random accounts with random weights.
+func randRateRecipients(r *rand.Rand, accounts []simtypes.Account) []types.RateRecipient {
+	recipients := make([]types.RateRecipient, 0)
+	for i := simtypes.RandIntBetween(r, 1, 4); i > 0; i-- {
+		acc, _ := simtypes.RandomAcc(r, accounts)
+		address := acc.Address.String()
+		if lo.ContainsBy(recipients, func(recipient types.RateRecipient) bool {
+			return recipient.Address == address
+		}) {
+			continue
+		}
+		recipients = append(recipients, types.RateRecipient{
+			Address: address,
+			Weight:  uint32(simtypes.RandIntBetween(r, 1, 10)),
+		})
+	}
+	return recipients
+}
+
+// randTransferLimits returns the transfer limits having each of the limits set in half of the cases.
+func randTransferLimits(r *rand.Rand, denom string) types.TransferLimits {
+	randLimit := func() sdk.Int {
+		if r.Intn(2) == 0 {
+			return sdk.ZeroInt()
+		}
+		return simtypes.RandomAmount(r, sdk.NewInt(1_000_000_000)).AddRaw(1)
+	}
+	return types.TransferLimits{
+		Denom:                 denom,
+		MaxTransferAmount:     randLimit(),
+		MaxAccountDailyAmount: randLimit(),
+		MaxTokenDailyAmount:   randLimit(),
+	}
+}
@@ -0,0 +1,49 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v2/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/v2/x/asset/ft/simulation"
+	"github.com/CoreumFoundation/coreum/v2/x/asset/ft/types"
+)
+
+func TestRandomizedGenState(t *testing.T) {
+	app := simapp.New()
+
+	s := rand.NewSource(1)
+	r := rand.New(s)
+
+	simState := module.SimulationState{
+		AppParams:    make(simtypes.AppParams),
+		Cdc:          app.AppCodec(),
+		Rand:         r,
+		NumBonded:    3,
+		Accounts:     simtypes.RandomAccounts(r, 10),
+		InitialStake: 1000,
+		GenState:     make(map[string]json.RawMessage),
+		GenTimestamp: time.Now().UTC(),
+	}
+
+	simulation.RandomizedGenState(&simState)
+	var ftGenesis types.GenesisState
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &ftGenesis)
+	require.NoError(t, ftGenesis.Validate())
+	require.NotEmpty(t, ftGenesis.Tokens)
+
+	var bankGenesis banktypes.GenesisState
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[banktypes.ModuleName], &bankGenesis)
+	require.NoError(t, bankGenesis.Validate())
+	require.Len(t, bankGenesis.DenomMetadata, len(ftGenesis.Tokens))
+	for i, token := range ftGenesis.Tokens {
+		require.Equal(t, token.Denom, bankGenesis.DenomMetadata[i].Base)
+	}
+}
@@ -0,0 +1,1089 @@
+package simulation
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/samber/lo"
+
+	"github.com/CoreumFoundation/coreum/v2/x/asset/ft/keeper"
+	"github.com/CoreumFoundation/coreum/v2/x/asset/ft/types"
+)
+
+// Simulation operation weights constants.
+//
+//nolint:gosec // these are not hardcoded credentials
+const (
+	OpWeightMsgIssue                = "op_weight_msg_issue"
+	OpWeightMsgMint                 = "op_weight_msg_mint"
+	OpWeightMsgBurn                 = "op_weight_msg_burn"
+	OpWeightMsgFreeze               = "op_weight_msg_freeze"
+	OpWeightMsgUnfreeze             = "op_weight_msg_unfreeze"
+	OpWeightMsgGloballyFreeze       = "op_weight_msg_globally_freeze"
+	OpWeightMsgGloballyUnfreeze     = "op_weight_msg_globally_unfreeze"
+	OpWeightMsgSetWhitelistedLimit  = "op_weight_msg_set_whitelisted_limit"
+	OpWeightMsgUpgradeTokenV1       = "op_weight_msg_upgrade_token_v1"
+	OpWeightMsgTransferAdmin        = "op_weight_msg_transfer_admin"
+	OpWeightMsgClearAdmin           = "op_weight_msg_clear_admin"
+	OpWeightMsgGrantRole            = "op_weight_msg_grant_role"
+	OpWeightMsgRevokeRole           = "op_weight_msg_revoke_role"
+	OpWeightMsgUpdateMetadata       = "op_weight_msg_update_metadata"
+	OpWeightMsgClawback             = "op_weight_msg_clawback"
+	OpWeightMsgScheduleMint         = "op_weight_msg_schedule_mint"
+	OpWeightMsgScheduleUnfreeze     = "op_weight_msg_schedule_unfreeze"
+	OpWeightMsgCancelScheduled      = "op_weight_msg_cancel_scheduled_action"
+	OpWeightMsgAddRateExemption     = "op_weight_msg_add_rate_exemption"
+	OpWeightMsgRemoveRateExemption  = "op_weight_msg_remove_rate_exemption"
+	OpWeightMsgUpdateRateRecipients = "op_weight_msg_update_rate_recipients"
+	OpWeightMsgSetTransferLimits    = "op_weight_msg_set_transfer_limits"
+	OpWeightMsgLockSupply           = "op_weight_msg_lock_supply"
+)
+
+// Default asset ft operations weights.
+const (
+	WeightIssue                = 30
+	WeightMint                 = 50
+	WeightBurn                 = 50
+	WeightFreeze               = 30
+	WeightUnfreeze             = 20
+	WeightGloballyFreeze       = 10
+	WeightGloballyUnfreeze     = 10
+	WeightSetWhitelistedLimit  = 30
+	WeightUpgradeTokenV1       = 5
+	WeightTransferAdmin        = 10
+	WeightClearAdmin           = 2
+	WeightGrantRole            = 20
+	WeightRevokeRole           = 10
+	WeightUpdateMetadata       = 10
+	WeightClawback             = 10
+	WeightScheduleMint         = 10
+	WeightScheduleUnfreeze     = 10
+	WeightCancelScheduled      = 5
+	WeightAddRateExemption     = 10
+	WeightRemoveRateExemption  = 5
+	WeightUpdateRateRecipients = 5
+	WeightSetTransferLimits    = 5
+	WeightLockSupply           = 2
+)
+
+// maxSimAmount is the upper bound of the amounts used by the operations.
+var maxSimAmount = sdk.NewInt(1_000_000_000_000)
+
+// WeightedOperations returns all the operations from the module with their respective weights.
+func WeightedOperations(
+	appParams simtypes.AppParams,
+	cdc codec.JSONCodec,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simulation.WeightedOperations {
+	weight := func(key string, defaultWeight int) int {
+		var w int
+		appParams.GetOrGenerate(cdc, key, &w, nil, func(_ *rand.Rand) { w = defaultWeight })
+		return w
+	}
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weight(OpWeightMsgIssue, WeightIssue), SimulateMsgIssue(ak, bk, k)),
+		simulation.NewWeightedOperation(weight(OpWeightMsgMint, WeightMint), SimulateMsgMint(ak, bk, k)),
+		simulation.NewWeightedOperation(weight(OpWeightMsgBurn, WeightBurn), SimulateMsgBurn(ak, bk, k)),
+		simulation.NewWeightedOperation(weight(OpWeightMsgFreeze, WeightFreeze), SimulateMsgFreeze(ak, bk, k)),
+		simulation.NewWeightedOperation(weight(OpWeightMsgUnfreeze, WeightUnfreeze), SimulateMsgUnfreeze(ak, bk, k)),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgGloballyFreeze, WeightGloballyFreeze),
+			SimulateMsgGloballyFreeze(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgGloballyUnfreeze, WeightGloballyUnfreeze),
+			SimulateMsgGloballyUnfreeze(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgSetWhitelistedLimit, WeightSetWhitelistedLimit),
+			SimulateMsgSetWhitelistedLimit(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgUpgradeTokenV1, WeightUpgradeTokenV1),
+			SimulateMsgUpgradeTokenV1(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgTransferAdmin, WeightTransferAdmin),
+			SimulateMsgTransferAdmin(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(weight(OpWeightMsgClearAdmin, WeightClearAdmin), SimulateMsgClearAdmin(ak, bk, k)),
+		simulation.NewWeightedOperation(weight(OpWeightMsgGrantRole, WeightGrantRole), SimulateMsgGrantRole(ak, bk, k)),
+		simulation.NewWeightedOperation(weight(OpWeightMsgRevokeRole, WeightRevokeRole), SimulateMsgRevokeRole(ak, bk, k)),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgUpdateMetadata, WeightUpdateMetadata),
+			SimulateMsgUpdateMetadata(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(weight(OpWeightMsgClawback, WeightClawback), SimulateMsgClawback(ak, bk, k)),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgScheduleMint, WeightScheduleMint),
+			SimulateMsgScheduleMint(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgScheduleUnfreeze, WeightScheduleUnfreeze),
+			SimulateMsgScheduleUnfreeze(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgCancelScheduled, WeightCancelScheduled),
+			SimulateMsgCancelScheduledAction(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgAddRateExemption, WeightAddRateExemption),
+			SimulateMsgAddRateExemption(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgRemoveRateExemption, WeightRemoveRateExemption),
+			SimulateMsgRemoveRateExemption(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgUpdateRateRecipients, WeightUpdateRateRecipients),
+			SimulateMsgUpdateRateRecipients(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgSetTransferLimits, WeightSetTransferLimits),
+			SimulateMsgSetTransferLimits(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(weight(OpWeightMsgLockSupply, WeightLockSupply), SimulateMsgLockSupply(ak, bk, k)),
+	}
+}
+
+// SimulateMsgIssue generates a MsgIssue with random values.
+func SimulateMsgIssue(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		issuer, _ := simtypes.RandomAcc(r, accs)
+
+		msg := &types.MsgIssue{
+			Issuer:             issuer.Address.String(),
+			Symbol:             randSymbol(r),
+			Subunit:            randSubunit(r),
+			Precision:          uint32(simtypes.RandIntBetween(r, 1, types.MaxPrecision+1)),
+			InitialAmount:      sdk.ZeroInt(),
+			Description:        simtypes.RandStringOfLength(r, simtypes.RandIntBetween(r, 0, 50)),
+			Features:           randFeatures(r),
+			BurnRate:           randRate(r),
+			SendCommissionRate: randRate(r),
+			URI:                randURI(r),
+			URIHash:            randURIHash(r),
+		}
+		if r.Intn(3) == 0 {
+			msg.SendCommissionRecipients = randRateRecipients(r, accs)
+		}
+		if r.Intn(3) == 0 {
+			msg.BurnRateRecipients = randRateRecipients(r, accs)
+		}
+		if isTransferable(msg.Features, msg.BurnRate, msg.SendCommissionRate) {
+			msg.InitialAmount = simtypes.RandomAmount(r, maxSimAmount)
+		}
+		if r.Intn(3) == 0 {
+			msg.MaxSupply = msg.InitialAmount.Add(simtypes.RandomAmount(r, maxSimAmount))
+		}
+
+		return deliver(r, app, ctx, ak, bk, issuer, msg, types.TypeMsgIssue, sdk.NewCoins(k.GetParams(ctx).IssueFee))
+	}
+}
+
+// SimulateMsgMint generates a MsgMint with random values.
+func SimulateMsgMint(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		def, found := randDefinition(ctx, r, k, func(def types.Definition) bool {
+			return def.IsFeatureEnabled(types.Feature_minting) && isTransferableDefinition(def)
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgMint, "no mintable token found"), nil, nil
+		}
+
+		grant, sender, found := randPrivilegedAccount(ctx, r, k, accs, def, types.Role_minter)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgMint, "no minter found"), nil, nil
+		}
+
+		maxAmount := maxSimAmount
+		if grant != nil && grant.IsMintCapped() {
+			maxAmount = grant.MintCap.Sub(grant.Minted)
+		}
+		remaining, limited, err := k.GetRemainingMintableAmount(ctx, def.Denom)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgMint, err.Error()), nil, err
+		}
+		if limited && remaining.LT(maxAmount) {
+			maxAmount = remaining
+		}
+		amount := simtypes.RandomAmount(r, maxAmount)
+		if !amount.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgMint, "mint amount is zero"), nil, nil
+		}
+
+		msg := &types.MsgMint{
+			Sender: sender.Address.String(),
+			Coin:   sdk.NewCoin(def.Denom, amount),
+		}
+
+		return deliver(r, app, ctx, ak, bk, sender, msg, types.TypeMsgMint, nil)
+	}
+}
+
+// SimulateMsgBurn generates a MsgBurn with random values.
+func SimulateMsgBurn(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		// the accounts are checked in random order to find the first one holding the burnable coins
+		var (
+			sender   simtypes.Account
+			burnable sdk.Coins
+		)
+		for _, i := range r.Perm(len(accs)) {
+			sender = accs[i]
+			burnable = burnableCoins(ctx, k, bk, sender.Address)
+			if len(burnable) > 0 {
+				break
+			}
+		}
+		if len(burnable) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBurn, "no burnable coins found"), nil, nil
+		}
+
+		coin := burnable[r.Intn(len(burnable))]
+		amount := simtypes.RandomAmount(r, coin.Amount)
+		if !amount.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBurn, "burn amount is zero"), nil, nil
+		}
+
+		msg := &types.MsgBurn{
+			Sender: sender.Address.String(),
+			Coin:   sdk.NewCoin(coin.Denom, amount),
+		}
+
+		return deliver(r, app, ctx, ak, bk, sender, msg, types.TypeMsgBurn, sdk.NewCoins(msg.Coin))
+	}
+}
+
+// SimulateMsgFreeze generates a MsgFreeze with random values.
+func SimulateMsgFreeze(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		def, found := randDefinition(ctx, r, k, func(def types.Definition) bool {
+			return def.IsFeatureEnabled(types.Feature_freezing)
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgFreeze, "no freezable token found"), nil, nil
+		}
+
+		_, sender, found := randPrivilegedAccount(ctx, r, k, accs, def, types.Role_freezer)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgFreeze, "no freezer found"), nil, nil
+		}
+
+		account, found := randRegularAccount(r, accs, def)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgFreeze, "no account to freeze found"), nil, nil
+		}
+
+		msg := &types.MsgFreeze{
+			Sender:  sender.Address.String(),
+			Account: account.Address.String(),
+			Coin:    sdk.NewCoin(def.Denom, simtypes.RandomAmount(r, maxSimAmount).AddRaw(1)),
+		}
+
+		return deliver(r, app, ctx, ak, bk, sender, msg, types.TypeMsgFreeze, nil)
+	}
+}
+
+// SimulateMsgUnfreeze generates a MsgUnfreeze with random values.
+func SimulateMsgUnfreeze(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		type frozenBalance struct {
+			account sdk.AccAddress
+			coin    sdk.Coin
+		}
+		frozenBalances := make([]frozenBalance, 0)
+		if err := k.IterateAccountsFrozenBalances(ctx, func(account sdk.AccAddress, coin sdk.Coin) bool {
+			frozenBalances = append(frozenBalances, frozenBalance{account: account, coin: coin})
+			return false
+		}); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUnfreeze, err.Error()), nil, err
+		}
+		if len(frozenBalances) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUnfreeze, "no frozen balances found"), nil, nil
+		}
+
+		balance := frozenBalances[r.Intn(len(frozenBalances))]
+		def, err := k.GetDefinition(ctx, balance.coin.Denom)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUnfreeze, err.Error()), nil, err
+		}
+
+		_, sender, found := randPrivilegedAccount(ctx, r, k, accs, def, types.Role_freezer)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUnfreeze, "no freezer found"), nil, nil
+		}
+
+		amount := simtypes.RandomAmount(r, balance.coin.Amount)
+		if !amount.IsPositive() {
+			amount = balance.coin.Amount
+		}
+
+		msg := &types.MsgUnfreeze{
+			Sender:  sender.Address.String(),
+			Account: balance.account.String(),
+			Coin:    sdk.NewCoin(def.Denom, amount),
+		}
+
+		return deliver(r, app, ctx, ak, bk, sender, msg, types.TypeMsgUnfreeze, nil)
+	}
+}
+
+// SimulateMsgGloballyFreeze generates a MsgGloballyFreeze with random values.
+func SimulateMsgGloballyFreeze(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return simulateGlobalFreezing(ak, bk, k, true)
+}
+
+// SimulateMsgGloballyUnfreeze generates a MsgGloballyUnfreeze with random values.
+func SimulateMsgGloballyUnfreeze(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return simulateGlobalFreezing(ak, bk, k, false)
+}
+
+func simulateGlobalFreezing(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	freeze bool,
+) simtypes.Operation {
+	msgType := types.TypeMsgGloballyUnfreeze
+	if freeze {
+		msgType = types.TypeMsgGloballyFreeze
+	}
+
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		def, found := randDefinition(ctx, r, k, func(def types.Definition) bool {
+			if !def.IsFeatureEnabled(types.Feature_freezing) {
+				return false
+			}
+			token, err := k.GetToken(ctx, def.Denom)
+			return err == nil && token.GloballyFrozen != freeze
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no token to change the global freezing found"), nil, nil
+		}
+
+		_, sender, found := randPrivilegedAccount(ctx, r, k, accs, def, types.Role_freezer)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no freezer found"), nil, nil
+		}
+
+		var msg sdk.Msg = &types.MsgGloballyUnfreeze{
+			Sender: sender.Address.String(),
+			Denom:  def.Denom,
+		}
+		if freeze {
+			msg = &types.MsgGloballyFreeze{
+				Sender: sender.Address.String(),
+				Denom:  def.Denom,
+			}
+		}
+
+		return deliver(r, app, ctx, ak, bk, sender, msg, msgType, nil)
+	}
+}
+
+// SimulateMsgSetWhitelistedLimit generates a MsgSetWhitelistedLimit with random values.
+func SimulateMsgSetWhitelistedLimit(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		def, found := randDefinition(ctx, r, k, func(def types.Definition) bool {
+			return def.IsFeatureEnabled(types.Feature_whitelisting)
+		})
+		if !found {
+			return simtypes.NoOpMsg(
+				types.ModuleName, types.TypeMsgSetWhitelistedLimit, "no whitelistable token found",
+			), nil, nil
+		}
+
+		_, sender, found := randPrivilegedAccount(ctx, r, k, accs, def, types.Role_whitelister)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSetWhitelistedLimit, "no whitelister found"), nil, nil
+		}
+
+		account, found := randRegularAccount(r, accs, def)
+		if !found {
+			return simtypes.NoOpMsg(
+				types.ModuleName, types.TypeMsgSetWhitelistedLimit, "no account to whitelist found",
+			), nil, nil
+		}
+
+		msg := &types.MsgSetWhitelistedLimit{
+			Sender:  sender.Address.String(),
+			Account: account.Address.String(),
+			Coin:    sdk.NewCoin(def.Denom, simtypes.RandomAmount(r, maxSimAmount)),
+		}
+
+		return deliver(r, app, ctx, ak, bk, sender, msg, types.TypeMsgSetWhitelistedLimit, nil)
+	}
+}
+
+// SimulateMsgUpgradeTokenV1 generates a MsgUpgradeTokenV1 with random values.
+func SimulateMsgUpgradeTokenV1(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		if ctx.BlockTime().After(k.GetParams(ctx).TokenUpgradeDecisionTimeout) {
+			return simtypes.NoOpMsg(
+				types.ModuleName, types.TypeMsgUpgradeTokenV1, "token upgrade decision timeout passed",
+			), nil, nil
+		}
+
+		def, found := randDefinition(ctx, r, k, func(def types.Definition) bool {
+			return def.Admin != "" && def.Version < 1 && k.GetTokenUpgradeStatuses(ctx, def.Denom).V1 == nil
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUpgradeTokenV1, "no token to upgrade found"), nil, nil
+		}
+
+		admin, found := findAdmin(accs, def)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUpgradeTokenV1, "admin not found"), nil, nil
+		}
+
+		msg := &types.MsgUpgradeTokenV1{
+			Sender:     admin.Address.String(),
+			Denom:      def.Denom,
+			IbcEnabled: r.Intn(2) == 0,
+		}
+
+		return deliver(r, app, ctx, ak, bk, admin, msg, types.TypeMsgUpgradeTokenV1, nil)
+	}
+}
+
+// SimulateMsgTransferAdmin generates a MsgTransferAdmin with random values.
+func SimulateMsgTransferAdmin(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		def, found := randDefinition(ctx, r, k, func(def types.Definition) bool {
+			return def.Admin != ""
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTransferAdmin, "no token with admin found"), nil, nil
+		}
+
+		admin, found := findAdmin(accs, def)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTransferAdmin, "admin not found"), nil, nil
+		}
+
+		account, _ := simtypes.RandomAcc(r, accs)
+		if account.Address.Equals(admin.Address) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTransferAdmin, "account is already the admin"), nil, nil
+		}
+
+		msg := &types.MsgTransferAdmin{
+			Sender:  admin.Address.String(),
+			Account: account.Address.String(),
+			Denom:   def.Denom,
+		}
+
+		return deliver(r, app, ctx, ak, bk, admin, msg, types.TypeMsgTransferAdmin, nil)
+	}
+}
+
+// SimulateMsgUpdateMetadata generates a MsgUpdateMetadata with random values.
+func SimulateMsgUpdateMetadata(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		def, found := randDefinition(ctx, r, k, func(def types.Definition) bool {
+			return def.Admin != ""
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUpdateMetadata, "no token with admin found"), nil, nil
+		}
+
+		admin, found := findAdmin(accs, def)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUpdateMetadata, "admin not found"), nil, nil
+		}
+
+		msg := &types.MsgUpdateMetadata{
+			Sender:      admin.Address.String(),
+			Denom:       def.Denom,
+			Description: simtypes.RandStringOfLength(r, simtypes.RandIntBetween(r, 0, 50)),
+			URI:         randURI(r),
+			URIHash:     randURIHash(r),
+		}
+
+		return deliver(r, app, ctx, ak, bk, admin, msg, types.TypeMsgUpdateMetadata, nil)
+	}
+}
+
+// SimulateMsgClawback generates a MsgClawback with random values.
+func SimulateMsgClawback(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		def, found := randDefinition(ctx, r, k, func(def types.Definition) bool {
+			return def.IsFeatureEnabled(types.Feature_clawback) && def.Admin != ""
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgClawback, "no token with clawback found"), nil, nil
+		}
+
+		admin, found := findAdmin(accs, def)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgClawback, "admin not found"), nil, nil
+		}
+
+		holders := lo.Filter(accs, func(acc simtypes.Account, _ int) bool {
+			return !def.IsAdmin(acc.Address) && bk.GetBalance(ctx, acc.Address, def.Denom).IsPositive()
+		})
+		if len(holders) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgClawback, "no account to claw back from found"), nil, nil
+		}
+
+		account := holders[r.Intn(len(holders))]
+		balance := bk.GetBalance(ctx, account.Address, def.Denom)
+
+		amount := simtypes.RandomAmount(r, balance.Amount)
+		if amount.IsZero() {
+			amount = balance.Amount
+		}
+
+		msg := &types.MsgClawback{
+			Sender:  admin.Address.String(),
+			Account: account.Address.String(),
+			Coin:    sdk.NewCoin(def.Denom, amount),
+		}
+
+		return deliver(r, app, ctx, ak, bk, admin, msg, types.TypeMsgClawback, nil)
+	}
+}
+
+// SimulateMsgScheduleMint generates a MsgScheduleMint with random values.
+func SimulateMsgScheduleMint(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		def, found := randDefinition(ctx, r, k, func(def types.Definition) bool {
+			return def.IsFeatureEnabled(types.Feature_minting) && isTransferableDefinition(def)
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgScheduleMint, "no mintable token found"), nil, nil
+		}
+
+		_, sender, found := randPrivilegedAccount(ctx, r, k, accs, def, types.Role_minter)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgScheduleMint, "no minter found"), nil, nil
+		}
+
+		msg := &types.MsgScheduleMint{
+			Sender:        sender.Address.String(),
+			Coin:          sdk.NewCoin(def.Denom, simtypes.RandomAmount(r, maxSimAmount).AddRaw(1)),
+			ExecutionTime: randExecutionTime(ctx, r),
+		}
+
+		return deliver(r, app, ctx, ak, bk, sender, msg, types.TypeMsgScheduleMint, nil)
+	}
+}
+
+// SimulateMsgScheduleUnfreeze generates a MsgScheduleUnfreeze with random values.
+func SimulateMsgScheduleUnfreeze(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		type frozenBalance struct {
+			account sdk.AccAddress
+			coin    sdk.Coin
+		}
+		frozenBalances := make([]frozenBalance, 0)
+		if err := k.IterateAccountsFrozenBalances(ctx, func(account sdk.AccAddress, coin sdk.Coin) bool {
+			frozenBalances = append(frozenBalances, frozenBalance{account: account, coin: coin})
+			return false
+		}); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgScheduleUnfreeze, err.Error()), nil, err
+		}
+		if len(frozenBalances) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgScheduleUnfreeze, "no frozen balances found"), nil, nil
+		}
+
+		balance := frozenBalances[r.Intn(len(frozenBalances))]
+		def, err := k.GetDefinition(ctx, balance.coin.Denom)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgScheduleUnfreeze, err.Error()), nil, err
+		}
+
+		_, sender, found := randPrivilegedAccount(ctx, r, k, accs, def, types.Role_freezer)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgScheduleUnfreeze, "no freezer found"), nil, nil
+		}
+
+		amount := simtypes.RandomAmount(r, balance.coin.Amount)
+		if !amount.IsPositive() {
+			amount = balance.coin.Amount
+		}
+
+		msg := &types.MsgScheduleUnfreeze{
+			Sender:        sender.Address.String(),
+			Account:       balance.account.String(),
+			Coin:          sdk.NewCoin(def.Denom, amount),
+			ExecutionTime: randExecutionTime(ctx, r),
+		}
+
+		return deliver(r, app, ctx, ak, bk, sender, msg, types.TypeMsgScheduleUnfreeze, nil)
+	}
+}
+
+// SimulateMsgCancelScheduledAction generates a MsgCancelScheduledAction with random values.
+func SimulateMsgCancelScheduledAction(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		actions := make([]types.ScheduledAction, 0)
+		if err := k.IterateAllScheduledActions(ctx, func(action types.ScheduledAction) bool {
+			actions = append(actions, action)
+			return false
+		}); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelScheduledAction, err.Error()), nil, err
+		}
+		if len(actions) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelScheduledAction, "no scheduled actions found"), nil, nil
+		}
+
+		action := actions[r.Intn(len(actions))]
+		sender, found := simtypes.FindAccount(accs, sdk.MustAccAddressFromBech32(action.Sender))
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelScheduledAction, "sender not found"), nil, nil
+		}
+
+		msg := &types.MsgCancelScheduledAction{
+			Sender: sender.Address.String(),
+			Id:     action.Id,
+		}
+
+		return deliver(r, app, ctx, ak, bk, sender, msg, types.TypeMsgCancelScheduledAction, nil)
+	}
+}
+
+// SimulateMsgClearAdmin generates a MsgClearAdmin with random values.
+func SimulateMsgClearAdmin(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		def, found := randDefinition(ctx, r, k, func(def types.Definition) bool {
+			return def.Admin != ""
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgClearAdmin, "no token with admin found"), nil, nil
+		}
+
+		admin, found := findAdmin(accs, def)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgClearAdmin, "admin not found"), nil, nil
+		}
+
+		msg := &types.MsgClearAdmin{
+			Sender: admin.Address.String(),
+			Denom:  def.Denom,
+		}
+
+		return deliver(r, app, ctx, ak, bk, admin, msg, types.TypeMsgClearAdmin, nil)
+	}
+}
+
+// SimulateMsgGrantRole generates a MsgGrantRole with random values.
+func SimulateMsgGrantRole(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		role := []types.Role{types.Role_minter, types.Role_freezer, types.Role_whitelister}[r.Intn(3)]
+		def, found := randDefinition(ctx, r, k, func(def types.Definition) bool {
+			return def.Admin != "" && def.IsFeatureEnabled(role.Feature())
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGrantRole, "no token supporting the role found"), nil, nil
+		}
+
+		admin, found := findAdmin(accs, def)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGrantRole, "admin not found"), nil, nil
+		}
+
+		account, _ := simtypes.RandomAcc(r, accs)
+		if account.Address.Equals(admin.Address) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGrantRole, "role can't be granted to the admin"), nil, nil
+		}
+
+		msg := &types.MsgGrantRole{
+			Sender:  admin.Address.String(),
+			Account: account.Address.String(),
+			Denom:   def.Denom,
+			Role:    role,
+			MintCap: randMintCap(r, role),
+		}
+
+		return deliver(r, app, ctx, ak, bk, admin, msg, types.TypeMsgGrantRole, nil)
+	}
+}
+
+// SimulateMsgRevokeRole generates a MsgRevokeRole with random values.
+func SimulateMsgRevokeRole(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		grants := make([]types.RoleGrant, 0)
+		if err := k.IterateAllRoleGrants(ctx, func(grant types.RoleGrant) bool {
+			grants = append(grants, grant)
+			return false
+		}); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRevokeRole, err.Error()), nil, err
+		}
+		if len(grants) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRevokeRole, "no role grants found"), nil, nil
+		}
+
+		grant := grants[r.Intn(len(grants))]
+		def, err := k.GetDefinition(ctx, grant.Denom)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRevokeRole, err.Error()), nil, err
+		}
+
+		admin, found := findAdmin(accs, def)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRevokeRole, "admin not found"), nil, nil
+		}
+
+		msg := &types.MsgRevokeRole{
+			Sender:  admin.Address.String(),
+			Account: grant.Account,
+			Denom:   grant.Denom,
+			Role:    grant.Role,
+		}
+
+		return deliver(r, app, ctx, ak, bk, admin, msg, types.TypeMsgRevokeRole, nil)
+	}
+}
+
+// SimulateMsgAddRateExemption generates a MsgAddRateExemption with random values.
+func SimulateMsgAddRateExemption(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		def, found := randDefinition(ctx, r, k, func(def types.Definition) bool {
+			return def.Admin != ""
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddRateExemption, "no token having admin found"), nil, nil
+		}
+
+		admin, found := findAdmin(accs, def)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddRateExemption, "admin not found"), nil, nil
+		}
+
+		account, _ := simtypes.RandomAcc(r, accs)
+		if account.Address.Equals(admin.Address) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddRateExemption, "admin can't be exempted"), nil, nil
+		}
+
+		exemptions, _, err := k.GetRateExemptions(ctx, def.Denom, nil)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddRateExemption, err.Error()), nil, err
+		}
+		if lo.ContainsBy(exemptions, func(exemption types.RateExemption) bool {
+			return exemption.Account == account.Address.String()
+		}) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddRateExemption, "account is already exempted"), nil, nil
+		}
+
+		msg := &types.MsgAddRateExemption{
+			Sender:  admin.Address.String(),
+			Account: account.Address.String(),
+			Denom:   def.Denom,
+		}
+
+		return deliver(r, app, ctx, ak, bk, admin, msg, types.TypeMsgAddRateExemption, nil)
+	}
+}
+
+// SimulateMsgRemoveRateExemption generates a MsgRemoveRateExemption with random values.
+func SimulateMsgRemoveRateExemption(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		exemptions := make([]types.RateExemption, 0)
+		if err := k.IterateAllRateExemptions(ctx, func(exemption types.RateExemption) bool {
+			exemptions = append(exemptions, exemption)
+			return false
+		}); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRemoveRateExemption, err.Error()), nil, err
+		}
+		if len(exemptions) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRemoveRateExemption, "no rate exemptions found"), nil, nil
+		}
+
+		exemption := exemptions[r.Intn(len(exemptions))]
+		def, err := k.GetDefinition(ctx, exemption.Denom)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRemoveRateExemption, err.Error()), nil, err
+		}
+
+		admin, found := findAdmin(accs, def)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRemoveRateExemption, "admin not found"), nil, nil
+		}
+
+		msg := &types.MsgRemoveRateExemption{
+			Sender:  admin.Address.String(),
+			Account: exemption.Account,
+			Denom:   exemption.Denom,
+		}
+
+		return deliver(r, app, ctx, ak, bk, admin, msg, types.TypeMsgRemoveRateExemption, nil)
+	}
+}
+
+// SimulateMsgUpdateRateRecipients generates a MsgUpdateRateRecipients with random values.
+func SimulateMsgUpdateRateRecipients(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		def, found := randDefinition(ctx, r, k, func(def types.Definition) bool {
+			return def.Admin != ""
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUpdateRateRecipients, "no token having admin found"), nil, nil
+		}
+
+		admin, found := findAdmin(accs, def)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUpdateRateRecipients, "admin not found"), nil, nil
+		}
+
+		msg := &types.MsgUpdateRateRecipients{
+			Sender: admin.Address.String(),
+			Denom:  def.Denom,
+		}
+		if r.Intn(2) == 0 {
+			msg.SendCommissionRecipients = randRateRecipients(r, accs)
+		}
+		if r.Intn(2) == 0 {
+			msg.BurnRateRecipients = randRateRecipients(r, accs)
+		}
+
+		return deliver(r, app, ctx, ak, bk, admin, msg, types.TypeMsgUpdateRateRecipients, nil)
+	}
+}
+
+// SimulateMsgSetTransferLimits generates a MsgSetTransferLimits with random values.
+func SimulateMsgSetTransferLimits(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		def, found := randDefinition(ctx, r, k, func(def types.Definition) bool {
+			return def.IsFeatureEnabled(types.Feature_transfer_limiting) && def.Admin != ""
+		})
+		if !found {
+			return simtypes.NoOpMsg(
+				types.ModuleName, types.TypeMsgSetTransferLimits, "no token with transfer limiting found",
+			), nil, nil
+		}
+
+		admin, found := findAdmin(accs, def)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSetTransferLimits, "admin not found"), nil, nil
+		}
+
+		limits := randTransferLimits(r, def.Denom)
+		msg := &types.MsgSetTransferLimits{
+			Sender:                admin.Address.String(),
+			Denom:                 def.Denom,
+			MaxTransferAmount:     limits.MaxTransferAmount,
+			MaxAccountDailyAmount: limits.MaxAccountDailyAmount,
+			MaxTokenDailyAmount:   limits.MaxTokenDailyAmount,
+		}
+
+		return deliver(r, app, ctx, ak, bk, admin, msg, types.TypeMsgSetTransferLimits, nil)
+	}
+}
+
+// SimulateMsgLockSupply generates a MsgLockSupply with random values.
+func SimulateMsgLockSupply(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		def, found := randDefinition(ctx, r, k, func(def types.Definition) bool {
+			return !def.SupplyLocked && def.Admin != ""
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgLockSupply, "no token with unlocked supply found"), nil, nil
+		}
+
+		admin, found := findAdmin(accs, def)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgLockSupply, "admin not found"), nil, nil
+		}
+
+		msg := &types.MsgLockSupply{
+			Sender: admin.Address.String(),
+			Denom:  def.Denom,
+		}
+
+		return deliver(r, app, ctx, ak, bk, admin, msg, types.TypeMsgLockSupply, nil)
+	}
+}
+
+func burnableCoins(ctx sdk.Context, k keeper.Keeper, bk types.BankKeeper, account sdk.AccAddress) sdk.Coins {
+	burnable := make(sdk.Coins, 0)
+	for _, coin := range bk.SpendableCoins(ctx, account) {
+		def, err := k.GetDefinition(ctx, coin.Denom)
+		if err != nil || !def.IsFeatureAllowed(account, types.Feature_burning) {
+			continue
+		}
+		burnable = append(burnable, coin)
+	}
+	return burnable
+}
+
+func deliver(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	signer simtypes.Account,
+	msg sdk.Msg,
+	msgType string,
+	coinsSpentInMsg sdk.Coins,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	// the fees are paid in the bond denom only, because the signers might hold the issued tokens which are not
+	// accepted as the fees
+	coins, hasNeg := sdk.NewCoins(
+		sdk.NewCoin(sdk.DefaultBondDenom, bk.SpendableCoins(ctx, signer.Address).AmountOf(sdk.DefaultBondDenom)),
+	).SafeSub(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, coinsSpentInMsg.AmountOf(sdk.DefaultBondDenom))))
+	if hasNeg {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "message doesn't leave room for fees"), nil, nil
+	}
+	fees, err := simtypes.RandomFees(r, ctx, coins)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate fees"), nil, err
+	}
+
+	return simulation.GenAndDeliverTx(simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+		Cdc:             nil,
+		Msg:             msg,
+		MsgType:         msgType,
+		CoinsSpentInMsg: coinsSpentInMsg,
+		Context:         ctx,
+		SimAccount:      signer,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      types.ModuleName,
+	}, fees)
+}
+
+// isTransferable returns true if the token with the provided settings may be sent by anyone without restrictions.
+// Only such tokens get the supply in the simulation, because other modules send random coins without taking
+// the asset ft rules into account.
+func isTransferable(features []types.Feature, burnRate, sendCommissionRate sdk.Dec) bool {
+	return !lo.Contains(features, types.Feature_freezing) &&
+		!lo.Contains(features, types.Feature_whitelisting) &&
+		!lo.Contains(features, types.Feature_transfer_limiting) &&
+		burnRate.IsZero() &&
+		sendCommissionRate.IsZero()
+}
+
+func isTransferableDefinition(def types.Definition) bool {
+	return isTransferable(def.Features, def.BurnRate, def.SendCommissionRate)
+}
+
+func randDefinition(
+	ctx sdk.Context,
+	r *rand.Rand,
+	k keeper.Keeper,
+	filter func(types.Definition) bool,
+) (types.Definition, bool) {
+	definitions := make([]types.Definition, 0)
+	if err := k.IterateAllDefinitions(ctx, func(def types.Definition) (bool, error) {
+		if filter(def) {
+			definitions = append(definitions, def)
+		}
+		return false, nil
+	}); err != nil {
+		panic(err)
+	}
+	if len(definitions) == 0 {
+		return types.Definition{}, false
+	}
+	return definitions[r.Intn(len(definitions))], true
+}
+
+// randPrivilegedAccount returns either the admin of the token or the account having the role granted. The grant is
+// nil if the admin is returned.
+func randPrivilegedAccount(
+	ctx sdk.Context,
+	r *rand.Rand,
+	k keeper.Keeper,
+	accs []simtypes.Account,
+	def types.Definition,
+	role types.Role,
+) (*types.RoleGrant, simtypes.Account, bool) {
+	grants, _, err := k.GetRoleGrants(ctx, def.Denom, nil)
+	if err != nil {
+		panic(err)
+	}
+	grants = lo.Filter(grants, func(grant types.RoleGrant, _ int) bool {
+		return grant.Role == role
+	})
+
+	if def.Admin != "" && (len(grants) == 0 || r.Intn(2) == 0) {
+		admin, found := findAdmin(accs, def)
+		return nil, admin, found
+	}
+	if len(grants) == 0 {
+		return nil, simtypes.Account{}, false
+	}
+
+	grant := grants[r.Intn(len(grants))]
+	account, found := simtypes.FindAccount(accs, sdk.MustAccAddressFromBech32(grant.Account))
+	return &grant, account, found
+}
+
+// randExecutionTime returns the random time in the future, up to one hour after the current block.
+func randExecutionTime(ctx sdk.Context, r *rand.Rand) time.Time {
+	return ctx.BlockTime().Add(time.Second + time.Duration(r.Int63n(int64(time.Hour))))
+}
+
+// randRegularAccount returns the account which is neither the issuer nor the admin of the token.
+func randRegularAccount(r *rand.Rand, accs []simtypes.Account, def types.Definition) (simtypes.Account, bool) {
+	account, _ := simtypes.RandomAcc(r, accs)
+	if account.Address.String() == def.Issuer || def.IsAdmin(account.Address) {
+		return simtypes.Account{}, false
+	}
+	return account, true
+}
+
+func findAdmin(accs []simtypes.Account, def types.Definition) (simtypes.Account, bool) {
+	if def.Admin == "" {
+		return simtypes.Account{}, false
+	}
+	return simtypes.FindAccount(accs, sdk.MustAccAddressFromBech32(def.Admin))
+}
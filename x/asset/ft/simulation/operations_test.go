@@ -0,0 +1,199 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/v2/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/v2/x/asset/ft/simulation"
+	"github.com/CoreumFoundation/coreum/v2/x/asset/ft/types"
+)
+
+type SimTestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+	app *simapp.App
+}
+
+func (suite *SimTestSuite) SetupTest() {
+	app := simapp.New()
+	suite.app = app
+	suite.ctx = app.BaseApp.NewContext(false, tmproto.Header{})
+}
+
+func (suite *SimTestSuite) TestWeightedOperations() {
+	weightedOps := simulation.WeightedOperations(
+		make(simtypes.AppParams),
+		suite.app.AppCodec(),
+		suite.app.AccountKeeper,
+		suite.app.BankKeeper,
+		suite.app.AssetFTKeeper,
+	)
+
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accs := suite.getTestingAccounts(r, 3)
+
+	expected := []struct {
+		weight    int
+		opMsgName string
+	}{
+		{simulation.WeightIssue, types.TypeMsgIssue},
+		{simulation.WeightMint, types.TypeMsgMint},
+		{simulation.WeightBurn, types.TypeMsgBurn},
+		{simulation.WeightFreeze, types.TypeMsgFreeze},
+		{simulation.WeightUnfreeze, types.TypeMsgUnfreeze},
+		{simulation.WeightGloballyFreeze, types.TypeMsgGloballyFreeze},
+		{simulation.WeightGloballyUnfreeze, types.TypeMsgGloballyUnfreeze},
+		{simulation.WeightSetWhitelistedLimit, types.TypeMsgSetWhitelistedLimit},
+		{simulation.WeightUpgradeTokenV1, types.TypeMsgUpgradeTokenV1},
+		{simulation.WeightTransferAdmin, types.TypeMsgTransferAdmin},
+		{simulation.WeightClearAdmin, types.TypeMsgClearAdmin},
+		{simulation.WeightGrantRole, types.TypeMsgGrantRole},
+		{simulation.WeightRevokeRole, types.TypeMsgRevokeRole},
+		{simulation.WeightUpdateMetadata, types.TypeMsgUpdateMetadata},
+		{simulation.WeightClawback, types.TypeMsgClawback},
+		{simulation.WeightScheduleMint, types.TypeMsgScheduleMint},
+		{simulation.WeightScheduleUnfreeze, types.TypeMsgScheduleUnfreeze},
+		{simulation.WeightCancelScheduled, types.TypeMsgCancelScheduledAction},
+		{simulation.WeightAddRateExemption, types.TypeMsgAddRateExemption},
+		{simulation.WeightRemoveRateExemption, types.TypeMsgRemoveRateExemption},
+		{simulation.WeightUpdateRateRecipients, types.TypeMsgUpdateRateRecipients},
+		{simulation.WeightSetTransferLimits, types.TypeMsgSetTransferLimits},
+		{simulation.WeightLockSupply, types.TypeMsgLockSupply},
+	}
+
+	suite.Require().Len(weightedOps, len(expected))
+	for i, w := range weightedOps {
+		operationMsg, _, _ := w.Op()(r, suite.app.BaseApp, suite.ctx, accs, "")
+		// the following checks are very much dependent from the ordering of the output given
+		// by WeightedOperations. if the ordering in WeightedOperations changes some tests
+		// will fail
+		suite.Require().Equal(expected[i].weight, w.Weight(), "weight should be the same")
+		suite.Require().Equal(types.RouterKey, operationMsg.Route, "route should be the same")
+		suite.Require().Equal(expected[i].opMsgName, operationMsg.Name, "operation Msg name should be the same")
+	}
+}
+
+func (suite *SimTestSuite) TestSimulateMsgIssueMintAndBurn() {
+	s := rand.NewSource(5)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 3)
+
+	suite.app.BeginBlock(abci.RequestBeginBlock{
+		Header: tmproto.Header{
+			Height:  suite.app.LastBlockHeight() + 1,
+			AppHash: suite.app.LastCommitID().Hash,
+		},
+	})
+
+	ak, bk, k := suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.AssetFTKeeper
+
+	operationMsg, futureOperations, err := simulation.SimulateMsgIssue(ak, bk, k)(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+	suite.Require().True(operationMsg.OK)
+	suite.Require().Len(futureOperations, 0)
+
+	var issueMsg types.MsgIssue
+	suite.app.LegacyAmino().MustUnmarshalJSON(operationMsg.Msg, &issueMsg)
+	suite.Require().Equal(types.TypeMsgIssue, operationMsg.Name)
+
+	tokens, _, err := k.GetIssuerTokens(suite.ctx, sdk.MustAccAddressFromBech32(issueMsg.Issuer), nil)
+	suite.Require().NoError(err)
+	suite.Require().Len(tokens, 1)
+
+	// freezing and whitelisting are disabled, so the token is always mintable and burnable
+	def, err := k.GetDefinition(suite.ctx, tokens[0].Denom)
+	suite.Require().NoError(err)
+	def.Features = []types.Feature{types.Feature_minting, types.Feature_burning}
+	def.BurnRate = sdk.ZeroDec()
+	def.SendCommissionRate = sdk.ZeroDec()
+	k.SetDefinition(suite.ctx, sdk.MustAccAddressFromBech32(def.Issuer), tokens[0].Subunit, def)
+
+	operationMsg, _, err = simulation.SimulateMsgMint(ak, bk, k)(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+	suite.Require().True(operationMsg.OK)
+	suite.Require().True(bk.GetBalance(suite.ctx, sdk.MustAccAddressFromBech32(def.Admin), def.Denom).IsPositive())
+
+	operationMsg, _, err = simulation.SimulateMsgBurn(ak, bk, k)(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+	suite.Require().True(operationMsg.OK)
+	suite.Require().Equal(types.TypeMsgBurn, operationMsg.Name)
+}
+
+func (suite *SimTestSuite) TestSimulateMsgGrantAndRevokeRole() {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 3)
+
+	ak, bk, k := suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.AssetFTKeeper
+	_, err := k.Issue(suite.ctx, types.IssueSettings{
+		Issuer:        accounts[0].Address,
+		Symbol:        "ABC",
+		Subunit:       "abc",
+		Precision:     6,
+		InitialAmount: sdk.ZeroInt(),
+		Features: []types.Feature{
+			types.Feature_minting,
+			types.Feature_freezing,
+			types.Feature_whitelisting,
+		},
+	})
+	suite.Require().NoError(err)
+
+	suite.app.BeginBlock(abci.RequestBeginBlock{
+		Header: tmproto.Header{
+			Height:  suite.app.LastBlockHeight() + 1,
+			AppHash: suite.app.LastCommitID().Hash,
+		},
+	})
+
+	var granted bool
+	for i := 0; i < 10 && !granted; i++ {
+		operationMsg, _, err := simulation.SimulateMsgGrantRole(ak, bk, k)(r, suite.app.BaseApp, suite.ctx, accounts, "")
+		suite.Require().NoError(err)
+		granted = operationMsg.OK
+	}
+	suite.Require().True(granted)
+
+	operationMsg, _, err := simulation.SimulateMsgRevokeRole(ak, bk, k)(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+	suite.Require().True(operationMsg.OK)
+	suite.Require().Equal(types.TypeMsgRevokeRole, operationMsg.Name)
+}
+
+func TestSimTestSuite(t *testing.T) {
+	suite.Run(t, new(SimTestSuite))
+}
+
+func (suite *SimTestSuite) getTestingAccounts(r *rand.Rand, n int) []simtypes.Account {
+	accounts := simtypes.RandomAccounts(r, n)
+
+	initAmt := suite.app.StakingKeeper.TokensFromConsensusPower(suite.ctx, 200000)
+	initCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initAmt))
+
+	for _, account := range accounts {
+		acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, account.Address)
+		suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+		suite.Require().NoError(fundAccount(suite.app.BankKeeper, suite.ctx, account.Address, initCoins))
+	}
+
+	return accounts
+}
+
+func fundAccount(bankKeeper bankkeeper.Keeper, ctx sdk.Context, addr sdk.AccAddress, amounts sdk.Coins) error {
+	if err := bankKeeper.MintCoins(ctx, minttypes.ModuleName, amounts); err != nil {
+		return err
+	}
+
+	return bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr, amounts)
+}
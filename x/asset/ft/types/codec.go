@@ -19,9 +19,26 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgGloballyUnfreeze{},
 		&MsgSetWhitelistedLimit{},
 		&MsgUpgradeTokenV1{},
+		&MsgTransferAdmin{},
+		&MsgClearAdmin{},
+		&MsgGrantRole{},
+		&MsgRevokeRole{},
+		&MsgUpdateMetadata{},
+		&MsgClawback{},
+		&MsgScheduleMint{},
+		&MsgScheduleUnfreeze{},
+		&MsgCancelScheduledAction{},
+		&MsgRetryScheduledAction{},
+		&MsgAddRateExemption{},
+		&MsgRemoveRateExemption{},
+		&MsgUpdateRateRecipients{},
+		&MsgSetTransferLimits{},
+		&MsgLockSupply{},
 	)
 	registry.RegisterImplementations((*codec.ProtoMarshaler)(nil),
 		&DelayedTokenUpgradeV1{},
+		&DelayedMint{},
+		&DelayedUnfreeze{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -21,4 +21,12 @@ var (
 	ErrWhitelistedLimitExceeded = sdkerrors.Register(ModuleName, 7, "whitelisted limit exceeded")
 	// ErrInvalidState is returned when state of the module is invalid.
 	ErrInvalidState = sdkerrors.Register(ModuleName, 8, "invalid state")
+	// ErrMintCapExceeded is returned when the minter tries to mint more than its mint cap allows.
+	ErrMintCapExceeded = sdkerrors.Register(ModuleName, 9, "mint cap exceeded")
+	// ErrTransferLimitExceeded is returned when the sent amount exceeds the transfer limits of the token.
+	ErrTransferLimitExceeded = sdkerrors.Register(ModuleName, 10, "transfer limit exceeded")
+	// ErrMaxSupplyExceeded is returned when the minted amount exceeds the max supply of the token.
+	ErrMaxSupplyExceeded = sdkerrors.Register(ModuleName, 11, "max supply exceeded")
+	// ErrSupplyLocked is returned when the tokens are minted after the supply has been locked.
+	ErrSupplyLocked = sdkerrors.Register(ModuleName, 12, "supply locked")
 )
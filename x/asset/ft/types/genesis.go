@@ -1,5 +1,10 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
 // DefaultGenesis returns the default Token genesis state.
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
@@ -27,6 +32,67 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	for _, grant := range gs.RoleGrants {
+		if err := grant.Validate(); err != nil {
+			return err
+		}
+	}
+
+	rateExemptions := map[string]struct{}{}
+	for _, exemption := range gs.RateExemptions {
+		if err := exemption.Validate(); err != nil {
+			return err
+		}
+		key := exemption.Denom + "/" + exemption.Account
+		if _, exists := rateExemptions[key]; exists {
+			return sdkerrors.Wrapf(
+				ErrInvalidInput, "duplicated rate exemption of account %s for denom %s", exemption.Account, exemption.Denom,
+			)
+		}
+		rateExemptions[key] = struct{}{}
+	}
+
+	transferLimits := map[string]struct{}{}
+	for _, limits := range gs.TransferLimits {
+		if err := limits.Validate(); err != nil {
+			return err
+		}
+		if _, exists := transferLimits[limits.Denom]; exists {
+			return sdkerrors.Wrapf(ErrInvalidInput, "duplicated transfer limits for denom %s", limits.Denom)
+		}
+		transferLimits[limits.Denom] = struct{}{}
+	}
+
+	transferVolumes := map[string]struct{}{}
+	for _, volume := range gs.TransferVolumes {
+		if err := volume.Validate(); err != nil {
+			return err
+		}
+		key := volume.Denom + "/" + volume.Account
+		if _, exists := transferVolumes[key]; exists {
+			return sdkerrors.Wrapf(
+				ErrInvalidInput, "duplicated transfer volume of account %q for denom %s", volume.Account, volume.Denom,
+			)
+		}
+		transferVolumes[key] = struct{}{}
+	}
+
+	scheduledActionIDs := map[uint64]struct{}{}
+	for _, action := range gs.ScheduledActions {
+		if err := action.Validate(); err != nil {
+			return err
+		}
+		if action.Id >= gs.NextScheduledActionId {
+			return sdkerrors.Wrapf(
+				ErrInvalidInput, "scheduled action id %d must be lower than the next id %d", action.Id, gs.NextScheduledActionId,
+			)
+		}
+		if _, exists := scheduledActionIDs[action.Id]; exists {
+			return sdkerrors.Wrapf(ErrInvalidInput, "duplicated scheduled action id %d", action.Id)
+		}
+		scheduledActionIDs[action.Id] = struct{}{}
+	}
+
 	return gs.Params.ValidateBasic()
 }
 
@@ -49,9 +115,31 @@ func (token Token) Validate() error {
 		return err
 	}
 
+	if err := ValidateMetadata(token.Description, token.URI, token.URIHash); err != nil {
+		return err
+	}
+
+	if token.Admin != "" {
+		if _, err := sdk.AccAddressFromBech32(token.Admin); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidInput, "invalid admin address %s", token.Admin)
+		}
+	}
+
 	if err := ValidateSendCommissionRate(token.SendCommissionRate); err != nil {
 		return err
 	}
 
-	return ValidateBurnRate(token.BurnRate)
+	if err := ValidateBurnRate(token.BurnRate); err != nil {
+		return err
+	}
+
+	if err := ValidateSendCommissionRecipients(token.SendCommissionRecipients); err != nil {
+		return err
+	}
+
+	if err := ValidateBurnRateRecipients(token.BurnRateRecipients); err != nil {
+		return err
+	}
+
+	return ValidateMaxSupply(token.MaxSupply, sdk.ZeroInt())
 }
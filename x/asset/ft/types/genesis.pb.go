@@ -37,6 +37,18 @@ type GenesisState struct {
 	WhitelistedBalances []Balance `protobuf:"bytes,4,rep,name=whitelisted_balances,json=whitelistedBalances,proto3" json:"whitelisted_balances"`
 	// pending_token_upgrades contains pending token upgrades.
 	PendingTokenUpgrades []PendingTokenUpgrade `protobuf:"bytes,5,rep,name=pending_token_upgrades,json=pendingTokenUpgrades,proto3" json:"pending_token_upgrades"`
+	// role_grants contains the roles granted to the accounts.
+	RoleGrants []RoleGrant `protobuf:"bytes,6,rep,name=role_grants,json=roleGrants,proto3" json:"role_grants"`
+	// scheduled_actions contains the pending scheduled actions.
+	ScheduledActions []ScheduledAction `protobuf:"bytes,7,rep,name=scheduled_actions,json=scheduledActions,proto3" json:"scheduled_actions"`
+	// next_scheduled_action_id is the id assigned to the next scheduled action.
+	NextScheduledActionId uint64 `protobuf:"varint,8,opt,name=next_scheduled_action_id,json=nextScheduledActionId,proto3" json:"next_scheduled_action_id,omitempty"`
+	// rate_exemptions contains the accounts exempted from the burn rate and the send commission of the tokens.
+	RateExemptions []RateExemption `protobuf:"bytes,9,rep,name=rate_exemptions,json=rateExemptions,proto3" json:"rate_exemptions"`
+	// transfer_limits contains the limits of the amounts of the tokens sent by the accounts.
+	TransferLimits []TransferLimits `protobuf:"bytes,10,rep,name=transfer_limits,json=transferLimits,proto3" json:"transfer_limits"`
+	// transfer_volumes contains the amounts of the tokens sent within the rolling window.
+	TransferVolumes []TransferVolume `protobuf:"bytes,11,rep,name=transfer_volumes,json=transferVolumes,proto3" json:"transfer_volumes"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -107,6 +119,48 @@ func (m *GenesisState) GetPendingTokenUpgrades() []PendingTokenUpgrade {
 	return nil
 }
 
+func (m *GenesisState) GetRoleGrants() []RoleGrant {
+	if m != nil {
+		return m.RoleGrants
+	}
+	return nil
+}
+
+func (m *GenesisState) GetScheduledActions() []ScheduledAction {
+	if m != nil {
+		return m.ScheduledActions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextScheduledActionId() uint64 {
+	if m != nil {
+		return m.NextScheduledActionId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetRateExemptions() []RateExemption {
+	if m != nil {
+		return m.RateExemptions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTransferLimits() []TransferLimits {
+	if m != nil {
+		return m.TransferLimits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTransferVolumes() []TransferVolume {
+	if m != nil {
+		return m.TransferVolumes
+	}
+	return nil
+}
+
 // Balance defines an account address and balance pair used module genesis genesis state.
 type Balance struct {
 	// address is the address of the balance holder.
@@ -224,37 +278,47 @@ func init() {
 func init() { proto.RegisterFile("coreum/asset/ft/v1/genesis.proto", fileDescriptor_d281657d6c91cb92) }
 
 var fileDescriptor_d281657d6c91cb92 = []byte{
-	// 465 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x4d, 0x6f, 0xd3, 0x30,
-	0x18, 0x6e, 0xd6, 0xb5, 0x13, 0x1e, 0x1f, 0x92, 0x57, 0xa1, 0x50, 0xa4, 0xb4, 0xea, 0x85, 0x5e,
-	0xb0, 0x69, 0x91, 0x80, 0x73, 0x27, 0x40, 0xe2, 0x34, 0x95, 0x71, 0xe1, 0x52, 0xb9, 0xc9, 0xdb,
-	0xcc, 0xda, 0x62, 0x47, 0x79, 0xdd, 0xf0, 0xf1, 0x03, 0x10, 0x47, 0x7e, 0x07, 0xbf, 0x64, 0xc7,
-	0x1d, 0x39, 0x01, 0x6a, 0xff, 0x08, 0x8a, 0xed, 0xd2, 0x4a, 0xcd, 0x61, 0xa7, 0xc4, 0x79, 0x9f,
-	0xaf, 0xbc, 0x7e, 0x48, 0x3f, 0xd6, 0x05, 0x2c, 0x33, 0x2e, 0x10, 0xc1, 0xf0, 0x85, 0xe1, 0xe5,
-	0x88, 0xa7, 0xa0, 0x00, 0x25, 0xb2, 0xbc, 0xd0, 0x46, 0x53, 0xea, 0x10, 0xcc, 0x22, 0xd8, 0xc2,
-	0xb0, 0x72, 0xd4, 0xed, 0xa4, 0x3a, 0xd5, 0x76, 0xcc, 0xab, 0x37, 0x87, 0xec, 0x46, 0xb1, 0xc6,
-	0x4c, 0x23, 0x9f, 0x0b, 0x04, 0x5e, 0x8e, 0xe6, 0x60, 0xc4, 0x88, 0xc7, 0x5a, 0xaa, 0xed, 0x7c,
-	0xcf, 0xcb, 0xe8, 0x4b, 0xd8, 0xcc, 0x7b, 0x35, 0xf3, 0x5c, 0x14, 0x22, 0xf3, 0x51, 0x06, 0xdf,
-	0x9b, 0xe4, 0xee, 0x5b, 0x17, 0xee, 0xbd, 0x11, 0x06, 0xe8, 0x2b, 0xd2, 0x76, 0x80, 0x30, 0xe8,
-	0x07, 0xc3, 0xe3, 0x71, 0x97, 0xed, 0x87, 0x65, 0x67, 0x16, 0x31, 0x39, 0xbc, 0xfe, 0xdd, 0x6b,
-	0x4c, 0x3d, 0x9e, 0xbe, 0x24, 0x6d, 0x6b, 0x8d, 0xe1, 0x41, 0xbf, 0x39, 0x3c, 0x1e, 0x3f, 0xaa,
-	0x63, 0x9e, 0x57, 0x88, 0x0d, 0xd1, 0xc1, 0xe9, 0x3b, 0xf2, 0x60, 0x51, 0xe8, 0xaf, 0xa0, 0x66,
-	0x73, 0x71, 0x25, 0x54, 0x0c, 0x18, 0x36, 0xad, 0xc2, 0xe3, 0x3a, 0x85, 0x89, 0xc3, 0x78, 0x8d,
-	0xfb, 0x8e, 0xe9, 0x3f, 0x22, 0x3d, 0x27, 0x9d, 0x4f, 0x17, 0xd2, 0xc0, 0x95, 0x44, 0x03, 0xc9,
-	0x56, 0xf0, 0xf0, 0xb6, 0x82, 0x27, 0x3b, 0xf4, 0xff, 0xaa, 0x31, 0x79, 0x98, 0x83, 0x4a, 0xa4,
-	0x4a, 0x67, 0x36, 0xf3, 0x6c, 0x99, 0xa7, 0x85, 0x48, 0x00, 0xc3, 0x96, 0xd5, 0x7d, 0x52, 0xbb,
-	0x24, 0xc7, 0xb0, 0x7f, 0xfc, 0xc1, 0xe1, 0xbd, 0x47, 0x27, 0xdf, 0x1f, 0xe1, 0xe0, 0x5b, 0x40,
-	0x8e, 0xbc, 0x23, 0x0d, 0xc9, 0x91, 0x48, 0x92, 0x02, 0xd0, 0x5d, 0xc3, 0x9d, 0xe9, 0xe6, 0x48,
-	0x05, 0x69, 0x55, 0xf7, 0xbf, 0xbb, 0xe4, 0xaa, 0x21, 0xac, 0x6a, 0x08, 0xf3, 0x0d, 0x61, 0xa7,
-	0x5a, 0xaa, 0xc9, 0xb3, 0xca, 0xeb, 0xe7, 0x9f, 0xde, 0x30, 0x95, 0xe6, 0x62, 0x39, 0x67, 0xb1,
-	0xce, 0xb8, 0xaf, 0x93, 0x7b, 0x3c, 0xc5, 0xe4, 0x92, 0x9b, 0x2f, 0x39, 0xa0, 0x25, 0xe0, 0xd4,
-	0x29, 0x0f, 0x5e, 0x93, 0x93, 0x9a, 0xec, 0xb4, 0x43, 0x5a, 0x09, 0x28, 0x9d, 0xf9, 0x44, 0xee,
-	0x50, 0x25, 0x2d, 0xa1, 0x40, 0xa9, 0x55, 0x78, 0xd0, 0x0f, 0x86, 0xf7, 0xa6, 0x9b, 0xe3, 0xe4,
-	0xec, 0x7a, 0x15, 0x05, 0x37, 0xab, 0x28, 0xf8, 0xbb, 0x8a, 0x82, 0x1f, 0xeb, 0xa8, 0x71, 0xb3,
-	0x8e, 0x1a, 0xbf, 0xd6, 0x51, 0xe3, 0xe3, 0x8b, 0x9d, 0x44, 0xa7, 0x76, 0x71, 0x6f, 0xf4, 0x52,
-	0x25, 0xc2, 0x48, 0xad, 0xb8, 0x6f, 0x6c, 0x39, 0xe6, 0x9f, 0xb7, 0xb5, 0xb5, 0x29, 0xe7, 0x6d,
-	0xdb, 0xd9, 0xe7, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x1f, 0xeb, 0x41, 0x98, 0x62, 0x03, 0x00,
-	0x00,
+	// 636 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcf, 0x72, 0xd3, 0x3a,
+	0x14, 0xc6, 0xe3, 0xfe, 0x49, 0x6f, 0x95, 0x7b, 0x6f, 0x8a, 0x1a, 0x18, 0x53, 0x86, 0x34, 0x84,
+	0x05, 0xd9, 0x60, 0x93, 0x32, 0x03, 0x6c, 0x49, 0x29, 0x1d, 0x18, 0x16, 0x25, 0x2d, 0x5d, 0xb0,
+	0xf1, 0x28, 0xf6, 0x89, 0xeb, 0xa9, 0x2d, 0x79, 0x74, 0x94, 0x50, 0x78, 0x00, 0xd6, 0x3c, 0x07,
+	0x4f, 0xd2, 0x65, 0x97, 0xb0, 0x01, 0xa6, 0x7d, 0x11, 0xc6, 0x92, 0xdc, 0xa6, 0xad, 0x67, 0x60,
+	0x95, 0xe8, 0x9c, 0xef, 0xfc, 0xf4, 0x59, 0xd2, 0x39, 0xa4, 0x13, 0x0a, 0x09, 0x93, 0xcc, 0x67,
+	0x88, 0xa0, 0xfc, 0xb1, 0xf2, 0xa7, 0x7d, 0x3f, 0x06, 0x0e, 0x98, 0xa0, 0x97, 0x4b, 0xa1, 0x04,
+	0xa5, 0x46, 0xe1, 0x69, 0x85, 0x37, 0x56, 0xde, 0xb4, 0xbf, 0xd6, 0x8a, 0x45, 0x2c, 0x74, 0xda,
+	0x2f, 0xfe, 0x19, 0xe5, 0x5a, 0x3b, 0x14, 0x98, 0x09, 0xf4, 0x47, 0x0c, 0xc1, 0x9f, 0xf6, 0x47,
+	0xa0, 0x58, 0xdf, 0x0f, 0x45, 0xc2, 0x2f, 0xf2, 0xd7, 0xf6, 0x52, 0xe2, 0x10, 0xca, 0xfc, 0x7a,
+	0x45, 0x3e, 0x67, 0x92, 0x65, 0xd6, 0x4a, 0xf7, 0x7b, 0x9d, 0xfc, 0xbb, 0x6d, 0xcc, 0xed, 0x2a,
+	0xa6, 0x80, 0x3e, 0x23, 0x75, 0x23, 0x70, 0x9d, 0x8e, 0xd3, 0x6b, 0x6c, 0xac, 0x79, 0xd7, 0xcd,
+	0x7a, 0x3b, 0x5a, 0x31, 0x58, 0x38, 0xfe, 0xb1, 0x5e, 0x1b, 0x5a, 0x3d, 0x7d, 0x4a, 0xea, 0x7a,
+	0x6b, 0x74, 0xe7, 0x3a, 0xf3, 0xbd, 0xc6, 0xc6, 0xed, 0xaa, 0xca, 0xbd, 0x42, 0x51, 0x16, 0x1a,
+	0x39, 0x7d, 0x4d, 0x9a, 0x63, 0x29, 0x3e, 0x01, 0x0f, 0x46, 0x2c, 0x65, 0x3c, 0x04, 0x74, 0xe7,
+	0x35, 0xe1, 0x4e, 0x15, 0x61, 0x60, 0x34, 0x96, 0xf1, 0xbf, 0xa9, 0xb4, 0x41, 0xa4, 0x7b, 0xa4,
+	0xf5, 0xe1, 0x20, 0x51, 0x90, 0x26, 0xa8, 0x20, 0xba, 0x00, 0x2e, 0xfc, 0x2d, 0x70, 0x75, 0xa6,
+	0xfc, 0x9c, 0x1a, 0x92, 0x5b, 0x39, 0xf0, 0x28, 0xe1, 0x71, 0xa0, 0x3d, 0x07, 0x93, 0x3c, 0x96,
+	0x2c, 0x02, 0x74, 0x17, 0x35, 0xf7, 0x41, 0xe5, 0x21, 0x99, 0x0a, 0xfd, 0xc5, 0xef, 0x8c, 0xde,
+	0xee, 0xd1, 0xca, 0xaf, 0xa7, 0x90, 0xbe, 0x20, 0x0d, 0x29, 0x52, 0x08, 0x62, 0xc9, 0xb8, 0x42,
+	0xb7, 0xae, 0xc9, 0x77, 0xab, 0xc8, 0x43, 0x91, 0xc2, 0x76, 0xa1, 0xb2, 0x3c, 0x22, 0xcb, 0x00,
+	0xd2, 0x7d, 0x72, 0x03, 0xc3, 0x03, 0x88, 0x26, 0x29, 0x44, 0x01, 0x0b, 0x55, 0x22, 0x38, 0xba,
+	0x4b, 0x9a, 0x75, 0xbf, 0x8a, 0xb5, 0x5b, 0x8a, 0x9f, 0x6b, 0xad, 0x25, 0xae, 0xe0, 0xe5, 0x70,
+	0x71, 0xbb, 0x2e, 0x87, 0x23, 0x15, 0x5c, 0x85, 0x07, 0x49, 0xe4, 0xfe, 0xd3, 0x71, 0x7a, 0x0b,
+	0xc3, 0x9b, 0x45, 0xfe, 0x0a, 0xee, 0x55, 0x44, 0x77, 0x48, 0x53, 0x32, 0x05, 0x01, 0x1c, 0x41,
+	0x96, 0x1b, 0x3b, 0xcb, 0xda, 0xce, 0xbd, 0xca, 0x4f, 0x63, 0x0a, 0xb6, 0x4a, 0x65, 0x79, 0xc7,
+	0x72, 0x36, 0x88, 0xf4, 0x2d, 0x69, 0x2a, 0xc9, 0x38, 0x8e, 0x41, 0x06, 0x69, 0x92, 0x25, 0x0a,
+	0x5d, 0xa2, 0x89, 0xdd, 0xca, 0x17, 0x67, 0xa5, 0x6f, 0xb4, 0xb2, 0x44, 0xaa, 0x4b, 0x51, 0xba,
+	0x4b, 0x56, 0xce, 0x91, 0x53, 0x91, 0x4e, 0x32, 0x40, 0xb7, 0xf1, 0x67, 0xe6, 0xbe, 0x96, 0x5a,
+	0x66, 0x53, 0x5d, 0x8a, 0x62, 0xf7, 0xb3, 0x43, 0x96, 0xec, 0x13, 0xa2, 0x2e, 0x59, 0x62, 0x51,
+	0x24, 0x01, 0x4d, 0x5f, 0x2d, 0x0f, 0xcb, 0x25, 0x65, 0x64, 0xb1, 0x68, 0xe8, 0xd9, 0xae, 0x29,
+	0x5a, 0xde, 0x2b, 0x5a, 0xde, 0xb3, 0x2d, 0xef, 0x6d, 0x8a, 0x84, 0x0f, 0x1e, 0x15, 0xdb, 0x7c,
+	0xfd, 0xb9, 0xde, 0x8b, 0x13, 0x75, 0x30, 0x19, 0x79, 0xa1, 0xc8, 0x7c, 0x3b, 0x1f, 0xcc, 0xcf,
+	0x43, 0x8c, 0x0e, 0x7d, 0xf5, 0x31, 0x07, 0xd4, 0x05, 0x38, 0x34, 0xe4, 0xee, 0x16, 0x59, 0xad,
+	0x78, 0x8c, 0xb4, 0x45, 0x16, 0x23, 0xe0, 0x22, 0xb3, 0x8e, 0xcc, 0xa2, 0x70, 0x3a, 0x05, 0x89,
+	0x89, 0xe0, 0xee, 0x5c, 0xc7, 0xe9, 0xfd, 0x37, 0x2c, 0x97, 0x83, 0x9d, 0xe3, 0xd3, 0xb6, 0x73,
+	0x72, 0xda, 0x76, 0x7e, 0x9d, 0xb6, 0x9d, 0x2f, 0x67, 0xed, 0xda, 0xc9, 0x59, 0xbb, 0xf6, 0xed,
+	0xac, 0x5d, 0x7b, 0xff, 0x64, 0xc6, 0xd1, 0xa6, 0x3e, 0xae, 0x97, 0x62, 0xc2, 0x23, 0x56, 0xdc,
+	0x97, 0x6f, 0x47, 0xd0, 0x74, 0xc3, 0x3f, 0xba, 0x98, 0x43, 0xda, 0xe5, 0xa8, 0xae, 0x87, 0xd0,
+	0xe3, 0xdf, 0x03, 0x00, 0x75, 0x13, 0x37, 0xf2, 0x33, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -277,6 +341,81 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TransferVolumes) > 0 {
+		for iNdEx := len(m.TransferVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.TransferLimits) > 0 {
+		for iNdEx := len(m.TransferLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.RateExemptions) > 0 {
+		for iNdEx := len(m.RateExemptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateExemptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.NextScheduledActionId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextScheduledActionId))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ScheduledActions) > 0 {
+		for iNdEx := len(m.ScheduledActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.RoleGrants) > 0 {
+		for iNdEx := len(m.RoleGrants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoleGrants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.PendingTokenUpgrades) > 0 {
 		for iNdEx := len(m.PendingTokenUpgrades) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -468,6 +607,39 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.RoleGrants) > 0 {
+		for _, e := range m.RoleGrants {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ScheduledActions) > 0 {
+		for _, e := range m.ScheduledActions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextScheduledActionId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextScheduledActionId))
+	}
+	if len(m.RateExemptions) > 0 {
+		for _, e := range m.RateExemptions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TransferLimits) > 0 {
+		for _, e := range m.TransferLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TransferVolumes) > 0 {
+		for _, e := range m.TransferVolumes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -710,6 +882,195 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleGrants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoleGrants = append(m.RoleGrants, RoleGrant{})
+			if err := m.RoleGrants[len(m.RoleGrants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledActions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledActions = append(m.ScheduledActions, ScheduledAction{})
+			if err := m.ScheduledActions[len(m.ScheduledActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextScheduledActionId", wireType)
+			}
+			m.NextScheduledActionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextScheduledActionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateExemptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateExemptions = append(m.RateExemptions, RateExemption{})
+			if err := m.RateExemptions[len(m.RateExemptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferLimits = append(m.TransferLimits, TransferLimits{})
+			if err := m.TransferLimits[len(m.TransferLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferVolumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferVolumes = append(m.TransferVolumes, TransferVolume{})
+			if err := m.TransferVolumes[len(m.TransferVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
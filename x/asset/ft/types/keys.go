@@ -1,10 +1,12 @@
 package types
 
 import (
+	"encoding/binary"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/CoreumFoundation/coreum/v2/pkg/store"
 )
@@ -39,8 +41,43 @@ var (
 	PendingTokenUpgradeKeyPrefix = []byte{0x06}
 	// TokenUpgradeStatusesKeyPrefix defines the key prefix for the fungible token upgrade statuses.
 	TokenUpgradeStatusesKeyPrefix = []byte{0x07}
+	// AdminTokensKeyPrefix defines the key prefix to index the fungible tokens by their admin.
+	AdminTokensKeyPrefix = []byte{0x08}
+	// RoleGrantsKeyPrefix defines the key prefix for the roles granted to the accounts.
+	RoleGrantsKeyPrefix = []byte{0x09}
+	// ScheduledActionsKeyPrefix defines the key prefix for the scheduled actions.
+	ScheduledActionsKeyPrefix = []byte{0x0a}
+	// AccountScheduledActionsKeyPrefix defines the key prefix to index the scheduled actions by the affected account.
+	AccountScheduledActionsKeyPrefix = []byte{0x0b}
+	// NextScheduledActionIDKey defines the key for the id assigned to the next scheduled action.
+	NextScheduledActionIDKey = []byte{0x0c}
+	// RateExemptionsKeyPrefix defines the key prefix for the accounts exempted from the burn rate and the send commission.
+	RateExemptionsKeyPrefix = []byte{0x0d}
+	// TransferLimitsKeyPrefix defines the key prefix for the transfer limits of the fungible tokens.
+	TransferLimitsKeyPrefix = []byte{0x0e}
+	// AccountTransferVolumesKeyPrefix defines the key prefix for the amounts sent by the accounts.
+	AccountTransferVolumesKeyPrefix = []byte{0x0f}
+	// TokenTransferVolumesKeyPrefix defines the key prefix for the amounts sent by all the accounts.
+	TokenTransferVolumesKeyPrefix = []byte{0x10}
 )
 
+const uint64Length = 8
+
+// Uint64ToBytes encodes uint64 using big endian so the values are sortable lexicographically.
+func Uint64ToBytes(v uint64) []byte {
+	b := make([]byte, uint64Length)
+	binary.BigEndian.PutUint64(b, v)
+	return b
+}
+
+// BytesToUint64 decodes big endian encoded uint64.
+func BytesToUint64(b []byte) (uint64, error) {
+	if len(b) != uint64Length {
+		return 0, sdkerrors.Wrapf(ErrInvalidKey, "expected %d bytes, got %d", uint64Length, len(b))
+	}
+	return binary.BigEndian.Uint64(b), nil
+}
+
 // CreateTokenKey creates the key for the fungible token.
 func CreateTokenKey(issuer sdk.AccAddress, subunit string) []byte {
 	return store.JoinKeys(CreateIssuerTokensPrefix(issuer), []byte(strings.ToLower(subunit)))
@@ -56,6 +93,16 @@ func CreateIssuerTokensPrefix(issuer sdk.AccAddress) []byte {
 	return store.JoinKeys(TokenKeyPrefix, address.MustLengthPrefix(issuer))
 }
 
+// CreateAdminTokensPrefix creates the key prefix for the fungible tokens administered by the account.
+func CreateAdminTokensPrefix(admin sdk.AccAddress) []byte {
+	return store.JoinKeys(AdminTokensKeyPrefix, address.MustLengthPrefix(admin))
+}
+
+// CreateAdminTokenKey creates the key for the fungible token administered by the account.
+func CreateAdminTokenKey(admin sdk.AccAddress, denom string) []byte {
+	return store.JoinKeys(CreateAdminTokensPrefix(admin), []byte(denom))
+}
+
 // CreateSymbolKey creates the key for a ft symbol.
 func CreateSymbolKey(addr []byte, symbol string) []byte {
 	return store.JoinKeys(store.JoinKeys(SymbolKeyPrefix, addr), []byte(symbol))
@@ -81,6 +128,64 @@ func CreateTokenUpgradeStatusesKey(denom string) []byte {
 	return store.JoinKeys(TokenUpgradeStatusesKeyPrefix, []byte(denom))
 }
 
+// CreateRoleGrantsPrefix creates the key prefix for the roles granted for the fungible token.
+func CreateRoleGrantsPrefix(denom string) []byte {
+	return store.JoinKeys(RoleGrantsKeyPrefix, address.MustLengthPrefix([]byte(denom)))
+}
+
+// CreateAccountRoleGrantsPrefix creates the key prefix for the roles of the fungible token granted to the account.
+func CreateAccountRoleGrantsPrefix(denom string, account sdk.AccAddress) []byte {
+	return store.JoinKeys(CreateRoleGrantsPrefix(denom), address.MustLengthPrefix(account))
+}
+
+// CreateRoleGrantKey creates the key for the role of the fungible token granted to the account.
+func CreateRoleGrantKey(denom string, account sdk.AccAddress, role Role) []byte {
+	return store.JoinKeys(CreateAccountRoleGrantsPrefix(denom, account), []byte{byte(role)})
+}
+
+// CreateScheduledActionKey creates the key for the scheduled action.
+func CreateScheduledActionKey(id uint64) []byte {
+	return store.JoinKeys(ScheduledActionsKeyPrefix, Uint64ToBytes(id))
+}
+
+// CreateAccountScheduledActionsPrefix creates the key prefix for the actions scheduled for the account.
+func CreateAccountScheduledActionsPrefix(account sdk.AccAddress) []byte {
+	return store.JoinKeys(AccountScheduledActionsKeyPrefix, address.MustLengthPrefix(account))
+}
+
+// CreateAccountScheduledActionKey creates the key indexing the scheduled action by the account.
+func CreateAccountScheduledActionKey(account sdk.AccAddress, id uint64) []byte {
+	return store.JoinKeys(CreateAccountScheduledActionsPrefix(account), Uint64ToBytes(id))
+}
+
+// CreateRateExemptionsPrefix creates the key prefix for the accounts exempted from the rates of the fungible token.
+func CreateRateExemptionsPrefix(denom string) []byte {
+	return store.JoinKeys(RateExemptionsKeyPrefix, address.MustLengthPrefix([]byte(denom)))
+}
+
+// CreateRateExemptionKey creates the key for the account exempted from the rates of the fungible token.
+func CreateRateExemptionKey(denom string, account sdk.AccAddress) []byte {
+	return store.JoinKeys(CreateRateExemptionsPrefix(denom), address.MustLengthPrefix(account))
+}
+
+// CreateTransferLimitsKey creates the key for the transfer limits of the fungible token.
+func CreateTransferLimitsKey(denom string) []byte {
+	return store.JoinKeys(TransferLimitsKeyPrefix, []byte(denom))
+}
+
+// CreateAccountTransferVolumeKey creates the key for the amounts of the fungible token sent by the account.
+func CreateAccountTransferVolumeKey(denom string, account sdk.AccAddress) []byte {
+	return store.JoinKeys(
+		store.JoinKeys(AccountTransferVolumesKeyPrefix, address.MustLengthPrefix([]byte(denom))),
+		address.MustLengthPrefix(account),
+	)
+}
+
+// CreateTokenTransferVolumeKey creates the key for the amounts of the fungible token sent by all the accounts.
+func CreateTokenTransferVolumeKey(denom string) []byte {
+	return store.JoinKeys(TokenTransferVolumesKeyPrefix, []byte(denom))
+}
+
 // AddressFromBalancesStore returns an account address from a balances prefix
 // store. The key must not contain the prefix BalancesPrefix as the prefix store
 // iterator discards the actual prefix.
@@ -13,15 +13,30 @@ import (
 
 // Type of messages for amino.
 const (
-	TypeMsgIssue               = "issue"
-	TypeMsgMint                = "mint"
-	TypeMsgBurn                = "burn"
-	TypeMsgFreeze              = "freeze"
-	TypeMsgUnfreeze            = "unfreeze"
-	TypeMsgGloballyFreeze      = "globally-freeze"
-	TypeMsgGloballyUnfreeze    = "globally-unfreeze"
-	TypeMsgSetWhitelistedLimit = "set-whitelisted-limit"
-	TypeMsgUpgradeTokenV1      = "upgrade-token-v1"
+	TypeMsgIssue                 = "issue"
+	TypeMsgMint                  = "mint"
+	TypeMsgBurn                  = "burn"
+	TypeMsgFreeze                = "freeze"
+	TypeMsgUnfreeze              = "unfreeze"
+	TypeMsgGloballyFreeze        = "globally-freeze"
+	TypeMsgGloballyUnfreeze      = "globally-unfreeze"
+	TypeMsgSetWhitelistedLimit   = "set-whitelisted-limit"
+	TypeMsgUpgradeTokenV1        = "upgrade-token-v1"
+	TypeMsgTransferAdmin         = "transfer-admin"
+	TypeMsgClearAdmin            = "clear-admin"
+	TypeMsgGrantRole             = "grant-role"
+	TypeMsgRevokeRole            = "revoke-role"
+	TypeMsgUpdateMetadata        = "update-metadata"
+	TypeMsgClawback              = "clawback"
+	TypeMsgScheduleMint          = "schedule-mint"
+	TypeMsgScheduleUnfreeze      = "schedule-unfreeze"
+	TypeMsgCancelScheduledAction = "cancel-scheduled-action"
+	TypeMsgRetryScheduledAction  = "retry-scheduled-action"
+	TypeMsgAddRateExemption      = "add-rate-exemption"
+	TypeMsgRemoveRateExemption   = "remove-rate-exemption"
+	TypeMsgUpdateRateRecipients  = "update-rate-recipients"
+	TypeMsgSetTransferLimits     = "set-transfer-limits"
+	TypeMsgLockSupply            = "lock-supply"
 )
 
 var (
@@ -43,6 +58,36 @@ var (
 	_ legacytx.LegacyMsg = &MsgSetWhitelistedLimit{}
 	_ sdk.Msg            = &MsgUpgradeTokenV1{}
 	_ legacytx.LegacyMsg = &MsgUpgradeTokenV1{}
+	_ sdk.Msg            = &MsgTransferAdmin{}
+	_ legacytx.LegacyMsg = &MsgTransferAdmin{}
+	_ sdk.Msg            = &MsgClearAdmin{}
+	_ legacytx.LegacyMsg = &MsgClearAdmin{}
+	_ sdk.Msg            = &MsgGrantRole{}
+	_ legacytx.LegacyMsg = &MsgGrantRole{}
+	_ sdk.Msg            = &MsgRevokeRole{}
+	_ legacytx.LegacyMsg = &MsgRevokeRole{}
+	_ sdk.Msg            = &MsgUpdateMetadata{}
+	_ legacytx.LegacyMsg = &MsgUpdateMetadata{}
+	_ sdk.Msg            = &MsgClawback{}
+	_ legacytx.LegacyMsg = &MsgClawback{}
+	_ sdk.Msg            = &MsgScheduleMint{}
+	_ legacytx.LegacyMsg = &MsgScheduleMint{}
+	_ sdk.Msg            = &MsgScheduleUnfreeze{}
+	_ legacytx.LegacyMsg = &MsgScheduleUnfreeze{}
+	_ sdk.Msg            = &MsgCancelScheduledAction{}
+	_ legacytx.LegacyMsg = &MsgCancelScheduledAction{}
+	_ sdk.Msg            = &MsgRetryScheduledAction{}
+	_ legacytx.LegacyMsg = &MsgRetryScheduledAction{}
+	_ sdk.Msg            = &MsgAddRateExemption{}
+	_ legacytx.LegacyMsg = &MsgAddRateExemption{}
+	_ sdk.Msg            = &MsgRemoveRateExemption{}
+	_ legacytx.LegacyMsg = &MsgRemoveRateExemption{}
+	_ sdk.Msg            = &MsgUpdateRateRecipients{}
+	_ legacytx.LegacyMsg = &MsgUpdateRateRecipients{}
+	_ sdk.Msg            = &MsgSetTransferLimits{}
+	_ legacytx.LegacyMsg = &MsgSetTransferLimits{}
+	_ sdk.Msg            = &MsgLockSupply{}
+	_ legacytx.LegacyMsg = &MsgLockSupply{}
 )
 
 // RegisterLegacyAminoCodec registers the amino types and interfaces.
@@ -56,12 +101,25 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgGloballyUnfreeze{}, fmt.Sprintf("%s/MsgGloballyUnfreeze", ModuleName), nil)
 	cdc.RegisterConcrete(&MsgSetWhitelistedLimit{}, fmt.Sprintf("%s/MsgUnMsgSetWhitelistedLimitfreeze", ModuleName), nil)
 	cdc.RegisterConcrete(&MsgUpgradeTokenV1{}, fmt.Sprintf("%s/MsgUpgradeTokenV1", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgTransferAdmin{}, fmt.Sprintf("%s/MsgTransferAdmin", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgClearAdmin{}, fmt.Sprintf("%s/MsgClearAdmin", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgGrantRole{}, fmt.Sprintf("%s/MsgGrantRole", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgRevokeRole{}, fmt.Sprintf("%s/MsgRevokeRole", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgUpdateMetadata{}, fmt.Sprintf("%s/MsgUpdateMetadata", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgClawback{}, fmt.Sprintf("%s/MsgClawback", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgScheduleMint{}, fmt.Sprintf("%s/MsgScheduleMint", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgScheduleUnfreeze{}, fmt.Sprintf("%s/MsgScheduleUnfreeze", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgCancelScheduledAction{}, fmt.Sprintf("%s/MsgCancelScheduledAction", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgRetryScheduledAction{}, fmt.Sprintf("%s/MsgRetryScheduledAction", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgAddRateExemption{}, fmt.Sprintf("%s/MsgAddRateExemption", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgRemoveRateExemption{}, fmt.Sprintf("%s/MsgRemoveRateExemption", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgUpdateRateRecipients{}, fmt.Sprintf("%s/MsgUpdateRateRecipients", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgSetTransferLimits{}, fmt.Sprintf("%s/MsgSetTransferLimits", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgLockSupply{}, fmt.Sprintf("%s/MsgLockSupply", ModuleName), nil)
 }
 
 // ValidateBasic validates the message.
 func (m MsgIssue) ValidateBasic() error {
-	const maxDescriptionLength = 200
-
 	if _, err := sdk.AccAddressFromBech32(m.Issuer); err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid issuer %s", m.Issuer)
 	}
@@ -82,6 +140,14 @@ func (m MsgIssue) ValidateBasic() error {
 		return err
 	}
 
+	if err := ValidateSendCommissionRecipients(m.SendCommissionRecipients); err != nil {
+		return err
+	}
+
+	if err := ValidateBurnRateRecipients(m.BurnRateRecipients); err != nil {
+		return err
+	}
+
 	if err := ValidatePrecision(m.Precision); err != nil {
 		return err
 	}
@@ -91,8 +157,12 @@ func (m MsgIssue) ValidateBasic() error {
 		return sdkerrors.Wrapf(ErrInvalidInput, "invalid initial amount %s, can't be negative", m.InitialAmount.String())
 	}
 
-	if len(m.Description) > maxDescriptionLength {
-		return sdkerrors.Wrapf(ErrInvalidInput, "invalid description %q, the length must be less than %d", m.Description, maxDescriptionLength)
+	if err := ValidateMaxSupply(m.MaxSupply, m.InitialAmount); err != nil {
+		return err
+	}
+
+	if err := ValidateMetadata(m.Description, m.URI, m.URIHash); err != nil {
+		return err
 	}
 
 	duplicates := lo.FindDuplicates(m.Features)
@@ -398,15 +468,10 @@ func (m MsgUpgradeTokenV1) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
 	}
 
-	_, issuer, err := DeconstructDenom(m.Denom)
-	if err != nil {
+	if _, _, err := DeconstructDenom(m.Denom); err != nil {
 		return err
 	}
 
-	if issuer.String() != m.Sender {
-		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only issuer can upgrade the denom")
-	}
-
 	return nil
 }
 
@@ -432,6 +497,166 @@ func (m MsgUpgradeTokenV1) Type() string {
 	return TypeMsgUpgradeTokenV1
 }
 
+// ValidateBasic checks that message fields are valid.
+func (m MsgTransferAdmin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Account); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if m.Sender == m.Account {
+		return sdkerrors.Wrap(ErrInvalidInput, "sender and account must be different")
+	}
+
+	if _, _, err := DeconstructDenom(m.Denom); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSigners returns the required signers of this message type.
+func (m MsgTransferAdmin) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgTransferAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgTransferAdmin) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgTransferAdmin) Type() string {
+	return TypeMsgTransferAdmin
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgClearAdmin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, _, err := DeconstructDenom(m.Denom); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSigners returns the required signers of this message type.
+func (m MsgClearAdmin) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgClearAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgClearAdmin) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgClearAdmin) Type() string {
+	return TypeMsgClearAdmin
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgGrantRole) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Account); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if _, _, err := DeconstructDenom(m.Denom); err != nil {
+		return err
+	}
+
+	if err := ValidateRole(m.Role); err != nil {
+		return err
+	}
+
+	return ValidateMintCap(m.Role, m.MintCap)
+}
+
+// GetSigners returns the required signers of this message type.
+func (m MsgGrantRole) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgGrantRole) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgGrantRole) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgGrantRole) Type() string {
+	return TypeMsgGrantRole
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgRevokeRole) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Account); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if _, _, err := DeconstructDenom(m.Denom); err != nil {
+		return err
+	}
+
+	return ValidateRole(m.Role)
+}
+
+// GetSigners returns the required signers of this message type.
+func (m MsgRevokeRole) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgRevokeRole) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgRevokeRole) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgRevokeRole) Type() string {
+	return TypeMsgRevokeRole
+}
+
 var (
 	amino          = codec.NewLegacyAmino()
 	moduleAminoCdc = codec.NewAminoCodec(amino)
@@ -442,3 +667,402 @@ func init() {
 	cryptocodec.RegisterCrypto(amino)
 	amino.Seal()
 }
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgUpdateMetadata) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, _, err := DeconstructDenom(m.Denom); err != nil {
+		return err
+	}
+
+	return ValidateMetadata(m.Description, m.URI, m.URIHash)
+}
+
+// GetSigners returns the required signers of this message type.
+func (m MsgUpdateMetadata) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgUpdateMetadata) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgUpdateMetadata) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgUpdateMetadata) Type() string {
+	return TypeMsgUpdateMetadata
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgClawback) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Account); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if _, _, err := DeconstructDenom(m.Coin.Denom); err != nil {
+		return err
+	}
+
+	return m.Coin.Validate()
+}
+
+// GetSigners returns the required signers of this message type.
+func (m MsgClawback) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgClawback) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgClawback) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgClawback) Type() string {
+	return TypeMsgClawback
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgScheduleMint) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if m.ExecutionTime.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidInput, "execution time must be set")
+	}
+
+	if _, _, err := DeconstructDenom(m.Coin.Denom); err != nil {
+		return err
+	}
+
+	return m.Coin.Validate()
+}
+
+// GetSigners returns the required signers of this message type.
+func (m MsgScheduleMint) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgScheduleMint) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgScheduleMint) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgScheduleMint) Type() string {
+	return TypeMsgScheduleMint
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgScheduleUnfreeze) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Account); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if m.ExecutionTime.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidInput, "execution time must be set")
+	}
+
+	if _, _, err := DeconstructDenom(m.Coin.Denom); err != nil {
+		return err
+	}
+
+	return m.Coin.Validate()
+}
+
+// GetSigners returns the required signers of this message type.
+func (m MsgScheduleUnfreeze) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgScheduleUnfreeze) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgScheduleUnfreeze) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgScheduleUnfreeze) Type() string {
+	return TypeMsgScheduleUnfreeze
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgCancelScheduledAction) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	return nil
+}
+
+// GetSigners returns the required signers of this message type.
+func (m MsgCancelScheduledAction) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgCancelScheduledAction) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgCancelScheduledAction) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgCancelScheduledAction) Type() string {
+	return TypeMsgCancelScheduledAction
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgRetryScheduledAction) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	return nil
+}
+
+// GetSigners returns the required signers of this message type.
+func (m MsgRetryScheduledAction) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgRetryScheduledAction) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgRetryScheduledAction) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgRetryScheduledAction) Type() string {
+	return TypeMsgRetryScheduledAction
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgAddRateExemption) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Account); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	_, _, err := DeconstructDenom(m.Denom)
+	return err
+}
+
+// GetSigners returns the required signers of this message type.
+func (m MsgAddRateExemption) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgAddRateExemption) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgAddRateExemption) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgAddRateExemption) Type() string {
+	return TypeMsgAddRateExemption
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgRemoveRateExemption) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Account); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	_, _, err := DeconstructDenom(m.Denom)
+	return err
+}
+
+// GetSigners returns the required signers of this message type.
+func (m MsgRemoveRateExemption) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgRemoveRateExemption) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgRemoveRateExemption) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgRemoveRateExemption) Type() string {
+	return TypeMsgRemoveRateExemption
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgUpdateRateRecipients) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, _, err := DeconstructDenom(m.Denom); err != nil {
+		return err
+	}
+
+	if err := ValidateSendCommissionRecipients(m.SendCommissionRecipients); err != nil {
+		return err
+	}
+
+	return ValidateBurnRateRecipients(m.BurnRateRecipients)
+}
+
+// GetSigners returns the required signers of this message type.
+func (m MsgUpdateRateRecipients) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgUpdateRateRecipients) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgUpdateRateRecipients) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgUpdateRateRecipients) Type() string {
+	return TypeMsgUpdateRateRecipients
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgSetTransferLimits) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, _, err := DeconstructDenom(m.Denom); err != nil {
+		return err
+	}
+
+	return ValidateTransferLimitAmounts(m.MaxTransferAmount, m.MaxAccountDailyAmount, m.MaxTokenDailyAmount)
+}
+
+// GetSigners returns the required signers of this message type.
+func (m MsgSetTransferLimits) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgSetTransferLimits) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgSetTransferLimits) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgSetTransferLimits) Type() string {
+	return TypeMsgSetTransferLimits
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgLockSupply) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, _, err := DeconstructDenom(m.Denom); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSigners returns the required signers of this message type.
+func (m MsgLockSupply) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgLockSupply) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgLockSupply) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgLockSupply) Type() string {
+	return TypeMsgLockSupply
+}
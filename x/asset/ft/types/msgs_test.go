@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -78,6 +79,30 @@ func TestMsgIssue_ValidateBasic(t *testing.T) {
 			},
 			expectedError: types.ErrInvalidInput,
 		},
+		{
+			name: "valid rate recipients",
+			messageFunc: func(msg types.MsgIssue) types.MsgIssue {
+				msg.SendCommissionRecipients = []types.RateRecipient{{Address: acc.String(), Weight: 1}}
+				msg.BurnRateRecipients = []types.RateRecipient{{Address: acc.String(), Weight: 2}}
+				return msg
+			},
+		},
+		{
+			name: "invalid send commission recipient weight",
+			messageFunc: func(msg types.MsgIssue) types.MsgIssue {
+				msg.SendCommissionRecipients = []types.RateRecipient{{Address: acc.String(), Weight: 0}}
+				return msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid burn rate recipient address",
+			messageFunc: func(msg types.MsgIssue) types.MsgIssue {
+				msg.BurnRateRecipients = []types.RateRecipient{{Address: "invalid", Weight: 1}}
+				return msg
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
 		{
 			name: "invalid nil initial amount",
 			messageFunc: func(msg types.MsgIssue) types.MsgIssue {
@@ -94,6 +119,29 @@ func TestMsgIssue_ValidateBasic(t *testing.T) {
 			},
 			expectedError: types.ErrInvalidInput,
 		},
+		{
+			name: "valid max supply",
+			messageFunc: func(msg types.MsgIssue) types.MsgIssue {
+				msg.MaxSupply = sdk.NewInt(1000)
+				return msg
+			},
+		},
+		{
+			name: "invalid negative max supply",
+			messageFunc: func(msg types.MsgIssue) types.MsgIssue {
+				msg.MaxSupply = sdk.NewInt(-1)
+				return msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid initial amount greater than max supply",
+			messageFunc: func(msg types.MsgIssue) types.MsgIssue {
+				msg.MaxSupply = sdk.NewInt(776)
+				return msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
 		{
 			name: "invalid long description",
 			messageFunc: func(msg types.MsgIssue) types.MsgIssue {
@@ -102,6 +150,30 @@ func TestMsgIssue_ValidateBasic(t *testing.T) {
 			},
 			expectedError: types.ErrInvalidInput,
 		},
+		{
+			name: "valid uri and uri hash",
+			messageFunc: func(msg types.MsgIssue) types.MsgIssue {
+				msg.URI = "https://my-token-meta.invalid/1"
+				msg.URIHash = "e000624"
+				return msg
+			},
+		},
+		{
+			name: "invalid long uri",
+			messageFunc: func(msg types.MsgIssue) types.MsgIssue {
+				msg.URI = string(make([]byte, types.MaxURILength+1))
+				return msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid long uri hash",
+			messageFunc: func(msg types.MsgIssue) types.MsgIssue {
+				msg.URIHash = string(make([]byte, types.MaxURIHashLength+1))
+				return msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
 		{
 			name: "invalid empty subunit",
 			messageFunc: func(msg types.MsgIssue) types.MsgIssue {
@@ -482,104 +554,1161 @@ func TestMsgSetWhitelistedLimit_ValidateBasic(t *testing.T) {
 	}
 }
 
-func TestAmino(t *testing.T) {
-	const address = "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"
-	coin := sdk.NewInt64Coin("my-denom", 1)
+func TestMsgTransferAdmin_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name          string
+		message       types.MsgTransferAdmin
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			message: types.MsgTransferAdmin{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+			},
+		},
+		{
+			name: "invalid sender address",
+			message: types.MsgTransferAdmin{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5+",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid account address",
+			message: types.MsgTransferAdmin{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq+",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "same sender and account",
+			message: types.MsgTransferAdmin{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid denom",
+			message: types.MsgTransferAdmin{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Denom:   "abc",
+			},
+			expectedError: types.ErrInvalidDenom,
+		},
+	}
 
-	tests := []struct {
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgClearAdmin_ValidateBasic(t *testing.T) {
+	testCases := []struct {
 		name          string
-		msg           legacytx.LegacyMsg
-		wantAminoJSON string
+		message       types.MsgClearAdmin
+		expectedError error
 	}{
 		{
-			name: types.TypeMsgIssue,
-			msg: &types.MsgIssue{
-				Issuer: address,
-				Symbol: "ABC",
+			name: "valid msg",
+			message: types.MsgClearAdmin{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
 			},
-			wantAminoJSON: `{"type":"assetft/MsgIssue","value":{"burn_rate":"0","initial_amount":"0","issuer":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","send_commission_rate":"0","symbol":"ABC"}}`,
 		},
 		{
-			name: types.TypeMsgMint,
-			msg: &types.MsgMint{
-				Sender: address,
-				Coin:   coin,
+			name: "invalid sender address",
+			message: types.MsgClearAdmin{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5+",
+				Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
 			},
-			wantAminoJSON: `{"type":"assetft/MsgMint","value":{"coin":{"amount":"1","denom":"my-denom"},"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+			expectedError: sdkerrors.ErrInvalidAddress,
 		},
 		{
-			name: types.TypeMsgBurn,
-			msg: &types.MsgBurn{
-				Sender: address,
-				Coin:   coin,
+			name: "invalid denom",
+			message: types.MsgClearAdmin{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:  "abc",
 			},
-			wantAminoJSON: `{"type":"assetft/MsgBurn","value":{"coin":{"amount":"1","denom":"my-denom"},"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+			expectedError: types.ErrInvalidDenom,
 		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgGrantRole_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name          string
+		message       types.MsgGrantRole
+		expectedError error
+	}{
 		{
-			name: types.TypeMsgFreeze,
-			msg: &types.MsgFreeze{
-				Sender: address,
-				Coin:   coin,
+			name: "valid msg",
+			message: types.MsgGrantRole{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Role:    types.Role_freezer,
 			},
-			wantAminoJSON: `{"type":"assetft/MsgFreeze","value":{"coin":{"amount":"1","denom":"my-denom"},"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
 		},
 		{
-			name: types.TypeMsgUnfreeze,
-			msg: &types.MsgUnfreeze{
-				Sender: address,
-				Coin:   coin,
+			name: "valid msg with mint cap",
+			message: types.MsgGrantRole{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Role:    types.Role_minter,
+				MintCap: sdk.NewInt(100),
 			},
-			wantAminoJSON: `{"type":"assetft/MsgUnfreeze","value":{"coin":{"amount":"1","denom":"my-denom"},"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
 		},
 		{
-			name: types.TypeMsgUnfreeze,
-			msg: &types.MsgUnfreeze{
-				Sender: address,
-				Coin:   coin,
+			name: "invalid sender address",
+			message: types.MsgGrantRole{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5+",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Role:    types.Role_minter,
 			},
-			wantAminoJSON: `{"type":"assetft/MsgUnfreeze","value":{"coin":{"amount":"1","denom":"my-denom"},"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+			expectedError: sdkerrors.ErrInvalidAddress,
 		},
 		{
-			name: types.TypeMsgGloballyFreeze,
-			msg: &types.MsgGloballyFreeze{
-				Sender: address,
-				Denom:  coin.Denom,
+			name: "invalid account address",
+			message: types.MsgGrantRole{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq+",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Role:    types.Role_minter,
 			},
-			wantAminoJSON: `{"type":"assetft/MsgGloballyFreeze","value":{"denom":"my-denom","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+			expectedError: sdkerrors.ErrInvalidAddress,
 		},
 		{
-			name: types.TypeMsgGloballyUnfreeze,
-			msg: &types.MsgGloballyUnfreeze{
-				Sender: address,
-				Denom:  coin.Denom,
+			name: "invalid denom",
+			message: types.MsgGrantRole{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Denom:   "abc",
+				Role:    types.Role_minter,
 			},
-			wantAminoJSON: `{"type":"assetft/MsgGloballyUnfreeze","value":{"denom":"my-denom","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+			expectedError: types.ErrInvalidDenom,
 		},
 		{
-			name: types.TypeMsgUnfreeze,
-			msg: &types.MsgUnfreeze{
-				Sender: address,
-				Coin:   coin,
+			name: "invalid role",
+			message: types.MsgGrantRole{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Role:    types.Role(100),
 			},
-			wantAminoJSON: `{"type":"assetft/MsgUnfreeze","value":{"coin":{"amount":"1","denom":"my-denom"},"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+			expectedError: types.ErrInvalidInput,
 		},
 		{
-			name: types.TypeMsgSetWhitelistedLimit,
-			msg: &types.MsgSetWhitelistedLimit{
-				Sender:  address,
-				Account: address,
-				Coin:    coin,
+			name: "negative mint cap",
+			message: types.MsgGrantRole{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Role:    types.Role_minter,
+				MintCap: sdk.NewInt(-1),
 			},
-			wantAminoJSON: `{"type":"assetft/MsgUnMsgSetWhitelistedLimitfreeze","value":{"account":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","coin":{"amount":"1","denom":"my-denom"},"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+			expectedError: types.ErrInvalidInput,
 		},
 		{
-			name: types.TypeMsgUpgradeTokenV1,
-			msg: &types.MsgUpgradeTokenV1{
-				Sender:     address,
-				Denom:      coin.Denom,
-				IbcEnabled: false,
+			name: "mint cap for non-minter role",
+			message: types.MsgGrantRole{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Role:    types.Role_whitelister,
+				MintCap: sdk.NewInt(100),
 			},
-			wantAminoJSON: `{"type":"assetft/MsgUpgradeTokenV1","value":{"denom":"my-denom","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgRevokeRole_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name          string
+		message       types.MsgRevokeRole
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			message: types.MsgRevokeRole{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Role:    types.Role_minter,
+			},
+		},
+		{
+			name: "invalid sender address",
+			message: types.MsgRevokeRole{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5+",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Role:    types.Role_minter,
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid account address",
+			message: types.MsgRevokeRole{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq+",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Role:    types.Role_minter,
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid denom",
+			message: types.MsgRevokeRole{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Denom:   "abc",
+				Role:    types.Role_minter,
+			},
+			expectedError: types.ErrInvalidDenom,
+		},
+		{
+			name: "invalid role",
+			message: types.MsgRevokeRole{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Role:    types.Role(100),
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgUpdateMetadata_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name          string
+		message       types.MsgUpdateMetadata
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			message: types.MsgUpdateMetadata{
+				Sender:      "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:       "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Description: "ABC Description",
+				URI:         "https://my-token-meta.invalid/1",
+				URIHash:     "e000624",
+			},
+		},
+		{
+			name: "valid empty metadata",
+			message: types.MsgUpdateMetadata{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+			},
+		},
+		{
+			name: "invalid sender address",
+			message: types.MsgUpdateMetadata{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5+",
+				Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid denom",
+			message: types.MsgUpdateMetadata{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:  "abc",
+			},
+			expectedError: types.ErrInvalidDenom,
+		},
+		{
+			name: "invalid long description",
+			message: types.MsgUpdateMetadata{
+				Sender:      "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:       "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Description: string(make([]byte, types.MaxDescriptionLength+1)),
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid long uri",
+			message: types.MsgUpdateMetadata{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				URI:    string(make([]byte, types.MaxURILength+1)),
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid long uri hash",
+			message: types.MsgUpdateMetadata{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				URIHash: string(make([]byte, types.MaxURIHashLength+1)),
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgClawback_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name          string
+		message       types.MsgClawback
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			message: types.MsgClawback{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Coin: sdk.Coin{
+					Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+					Amount: sdk.NewInt(100),
+				},
+			},
+		},
+		{
+			name: "invalid sender address",
+			message: types.MsgClawback{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5+",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Coin: sdk.Coin{
+					Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+					Amount: sdk.NewInt(100),
+				},
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid account",
+			message: types.MsgClawback{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq+",
+				Coin: sdk.Coin{
+					Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+					Amount: sdk.NewInt(100),
+				},
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid denom",
+			message: types.MsgClawback{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Coin: sdk.Coin{
+					Denom:  "abc",
+					Amount: sdk.NewInt(100),
+				},
+			},
+			expectedError: types.ErrInvalidDenom,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgScheduleMint_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name          string
+		message       types.MsgScheduleMint
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			message: types.MsgScheduleMint{
+				Sender:        "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Coin:          sdk.NewInt64Coin("abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5", 100),
+				ExecutionTime: time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC),
+			},
+		},
+		{
+			name: "invalid sender address",
+			message: types.MsgScheduleMint{
+				Sender:        "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5+",
+				Coin:          sdk.NewInt64Coin("abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5", 100),
+				ExecutionTime: time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC),
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "missing execution time",
+			message: types.MsgScheduleMint{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Coin:   sdk.NewInt64Coin("abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5", 100),
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid denom",
+			message: types.MsgScheduleMint{
+				Sender:        "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Coin:          sdk.NewInt64Coin("abc", 100),
+				ExecutionTime: time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC),
+			},
+			expectedError: types.ErrInvalidDenom,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgScheduleUnfreeze_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name          string
+		message       types.MsgScheduleUnfreeze
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			message: types.MsgScheduleUnfreeze{
+				Sender:        "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account:       "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Coin:          sdk.NewInt64Coin("abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5", 100),
+				ExecutionTime: time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC),
+			},
+		},
+		{
+			name: "invalid sender address",
+			message: types.MsgScheduleUnfreeze{
+				Sender:        "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5+",
+				Account:       "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Coin:          sdk.NewInt64Coin("abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5", 100),
+				ExecutionTime: time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC),
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid account",
+			message: types.MsgScheduleUnfreeze{
+				Sender:        "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account:       "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq+",
+				Coin:          sdk.NewInt64Coin("abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5", 100),
+				ExecutionTime: time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC),
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "missing execution time",
+			message: types.MsgScheduleUnfreeze{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Coin:    sdk.NewInt64Coin("abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5", 100),
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgCancelScheduledAction_ValidateBasic(t *testing.T) {
+	requireT := require.New(t)
+
+	requireT.NoError(types.MsgCancelScheduledAction{
+		Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		Id:     1,
+	}.ValidateBasic())
+
+	err := types.MsgCancelScheduledAction{
+		Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5+",
+		Id:     1,
+	}.ValidateBasic()
+	requireT.True(sdkerrors.IsOf(err, sdkerrors.ErrInvalidAddress))
+}
+
+func TestMsgRetryScheduledAction_ValidateBasic(t *testing.T) {
+	requireT := require.New(t)
+
+	requireT.NoError(types.MsgRetryScheduledAction{
+		Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		Id:     1,
+	}.ValidateBasic())
+
+	err := types.MsgRetryScheduledAction{
+		Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5+",
+		Id:     1,
+	}.ValidateBasic()
+	requireT.True(sdkerrors.IsOf(err, sdkerrors.ErrInvalidAddress))
+}
+
+func TestMsgAddRateExemption_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name          string
+		message       types.MsgAddRateExemption
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			message: types.MsgAddRateExemption{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+			},
+		},
+		{
+			name: "invalid sender address",
+			message: types.MsgAddRateExemption{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5+",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid account address",
+			message: types.MsgAddRateExemption{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq+",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid denom",
+			message: types.MsgAddRateExemption{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Denom:   "abc",
+			},
+			expectedError: types.ErrInvalidDenom,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgRemoveRateExemption_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name          string
+		message       types.MsgRemoveRateExemption
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			message: types.MsgRemoveRateExemption{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+			},
+		},
+		{
+			name: "invalid sender address",
+			message: types.MsgRemoveRateExemption{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5+",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid account address",
+			message: types.MsgRemoveRateExemption{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq+",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid denom",
+			message: types.MsgRemoveRateExemption{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Denom:   "abc",
+			},
+			expectedError: types.ErrInvalidDenom,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgUpdateRateRecipients_ValidateBasic(t *testing.T) {
+	const (
+		sender    = "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"
+		recipient = "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq"
+		denom     = "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"
+	)
+
+	testCases := []struct {
+		name          string
+		message       types.MsgUpdateRateRecipients
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			message: types.MsgUpdateRateRecipients{
+				Sender:                   sender,
+				Denom:                    denom,
+				SendCommissionRecipients: []types.RateRecipient{{Address: sender, Weight: 3}, {Address: recipient, Weight: 1}},
+				BurnRateRecipients:       []types.RateRecipient{{Address: sender, Weight: 1}},
+			},
+		},
+		{
+			name: "valid msg clearing the recipients",
+			message: types.MsgUpdateRateRecipients{
+				Sender: sender,
+				Denom:  denom,
+			},
+		},
+		{
+			name: "invalid sender address",
+			message: types.MsgUpdateRateRecipients{
+				Sender: sender + "+",
+				Denom:  denom,
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid denom",
+			message: types.MsgUpdateRateRecipients{
+				Sender: sender,
+				Denom:  "abc",
+			},
+			expectedError: types.ErrInvalidDenom,
+		},
+		{
+			name: "invalid recipient address",
+			message: types.MsgUpdateRateRecipients{
+				Sender:                   sender,
+				Denom:                    denom,
+				SendCommissionRecipients: []types.RateRecipient{{Address: recipient + "+", Weight: 1}},
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "zero weight",
+			message: types.MsgUpdateRateRecipients{
+				Sender:             sender,
+				Denom:              denom,
+				BurnRateRecipients: []types.RateRecipient{{Address: recipient, Weight: 0}},
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "duplicated recipient",
+			message: types.MsgUpdateRateRecipients{
+				Sender:             sender,
+				Denom:              denom,
+				BurnRateRecipients: []types.RateRecipient{{Address: recipient, Weight: 1}, {Address: recipient, Weight: 2}},
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgSetTransferLimits_ValidateBasic(t *testing.T) {
+	const (
+		sender = "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"
+		denom  = "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"
+	)
+
+	testCases := []struct {
+		name          string
+		message       types.MsgSetTransferLimits
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			message: types.MsgSetTransferLimits{
+				Sender:                sender,
+				Denom:                 denom,
+				MaxTransferAmount:     sdk.NewInt(10),
+				MaxAccountDailyAmount: sdk.NewInt(100),
+				MaxTokenDailyAmount:   sdk.NewInt(1000),
+			},
+		},
+		{
+			name: "valid msg removing the limits",
+			message: types.MsgSetTransferLimits{
+				Sender:                sender,
+				Denom:                 denom,
+				MaxTransferAmount:     sdk.ZeroInt(),
+				MaxAccountDailyAmount: sdk.ZeroInt(),
+				MaxTokenDailyAmount:   sdk.ZeroInt(),
+			},
+		},
+		{
+			name: "invalid sender address",
+			message: types.MsgSetTransferLimits{
+				Sender:                sender + "+",
+				Denom:                 denom,
+				MaxTransferAmount:     sdk.ZeroInt(),
+				MaxAccountDailyAmount: sdk.ZeroInt(),
+				MaxTokenDailyAmount:   sdk.ZeroInt(),
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid denom",
+			message: types.MsgSetTransferLimits{
+				Sender:                sender,
+				Denom:                 "abc",
+				MaxTransferAmount:     sdk.ZeroInt(),
+				MaxAccountDailyAmount: sdk.ZeroInt(),
+				MaxTokenDailyAmount:   sdk.ZeroInt(),
+			},
+			expectedError: types.ErrInvalidDenom,
+		},
+		{
+			name: "negative limit",
+			message: types.MsgSetTransferLimits{
+				Sender:                sender,
+				Denom:                 denom,
+				MaxTransferAmount:     sdk.ZeroInt(),
+				MaxAccountDailyAmount: sdk.NewInt(-1),
+				MaxTokenDailyAmount:   sdk.ZeroInt(),
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "nil limit",
+			message: types.MsgSetTransferLimits{
+				Sender:              sender,
+				Denom:               denom,
+				MaxTransferAmount:   sdk.ZeroInt(),
+				MaxTokenDailyAmount: sdk.ZeroInt(),
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgLockSupply_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name          string
+		message       types.MsgLockSupply
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			message: types.MsgLockSupply{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+			},
+		},
+		{
+			name: "invalid sender address",
+			message: types.MsgLockSupply{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5+",
+				Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid denom",
+			message: types.MsgLockSupply{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:  "abc",
+			},
+			expectedError: types.ErrInvalidDenom,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestAmino(t *testing.T) {
+	const address = "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"
+	coin := sdk.NewInt64Coin("my-denom", 1)
+
+	tests := []struct {
+		name          string
+		msg           legacytx.LegacyMsg
+		wantAminoJSON string
+	}{
+		{
+			name: types.TypeMsgIssue,
+			msg: &types.MsgIssue{
+				Issuer: address,
+				Symbol: "ABC",
+			},
+			wantAminoJSON: `{"type":"assetft/MsgIssue","value":{"burn_rate":"0","initial_amount":"0","issuer":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","send_commission_rate":"0","symbol":"ABC"}}`,
+		},
+		{
+			name: types.TypeMsgMint,
+			msg: &types.MsgMint{
+				Sender: address,
+				Coin:   coin,
+			},
+			wantAminoJSON: `{"type":"assetft/MsgMint","value":{"coin":{"amount":"1","denom":"my-denom"},"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: types.TypeMsgBurn,
+			msg: &types.MsgBurn{
+				Sender: address,
+				Coin:   coin,
+			},
+			wantAminoJSON: `{"type":"assetft/MsgBurn","value":{"coin":{"amount":"1","denom":"my-denom"},"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: types.TypeMsgFreeze,
+			msg: &types.MsgFreeze{
+				Sender: address,
+				Coin:   coin,
+			},
+			wantAminoJSON: `{"type":"assetft/MsgFreeze","value":{"coin":{"amount":"1","denom":"my-denom"},"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: types.TypeMsgUnfreeze,
+			msg: &types.MsgUnfreeze{
+				Sender: address,
+				Coin:   coin,
+			},
+			wantAminoJSON: `{"type":"assetft/MsgUnfreeze","value":{"coin":{"amount":"1","denom":"my-denom"},"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: types.TypeMsgUnfreeze,
+			msg: &types.MsgUnfreeze{
+				Sender: address,
+				Coin:   coin,
+			},
+			wantAminoJSON: `{"type":"assetft/MsgUnfreeze","value":{"coin":{"amount":"1","denom":"my-denom"},"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: types.TypeMsgGloballyFreeze,
+			msg: &types.MsgGloballyFreeze{
+				Sender: address,
+				Denom:  coin.Denom,
+			},
+			wantAminoJSON: `{"type":"assetft/MsgGloballyFreeze","value":{"denom":"my-denom","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: types.TypeMsgGloballyUnfreeze,
+			msg: &types.MsgGloballyUnfreeze{
+				Sender: address,
+				Denom:  coin.Denom,
+			},
+			wantAminoJSON: `{"type":"assetft/MsgGloballyUnfreeze","value":{"denom":"my-denom","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: types.TypeMsgUnfreeze,
+			msg: &types.MsgUnfreeze{
+				Sender: address,
+				Coin:   coin,
+			},
+			wantAminoJSON: `{"type":"assetft/MsgUnfreeze","value":{"coin":{"amount":"1","denom":"my-denom"},"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: types.TypeMsgSetWhitelistedLimit,
+			msg: &types.MsgSetWhitelistedLimit{
+				Sender:  address,
+				Account: address,
+				Coin:    coin,
+			},
+			wantAminoJSON: `{"type":"assetft/MsgUnMsgSetWhitelistedLimitfreeze","value":{"account":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","coin":{"amount":"1","denom":"my-denom"},"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: types.TypeMsgUpgradeTokenV1,
+			msg: &types.MsgUpgradeTokenV1{
+				Sender:     address,
+				Denom:      coin.Denom,
+				IbcEnabled: false,
+			},
+			wantAminoJSON: `{"type":"assetft/MsgUpgradeTokenV1","value":{"denom":"my-denom","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: types.TypeMsgTransferAdmin,
+			msg: &types.MsgTransferAdmin{
+				Sender:  address,
+				Account: address,
+				Denom:   coin.Denom,
+			},
+			wantAminoJSON: `{"type":"assetft/MsgTransferAdmin","value":{"account":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","denom":"my-denom","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: types.TypeMsgClearAdmin,
+			msg: &types.MsgClearAdmin{
+				Sender: address,
+				Denom:  coin.Denom,
+			},
+			wantAminoJSON: `{"type":"assetft/MsgClearAdmin","value":{"denom":"my-denom","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: types.TypeMsgGrantRole,
+			msg: &types.MsgGrantRole{
+				Sender:  address,
+				Account: address,
+				Denom:   coin.Denom,
+				Role:    types.Role_minter,
+				MintCap: sdk.NewInt(100),
+			},
+			wantAminoJSON: `{"type":"assetft/MsgGrantRole","value":{"account":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","denom":"my-denom","mint_cap":"100","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: types.TypeMsgRevokeRole,
+			msg: &types.MsgRevokeRole{
+				Sender:  address,
+				Account: address,
+				Denom:   coin.Denom,
+				Role:    types.Role_freezer,
+			},
+			wantAminoJSON: `{"type":"assetft/MsgRevokeRole","value":{"account":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","denom":"my-denom","role":1,"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: types.TypeMsgUpdateMetadata,
+			msg: &types.MsgUpdateMetadata{
+				Sender:      address,
+				Denom:       coin.Denom,
+				Description: "desc",
+				URI:         "https://my-token-meta.invalid/1",
+				URIHash:     "e000624",
+			},
+			wantAminoJSON: `{"type":"assetft/MsgUpdateMetadata","value":{"denom":"my-denom","description":"desc","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","uri":"https://my-token-meta.invalid/1","uri_hash":"e000624"}}`,
+		},
+		{
+			name: types.TypeMsgClawback,
+			msg: &types.MsgClawback{
+				Sender:  address,
+				Account: address,
+				Coin:    coin,
+			},
+			wantAminoJSON: `{"type":"assetft/MsgClawback","value":{"account":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","coin":{"amount":"1","denom":"my-denom"},"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: types.TypeMsgScheduleMint,
+			msg: &types.MsgScheduleMint{
+				Sender:        address,
+				Coin:          coin,
+				ExecutionTime: time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC),
+			},
+			wantAminoJSON: `{"type":"assetft/MsgScheduleMint","value":{"coin":{"amount":"1","denom":"my-denom"},"execution_time":"2030-01-02T03:04:05Z","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: types.TypeMsgScheduleUnfreeze,
+			msg: &types.MsgScheduleUnfreeze{
+				Sender:        address,
+				Account:       address,
+				Coin:          coin,
+				ExecutionTime: time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC),
+			},
+			wantAminoJSON: `{"type":"assetft/MsgScheduleUnfreeze","value":{"account":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","coin":{"amount":"1","denom":"my-denom"},"execution_time":"2030-01-02T03:04:05Z","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: types.TypeMsgCancelScheduledAction,
+			msg: &types.MsgCancelScheduledAction{
+				Sender: address,
+				Id:     1,
+			},
+			wantAminoJSON: `{"type":"assetft/MsgCancelScheduledAction","value":{"id":"1","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: types.TypeMsgRetryScheduledAction,
+			msg: &types.MsgRetryScheduledAction{
+				Sender: address,
+				Id:     1,
+			},
+			wantAminoJSON: `{"type":"assetft/MsgRetryScheduledAction","value":{"id":"1","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: types.TypeMsgAddRateExemption,
+			msg: &types.MsgAddRateExemption{
+				Sender:  address,
+				Account: address,
+				Denom:   "my-denom",
+			},
+			wantAminoJSON: `{"type":"assetft/MsgAddRateExemption","value":{"account":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","denom":"my-denom","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: types.TypeMsgRemoveRateExemption,
+			msg: &types.MsgRemoveRateExemption{
+				Sender:  address,
+				Account: address,
+				Denom:   "my-denom",
+			},
+			wantAminoJSON: `{"type":"assetft/MsgRemoveRateExemption","value":{"account":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","denom":"my-denom","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: types.TypeMsgUpdateRateRecipients,
+			msg: &types.MsgUpdateRateRecipients{
+				Sender:                   address,
+				Denom:                    "my-denom",
+				SendCommissionRecipients: []types.RateRecipient{{Address: address, Weight: 1}},
+			},
+			wantAminoJSON: `{"type":"assetft/MsgUpdateRateRecipients","value":{"burn_rate_recipients":null,"denom":"my-denom","send_commission_recipients":[{"address":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","weight":1}],"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: types.TypeMsgSetTransferLimits,
+			msg: &types.MsgSetTransferLimits{
+				Sender:                address,
+				Denom:                 "my-denom",
+				MaxTransferAmount:     sdk.NewInt(10),
+				MaxAccountDailyAmount: sdk.ZeroInt(),
+				MaxTokenDailyAmount:   sdk.NewInt(1000),
+			},
+			wantAminoJSON: `{"type":"assetft/MsgSetTransferLimits","value":{"denom":"my-denom","max_account_daily_amount":"0","max_token_daily_amount":"1000","max_transfer_amount":"10","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: types.TypeMsgLockSupply,
+			msg: &types.MsgLockSupply{
+				Sender: address,
+				Denom:  "my-denom",
+			},
+			wantAminoJSON: `{"type":"assetft/MsgLockSupply","value":{"denom":"my-denom","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
 		},
 	}
 	for _, tt := range tests {
@@ -0,0 +1,19 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Validate checks that the rate exemption is valid.
+func (e RateExemption) Validate() error {
+	if _, _, err := DeconstructDenom(e.Denom); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(e.Account); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid account %s", e.Account)
+	}
+
+	return nil
+}
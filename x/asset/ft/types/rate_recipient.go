@@ -0,0 +1,73 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/pkg/errors"
+)
+
+// MaxRateRecipients is the maximum number of the recipients of the burn rate or the send commission.
+const MaxRateRecipients = 10
+
+// ValidateSendCommissionRecipients checks that provided send commission recipients are valid.
+func ValidateSendCommissionRecipients(recipients []RateRecipient) error {
+	if err := validateRateRecipients(recipients); err != nil {
+		return errors.Wrap(err, "send commission recipients are invalid")
+	}
+	return nil
+}
+
+// ValidateBurnRateRecipients checks that provided burn rate recipients are valid.
+func ValidateBurnRateRecipients(recipients []RateRecipient) error {
+	if err := validateRateRecipients(recipients); err != nil {
+		return errors.Wrap(err, "burn rate recipients are invalid")
+	}
+	return nil
+}
+
+// SplitByWeights splits the amount between the recipients proportionally to their weights.
+// The shares are rounded down and the remainder is assigned to the first recipient, so the sum of the shares
+// is always equal to the amount.
+func SplitByWeights(amount sdk.Int, recipients []RateRecipient) []sdk.Int {
+	if len(recipients) == 0 {
+		return nil
+	}
+
+	totalWeight := sdk.ZeroInt()
+	for _, recipient := range recipients {
+		totalWeight = totalWeight.Add(sdk.NewIntFromUint64(uint64(recipient.Weight)))
+	}
+
+	shares := make([]sdk.Int, 0, len(recipients))
+	remainder := amount
+	for _, recipient := range recipients {
+		share := amount.Mul(sdk.NewIntFromUint64(uint64(recipient.Weight))).Quo(totalWeight)
+		shares = append(shares, share)
+		remainder = remainder.Sub(share)
+	}
+	shares[0] = shares[0].Add(remainder)
+
+	return shares
+}
+
+func validateRateRecipients(recipients []RateRecipient) error {
+	if len(recipients) > MaxRateRecipients {
+		return sdkerrors.Wrapf(ErrInvalidInput, "the number of recipients must not exceed %d", MaxRateRecipients)
+	}
+
+	addresses := make(map[string]struct{}, len(recipients))
+	for _, recipient := range recipients {
+		if _, err := sdk.AccAddressFromBech32(recipient.Address); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address %s", recipient.Address)
+		}
+		if recipient.Weight == 0 {
+			return sdkerrors.Wrapf(ErrInvalidInput, "weight of the recipient %s must be positive", recipient.Address)
+		}
+		if _, exists := addresses[recipient.Address]; exists {
+			return sdkerrors.Wrapf(ErrInvalidInput, "duplicated recipient %s", recipient.Address)
+		}
+		addresses[recipient.Address] = struct{}{}
+	}
+
+	return nil
+}
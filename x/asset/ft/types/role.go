@@ -0,0 +1,95 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// roleFeatures maps the roles to the features they give access to.
+var roleFeatures = map[Role]Feature{
+	Role_minter:      Feature_minting,
+	Role_freezer:     Feature_freezing,
+	Role_whitelister: Feature_whitelisting,
+}
+
+// featureRoles maps the features to the roles giving access to them.
+var featureRoles = map[Feature]Role{
+	Feature_minting:      Role_minter,
+	Feature_freezing:     Role_freezer,
+	Feature_whitelisting: Role_whitelister,
+}
+
+// RoleForFeature returns the role giving access to the feature. False is returned if there is no such role.
+func RoleForFeature(feature Feature) (Role, bool) {
+	role, exists := featureRoles[feature]
+	return role, exists
+}
+
+// Feature returns the feature the role gives access to.
+func (r Role) Feature() Feature {
+	return roleFeatures[r]
+}
+
+// ValidateRole checks that the role exists.
+func ValidateRole(role Role) error {
+	if _, exists := roleFeatures[role]; !exists {
+		return sdkerrors.Wrapf(ErrInvalidInput, "non-existing role provided: %d", role)
+	}
+	return nil
+}
+
+// ValidateMintCap checks that the mint cap is valid for the role.
+func ValidateMintCap(role Role, mintCap sdk.Int) error {
+	if mintCap.IsNil() || mintCap.IsZero() {
+		return nil
+	}
+	if mintCap.IsNegative() {
+		return sdkerrors.Wrap(ErrInvalidInput, "mint cap must not be negative")
+	}
+	if role != Role_minter {
+		return sdkerrors.Wrapf(ErrInvalidInput, "mint cap can't be set for the %s role", role)
+	}
+	return nil
+}
+
+// IsMintCapped returns true if the amount the account may mint is limited.
+func (g RoleGrant) IsMintCapped() bool {
+	return !g.MintCap.IsNil() && g.MintCap.IsPositive()
+}
+
+// Validate checks that the role grant is valid.
+func (g RoleGrant) Validate() error {
+	if _, _, err := DeconstructDenom(g.Denom); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(g.Account); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid account %s", g.Account)
+	}
+
+	if err := ValidateRole(g.Role); err != nil {
+		return err
+	}
+
+	if err := ValidateMintCap(g.Role, g.MintCap); err != nil {
+		return err
+	}
+
+	if g.Minted.IsNil() || g.Minted.IsZero() {
+		return nil
+	}
+
+	if g.Minted.IsNegative() {
+		return sdkerrors.Wrap(ErrInvalidInput, "minted amount must not be negative")
+	}
+
+	if g.Role != Role_minter {
+		return sdkerrors.Wrapf(ErrInvalidInput, "minted amount can't be set for the %s role", g.Role)
+	}
+
+	if g.IsMintCapped() && g.Minted.GT(g.MintCap) {
+		return sdkerrors.Wrap(ErrInvalidInput, "minted amount must not exceed the mint cap")
+	}
+
+	return nil
+}
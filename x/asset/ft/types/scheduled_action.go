@@ -0,0 +1,69 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gogo/protobuf/proto"
+
+	delaytypes "github.com/CoreumFoundation/coreum/v2/x/delay/types"
+)
+
+// ScheduledActionKeeper defines methods required to execute the scheduled actions.
+type ScheduledActionKeeper interface {
+	ExecuteScheduledMint(ctx sdk.Context, data *DelayedMint) error
+	ExecuteScheduledUnfreeze(ctx sdk.Context, data *DelayedUnfreeze) error
+}
+
+// NewScheduledMintHandler handles the scheduled mint.
+func NewScheduledMintHandler(keeper ScheduledActionKeeper) delaytypes.Handler {
+	return func(ctx sdk.Context, data proto.Message) error {
+		return keeper.ExecuteScheduledMint(ctx, data.(*DelayedMint))
+	}
+}
+
+// NewScheduledUnfreezeHandler handles the scheduled unfreeze.
+func NewScheduledUnfreezeHandler(keeper ScheduledActionKeeper) delaytypes.Handler {
+	return func(ctx sdk.Context, data proto.Message) error {
+		return keeper.ExecuteScheduledUnfreeze(ctx, data.(*DelayedUnfreeze))
+	}
+}
+
+// ScheduledActionDelayID returns the id of the delayed item executing the scheduled action.
+func ScheduledActionDelayID(id uint64) string {
+	return fmt.Sprintf("%s-scheduled-action-%d", ModuleName, id)
+}
+
+// Validate checks that the scheduled action is valid.
+func (a ScheduledAction) Validate() error {
+	if _, ok := ScheduledActionType_name[int32(a.Type)]; !ok {
+		return sdkerrors.Wrapf(ErrInvalidInput, "non-existing scheduled action type provided: %d", a.Type)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(a.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender %s", a.Sender)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(a.Account); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid account %s", a.Account)
+	}
+
+	if a.ExecutionTime.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidInput, "execution time must be set")
+	}
+
+	if _, _, err := DeconstructDenom(a.Coin.Denom); err != nil {
+		return err
+	}
+
+	if err := a.Coin.Validate(); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid coin: %s", err)
+	}
+
+	if !a.Coin.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalidInput, "amount must be positive")
+	}
+
+	return nil
+}
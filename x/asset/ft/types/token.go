@@ -34,6 +34,12 @@ const (
 	denomSeparator = "-"
 	// MaxPrecision used when issuing a token.
 	MaxPrecision = 20
+	// MaxDescriptionLength is the maximum length of the token description.
+	MaxDescriptionLength = 200
+	// MaxURILength is the maximum length of the token URI.
+	MaxURILength = 256
+	// MaxURIHashLength is the maximum length of the token URI hash.
+	MaxURIHashLength = 128
 )
 
 func init() {
@@ -52,6 +58,14 @@ type IssueSettings struct {
 	Features           []Feature
 	BurnRate           sdk.Dec
 	SendCommissionRate sdk.Dec
+	URI                string
+	URIHash            string
+	// SendCommissionRecipients receive the send commission instead of the admin if set.
+	SendCommissionRecipients []RateRecipient
+	// BurnRateRecipients receive the burn rate amount instead of burning it if set.
+	BurnRateRecipients []RateRecipient
+	// MaxSupply limits the supply of the token if set.
+	MaxSupply sdk.Int
 }
 
 // BuildDenom builds the denom string from the symbol and issuer address.
@@ -142,6 +156,23 @@ func ValidateSymbol(symbol string) error {
 	return nil
 }
 
+// ValidateMetadata checks the provided description, URI and URI hash are valid.
+func ValidateMetadata(description, uri, uriHash string) error {
+	if len(description) > MaxDescriptionLength {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid description %q, the length must be less than %d", description, MaxDescriptionLength)
+	}
+
+	if len(uri) > MaxURILength {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid URI %q, the length must be less than or equal %d", uri, MaxURILength)
+	}
+
+	if len(uriHash) > MaxURIHashLength {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid URI hash %q, the length must be less than or equal %d", uriHash, MaxURIHashLength)
+	}
+
+	return nil
+}
+
 // NormalizeSymbolForKey normalizes the symbol string.
 func NormalizeSymbolForKey(in string) string {
 	return strings.ToLower(in)
@@ -163,12 +194,12 @@ func (def Definition) CheckFeatureAllowed(addr sdk.AccAddress, feature Feature)
 // IsFeatureAllowed returns true if feature is allowed for the address.
 func (def Definition) IsFeatureAllowed(addr sdk.Address, feature Feature) bool {
 	featureEnabled := def.IsFeatureEnabled(feature)
-	// issuer can use any enabled feature and burning even if it is disabled
-	if def.IsIssuer(addr) {
+	// admin can use any enabled feature and burning even if it is disabled
+	if def.IsAdmin(addr) {
 		return featureEnabled || feature == Feature_burning
 	}
 
-	// non-issuer can use only burning and only if it is enabled
+	// non-admin can use only burning and only if it is enabled
 	return featureEnabled && feature == Feature_burning
 }
 
@@ -182,6 +213,30 @@ func (def Definition) IsIssuer(addr sdk.Address) bool {
 	return def.Issuer == addr.String()
 }
 
+// IsAdmin returns true if the addr is the admin.
+func (def Definition) IsAdmin(addr sdk.Address) bool {
+	return def.Admin != "" && def.Admin == addr.String()
+}
+
+// IsSupplyCapped returns true if the supply of the token is limited by the max supply.
+func (def Definition) IsSupplyCapped() bool {
+	return !def.MaxSupply.IsNil() && def.MaxSupply.IsPositive()
+}
+
+// ValidateMaxSupply checks that the max supply is valid and the initial amount doesn't exceed it.
+func ValidateMaxSupply(maxSupply, initialAmount sdk.Int) error {
+	if maxSupply.IsNil() || maxSupply.IsZero() {
+		return nil
+	}
+	if maxSupply.IsNegative() {
+		return sdkerrors.Wrap(ErrInvalidInput, "max supply must not be negative")
+	}
+	if !initialAmount.IsNil() && initialAmount.GT(maxSupply) {
+		return sdkerrors.Wrapf(ErrInvalidInput, "initial amount %s exceeds the max supply %s", initialAmount, maxSupply)
+	}
+	return nil
+}
+
 // ValidateFeatures verifies that provided features belong to the defined set.
 func ValidateFeatures(features []Feature) error {
 	present := map[Feature]struct{}{}
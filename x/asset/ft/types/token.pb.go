@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
@@ -32,11 +33,13 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Feature int32
 
 const (
-	Feature_minting      Feature = 0
-	Feature_burning      Feature = 1
-	Feature_freezing     Feature = 2
-	Feature_whitelisting Feature = 3
-	Feature_ibc          Feature = 4
+	Feature_minting           Feature = 0
+	Feature_burning           Feature = 1
+	Feature_freezing          Feature = 2
+	Feature_whitelisting      Feature = 3
+	Feature_ibc               Feature = 4
+	Feature_clawback          Feature = 5
+	Feature_transfer_limiting Feature = 6
 )
 
 var Feature_name = map[int32]string{
@@ -45,14 +48,18 @@ var Feature_name = map[int32]string{
 	2: "freezing",
 	3: "whitelisting",
 	4: "ibc",
+	5: "clawback",
+	6: "transfer_limiting",
 }
 
 var Feature_value = map[string]int32{
-	"minting":      0,
-	"burning":      1,
-	"freezing":     2,
-	"whitelisting": 3,
-	"ibc":          4,
+	"minting":           0,
+	"burning":           1,
+	"freezing":          2,
+	"whitelisting":      3,
+	"ibc":               4,
+	"clawback":          5,
+	"transfer_limiting": 6,
 }
 
 func (x Feature) String() string {
@@ -63,6 +70,61 @@ func (Feature) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_fe80c7a2c55589e7, []int{0}
 }
 
+// Role defines the roles the admin might grant to other accounts to delegate the privileged operations on the token.
+type Role int32
+
+const (
+	Role_minter      Role = 0
+	Role_freezer     Role = 1
+	Role_whitelister Role = 2
+)
+
+var Role_name = map[int32]string{
+	0: "minter",
+	1: "freezer",
+	2: "whitelister",
+}
+
+var Role_value = map[string]int32{
+	"minter":      0,
+	"freezer":     1,
+	"whitelister": 2,
+}
+
+func (x Role) String() string {
+	return proto.EnumName(Role_name, int32(x))
+}
+
+func (Role) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fe80c7a2c55589e7, []int{1}
+}
+
+// ScheduledActionType defines the types of the actions which might be scheduled for the future execution.
+type ScheduledActionType int32
+
+const (
+	ScheduledActionType_mint     ScheduledActionType = 0
+	ScheduledActionType_unfreeze ScheduledActionType = 1
+)
+
+var ScheduledActionType_name = map[int32]string{
+	0: "mint",
+	1: "unfreeze",
+}
+
+var ScheduledActionType_value = map[string]int32{
+	"mint":     0,
+	"unfreeze": 1,
+}
+
+func (x ScheduledActionType) String() string {
+	return proto.EnumName(ScheduledActionType_name, int32(x))
+}
+
+func (ScheduledActionType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fe80c7a2c55589e7, []int{2}
+}
+
 // Definition defines the fungible token settings to store.
 type Definition struct {
 	Denom    string    `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
@@ -75,6 +137,21 @@ type Definition struct {
 	// amount sent to the token issuer account.
 	SendCommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=send_commission_rate,json=sendCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"send_commission_rate"`
 	Version            uint32                                 `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	// admin is the account allowed to perform the privileged operations on the token, it is empty if the admin
+	// has been cleared.
+	Admin   string `protobuf:"bytes,7,opt,name=admin,proto3" json:"admin,omitempty"`
+	URI     string `protobuf:"bytes,8,opt,name=uri,proto3" json:"uri,omitempty"`
+	URIHash string `protobuf:"bytes,9,opt,name=uri_hash,json=uriHash,proto3" json:"uri_hash,omitempty"`
+	// send_commission_recipients are the accounts receiving the send commission proportionally to their weights.
+	// If empty, the send commission is sent to the admin.
+	SendCommissionRecipients []RateRecipient `protobuf:"bytes,10,rep,name=send_commission_recipients,json=sendCommissionRecipients,proto3" json:"send_commission_recipients"`
+	// burn_rate_recipients are the accounts receiving the burn rate amount proportionally to their weights
+	// instead of burning it. If empty, the burn rate amount is burnt.
+	BurnRateRecipients []RateRecipient `protobuf:"bytes,11,rep,name=burn_rate_recipients,json=burnRateRecipients,proto3" json:"burn_rate_recipients"`
+	// max_supply is the maximum supply of the token, zero means there is no limit.
+	MaxSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,12,opt,name=max_supply,json=maxSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_supply"`
+	// supply_locked is true if the admin has locked the supply, so no more tokens might be minted.
+	SupplyLocked bool `protobuf:"varint,13,opt,name=supply_locked,json=supplyLocked,proto3" json:"supply_locked,omitempty"`
 }
 
 func (m *Definition) Reset()         { *m = Definition{} }
@@ -125,8 +202,15 @@ type Token struct {
 	BurnRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=burn_rate,json=burnRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"burn_rate"`
 	// send_commission_rate is a number between 0 and 1 which will be multiplied by send amount to determine
 	// amount sent to the token issuer account.
-	SendCommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=send_commission_rate,json=sendCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"send_commission_rate"`
-	Version            uint32                                 `protobuf:"varint,11,opt,name=version,proto3" json:"version,omitempty"`
+	SendCommissionRate       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=send_commission_rate,json=sendCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"send_commission_rate"`
+	Version                  uint32                                 `protobuf:"varint,11,opt,name=version,proto3" json:"version,omitempty"`
+	Admin                    string                                 `protobuf:"bytes,12,opt,name=admin,proto3" json:"admin,omitempty"`
+	URI                      string                                 `protobuf:"bytes,13,opt,name=uri,proto3" json:"uri,omitempty"`
+	URIHash                  string                                 `protobuf:"bytes,14,opt,name=uri_hash,json=uriHash,proto3" json:"uri_hash,omitempty"`
+	SendCommissionRecipients []RateRecipient                        `protobuf:"bytes,15,rep,name=send_commission_recipients,json=sendCommissionRecipients,proto3" json:"send_commission_recipients"`
+	BurnRateRecipients       []RateRecipient                        `protobuf:"bytes,16,rep,name=burn_rate_recipients,json=burnRateRecipients,proto3" json:"burn_rate_recipients"`
+	MaxSupply                github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,17,opt,name=max_supply,json=maxSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_supply"`
+	SupplyLocked             bool                                   `protobuf:"varint,18,opt,name=supply_locked,json=supplyLocked,proto3" json:"supply_locked,omitempty"`
 }
 
 func (m *Token) Reset()         { *m = Token{} }
@@ -162,23 +246,29 @@ func (m *Token) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Token proto.InternalMessageInfo
 
-// DelayedTokenUpgradeV1 is executed by the delay module when it's time to enable IBC.
-type DelayedTokenUpgradeV1 struct {
-	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+// RoleGrant defines the role granted to the account for the fungible token.
+type RoleGrant struct {
+	Denom   string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Role    Role   `protobuf:"varint,3,opt,name=role,proto3,enum=coreum.asset.ft.v1.Role" json:"role,omitempty"`
+	// mint_cap is the maximum amount the minter is allowed to mint, zero means there is no limit.
+	MintCap github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=mint_cap,json=mintCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"mint_cap"`
+	// minted is the amount minted by the minter since the role has been granted.
+	Minted github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=minted,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"minted"`
 }
 
-func (m *DelayedTokenUpgradeV1) Reset()         { *m = DelayedTokenUpgradeV1{} }
-func (m *DelayedTokenUpgradeV1) String() string { return proto.CompactTextString(m) }
-func (*DelayedTokenUpgradeV1) ProtoMessage()    {}
-func (*DelayedTokenUpgradeV1) Descriptor() ([]byte, []int) {
+func (m *RoleGrant) Reset()         { *m = RoleGrant{} }
+func (m *RoleGrant) String() string { return proto.CompactTextString(m) }
+func (*RoleGrant) ProtoMessage()    {}
+func (*RoleGrant) Descriptor() ([]byte, []int) {
 	return fileDescriptor_fe80c7a2c55589e7, []int{2}
 }
-func (m *DelayedTokenUpgradeV1) XXX_Unmarshal(b []byte) error {
+func (m *RoleGrant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DelayedTokenUpgradeV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RoleGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DelayedTokenUpgradeV1.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RoleGrant.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -188,44 +278,57 @@ func (m *DelayedTokenUpgradeV1) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *DelayedTokenUpgradeV1) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DelayedTokenUpgradeV1.Merge(m, src)
+func (m *RoleGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleGrant.Merge(m, src)
 }
-func (m *DelayedTokenUpgradeV1) XXX_Size() int {
+func (m *RoleGrant) XXX_Size() int {
 	return m.Size()
 }
-func (m *DelayedTokenUpgradeV1) XXX_DiscardUnknown() {
-	xxx_messageInfo_DelayedTokenUpgradeV1.DiscardUnknown(m)
+func (m *RoleGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleGrant.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DelayedTokenUpgradeV1 proto.InternalMessageInfo
+var xxx_messageInfo_RoleGrant proto.InternalMessageInfo
 
-func (m *DelayedTokenUpgradeV1) GetDenom() string {
+func (m *RoleGrant) GetDenom() string {
 	if m != nil {
 		return m.Denom
 	}
 	return ""
 }
 
-// TokenUpgradeV1Status defines the current status of the v1 token migration.
-type TokenUpgradeV1Status struct {
-	IbcEnabled bool      `protobuf:"varint,1,opt,name=ibc_enabled,json=ibcEnabled,proto3" json:"ibc_enabled,omitempty"`
-	StartTime  time.Time `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
-	EndTime    time.Time `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+func (m *RoleGrant) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
 }
 
-func (m *TokenUpgradeV1Status) Reset()         { *m = TokenUpgradeV1Status{} }
-func (m *TokenUpgradeV1Status) String() string { return proto.CompactTextString(m) }
-func (*TokenUpgradeV1Status) ProtoMessage()    {}
-func (*TokenUpgradeV1Status) Descriptor() ([]byte, []int) {
+func (m *RoleGrant) GetRole() Role {
+	if m != nil {
+		return m.Role
+	}
+	return Role_minter
+}
+
+// RateExemption defines the account exempted from the burn rate and the send commission of the fungible token.
+type RateExemption struct {
+	Denom   string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (m *RateExemption) Reset()         { *m = RateExemption{} }
+func (m *RateExemption) String() string { return proto.CompactTextString(m) }
+func (*RateExemption) ProtoMessage()    {}
+func (*RateExemption) Descriptor() ([]byte, []int) {
 	return fileDescriptor_fe80c7a2c55589e7, []int{3}
 }
-func (m *TokenUpgradeV1Status) XXX_Unmarshal(b []byte) error {
+func (m *RateExemption) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TokenUpgradeV1Status) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RateExemption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TokenUpgradeV1Status.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RateExemption.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -235,56 +338,51 @@ func (m *TokenUpgradeV1Status) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *TokenUpgradeV1Status) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TokenUpgradeV1Status.Merge(m, src)
+func (m *RateExemption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateExemption.Merge(m, src)
 }
-func (m *TokenUpgradeV1Status) XXX_Size() int {
+func (m *RateExemption) XXX_Size() int {
 	return m.Size()
 }
-func (m *TokenUpgradeV1Status) XXX_DiscardUnknown() {
-	xxx_messageInfo_TokenUpgradeV1Status.DiscardUnknown(m)
+func (m *RateExemption) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateExemption.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TokenUpgradeV1Status proto.InternalMessageInfo
-
-func (m *TokenUpgradeV1Status) GetIbcEnabled() bool {
-	if m != nil {
-		return m.IbcEnabled
-	}
-	return false
-}
+var xxx_messageInfo_RateExemption proto.InternalMessageInfo
 
-func (m *TokenUpgradeV1Status) GetStartTime() time.Time {
+func (m *RateExemption) GetDenom() string {
 	if m != nil {
-		return m.StartTime
+		return m.Denom
 	}
-	return time.Time{}
+	return ""
 }
 
-func (m *TokenUpgradeV1Status) GetEndTime() time.Time {
+func (m *RateExemption) GetAccount() string {
 	if m != nil {
-		return m.EndTime
+		return m.Account
 	}
-	return time.Time{}
+	return ""
 }
 
-// TokenUpgradeStatuses defines all statuses of the token migrations.
-type TokenUpgradeStatuses struct {
-	V1 *TokenUpgradeV1Status `protobuf:"bytes,1,opt,name=v1,proto3" json:"v1,omitempty"`
+// RateRecipient defines the account receiving the part of the burn rate or the send commission amount
+// proportional to its weight.
+type RateRecipient struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Weight  uint32 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
 }
 
-func (m *TokenUpgradeStatuses) Reset()         { *m = TokenUpgradeStatuses{} }
-func (m *TokenUpgradeStatuses) String() string { return proto.CompactTextString(m) }
-func (*TokenUpgradeStatuses) ProtoMessage()    {}
-func (*TokenUpgradeStatuses) Descriptor() ([]byte, []int) {
+func (m *RateRecipient) Reset()         { *m = RateRecipient{} }
+func (m *RateRecipient) String() string { return proto.CompactTextString(m) }
+func (*RateRecipient) ProtoMessage()    {}
+func (*RateRecipient) Descriptor() ([]byte, []int) {
 	return fileDescriptor_fe80c7a2c55589e7, []int{4}
 }
-func (m *TokenUpgradeStatuses) XXX_Unmarshal(b []byte) error {
+func (m *RateRecipient) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TokenUpgradeStatuses) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RateRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TokenUpgradeStatuses.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RateRecipient.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -294,532 +392,3560 @@ func (m *TokenUpgradeStatuses) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *TokenUpgradeStatuses) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TokenUpgradeStatuses.Merge(m, src)
+func (m *RateRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateRecipient.Merge(m, src)
 }
-func (m *TokenUpgradeStatuses) XXX_Size() int {
+func (m *RateRecipient) XXX_Size() int {
 	return m.Size()
 }
-func (m *TokenUpgradeStatuses) XXX_DiscardUnknown() {
-	xxx_messageInfo_TokenUpgradeStatuses.DiscardUnknown(m)
+func (m *RateRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateRecipient.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TokenUpgradeStatuses proto.InternalMessageInfo
+var xxx_messageInfo_RateRecipient proto.InternalMessageInfo
 
-func (m *TokenUpgradeStatuses) GetV1() *TokenUpgradeV1Status {
+func (m *RateRecipient) GetAddress() string {
 	if m != nil {
-		return m.V1
+		return m.Address
 	}
-	return nil
+	return ""
 }
 
-func init() {
-	proto.RegisterEnum("coreum.asset.ft.v1.Feature", Feature_name, Feature_value)
-	proto.RegisterType((*Definition)(nil), "coreum.asset.ft.v1.Definition")
-	proto.RegisterType((*Token)(nil), "coreum.asset.ft.v1.Token")
-	proto.RegisterType((*DelayedTokenUpgradeV1)(nil), "coreum.asset.ft.v1.DelayedTokenUpgradeV1")
-	proto.RegisterType((*TokenUpgradeV1Status)(nil), "coreum.asset.ft.v1.TokenUpgradeV1Status")
-	proto.RegisterType((*TokenUpgradeStatuses)(nil), "coreum.asset.ft.v1.TokenUpgradeStatuses")
+func (m *RateRecipient) GetWeight() uint32 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("coreum/asset/ft/v1/token.proto", fileDescriptor_fe80c7a2c55589e7) }
+// TransferLimits defines the limits of the amounts of the fungible token sent by the accounts. Zero means no limit.
+type TransferLimits struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// max_transfer_amount is the maximum amount sent by the account at once.
+	MaxTransferAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=max_transfer_amount,json=maxTransferAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_transfer_amount"`
+	// max_account_daily_amount is the maximum amount sent by the account within the rolling 24 hours window.
+	MaxAccountDailyAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=max_account_daily_amount,json=maxAccountDailyAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_account_daily_amount"`
+	// max_token_daily_amount is the maximum amount sent by all the accounts within the rolling 24 hours window.
+	MaxTokenDailyAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=max_token_daily_amount,json=maxTokenDailyAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_token_daily_amount"`
+}
 
-var fileDescriptor_fe80c7a2c55589e7 = []byte{
-	// 660 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0x8d, 0x93, 0x26, 0x71, 0x6e, 0xfa, 0xf5, 0xab, 0x46, 0xf9, 0x2a, 0xab, 0x1f, 0xb2, 0xa3,
-	0x2c, 0x20, 0x42, 0xaa, 0xad, 0x04, 0x09, 0x10, 0x1b, 0xa4, 0xb6, 0x74, 0x03, 0x8b, 0xca, 0x14,
-	0x16, 0x6c, 0x82, 0x7f, 0xae, 0xdd, 0x51, 0x6d, 0x4f, 0xe4, 0x19, 0x07, 0xd2, 0x27, 0x60, 0xd9,
-	0x47, 0xe8, 0x8b, 0xb0, 0x45, 0x5d, 0x76, 0x89, 0x10, 0x2a, 0xa8, 0xdd, 0xf0, 0x18, 0x68, 0xc6,
-	0x49, 0x7f, 0x44, 0x59, 0xb4, 0x52, 0x57, 0xc9, 0x39, 0x73, 0x7d, 0x7c, 0x7c, 0xcf, 0xbd, 0x03,
-	0x66, 0xc0, 0x72, 0x2c, 0x52, 0xc7, 0xe3, 0x1c, 0x85, 0x13, 0x09, 0x67, 0x32, 0x70, 0x04, 0xdb,
-	0xc3, 0xcc, 0x1e, 0xe7, 0x4c, 0x30, 0x42, 0xca, 0x73, 0x5b, 0x9d, 0xdb, 0x91, 0xb0, 0x27, 0x83,
-	0xd5, 0x4e, 0xcc, 0x62, 0xa6, 0x8e, 0x1d, 0xf9, 0xaf, 0xac, 0x5c, 0xb5, 0x62, 0xc6, 0xe2, 0x04,
-	0x1d, 0x85, 0xfc, 0x22, 0x72, 0x04, 0x4d, 0x91, 0x0b, 0x2f, 0x1d, 0x97, 0x05, 0xbd, 0x2f, 0x55,
-	0x80, 0x4d, 0x8c, 0x68, 0x46, 0x05, 0x65, 0x19, 0xe9, 0x40, 0x3d, 0xc4, 0x8c, 0xa5, 0x86, 0xd6,
-	0xd5, 0xfa, 0x2d, 0xb7, 0x04, 0x64, 0x05, 0x1a, 0x94, 0xf3, 0x02, 0x73, 0xa3, 0xaa, 0xe8, 0x19,
-	0x22, 0x4f, 0x40, 0x8f, 0xd0, 0x13, 0x45, 0x8e, 0xdc, 0xa8, 0x75, 0x6b, 0xfd, 0xa5, 0xe1, 0xff,
-	0xf6, 0x9f, 0xd6, 0xec, 0xad, 0xb2, 0xc6, 0x3d, 0x2f, 0x26, 0x2f, 0xa1, 0xe5, 0x17, 0x79, 0x36,
-	0xca, 0x3d, 0x81, 0xc6, 0x82, 0xd4, 0x5c, 0xb7, 0x8f, 0x4e, 0xac, 0xca, 0xb7, 0x13, 0xeb, 0x7e,
-	0x4c, 0xc5, 0x6e, 0xe1, 0xdb, 0x01, 0x4b, 0x9d, 0x80, 0xf1, 0x94, 0xf1, 0xd9, 0xcf, 0x1a, 0x0f,
-	0xf7, 0x1c, 0x31, 0x1d, 0x23, 0xb7, 0x37, 0x31, 0x70, 0x75, 0x29, 0xe0, 0x7a, 0x02, 0xc9, 0x7b,
-	0xe8, 0x70, 0xcc, 0xc2, 0x51, 0xc0, 0xd2, 0x94, 0x72, 0x4e, 0xd9, 0x4c, 0xb7, 0x7e, 0x2b, 0x5d,
-	0x22, 0xb5, 0x36, 0xce, 0xa5, 0xd4, 0x1b, 0x0c, 0x68, 0x4e, 0x30, 0x97, 0xd0, 0x68, 0x74, 0xb5,
-	0xfe, 0x3f, 0xee, 0x1c, 0x3e, 0xd3, 0x3f, 0x1d, 0x5a, 0x95, 0x5f, 0x87, 0x56, 0xa5, 0xf7, 0xbd,
-	0x06, 0xf5, 0x1d, 0x99, 0xd1, 0x0d, 0x7b, 0xb8, 0x02, 0x0d, 0x3e, 0x4d, 0x7d, 0x96, 0x18, 0xb5,
-	0x92, 0x2f, 0x91, 0x7c, 0x27, 0x2f, 0xfc, 0x22, 0xa3, 0xa2, 0x6c, 0x90, 0x3b, 0x87, 0xe4, 0x1e,
-	0xb4, 0xc6, 0x39, 0x06, 0x54, 0xf9, 0xa9, 0x2b, 0x3f, 0x17, 0x04, 0xe9, 0x42, 0x3b, 0x44, 0x1e,
-	0xe4, 0x74, 0x2c, 0xe6, 0x7e, 0x5b, 0xee, 0x65, 0x8a, 0x3c, 0x80, 0x7f, 0xe3, 0x84, 0xf9, 0x5e,
-	0x92, 0x4c, 0x47, 0x51, 0xce, 0xf6, 0x31, 0x33, 0x9a, 0x5d, 0xad, 0xaf, 0xbb, 0x4b, 0x73, 0x7a,
-	0x4b, 0xb1, 0x57, 0xe2, 0xd5, 0x6f, 0x1d, 0x6f, 0xeb, 0x8e, 0xe2, 0x85, 0xbb, 0x88, 0xb7, 0xfd,
-	0xb7, 0x78, 0xd7, 0xe0, 0xbf, 0x4d, 0x4c, 0xbc, 0x29, 0x86, 0x2a, 0xe4, 0x37, 0xe3, 0x38, 0xf7,
-	0x42, 0x7c, 0x3b, 0xb8, 0x3e, 0xed, 0xde, 0x67, 0x0d, 0x3a, 0x57, 0x0b, 0x5f, 0x0b, 0x4f, 0x14,
-	0x9c, 0x58, 0xd0, 0xa6, 0x7e, 0x30, 0xc2, 0xcc, 0xf3, 0x13, 0x0c, 0xd5, 0x43, 0xba, 0x0b, 0xd4,
-	0x0f, 0x5e, 0x94, 0x0c, 0xd9, 0x00, 0xe0, 0xc2, 0xcb, 0xc5, 0x48, 0x6e, 0xaa, 0x9a, 0x95, 0xf6,
-	0x70, 0xd5, 0x2e, 0xd7, 0xd8, 0x9e, 0xaf, 0xb1, 0xbd, 0x33, 0x5f, 0xe3, 0x75, 0x5d, 0x36, 0xe0,
-	0xe0, 0x87, 0xa5, 0xb9, 0x2d, 0xf5, 0x9c, 0x3c, 0x21, 0xcf, 0x41, 0x97, 0x2d, 0x53, 0x12, 0xb5,
-	0x1b, 0x48, 0x34, 0x31, 0x0b, 0x25, 0xdf, 0xdb, 0xbe, 0x6a, 0xbf, 0x34, 0x8f, 0x9c, 0x3c, 0x85,
-	0xea, 0x64, 0xa0, 0x5c, 0xb7, 0x87, 0xfd, 0xeb, 0x86, 0xe1, 0xba, 0x8f, 0x76, 0xab, 0x93, 0xc1,
-	0xc3, 0x57, 0xd0, 0x9c, 0x0d, 0x0a, 0x69, 0x43, 0x33, 0xa5, 0x99, 0xa0, 0x59, 0xbc, 0x5c, 0x91,
-	0x40, 0x46, 0x2d, 0x81, 0x46, 0x16, 0x41, 0x8f, 0x72, 0xc4, 0x7d, 0x89, 0xaa, 0x64, 0x19, 0x16,
-	0x3f, 0xec, 0x52, 0x81, 0x09, 0xe5, 0xaa, 0xb8, 0x46, 0x9a, 0x50, 0xa3, 0x7e, 0xb0, 0xbc, 0xb0,
-	0xbe, 0x7d, 0x74, 0x6a, 0x6a, 0xc7, 0xa7, 0xa6, 0xf6, 0xf3, 0xd4, 0xd4, 0x0e, 0xce, 0xcc, 0xca,
-	0xf1, 0x99, 0x59, 0xf9, 0x7a, 0x66, 0x56, 0xde, 0x3d, 0xbe, 0x34, 0x08, 0x1b, 0xca, 0xdf, 0x16,
-	0x2b, 0xb2, 0xd0, 0x93, 0xa3, 0xef, 0xcc, 0xee, 0xd5, 0xc9, 0xd0, 0xf9, 0x78, 0x71, 0xb9, 0xaa,
-	0xe1, 0xf0, 0x1b, 0xaa, 0x31, 0x8f, 0x7e, 0x07, 0x00, 0x00, 0xff, 0xff, 0x85, 0x09, 0xdc, 0xbd,
-	0x7c, 0x05, 0x00, 0x00,
+func (m *TransferLimits) Reset()         { *m = TransferLimits{} }
+func (m *TransferLimits) String() string { return proto.CompactTextString(m) }
+func (*TransferLimits) ProtoMessage()    {}
+func (*TransferLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe80c7a2c55589e7, []int{5}
+}
+func (m *TransferLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferLimits.Merge(m, src)
+}
+func (m *TransferLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferLimits.DiscardUnknown(m)
 }
 
-func (m *Definition) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TransferLimits proto.InternalMessageInfo
+
+func (m *TransferLimits) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Definition) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// TransferVolume defines the amounts of the fungible token sent within the rolling window, grouped by hour.
+// The account is empty for the volume sent by all the accounts.
+type TransferVolume struct {
+	Denom   string                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Account string                 `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Buckets []TransferVolumeBucket `protobuf:"bytes,3,rep,name=buckets,proto3" json:"buckets"`
 }
 
-func (m *Definition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Version != 0 {
-		i = encodeVarintToken(dAtA, i, uint64(m.Version))
-		i--
-		dAtA[i] = 0x30
-	}
-	{
-		size := m.SendCommissionRate.Size()
-		i -= size
-		if _, err := m.SendCommissionRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintToken(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.BurnRate.Size()
-		i -= size
-		if _, err := m.BurnRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintToken(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.Features) > 0 {
-		dAtA2 := make([]byte, len(m.Features)*10)
-		var j1 int
-		for _, num := range m.Features {
-			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j1++
-			}
-			dAtA2[j1] = uint8(num)
-			j1++
+func (m *TransferVolume) Reset()         { *m = TransferVolume{} }
+func (m *TransferVolume) String() string { return proto.CompactTextString(m) }
+func (*TransferVolume) ProtoMessage()    {}
+func (*TransferVolume) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe80c7a2c55589e7, []int{6}
+}
+func (m *TransferVolume) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferVolume) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferVolume.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintToken(dAtA, i, uint64(j1))
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if len(m.Issuer) > 0 {
-		i -= len(m.Issuer)
-		copy(dAtA[i:], m.Issuer)
-		i = encodeVarintToken(dAtA, i, uint64(len(m.Issuer)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *TransferVolume) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferVolume.Merge(m, src)
+}
+func (m *TransferVolume) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferVolume) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferVolume.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferVolume proto.InternalMessageInfo
+
+func (m *TransferVolume) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintToken(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *TransferVolume) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Token) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TransferVolume) GetBuckets() []TransferVolumeBucket {
+	if m != nil {
+		return m.Buckets
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Token) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// TransferVolumeBucket defines the amount sent within the hour.
+type TransferVolumeBucket struct {
+	// hour is the number of full hours elapsed since the Unix epoch.
+	Hour   int64                                  `protobuf:"varint,1,opt,name=hour,proto3" json:"hour,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
 }
 
-func (m *Token) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Version != 0 {
-		i = encodeVarintToken(dAtA, i, uint64(m.Version))
-		i--
-		dAtA[i] = 0x58
-	}
-	{
-		size := m.SendCommissionRate.Size()
-		i -= size
-		if _, err := m.SendCommissionRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintToken(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x52
-	{
-		size := m.BurnRate.Size()
-		i -= size
-		if _, err := m.BurnRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *TransferVolumeBucket) Reset()         { *m = TransferVolumeBucket{} }
+func (m *TransferVolumeBucket) String() string { return proto.CompactTextString(m) }
+func (*TransferVolumeBucket) ProtoMessage()    {}
+func (*TransferVolumeBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe80c7a2c55589e7, []int{7}
+}
+func (m *TransferVolumeBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferVolumeBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferVolumeBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintToken(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x4a
-	if len(m.Features) > 0 {
-		dAtA4 := make([]byte, len(m.Features)*10)
-		var j3 int
-		for _, num := range m.Features {
-			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j3++
-			}
-			dAtA4[j3] = uint8(num)
-			j3++
-		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintToken(dAtA, i, uint64(j3))
-		i--
-		dAtA[i] = 0x42
+}
+func (m *TransferVolumeBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferVolumeBucket.Merge(m, src)
+}
+func (m *TransferVolumeBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferVolumeBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferVolumeBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferVolumeBucket proto.InternalMessageInfo
+
+func (m *TransferVolumeBucket) GetHour() int64 {
+	if m != nil {
+		return m.Hour
 	}
-	if m.GloballyFrozen {
-		i--
-		if m.GloballyFrozen {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return 0
+}
+
+// DelayedTokenUpgradeV1 is executed by the delay module when it's time to enable IBC.
+type DelayedTokenUpgradeV1 struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *DelayedTokenUpgradeV1) Reset()         { *m = DelayedTokenUpgradeV1{} }
+func (m *DelayedTokenUpgradeV1) String() string { return proto.CompactTextString(m) }
+func (*DelayedTokenUpgradeV1) ProtoMessage()    {}
+func (*DelayedTokenUpgradeV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe80c7a2c55589e7, []int{8}
+}
+func (m *DelayedTokenUpgradeV1) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelayedTokenUpgradeV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelayedTokenUpgradeV1.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintToken(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Precision != 0 {
-		i = encodeVarintToken(dAtA, i, uint64(m.Precision))
-		i--
-		dAtA[i] = 0x28
+		return b[:n], nil
 	}
-	if len(m.Subunit) > 0 {
-		i -= len(m.Subunit)
-		copy(dAtA[i:], m.Subunit)
-		i = encodeVarintToken(dAtA, i, uint64(len(m.Subunit)))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *DelayedTokenUpgradeV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelayedTokenUpgradeV1.Merge(m, src)
+}
+func (m *DelayedTokenUpgradeV1) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelayedTokenUpgradeV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelayedTokenUpgradeV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelayedTokenUpgradeV1 proto.InternalMessageInfo
+
+func (m *DelayedTokenUpgradeV1) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if len(m.Symbol) > 0 {
-		i -= len(m.Symbol)
-		copy(dAtA[i:], m.Symbol)
-		i = encodeVarintToken(dAtA, i, uint64(len(m.Symbol)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+// ScheduledAction defines the action scheduled by the admin to be executed by the delay module at the execution time.
+type ScheduledAction struct {
+	Id   uint64              `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type ScheduledActionType `protobuf:"varint,2,opt,name=type,proto3,enum=coreum.asset.ft.v1.ScheduledActionType" json:"type,omitempty"`
+	// sender is the account which scheduled the action.
+	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	// account is the account affected by the action, it receives the minted tokens or has its balance unfrozen.
+	Account       string     `protobuf:"bytes,4,opt,name=account,proto3" json:"account,omitempty"`
+	Coin          types.Coin `protobuf:"bytes,5,opt,name=coin,proto3" json:"coin"`
+	ExecutionTime time.Time  `protobuf:"bytes,6,opt,name=execution_time,json=executionTime,proto3,stdtime" json:"execution_time"`
+}
+
+func (m *ScheduledAction) Reset()         { *m = ScheduledAction{} }
+func (m *ScheduledAction) String() string { return proto.CompactTextString(m) }
+func (*ScheduledAction) ProtoMessage()    {}
+func (*ScheduledAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe80c7a2c55589e7, []int{9}
+}
+func (m *ScheduledAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Issuer) > 0 {
-		i -= len(m.Issuer)
-		copy(dAtA[i:], m.Issuer)
-		i = encodeVarintToken(dAtA, i, uint64(len(m.Issuer)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *ScheduledAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledAction.Merge(m, src)
+}
+func (m *ScheduledAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledAction proto.InternalMessageInfo
+
+func (m *ScheduledAction) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintToken(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *ScheduledAction) GetType() ScheduledActionType {
+	if m != nil {
+		return m.Type
 	}
-	return len(dAtA) - i, nil
+	return ScheduledActionType_mint
 }
 
-func (m *DelayedTokenUpgradeV1) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ScheduledAction) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *DelayedTokenUpgradeV1) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ScheduledAction) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
 }
 
-func (m *DelayedTokenUpgradeV1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintToken(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (m *ScheduledAction) GetCoin() types.Coin {
+	if m != nil {
+		return m.Coin
 	}
-	return len(dAtA) - i, nil
+	return types.Coin{}
 }
 
-func (m *TokenUpgradeV1Status) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ScheduledAction) GetExecutionTime() time.Time {
+	if m != nil {
+		return m.ExecutionTime
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *TokenUpgradeV1Status) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// DelayedMint is executed by the delay module when it's time to mint the scheduled tokens.
+type DelayedMint struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *TokenUpgradeV1Status) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
-	if err5 != nil {
-		return 0, err5
-	}
-	i -= n5
-	i = encodeVarintToken(dAtA, i, uint64(n5))
-	i--
-	dAtA[i] = 0x1a
-	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
-	if err6 != nil {
-		return 0, err6
-	}
-	i -= n6
-	i = encodeVarintToken(dAtA, i, uint64(n6))
-	i--
-	dAtA[i] = 0x12
-	if m.IbcEnabled {
-		i--
-		if m.IbcEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *DelayedMint) Reset()         { *m = DelayedMint{} }
+func (m *DelayedMint) String() string { return proto.CompactTextString(m) }
+func (*DelayedMint) ProtoMessage()    {}
+func (*DelayedMint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe80c7a2c55589e7, []int{10}
+}
+func (m *DelayedMint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelayedMint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelayedMint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *DelayedMint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelayedMint.Merge(m, src)
+}
+func (m *DelayedMint) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelayedMint) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelayedMint.DiscardUnknown(m)
 }
 
-func (m *TokenUpgradeStatuses) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DelayedMint proto.InternalMessageInfo
+
+func (m *DelayedMint) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *TokenUpgradeStatuses) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// DelayedUnfreeze is executed by the delay module when it's time to unfreeze the scheduled amount.
+type DelayedUnfreeze struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *TokenUpgradeStatuses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.V1 != nil {
-		{
-			size, err := m.V1.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintToken(dAtA, i, uint64(size))
+func (m *DelayedUnfreeze) Reset()         { *m = DelayedUnfreeze{} }
+func (m *DelayedUnfreeze) String() string { return proto.CompactTextString(m) }
+func (*DelayedUnfreeze) ProtoMessage()    {}
+func (*DelayedUnfreeze) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe80c7a2c55589e7, []int{11}
+}
+func (m *DelayedUnfreeze) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelayedUnfreeze) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelayedUnfreeze.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *DelayedUnfreeze) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelayedUnfreeze.Merge(m, src)
+}
+func (m *DelayedUnfreeze) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelayedUnfreeze) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelayedUnfreeze.DiscardUnknown(m)
 }
 
-func encodeVarintToken(dAtA []byte, offset int, v uint64) int {
-	offset -= sovToken(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_DelayedUnfreeze proto.InternalMessageInfo
+
+func (m *DelayedUnfreeze) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *Definition) Size() (n int) {
-	if m == nil {
-		return 0
+
+// TokenUpgradeV1Status defines the current status of the v1 token migration.
+type TokenUpgradeV1Status struct {
+	IbcEnabled bool      `protobuf:"varint,1,opt,name=ibc_enabled,json=ibcEnabled,proto3" json:"ibc_enabled,omitempty"`
+	StartTime  time.Time `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	EndTime    time.Time `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+}
+
+func (m *TokenUpgradeV1Status) Reset()         { *m = TokenUpgradeV1Status{} }
+func (m *TokenUpgradeV1Status) String() string { return proto.CompactTextString(m) }
+func (*TokenUpgradeV1Status) ProtoMessage()    {}
+func (*TokenUpgradeV1Status) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe80c7a2c55589e7, []int{12}
+}
+func (m *TokenUpgradeV1Status) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenUpgradeV1Status) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenUpgradeV1Status.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovToken(uint64(l))
+}
+func (m *TokenUpgradeV1Status) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenUpgradeV1Status.Merge(m, src)
+}
+func (m *TokenUpgradeV1Status) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenUpgradeV1Status) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenUpgradeV1Status.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenUpgradeV1Status proto.InternalMessageInfo
+
+func (m *TokenUpgradeV1Status) GetIbcEnabled() bool {
+	if m != nil {
+		return m.IbcEnabled
 	}
-	l = len(m.Issuer)
-	if l > 0 {
-		n += 1 + l + sovToken(uint64(l))
+	return false
+}
+
+func (m *TokenUpgradeV1Status) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
 	}
-	if len(m.Features) > 0 {
-		l = 0
-		for _, e := range m.Features {
-			l += sovToken(uint64(e))
+	return time.Time{}
+}
+
+func (m *TokenUpgradeV1Status) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+// TokenUpgradeStatuses defines all statuses of the token migrations.
+type TokenUpgradeStatuses struct {
+	V1 *TokenUpgradeV1Status `protobuf:"bytes,1,opt,name=v1,proto3" json:"v1,omitempty"`
+}
+
+func (m *TokenUpgradeStatuses) Reset()         { *m = TokenUpgradeStatuses{} }
+func (m *TokenUpgradeStatuses) String() string { return proto.CompactTextString(m) }
+func (*TokenUpgradeStatuses) ProtoMessage()    {}
+func (*TokenUpgradeStatuses) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe80c7a2c55589e7, []int{13}
+}
+func (m *TokenUpgradeStatuses) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenUpgradeStatuses) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenUpgradeStatuses.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		n += 1 + sovToken(uint64(l)) + l
+		return b[:n], nil
 	}
-	l = m.BurnRate.Size()
-	n += 1 + l + sovToken(uint64(l))
-	l = m.SendCommissionRate.Size()
-	n += 1 + l + sovToken(uint64(l))
-	if m.Version != 0 {
-		n += 1 + sovToken(uint64(m.Version))
+}
+func (m *TokenUpgradeStatuses) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenUpgradeStatuses.Merge(m, src)
+}
+func (m *TokenUpgradeStatuses) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenUpgradeStatuses) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenUpgradeStatuses.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenUpgradeStatuses proto.InternalMessageInfo
+
+func (m *TokenUpgradeStatuses) GetV1() *TokenUpgradeV1Status {
+	if m != nil {
+		return m.V1
 	}
-	return n
+	return nil
 }
 
-func (m *Token) Size() (n int) {
-	if m == nil {
-		return 0
+func init() {
+	proto.RegisterEnum("coreum.asset.ft.v1.Feature", Feature_name, Feature_value)
+	proto.RegisterEnum("coreum.asset.ft.v1.Role", Role_name, Role_value)
+	proto.RegisterEnum("coreum.asset.ft.v1.ScheduledActionType", ScheduledActionType_name, ScheduledActionType_value)
+	proto.RegisterType((*Definition)(nil), "coreum.asset.ft.v1.Definition")
+	proto.RegisterType((*Token)(nil), "coreum.asset.ft.v1.Token")
+	proto.RegisterType((*RoleGrant)(nil), "coreum.asset.ft.v1.RoleGrant")
+	proto.RegisterType((*RateExemption)(nil), "coreum.asset.ft.v1.RateExemption")
+	proto.RegisterType((*RateRecipient)(nil), "coreum.asset.ft.v1.RateRecipient")
+	proto.RegisterType((*TransferLimits)(nil), "coreum.asset.ft.v1.TransferLimits")
+	proto.RegisterType((*TransferVolume)(nil), "coreum.asset.ft.v1.TransferVolume")
+	proto.RegisterType((*TransferVolumeBucket)(nil), "coreum.asset.ft.v1.TransferVolumeBucket")
+	proto.RegisterType((*DelayedTokenUpgradeV1)(nil), "coreum.asset.ft.v1.DelayedTokenUpgradeV1")
+	proto.RegisterType((*ScheduledAction)(nil), "coreum.asset.ft.v1.ScheduledAction")
+	proto.RegisterType((*DelayedMint)(nil), "coreum.asset.ft.v1.DelayedMint")
+	proto.RegisterType((*DelayedUnfreeze)(nil), "coreum.asset.ft.v1.DelayedUnfreeze")
+	proto.RegisterType((*TokenUpgradeV1Status)(nil), "coreum.asset.ft.v1.TokenUpgradeV1Status")
+	proto.RegisterType((*TokenUpgradeStatuses)(nil), "coreum.asset.ft.v1.TokenUpgradeStatuses")
+}
+
+func init() { proto.RegisterFile("coreum/asset/ft/v1/token.proto", fileDescriptor_fe80c7a2c55589e7) }
+
+var fileDescriptor_fe80c7a2c55589e7 = []byte{
+	// 1324 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6e, 0xdb, 0xc6,
+	0x13, 0x16, 0x25, 0xd9, 0xa2, 0x46, 0x96, 0xac, 0x6c, 0x9c, 0x80, 0xf1, 0xef, 0x57, 0xc9, 0x51,
+	0x81, 0xc4, 0x08, 0x1a, 0xb2, 0x52, 0x80, 0xb6, 0x68, 0x0f, 0x81, 0xff, 0xc4, 0x8d, 0x91, 0x04,
+	0x08, 0x18, 0x3b, 0x40, 0x7b, 0xa8, 0xba, 0x24, 0x57, 0xd2, 0xc2, 0x24, 0x57, 0xe5, 0x2e, 0x15,
+	0x29, 0x4f, 0x50, 0xf4, 0x14, 0xf4, 0x09, 0xf2, 0x22, 0xbd, 0xe7, 0x98, 0x43, 0x0b, 0x14, 0x3d,
+	0xb8, 0x85, 0x73, 0x29, 0xd0, 0x57, 0xe8, 0xa1, 0xd8, 0x25, 0x25, 0x5b, 0x89, 0x9c, 0x42, 0x6a,
+	0x72, 0x12, 0x67, 0x77, 0xf6, 0x9b, 0x99, 0x6f, 0xe7, 0xdb, 0x5d, 0x41, 0xcd, 0x65, 0x11, 0x89,
+	0x03, 0x0b, 0x73, 0x4e, 0x84, 0xd5, 0x11, 0xd6, 0xa0, 0x69, 0x09, 0x76, 0x44, 0x42, 0xb3, 0x1f,
+	0x31, 0xc1, 0x10, 0x4a, 0xe6, 0x4d, 0x35, 0x6f, 0x76, 0x84, 0x39, 0x68, 0xae, 0xaf, 0x75, 0x59,
+	0x97, 0xa9, 0x69, 0x4b, 0x7e, 0x25, 0x9e, 0xeb, 0xf5, 0x2e, 0x63, 0x5d, 0x9f, 0x58, 0xca, 0x72,
+	0xe2, 0x8e, 0x25, 0x68, 0x40, 0xb8, 0xc0, 0x41, 0x3f, 0x75, 0xa8, 0xb9, 0x8c, 0x07, 0x8c, 0x5b,
+	0x0e, 0xe6, 0xc4, 0x1a, 0x34, 0x1d, 0x22, 0x70, 0xd3, 0x72, 0x19, 0x4d, 0x43, 0x35, 0x7e, 0x59,
+	0x02, 0xd8, 0x25, 0x1d, 0x1a, 0x52, 0x41, 0x59, 0x88, 0xd6, 0x60, 0xc9, 0x23, 0x21, 0x0b, 0x0c,
+	0x6d, 0x43, 0xdb, 0x2c, 0xda, 0x89, 0x81, 0x2e, 0xc3, 0x32, 0xe5, 0x3c, 0x26, 0x91, 0x91, 0x55,
+	0xc3, 0xa9, 0x85, 0x3e, 0x05, 0xbd, 0x43, 0xb0, 0x88, 0x23, 0xc2, 0x8d, 0xdc, 0x46, 0x6e, 0xb3,
+	0xd2, 0xfa, 0x9f, 0xf9, 0x66, 0xea, 0xe6, 0x5e, 0xe2, 0x63, 0x4f, 0x9c, 0xd1, 0x3d, 0x28, 0x3a,
+	0x71, 0x14, 0xb6, 0x23, 0x2c, 0x88, 0x91, 0x97, 0x98, 0xdb, 0xe6, 0x8b, 0xe3, 0x7a, 0xe6, 0xb7,
+	0xe3, 0xfa, 0xb5, 0x2e, 0x15, 0xbd, 0xd8, 0x31, 0x5d, 0x16, 0x58, 0x69, 0xee, 0xc9, 0xcf, 0x4d,
+	0xee, 0x1d, 0x59, 0x62, 0xd4, 0x27, 0xdc, 0xdc, 0x25, 0xae, 0xad, 0x4b, 0x00, 0x1b, 0x0b, 0x82,
+	0xbe, 0x85, 0x35, 0x4e, 0x42, 0xaf, 0xed, 0xb2, 0x20, 0xa0, 0x9c, 0x53, 0x96, 0xe2, 0x2e, 0x2d,
+	0x84, 0x8b, 0x24, 0xd6, 0xce, 0x04, 0x4a, 0x45, 0x30, 0xa0, 0x30, 0x20, 0x91, 0x34, 0x8d, 0xe5,
+	0x0d, 0x6d, 0xb3, 0x6c, 0x8f, 0x4d, 0xc9, 0x17, 0xf6, 0x02, 0x1a, 0x1a, 0x85, 0x84, 0x2f, 0x65,
+	0xa0, 0x2b, 0x90, 0x8b, 0x23, 0x6a, 0xe8, 0x2a, 0x81, 0xc2, 0xc9, 0x71, 0x3d, 0x77, 0x68, 0xef,
+	0xdb, 0x72, 0x0c, 0x5d, 0x03, 0x3d, 0x8e, 0x68, 0xbb, 0x87, 0x79, 0xcf, 0x28, 0xaa, 0xf9, 0xd2,
+	0xc9, 0x71, 0xbd, 0x70, 0x68, 0xef, 0xdf, 0xc5, 0xbc, 0x67, 0x17, 0xe2, 0x88, 0xca, 0x0f, 0x44,
+	0x60, 0xfd, 0x8d, 0xa2, 0x88, 0x4b, 0xfb, 0x94, 0x84, 0x82, 0x1b, 0xb0, 0x91, 0xdb, 0x2c, 0xb5,
+	0xae, 0xce, 0x22, 0x5b, 0x26, 0x6c, 0x8f, 0x3d, 0xb7, 0xf3, 0xb2, 0x7a, 0xdb, 0x78, 0xad, 0xa6,
+	0x09, 0x10, 0xfa, 0x0a, 0xd6, 0x26, 0x1b, 0x71, 0x36, 0x40, 0x69, 0xbe, 0x00, 0x68, 0xbc, 0x19,
+	0x67, 0xa0, 0x1f, 0x00, 0x04, 0x78, 0xd8, 0xe6, 0x71, 0xbf, 0xef, 0x8f, 0x8c, 0x95, 0xb9, 0x37,
+	0x63, 0x3f, 0x14, 0x76, 0x31, 0xc0, 0xc3, 0x47, 0x0a, 0x00, 0x7d, 0x08, 0xe5, 0x04, 0xaa, 0xed,
+	0x33, 0xf7, 0x88, 0x78, 0x46, 0x79, 0x43, 0xdb, 0xd4, 0xed, 0x95, 0x64, 0xf0, 0xbe, 0x1a, 0xfb,
+	0x5c, 0xff, 0xfe, 0x79, 0x3d, 0xf3, 0xe7, 0xf3, 0x7a, 0xa6, 0xf1, 0xd7, 0x32, 0x2c, 0x1d, 0x48,
+	0x49, 0xcd, 0xd9, 0xd2, 0x97, 0x61, 0x99, 0x8f, 0x02, 0x87, 0xf9, 0x46, 0x2e, 0x19, 0x4f, 0x2c,
+	0xd9, 0x02, 0x3c, 0x76, 0xe2, 0x90, 0x8a, 0xa4, 0x5f, 0xed, 0xb1, 0x89, 0xfe, 0x0f, 0xc5, 0xbe,
+	0x64, 0x4e, 0xb5, 0xc7, 0x92, 0x6a, 0x8f, 0xd3, 0x01, 0xb4, 0x01, 0x25, 0x8f, 0x70, 0x37, 0xa2,
+	0x7d, 0x31, 0x6e, 0x9f, 0xa2, 0x7d, 0x76, 0x08, 0x5d, 0x87, 0xd5, 0xae, 0xcf, 0x1c, 0xec, 0xfb,
+	0xa3, 0x76, 0x27, 0x62, 0x4f, 0x49, 0xd2, 0x4c, 0xba, 0x5d, 0x19, 0x0f, 0xef, 0xa9, 0xd1, 0x29,
+	0xb5, 0xe9, 0x0b, 0xab, 0xad, 0xf8, 0x9e, 0xd4, 0x06, 0xef, 0x43, 0x6d, 0xa5, 0x73, 0xd4, 0xb6,
+	0x32, 0x43, 0x6d, 0xe5, 0x7f, 0x51, 0x5b, 0x65, 0x61, 0xb5, 0xad, 0xbe, 0x6f, 0xb5, 0x55, 0xdf,
+	0xb5, 0xda, 0x2e, 0xbc, 0x73, 0xb5, 0xa1, 0xb7, 0xaa, 0xed, 0x6f, 0x0d, 0x8a, 0x36, 0xf3, 0xc9,
+	0x97, 0x11, 0x0e, 0xc5, 0x39, 0x8a, 0x33, 0xa0, 0x80, 0x5d, 0x97, 0xc5, 0xa1, 0x48, 0x25, 0x37,
+	0x36, 0xd1, 0x47, 0x90, 0x8f, 0x98, 0x4f, 0x94, 0xe2, 0x2a, 0x2d, 0x63, 0x26, 0x0d, 0xcc, 0x27,
+	0xb6, 0xf2, 0x42, 0xfb, 0xa0, 0x07, 0x34, 0x14, 0x6d, 0x17, 0xf7, 0x8d, 0xfc, 0x42, 0x75, 0x16,
+	0xe4, 0xfa, 0x1d, 0xdc, 0x47, 0x7b, 0xb0, 0x2c, 0x3f, 0x89, 0x67, 0x2c, 0x2d, 0x04, 0x94, 0xae,
+	0x6e, 0xdc, 0x86, 0xb2, 0xdc, 0x8e, 0x3b, 0x43, 0x12, 0xf4, 0xdf, 0x72, 0x8d, 0x9e, 0xcb, 0x40,
+	0x63, 0x0b, 0xca, 0x53, 0xfb, 0xa9, 0x5c, 0x3d, 0x2f, 0x22, 0x9c, 0xa7, 0x10, 0x63, 0x53, 0x1e,
+	0x50, 0x4f, 0x08, 0xed, 0xf6, 0x12, 0x8c, 0xb2, 0x9d, 0x5a, 0x8d, 0x9f, 0xb3, 0x50, 0x39, 0x88,
+	0x70, 0xc8, 0x3b, 0x24, 0xba, 0x4f, 0x03, 0x2a, 0xf8, 0x39, 0x59, 0x7c, 0x03, 0x17, 0x65, 0xa7,
+	0x88, 0xd4, 0xb7, 0x8d, 0x83, 0xd3, 0x8c, 0xe6, 0x66, 0xe0, 0x42, 0x80, 0x87, 0xe3, 0xa8, 0x5b,
+	0x0a, 0x08, 0x75, 0xc1, 0x90, 0xf8, 0x69, 0x69, 0x6d, 0x0f, 0x53, 0x7f, 0x34, 0x0e, 0x92, 0x5b,
+	0x28, 0xc8, 0xa5, 0x00, 0x0f, 0xb7, 0x12, 0xb8, 0x5d, 0x89, 0x96, 0x06, 0x72, 0xe1, 0xb2, 0x2a,
+	0x44, 0x9e, 0xf2, 0xd3, 0x61, 0x16, 0x6b, 0x0b, 0x49, 0x8b, 0xba, 0x32, 0xce, 0x04, 0x69, 0xfc,
+	0xa0, 0x9d, 0xd2, 0xfa, 0x98, 0xf9, 0x71, 0x40, 0xe6, 0x6e, 0xef, 0xbb, 0x50, 0x70, 0x62, 0xf7,
+	0x88, 0x88, 0xe4, 0x91, 0x54, 0x6a, 0x6d, 0xce, 0xea, 0xf0, 0xe9, 0x20, 0xdb, 0x6a, 0x41, 0xaa,
+	0xf7, 0xf1, 0xf2, 0x46, 0x04, 0x6b, 0xb3, 0xdc, 0x10, 0x82, 0x7c, 0x8f, 0xc5, 0x91, 0x4a, 0x28,
+	0x67, 0xab, 0x6f, 0xd9, 0xdb, 0xff, 0x69, 0x67, 0xd3, 0xd5, 0x8d, 0x9b, 0x70, 0x69, 0x97, 0xf8,
+	0x78, 0x44, 0x3c, 0xc5, 0xcd, 0x61, 0xbf, 0x1b, 0x61, 0x8f, 0x3c, 0x6e, 0xce, 0xa6, 0xa1, 0xf1,
+	0x63, 0x16, 0x56, 0x1f, 0xb9, 0x3d, 0xe2, 0xc5, 0x3e, 0xf1, 0xb6, 0x5c, 0xa5, 0x86, 0x0a, 0x64,
+	0xa9, 0xa7, 0xdc, 0xf2, 0x76, 0x96, 0x7a, 0xe8, 0x0b, 0xc8, 0xcb, 0x38, 0x2a, 0xb1, 0x4a, 0xeb,
+	0xfa, 0x2c, 0x36, 0x5e, 0x83, 0x38, 0x18, 0xf5, 0x89, 0xad, 0x16, 0xa9, 0x0b, 0x9a, 0x84, 0x1e,
+	0x89, 0x26, 0x17, 0xb4, 0xb2, 0xce, 0xf2, 0x9f, 0x9f, 0xe6, 0xff, 0x16, 0xe4, 0xe5, 0x83, 0x57,
+	0x69, 0xbc, 0xd4, 0xba, 0x62, 0x26, 0xe5, 0x9a, 0xf2, 0x45, 0x6c, 0xa6, 0x2f, 0x62, 0x73, 0x87,
+	0xd1, 0x30, 0x65, 0x5b, 0x39, 0xa3, 0x7b, 0x50, 0x21, 0x43, 0xe2, 0xc6, 0x32, 0x7a, 0x5b, 0x3e,
+	0xaa, 0xd5, 0xd5, 0x5d, 0x6a, 0xad, 0x9b, 0xc9, 0x8b, 0xdb, 0x1c, 0xbf, 0xb8, 0xcd, 0x83, 0xf1,
+	0x8b, 0x7b, 0x5b, 0x97, 0xeb, 0x9f, 0xfd, 0x5e, 0xd7, 0xec, 0xf2, 0x64, 0xad, 0x9c, 0x6d, 0x7c,
+	0x00, 0xa5, 0x94, 0xc3, 0x07, 0x34, 0x14, 0xaf, 0xf3, 0xd1, 0xb8, 0x0a, 0xab, 0xe9, 0xf4, 0x61,
+	0xd8, 0x89, 0x08, 0x79, 0x4a, 0xde, 0x70, 0xf9, 0x49, 0x83, 0xb5, 0x69, 0xfe, 0x1f, 0x09, 0x2c,
+	0x62, 0x8e, 0xea, 0x50, 0xa2, 0x8e, 0xdb, 0x26, 0x21, 0x76, 0x7c, 0x92, 0xac, 0xd0, 0x6d, 0xa0,
+	0x8e, 0x7b, 0x27, 0x19, 0x41, 0x3b, 0x00, 0x5c, 0xe0, 0x48, 0x24, 0x45, 0x64, 0xe7, 0x28, 0xa2,
+	0xa8, 0xd6, 0xc9, 0x19, 0x74, 0x1b, 0x74, 0x79, 0x3d, 0x2a, 0x88, 0xdc, 0x1c, 0x10, 0x05, 0x12,
+	0x7a, 0x8a, 0x81, 0x87, 0xd3, 0xe9, 0x27, 0xc9, 0x13, 0x8e, 0x3e, 0x83, 0xec, 0xa0, 0xa9, 0xb2,
+	0x3e, 0x4f, 0x16, 0x33, 0x8a, 0xb6, 0xb3, 0x83, 0xe6, 0x8d, 0xef, 0xa0, 0x90, 0xbe, 0x74, 0x50,
+	0x09, 0xd4, 0x89, 0x4e, 0xc3, 0x6e, 0x35, 0x23, 0x0d, 0x79, 0x3d, 0x4a, 0x43, 0x43, 0x2b, 0xa0,
+	0x2b, 0x42, 0xa5, 0x95, 0x45, 0x55, 0x58, 0x79, 0xd2, 0xa3, 0x82, 0xf8, 0x94, 0x2b, 0xe7, 0x1c,
+	0x2a, 0x40, 0x8e, 0x3a, 0x6e, 0x35, 0x2f, 0x1d, 0x5d, 0x1f, 0x3f, 0x71, 0xb0, 0x7b, 0x54, 0x5d,
+	0x42, 0x97, 0xe0, 0xc2, 0xe4, 0x78, 0xf4, 0xe5, 0x59, 0x2a, 0xbd, 0x97, 0x6f, 0x7c, 0x0c, 0x79,
+	0x79, 0x0f, 0x21, 0x48, 0xaf, 0x8d, 0x28, 0x09, 0x97, 0x6c, 0x59, 0x54, 0xd5, 0xd0, 0x2a, 0x94,
+	0x26, 0x01, 0x48, 0x54, 0xcd, 0xde, 0xb8, 0x09, 0x17, 0x67, 0x74, 0x32, 0xd2, 0x21, 0x2f, 0x01,
+	0xaa, 0x19, 0x19, 0x37, 0x4e, 0xf7, 0xbc, 0xaa, 0x6d, 0x3f, 0x7c, 0x71, 0x52, 0xd3, 0x5e, 0x9e,
+	0xd4, 0xb4, 0x3f, 0x4e, 0x6a, 0xda, 0xb3, 0x57, 0xb5, 0xcc, 0xcb, 0x57, 0xb5, 0xcc, 0xaf, 0xaf,
+	0x6a, 0x99, 0xaf, 0x3f, 0x39, 0xa3, 0xda, 0x1d, 0xc5, 0xd2, 0x1e, 0x8b, 0x43, 0x0f, 0x4b, 0x48,
+	0x2b, 0xfd, 0x37, 0x39, 0x68, 0x59, 0xc3, 0xd3, 0xbf, 0x94, 0x4a, 0xc9, 0xce, 0xb2, 0xda, 0x9e,
+	0x5b, 0xff, 0x0c, 0x00, 0xb1, 0x1a, 0xf1, 0x3f, 0x72, 0x0e, 0x00, 0x00,
+}
+
+func (m *Definition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Definition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Definition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovToken(uint64(l))
-	}
-	l = len(m.Issuer)
-	if l > 0 {
-		n += 1 + l + sovToken(uint64(l))
+	if m.SupplyLocked {
+		i--
+		if m.SupplyLocked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
 	}
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovToken(uint64(l))
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintToken(dAtA, i, uint64(size))
 	}
-	l = len(m.Subunit)
-	if l > 0 {
-		n += 1 + l + sovToken(uint64(l))
+	i--
+	dAtA[i] = 0x62
+	if len(m.BurnRateRecipients) > 0 {
+		for iNdEx := len(m.BurnRateRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BurnRateRecipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintToken(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
 	}
-	if m.Precision != 0 {
-		n += 1 + sovToken(uint64(m.Precision))
+	if len(m.SendCommissionRecipients) > 0 {
+		for iNdEx := len(m.SendCommissionRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SendCommissionRecipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintToken(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovToken(uint64(l))
+	if len(m.URIHash) > 0 {
+		i -= len(m.URIHash)
+		copy(dAtA[i:], m.URIHash)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.URIHash)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	if m.GloballyFrozen {
-		n += 2
+	if len(m.URI) > 0 {
+		i -= len(m.URI)
+		copy(dAtA[i:], m.URI)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.URI)))
+		i--
+		dAtA[i] = 0x42
 	}
-	if len(m.Features) > 0 {
-		l = 0
-		for _, e := range m.Features {
-			l += sovToken(uint64(e))
-		}
-		n += 1 + sovToken(uint64(l)) + l
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	l = m.BurnRate.Size()
-	n += 1 + l + sovToken(uint64(l))
-	l = m.SendCommissionRate.Size()
-	n += 1 + l + sovToken(uint64(l))
 	if m.Version != 0 {
-		n += 1 + sovToken(uint64(m.Version))
-	}
-	return n
-}
-
-func (m *DelayedTokenUpgradeV1) Size() (n int) {
-	if m == nil {
-		return 0
+		i = encodeVarintToken(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x30
 	}
-	var l int
+	{
+		size := m.SendCommissionRate.Size()
+		i -= size
+		if _, err := m.SendCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.BurnRate.Size()
+		i -= size
+		if _, err := m.BurnRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Features) > 0 {
+		dAtA2 := make([]byte, len(m.Features)*10)
+		var j1 int
+		for _, num := range m.Features {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintToken(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Token) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Token) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Token) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovToken(uint64(l))
+	if m.SupplyLocked {
+		i--
+		if m.SupplyLocked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
 	}
-	return n
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	if len(m.BurnRateRecipients) > 0 {
+		for iNdEx := len(m.BurnRateRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BurnRateRecipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintToken(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.SendCommissionRecipients) > 0 {
+		for iNdEx := len(m.SendCommissionRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SendCommissionRecipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintToken(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.URIHash) > 0 {
+		i -= len(m.URIHash)
+		copy(dAtA[i:], m.URIHash)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.URIHash)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.URI) > 0 {
+		i -= len(m.URI)
+		copy(dAtA[i:], m.URI)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.URI)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Version != 0 {
+		i = encodeVarintToken(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.SendCommissionRate.Size()
+		i -= size
+		if _, err := m.SendCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.BurnRate.Size()
+		i -= size
+		if _, err := m.BurnRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.Features) > 0 {
+		dAtA4 := make([]byte, len(m.Features)*10)
+		var j3 int
+		for _, num := range m.Features {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintToken(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.GloballyFrozen {
+		i--
+		if m.GloballyFrozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Precision != 0 {
+		i = encodeVarintToken(dAtA, i, uint64(m.Precision))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Subunit) > 0 {
+		i -= len(m.Subunit)
+		copy(dAtA[i:], m.Subunit)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Subunit)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *TokenUpgradeV1Status) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RoleGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RoleGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoleGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.IbcEnabled {
-		n += 2
+	{
+		size := m.Minted.Size()
+		i -= size
+		if _, err := m.Minted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MintCap.Size()
+		i -= size
+		if _, err := m.MintCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Role != 0 {
+		i = encodeVarintToken(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateExemption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateExemption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateExemption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Weight != 0 {
+		i = encodeVarintToken(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxTokenDailyAmount.Size()
+		i -= size
+		if _, err := m.MaxTokenDailyAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxAccountDailyAmount.Size()
+		i -= size
+		if _, err := m.MaxAccountDailyAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MaxTransferAmount.Size()
+		i -= size
+		if _, err := m.MaxTransferAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferVolume) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferVolume) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Buckets) > 0 {
+		for iNdEx := len(m.Buckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Buckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintToken(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferVolumeBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferVolumeBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferVolumeBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Hour != 0 {
+		i = encodeVarintToken(dAtA, i, uint64(m.Hour))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelayedTokenUpgradeV1) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelayedTokenUpgradeV1) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelayedTokenUpgradeV1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExecutionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ExecutionTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintToken(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Type != 0 {
+		i = encodeVarintToken(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintToken(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelayedMint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelayedMint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelayedMint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintToken(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelayedUnfreeze) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelayedUnfreeze) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelayedUnfreeze) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintToken(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenUpgradeV1Status) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenUpgradeV1Status) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenUpgradeV1Status) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintToken(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x1a
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintToken(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x12
+	if m.IbcEnabled {
+		i--
+		if m.IbcEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenUpgradeStatuses) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenUpgradeStatuses) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenUpgradeStatuses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.V1 != nil {
+		{
+			size, err := m.V1.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintToken(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintToken(dAtA []byte, offset int, v uint64) int {
+	offset -= sovToken(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Definition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	if len(m.Features) > 0 {
+		l = 0
+		for _, e := range m.Features {
+			l += sovToken(uint64(e))
+		}
+		n += 1 + sovToken(uint64(l)) + l
+	}
+	l = m.BurnRate.Size()
+	n += 1 + l + sovToken(uint64(l))
+	l = m.SendCommissionRate.Size()
+	n += 1 + l + sovToken(uint64(l))
+	if m.Version != 0 {
+		n += 1 + sovToken(uint64(m.Version))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.URI)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.URIHash)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	if len(m.SendCommissionRecipients) > 0 {
+		for _, e := range m.SendCommissionRecipients {
+			l = e.Size()
+			n += 1 + l + sovToken(uint64(l))
+		}
+	}
+	if len(m.BurnRateRecipients) > 0 {
+		for _, e := range m.BurnRateRecipients {
+			l = e.Size()
+			n += 1 + l + sovToken(uint64(l))
+		}
+	}
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovToken(uint64(l))
+	if m.SupplyLocked {
+		n += 2
+	}
+	return n
+}
+
+func (m *Token) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.Subunit)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	if m.Precision != 0 {
+		n += 1 + sovToken(uint64(m.Precision))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	if m.GloballyFrozen {
+		n += 2
+	}
+	if len(m.Features) > 0 {
+		l = 0
+		for _, e := range m.Features {
+			l += sovToken(uint64(e))
+		}
+		n += 1 + sovToken(uint64(l)) + l
+	}
+	l = m.BurnRate.Size()
+	n += 1 + l + sovToken(uint64(l))
+	l = m.SendCommissionRate.Size()
+	n += 1 + l + sovToken(uint64(l))
+	if m.Version != 0 {
+		n += 1 + sovToken(uint64(m.Version))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.URI)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.URIHash)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	if len(m.SendCommissionRecipients) > 0 {
+		for _, e := range m.SendCommissionRecipients {
+			l = e.Size()
+			n += 1 + l + sovToken(uint64(l))
+		}
+	}
+	if len(m.BurnRateRecipients) > 0 {
+		for _, e := range m.BurnRateRecipients {
+			l = e.Size()
+			n += 2 + l + sovToken(uint64(l))
+		}
+	}
+	l = m.MaxSupply.Size()
+	n += 2 + l + sovToken(uint64(l))
+	if m.SupplyLocked {
+		n += 3
+	}
+	return n
+}
+
+func (m *RoleGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovToken(uint64(m.Role))
+	}
+	l = m.MintCap.Size()
+	n += 1 + l + sovToken(uint64(l))
+	l = m.Minted.Size()
+	n += 1 + l + sovToken(uint64(l))
+	return n
+}
+
+func (m *RateExemption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	return n
+}
+
+func (m *RateRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovToken(uint64(m.Weight))
+	}
+	return n
+}
+
+func (m *TransferLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = m.MaxTransferAmount.Size()
+	n += 1 + l + sovToken(uint64(l))
+	l = m.MaxAccountDailyAmount.Size()
+	n += 1 + l + sovToken(uint64(l))
+	l = m.MaxTokenDailyAmount.Size()
+	n += 1 + l + sovToken(uint64(l))
+	return n
+}
+
+func (m *TransferVolume) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	if len(m.Buckets) > 0 {
+		for _, e := range m.Buckets {
+			l = e.Size()
+			n += 1 + l + sovToken(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TransferVolumeBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Hour != 0 {
+		n += 1 + sovToken(uint64(m.Hour))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovToken(uint64(l))
+	return n
+}
+
+func (m *DelayedTokenUpgradeV1) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	return n
+}
+
+func (m *ScheduledAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovToken(uint64(m.Id))
+	}
+	if m.Type != 0 {
+		n += 1 + sovToken(uint64(m.Type))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = m.Coin.Size()
+	n += 1 + l + sovToken(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ExecutionTime)
+	n += 1 + l + sovToken(uint64(l))
+	return n
+}
+
+func (m *DelayedMint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovToken(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *DelayedUnfreeze) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovToken(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *TokenUpgradeV1Status) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IbcEnabled {
+		n += 2
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovToken(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovToken(uint64(l))
+	return n
+}
+
+func (m *TokenUpgradeStatuses) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.V1 != nil {
+		l = m.V1.Size()
+		n += 1 + l + sovToken(uint64(l))
+	}
+	return n
+}
+
+func sovToken(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozToken(x uint64) (n int) {
+	return sovToken(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Definition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Definition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Definition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v Feature
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowToken
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Feature(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Features = append(m.Features, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowToken
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthToken
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthToken
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Features) == 0 {
+					m.Features = make([]Feature, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Feature
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowToken
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Feature(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Features = append(m.Features, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SendCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URIHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URIHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendCommissionRecipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendCommissionRecipients = append(m.SendCommissionRecipients, RateRecipient{})
+			if err := m.SendCommissionRecipients[len(m.SendCommissionRecipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnRateRecipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnRateRecipients = append(m.BurnRateRecipients, RateRecipient{})
+			if err := m.BurnRateRecipients[len(m.BurnRateRecipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyLocked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SupplyLocked = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Token) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Token: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Token: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subunit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subunit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Precision", wireType)
+			}
+			m.Precision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Precision |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GloballyFrozen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.GloballyFrozen = bool(v != 0)
+		case 8:
+			if wireType == 0 {
+				var v Feature
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowToken
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Feature(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Features = append(m.Features, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowToken
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthToken
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthToken
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Features) == 0 {
+					m.Features = make([]Feature, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Feature
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowToken
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Feature(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Features = append(m.Features, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SendCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URIHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URIHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendCommissionRecipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendCommissionRecipients = append(m.SendCommissionRecipients, RateRecipient{})
+			if err := m.SendCommissionRecipients[len(m.SendCommissionRecipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnRateRecipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnRateRecipients = append(m.BurnRateRecipients, RateRecipient{})
+			if err := m.BurnRateRecipients[len(m.BurnRateRecipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyLocked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SupplyLocked = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RoleGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoleGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoleGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= Role(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateExemption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateExemption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateExemption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTransferAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxTransferAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAccountDailyAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAccountDailyAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTokenDailyAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxTokenDailyAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
-	n += 1 + l + sovToken(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
-	n += 1 + l + sovToken(uint64(l))
-	return n
-}
 
-func (m *TokenUpgradeStatuses) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.V1 != nil {
-		l = m.V1.Size()
-		n += 1 + l + sovToken(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovToken(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozToken(x uint64) (n int) {
-	return sovToken(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Definition) Unmarshal(dAtA []byte) error {
+func (m *TransferVolume) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -842,10 +3968,10 @@ func (m *Definition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Definition: wiretype end group for non-group")
+			return fmt.Errorf("proto: TransferVolume: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Definition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TransferVolume: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -882,7 +4008,7 @@ func (m *Definition) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -910,82 +4036,13 @@ func (m *Definition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Issuer = string(dAtA[iNdEx:postIndex])
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType == 0 {
-				var v Feature
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowToken
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= Feature(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Features = append(m.Features, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowToken
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthToken
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthToken
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				if elementCount != 0 && len(m.Features) == 0 {
-					m.Features = make([]Feature, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v Feature
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowToken
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= Feature(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Features = append(m.Features, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowToken
@@ -995,29 +4052,98 @@ func (m *Definition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthToken
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthToken
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BurnRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Buckets = append(m.Buckets, TransferVolumeBucket{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferVolumeBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferVolumeBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferVolumeBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hour", wireType)
+			}
+			m.Hour = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Hour |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SendCommissionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1045,29 +4171,10 @@ func (m *Definition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SendCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
-			}
-			m.Version = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowToken
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Version |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipToken(dAtA[iNdEx:])
@@ -1089,7 +4196,7 @@ func (m *Definition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Token) Unmarshal(dAtA []byte) error {
+func (m *DelayedTokenUpgradeV1) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1112,10 +4219,10 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Token: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelayedTokenUpgradeV1: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Token: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelayedTokenUpgradeV1: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1150,11 +4257,61 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 			}
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowToken
@@ -1164,29 +4321,16 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthToken
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthToken
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Issuer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var stringLen uint64
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowToken
@@ -1196,27 +4340,14 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Type |= ScheduledActionType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthToken
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthToken
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subunit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1244,30 +4375,11 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Subunit = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Precision", wireType)
-			}
-			m.Precision = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowToken
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Precision |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1295,102 +4407,13 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GloballyFrozen", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowToken
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.GloballyFrozen = bool(v != 0)
-		case 8:
-			if wireType == 0 {
-				var v Feature
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowToken
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= Feature(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Features = append(m.Features, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowToken
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthToken
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthToken
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				if elementCount != 0 && len(m.Features) == 0 {
-					m.Features = make([]Feature, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v Feature
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowToken
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= Feature(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Features = append(m.Features, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
-			}
-		case 9:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowToken
@@ -1400,31 +4423,30 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthToken
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthToken
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BurnRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SendCommissionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowToken
@@ -1434,31 +4456,80 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthToken
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthToken
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SendCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ExecutionTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelayedMint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelayedMint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelayedMint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.Version = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowToken
@@ -1468,7 +4539,7 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint32(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1494,7 +4565,7 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelayedTokenUpgradeV1) Unmarshal(dAtA []byte) error {
+func (m *DelayedUnfreeze) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1517,17 +4588,17 @@ func (m *DelayedTokenUpgradeV1) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelayedTokenUpgradeV1: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelayedUnfreeze: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelayedTokenUpgradeV1: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelayedUnfreeze: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowToken
@@ -1537,24 +4608,11 @@ func (m *DelayedTokenUpgradeV1) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthToken
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthToken
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipToken(dAtA[iNdEx:])
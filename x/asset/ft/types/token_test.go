@@ -446,6 +446,170 @@ func TestValidateSendCommissionRate(t *testing.T) {
 	}
 }
 
+func TestValidateRateRecipients(t *testing.T) {
+	addr1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	addr2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+
+	tooMany := make([]types.RateRecipient, 0, types.MaxRateRecipients+1)
+	for i := 0; i <= types.MaxRateRecipients; i++ {
+		tooMany = append(tooMany, types.RateRecipient{
+			Address: sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+			Weight:  1,
+		})
+	}
+
+	testCases := []struct {
+		name       string
+		recipients []types.RateRecipient
+		invalid    bool
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:       "valid",
+			recipients: []types.RateRecipient{{Address: addr1, Weight: 3}, {Address: addr2, Weight: 1}},
+		},
+		{
+			name:       "invalid address",
+			recipients: []types.RateRecipient{{Address: "invalid", Weight: 1}},
+			invalid:    true,
+		},
+		{
+			name:       "zero weight",
+			recipients: []types.RateRecipient{{Address: addr1, Weight: 0}},
+			invalid:    true,
+		},
+		{
+			name:       "duplicated recipient",
+			recipients: []types.RateRecipient{{Address: addr1, Weight: 1}, {Address: addr1, Weight: 1}},
+			invalid:    true,
+		},
+		{
+			name:       "too many recipients",
+			recipients: tooMany,
+			invalid:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			for _, err := range []error{
+				types.ValidateSendCommissionRecipients(tc.recipients),
+				types.ValidateBurnRateRecipients(tc.recipients),
+			} {
+				if tc.invalid {
+					assertT.Error(err)
+				} else {
+					assertT.NoError(err)
+				}
+			}
+		})
+	}
+}
+
+func TestSplitByWeights(t *testing.T) {
+	testCases := []struct {
+		amount         int64
+		weights        []uint32
+		expectedShares []int64
+	}{
+		{
+			amount:         100,
+			weights:        []uint32{1},
+			expectedShares: []int64{100},
+		},
+		{
+			amount:         100,
+			weights:        []uint32{3, 1},
+			expectedShares: []int64{75, 25},
+		},
+		{
+			amount:         100,
+			weights:        []uint32{1, 1, 1},
+			expectedShares: []int64{34, 33, 33},
+		},
+		{
+			amount:         3,
+			weights:        []uint32{3, 1},
+			expectedShares: []int64{3, 0},
+		},
+		{
+			amount:         1,
+			weights:        []uint32{1, 4294967295},
+			expectedShares: []int64{1, 0},
+		},
+		{
+			amount:         0,
+			weights:        []uint32{1, 2},
+			expectedShares: []int64{0, 0},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		name := fmt.Sprintf("%+v", tc)
+		t.Run(name, func(t *testing.T) {
+			recipients := make([]types.RateRecipient, 0, len(tc.weights))
+			for _, weight := range tc.weights {
+				recipients = append(recipients, types.RateRecipient{Weight: weight})
+			}
+			shares := types.SplitByWeights(sdk.NewInt(tc.amount), recipients)
+			sharesInt64 := make([]int64, 0, len(shares))
+			for _, share := range shares {
+				sharesInt64 = append(sharesInt64, share.Int64())
+			}
+			assert.Equal(t, tc.expectedShares, sharesInt64)
+		})
+	}
+}
+
+func TestTransferVolume(t *testing.T) {
+	requireT := require.New(t)
+
+	volume := types.TransferVolume{
+		Denom: types.BuildDenom("abc", secp256k1.GenPrivKey().PubKey().Address().Bytes()),
+	}
+	requireT.NoError(volume.Validate())
+	requireT.Equal("0", volume.DailyVolume(100).String())
+
+	volume.Add(100, sdk.NewInt(10))
+	volume.Add(100, sdk.NewInt(5))
+	volume.Add(110, sdk.NewInt(20))
+	requireT.NoError(volume.Validate())
+	requireT.Len(volume.Buckets, 2)
+	requireT.Equal("35", volume.DailyVolume(110).String())
+	requireT.Equal("35", volume.DailyVolume(123).String())
+
+	// the amounts sent 24 hours ago leave the window
+	requireT.Equal("20", volume.DailyVolume(124).String())
+	requireT.Equal("0", volume.DailyVolume(134).String())
+
+	// and are dropped once the new amount is added
+	volume.Add(125, sdk.NewInt(1))
+	requireT.Len(volume.Buckets, 2)
+	requireT.Equal(int64(110), volume.Buckets[0].Hour)
+	requireT.Equal(int64(125), volume.Buckets[1].Hour)
+	requireT.Equal("21", volume.DailyVolume(125).String())
+
+	volume.Buckets = []types.TransferVolumeBucket{
+		{Hour: 2, Amount: sdk.NewInt(1)},
+		{Hour: 1, Amount: sdk.NewInt(1)},
+	}
+	requireT.ErrorIs(volume.Validate(), types.ErrInvalidInput)
+
+	volume.Buckets = []types.TransferVolumeBucket{
+		{Hour: 1, Amount: sdk.ZeroInt()},
+	}
+	requireT.ErrorIs(volume.Validate(), types.ErrInvalidInput)
+
+	volume.Buckets = nil
+	volume.Account = "invalid"
+	requireT.ErrorIs(volume.Validate(), sdkerrors.ErrInvalidAddress)
+}
+
 func TestDefinition_CheckFeatureAllowed(t *testing.T) {
 	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
 	nonIssuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
@@ -453,6 +617,7 @@ func TestDefinition_CheckFeatureAllowed(t *testing.T) {
 	type fields struct {
 		Denom              string
 		Issuer             string
+		Admin              string
 		Features           []types.Feature
 		BurnRate           sdk.Dec
 		SendCommissionRate sdk.Dec
@@ -471,6 +636,7 @@ func TestDefinition_CheckFeatureAllowed(t *testing.T) {
 			name: "minting_feature_enabled_for_issuer",
 			fields: fields{
 				Issuer: issuer.String(),
+				Admin:  issuer.String(),
 				Features: []types.Feature{
 					types.Feature_minting,
 				},
@@ -485,6 +651,7 @@ func TestDefinition_CheckFeatureAllowed(t *testing.T) {
 			name: "burning_feature_always_enabled_for_issuer",
 			fields: fields{
 				Issuer: issuer.String(),
+				Admin:  issuer.String(),
 				Features: []types.Feature{
 					types.Feature_burning,
 				},
@@ -499,6 +666,7 @@ func TestDefinition_CheckFeatureAllowed(t *testing.T) {
 			name: "burning_feature_enabled_for_non_issuer",
 			fields: fields{
 				Issuer: issuer.String(),
+				Admin:  issuer.String(),
 			},
 			args: args{
 				addr:    issuer,
@@ -510,6 +678,7 @@ func TestDefinition_CheckFeatureAllowed(t *testing.T) {
 			name: "minting_feature_disabled_for_non_issuer",
 			fields: fields{
 				Issuer: issuer.String(),
+				Admin:  issuer.String(),
 				Features: []types.Feature{
 					types.Feature_minting,
 				},
@@ -529,6 +698,7 @@ func TestDefinition_CheckFeatureAllowed(t *testing.T) {
 			name: "minting_feature_disabled_for_issuer",
 			fields: fields{
 				Issuer: issuer.String(),
+				Admin:  issuer.String(),
 			},
 			args: args{
 				addr:    issuer,
@@ -541,6 +711,26 @@ func TestDefinition_CheckFeatureAllowed(t *testing.T) {
 				t.FailNow()
 			},
 		},
+		{
+			name: "minting_feature_disabled_for_issuer_without_admin_rights",
+			fields: fields{
+				Issuer: issuer.String(),
+				Admin:  nonIssuer.String(),
+				Features: []types.Feature{
+					types.Feature_minting,
+				},
+			},
+			args: args{
+				addr:    issuer,
+				feature: types.Feature_minting,
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				if assert.ErrorIs(t, err, sdkerrors.ErrUnauthorized) {
+					return
+				}
+				t.FailNow()
+			},
+		},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -548,6 +738,7 @@ func TestDefinition_CheckFeatureAllowed(t *testing.T) {
 			def := types.Definition{
 				Denom:              tt.fields.Denom,
 				Issuer:             tt.fields.Issuer,
+				Admin:              tt.fields.Admin,
 				Features:           tt.fields.Features,
 				BurnRate:           tt.fields.BurnRate,
 				SendCommissionRate: tt.fields.SendCommissionRate,
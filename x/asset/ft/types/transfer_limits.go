@@ -0,0 +1,99 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// TransferVolumeWindowHours is the length of the rolling window in which the daily transfer limits are applied.
+const TransferVolumeWindowHours = 24
+
+// TransferVolumeHour returns the hour the amount sent at the time is accounted to.
+func TransferVolumeHour(t time.Time) int64 {
+	return t.Unix() / int64(time.Hour/time.Second)
+}
+
+// ValidateTransferLimitAmounts checks that the transfer limits are valid.
+func ValidateTransferLimitAmounts(maxTransferAmount, maxAccountDailyAmount, maxTokenDailyAmount sdk.Int) error {
+	for _, limit := range []struct {
+		name   string
+		amount sdk.Int
+	}{
+		{name: "max transfer amount", amount: maxTransferAmount},
+		{name: "max account daily amount", amount: maxAccountDailyAmount},
+		{name: "max token daily amount", amount: maxTokenDailyAmount},
+	} {
+		if limit.amount.IsNil() || limit.amount.IsNegative() {
+			return sdkerrors.Wrapf(ErrInvalidInput, "%s must not be negative", limit.name)
+		}
+	}
+	return nil
+}
+
+// Validate checks that the transfer limits are valid.
+func (l TransferLimits) Validate() error {
+	if _, _, err := DeconstructDenom(l.Denom); err != nil {
+		return err
+	}
+
+	return ValidateTransferLimitAmounts(l.MaxTransferAmount, l.MaxAccountDailyAmount, l.MaxTokenDailyAmount)
+}
+
+// IsSet returns true if any of the limits is set.
+func (l TransferLimits) IsSet() bool {
+	return l.MaxTransferAmount.IsPositive() || l.MaxAccountDailyAmount.IsPositive() || l.MaxTokenDailyAmount.IsPositive()
+}
+
+// Validate checks that the transfer volume is valid.
+func (v TransferVolume) Validate() error {
+	if _, _, err := DeconstructDenom(v.Denom); err != nil {
+		return err
+	}
+
+	if v.Account != "" {
+		if _, err := sdk.AccAddressFromBech32(v.Account); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid account %s", v.Account)
+		}
+	}
+
+	for i, bucket := range v.Buckets {
+		if bucket.Amount.IsNil() || !bucket.Amount.IsPositive() {
+			return sdkerrors.Wrapf(ErrInvalidInput, "amount sent within the hour %d must be positive", bucket.Hour)
+		}
+		if i > 0 && bucket.Hour <= v.Buckets[i-1].Hour {
+			return sdkerrors.Wrap(ErrInvalidInput, "hours of the transfer volume must be sorted and unique")
+		}
+	}
+
+	return nil
+}
+
+// DailyVolume returns the amount sent within the rolling window ending at the hour.
+func (v TransferVolume) DailyVolume(hour int64) sdk.Int {
+	volume := sdk.ZeroInt()
+	for _, bucket := range v.Buckets {
+		if bucket.Hour > hour-TransferVolumeWindowHours && bucket.Hour <= hour {
+			volume = volume.Add(bucket.Amount)
+		}
+	}
+	return volume
+}
+
+// Add adds the amount sent within the hour and drops the amounts sent outside the rolling window.
+func (v *TransferVolume) Add(hour int64, amount sdk.Int) {
+	buckets := make([]TransferVolumeBucket, 0, len(v.Buckets)+1)
+	for _, bucket := range v.Buckets {
+		if bucket.Hour > hour-TransferVolumeWindowHours && bucket.Hour < hour {
+			buckets = append(buckets, bucket)
+		}
+		if bucket.Hour == hour {
+			amount = amount.Add(bucket.Amount)
+		}
+	}
+	v.Buckets = append(buckets, TransferVolumeBucket{
+		Hour:   hour,
+		Amount: amount,
+	})
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
@@ -36,8 +37,14 @@ func GetQueryCmd() *cobra.Command {
 		CmdQueryFrozen(),
 		CmdQueryWhitelisted(),
 		CmdQueryWhitelistedAccounts(),
+		CmdQueryClassWhitelisted(),
+		CmdQueryClassWhitelistedAccounts(),
 		CmdQueryBurnt(),
 		CmdQueryParams(),
+		CmdQueryRoyaltyPayout(),
+		CmdQueryClassFrozen(),
+		CmdQueryClassFrozenForAccount(),
+		CmdQueryClassFrozenAccounts(),
 	)
 
 	return cmd
@@ -250,6 +257,88 @@ $ %s query %s whitelisted [class-id] [id]
 	return cmd
 }
 
+// CmdQueryClassWhitelisted return the CmdQueryClassWhitelisted cobra command.
+func CmdQueryClassWhitelisted() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "class-whitelisted [class-id] [account]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query if account is whitelisted for all non-fungible tokens of the class",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query if account is whitelisted for all non-fungible tokens of the class.
+
+Example:
+$ %s query %s class-whitelisted [class-id] %s
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			classID := args[0]
+			account := args[1]
+			res, err := queryClient.ClassWhitelisted(cmd.Context(), &types.QueryClassWhitelistedRequest{
+				ClassId: classID,
+				Account: account,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryClassWhitelistedAccounts return the CmdQueryClassWhitelistedAccounts cobra command.
+func CmdQueryClassWhitelistedAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "class-whitelisted-accounts [class-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for the list of accounts whitelisted for all non-fungible tokens of the class",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for the list of accounts whitelisted for all non-fungible tokens of the class.
+
+Example:
+$ %s query %s class-whitelisted-accounts [class-id]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			classID := args[0]
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ClassWhitelistedAccounts(cmd.Context(), &types.QueryClassWhitelistedAccountsRequest{
+				Pagination: pageReq,
+				ClassId:    classID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "class whitelisted accounts")
+
+	return cmd
+}
+
 // CmdQueryParams implements a command to fetch assetnft parameters.
 func CmdQueryParams() *cobra.Command {
 	cmd := &cobra.Command{
@@ -344,3 +433,163 @@ $ %s query %s burnt [class-id]
 
 	return cmd
 }
+
+// CmdQueryRoyaltyPayout return the CmdQueryRoyaltyPayout cobra command.
+func CmdQueryRoyaltyPayout() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "royalty-payout [class-id] [payment]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query for the royalty paid when the NFT of the class is sold",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for the royalty paid to the class issuer and the payout of the seller when the NFT of the class is sold.
+
+Example:
+$ %s query %s royalty-payout [class-id] 1000ucore
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			classID := args[0]
+			payment, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return errors.Wrap(err, "invalid payment")
+			}
+
+			res, err := queryClient.RoyaltyPayout(cmd.Context(), &types.QueryRoyaltyPayoutRequest{
+				ClassId: classID,
+				Payment: payment,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryClassFrozen return the CmdQueryClassFrozen cobra command.
+func CmdQueryClassFrozen() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "class-frozen [class-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query if non-fungible token class is frozen",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query if non-fungible token class is frozen.
+
+Example:
+$ %[1]s query %s class-frozen [class-id]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			classID := args[0]
+			res, err := queryClient.ClassFrozen(cmd.Context(), &types.QueryClassFrozenRequest{
+				ClassId: classID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryClassFrozenForAccount return the CmdQueryClassFrozenForAccount cobra command.
+func CmdQueryClassFrozenForAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "class-frozen-account [class-id] [account]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query if non-fungible token class is frozen for the account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query if non-fungible token class is frozen for the account.
+
+Example:
+$ %s query %s class-frozen-account [class-id] %s
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			classID := args[0]
+			account := args[1]
+			res, err := queryClient.ClassFrozenForAccount(cmd.Context(), &types.QueryClassFrozenForAccountRequest{
+				ClassId: classID,
+				Account: account,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryClassFrozenAccounts return the CmdQueryClassFrozenAccounts cobra command.
+func CmdQueryClassFrozenAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "class-frozen-accounts [class-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for the list of accounts for which non-fungible token class is frozen",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for the list of accounts for which non-fungible token class is frozen.
+
+Example:
+$ %s query %s class-frozen-accounts [class-id]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			classID := args[0]
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ClassFrozenAccounts(cmd.Context(), &types.QueryClassFrozenAccountsRequest{
+				Pagination: pageReq,
+				ClassId:    classID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "class frozen accounts")
+
+	return cmd
+}
@@ -148,6 +148,32 @@ func TestCmdQueryParams(t *testing.T) {
 	requireT.Equal(expectedMintFee, resp.Params.MintFee)
 }
 
+func TestCmdQueryRoyaltyPayout(t *testing.T) {
+	requireT := require.New(t)
+
+	testNetwork := network.New(t)
+
+	ctx := testNetwork.Validators[0].ClientCtx
+
+	classID := issueClass(
+		requireT, ctx,
+		"nft"+uuid.NewString()[:4], "class name", "class description", "https://my-class-meta.invalid/1", "",
+		testNetwork,
+		"0.1",
+	)
+
+	buf, err := clitestutil.ExecTestCLICmd(
+		ctx, cli.CmdQueryRoyaltyPayout(), []string{classID, "1005" + constant.DenomDev, "--output", "json"},
+	)
+	requireT.NoError(err)
+
+	var resp types.QueryRoyaltyPayoutResponse
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
+	requireT.Equal(testNetwork.Validators[0].Address.String(), resp.Issuer)
+	requireT.Equal(sdk.NewInt64Coin(constant.DenomDev, 100), resp.Royalty)
+	requireT.Equal(sdk.NewInt64Coin(constant.DenomDev, 905), resp.SellerPayout)
+}
+
 func mint(
 	requireT *require.Assertions,
 	ctx client.Context,
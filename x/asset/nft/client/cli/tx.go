@@ -40,6 +40,15 @@ func GetTxCmd() *cobra.Command {
 		CmdTxUnfreeze(),
 		CmdTxWhitelist(),
 		CmdTxUnwhitelist(),
+		CmdTxSendWithPayment(),
+		CmdTxUpdateClass(),
+		CmdTxUpdateData(),
+		CmdTxClassFreeze(),
+		CmdTxClassUnfreeze(),
+		CmdTxFreezeClassForAccount(),
+		CmdTxUnfreezeClassForAccount(),
+		CmdTxClassWhitelist(),
+		CmdTxClassUnwhitelist(),
 	)
 
 	return cmd
@@ -370,3 +379,373 @@ $ %s tx %s unwhitelist abc-%[3]s id1 %[3]s --from [sender]
 
 	return cmd
 }
+
+// CmdTxSendWithPayment returns SendWithPayment cobra command.
+func CmdTxSendWithPayment() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-with-payment [class-id] [id] [receiver] [payment] --from [sender]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Sell a non-fungible token to the receiver paying the class royalty to the issuer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Sell a non-fungible token to the receiver paying the class royalty to the issuer.
+The transaction must be signed by both the sender and the receiver.
+
+Example:
+$ %s tx %s send-with-payment abc-%[3]s id1 %[3]s 1000ucore --from [sender] --generate-only
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			classID := args[0]
+			ID := args[1]
+			receiver := args[2]
+			payment, err := sdk.ParseCoinNormalized(args[3])
+			if err != nil {
+				return errors.Wrap(err, "invalid payment")
+			}
+
+			msg := &types.MsgSendWithPayment{
+				Sender:   sender.String(),
+				ClassID:  classID,
+				ID:       ID,
+				Receiver: receiver,
+				Payment:  payment,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxUpdateClass returns UpdateClass cobra command.
+func CmdTxUpdateClass() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-class [class-id] [uri] [uri_hash] --from [sender]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Update the metadata of non-fungible token class",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Update the metadata of non-fungible token class.
+
+Example:
+$ %s tx %s update-class abc-%s https://my-class-meta.invalid/2 e000625 --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			classID := args[0]
+			uri := args[1]
+			uriHash := args[2]
+
+			msg := &types.MsgUpdateClass{
+				Sender:  sender.String(),
+				ClassID: classID,
+				URI:     uri,
+				URIHash: uriHash,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxUpdateData returns UpdateData cobra command.
+func CmdTxUpdateData() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-data [class-id] [id] [uri] [uri_hash] --from [sender]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Update the metadata of non-fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Update the metadata of non-fungible token.
+
+Example:
+$ %s tx %s update-data abc-%s id1 https://my-nft-meta.invalid/2 e000625 --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			classID := args[0]
+			ID := args[1]
+			uri := args[2]
+			uriHash := args[3]
+
+			msg := &types.MsgUpdateData{
+				Sender:  sender.String(),
+				ClassID: classID,
+				ID:      ID,
+				URI:     uri,
+				URIHash: uriHash,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxClassFreeze returns ClassFreeze cobra command.
+func CmdTxClassFreeze() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "class-freeze [class-id] --from [sender]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Freeze all non-fungible tokens of the class",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Freeze all non-fungible tokens of the class.
+
+Example:
+$ %s tx %s class-freeze abc-%s --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			classID := args[0]
+
+			msg := &types.MsgClassFreeze{
+				Sender:  sender.String(),
+				ClassID: classID,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxClassUnfreeze returns ClassUnfreeze cobra command.
+func CmdTxClassUnfreeze() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "class-unfreeze [class-id] --from [sender]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Unfreeze the non-fungible token class",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Unfreeze the non-fungible token class.
+
+Example:
+$ %s tx %s class-unfreeze abc-%s --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			classID := args[0]
+
+			msg := &types.MsgClassUnfreeze{
+				Sender:  sender.String(),
+				ClassID: classID,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxFreezeClassForAccount returns FreezeClassForAccount cobra command.
+func CmdTxFreezeClassForAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "class-freeze-account [class-id] [account] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Freeze all non-fungible tokens of the class held by the account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Freeze all non-fungible tokens of the class held by the account.
+
+Example:
+$ %s tx %s class-freeze-account abc-%s %s --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			classID := args[0]
+			account := args[1]
+
+			msg := &types.MsgFreezeClassForAccount{
+				Sender:  sender.String(),
+				ClassID: classID,
+				Account: account,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxUnfreezeClassForAccount returns UnfreezeClassForAccount cobra command.
+func CmdTxUnfreezeClassForAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "class-unfreeze-account [class-id] [account] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Unfreeze the non-fungible token class for the account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Unfreeze the non-fungible token class for the account.
+
+Example:
+$ %s tx %s class-unfreeze-account abc-%s %s --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			classID := args[0]
+			account := args[1]
+
+			msg := &types.MsgUnfreezeClassForAccount{
+				Sender:  sender.String(),
+				ClassID: classID,
+				Account: account,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxClassWhitelist returns ClassWhitelist cobra command.
+func CmdTxClassWhitelist() *cobra.Command { //nolint:dupl // all CLI commands are similar.
+	cmd := &cobra.Command{
+		Use:   "class-whitelist [class-id] [account] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Whitelist an account for all non-fungible tokens of the class",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Whitelist an account for all non-fungible tokens of the class.
+
+Example:
+$ %s tx %s class-whitelist abc-%[3]s %[3]s --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			classID := args[0]
+			account := args[1]
+
+			msg := &types.MsgAddToClassWhitelist{
+				Sender:  sender.String(),
+				ClassID: classID,
+				Account: account,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxClassUnwhitelist returns ClassUnwhitelist cobra command.
+func CmdTxClassUnwhitelist() *cobra.Command { //nolint:dupl // all CLI commands are similar.
+	cmd := &cobra.Command{
+		Use:   "class-unwhitelist [class-id] [account] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Remove an account from the whitelist of the non-fungible token class",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Remove an account from the whitelist of the non-fungible token class.
+
+Example:
+$ %s tx %s class-unwhitelist abc-%[3]s %[3]s --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			classID := args[0]
+			account := args[1]
+
+			msg := &types.MsgRemoveFromClassWhitelist{
+				Sender:  sender.String(),
+				ClassID: classID,
+				Account: account,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -102,6 +102,160 @@ func TestCmdFreeze(t *testing.T) {
 	requireT.False(frozenResp.Frozen)
 }
 
+func TestCmdClassFreeze(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	symbol := "nft" + uuid.NewString()[:4]
+	validator := testNetwork.Validators[0]
+	ctx := validator.ClientCtx
+
+	// create class
+	classID := issueClass(
+		requireT,
+		ctx,
+		symbol,
+		"class name",
+		"class description",
+		"https://my-class-meta.invalid/1",
+		"",
+		testNetwork,
+		"0.0",
+		types.ClassFeature_freezing,
+	)
+
+	// freeze
+	args := []string{classID}
+	args = append(args, txValidator1Args(testNetwork)...)
+	requireT.NoError(coreumclitestutil.ExecTestCLICmd(ctx, cli.CmdTxClassFreeze(), args))
+
+	// query frozen
+	var frozenResp types.QueryClassFrozenResponse
+	buf, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryClassFrozen(), []string{classID})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &frozenResp))
+	requireT.True(frozenResp.Frozen)
+
+	// unfreeze
+	args = []string{classID}
+	args = append(args, txValidator1Args(testNetwork)...)
+	requireT.NoError(coreumclitestutil.ExecTestCLICmd(ctx, cli.CmdTxClassUnfreeze(), args))
+
+	// query frozen
+	buf, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryClassFrozen(), []string{classID})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &frozenResp))
+	requireT.False(frozenResp.Frozen)
+}
+
+func TestCmdFreezeClassForAccount(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	symbol := "nft" + uuid.NewString()[:4]
+	validator := testNetwork.Validators[0]
+	ctx := validator.ClientCtx
+	account := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	// create class
+	classID := issueClass(
+		requireT,
+		ctx,
+		symbol,
+		"class name",
+		"class description",
+		"https://my-class-meta.invalid/1",
+		"",
+		testNetwork,
+		"0.0",
+		types.ClassFeature_freezing,
+	)
+
+	// freeze
+	args := []string{classID, account.String()}
+	args = append(args, txValidator1Args(testNetwork)...)
+	requireT.NoError(coreumclitestutil.ExecTestCLICmd(ctx, cli.CmdTxFreezeClassForAccount(), args))
+
+	// query frozen
+	var frozenResp types.QueryClassFrozenForAccountResponse
+	buf, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryClassFrozenForAccount(), []string{classID, account.String()})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &frozenResp))
+	requireT.True(frozenResp.Frozen)
+
+	// query frozen accounts
+	var accountsResp types.QueryClassFrozenAccountsResponse
+	buf, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryClassFrozenAccounts(), []string{classID})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &accountsResp))
+	requireT.Equal([]string{account.String()}, accountsResp.Accounts)
+
+	// unfreeze
+	args = []string{classID, account.String()}
+	args = append(args, txValidator1Args(testNetwork)...)
+	requireT.NoError(coreumclitestutil.ExecTestCLICmd(ctx, cli.CmdTxUnfreezeClassForAccount(), args))
+
+	// query frozen
+	buf, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryClassFrozenForAccount(), []string{classID, account.String()})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &frozenResp))
+	requireT.False(frozenResp.Frozen)
+}
+
+func TestCmdClassWhitelist(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	symbol := "nft" + uuid.NewString()[:4]
+	validator := testNetwork.Validators[0]
+	ctx := validator.ClientCtx
+	account := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	// create class
+	classID := issueClass(
+		requireT,
+		ctx,
+		symbol,
+		"class name",
+		"class description",
+		"https://my-class-meta.invalid/1",
+		"",
+		testNetwork,
+		"0",
+		types.ClassFeature_whitelisting,
+	)
+
+	// whitelist
+	args := []string{classID, account.String()}
+	args = append(args, txValidator1Args(testNetwork)...)
+	requireT.NoError(coreumclitestutil.ExecTestCLICmd(ctx, cli.CmdTxClassWhitelist(), args))
+
+	// query whitelisted
+	var whitelistedResp types.QueryClassWhitelistedResponse
+	buf, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryClassWhitelisted(), []string{classID, account.String()})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &whitelistedResp))
+	requireT.True(whitelistedResp.Whitelisted)
+
+	// query whitelisted accounts
+	var accountsResp types.QueryClassWhitelistedAccountsResponse
+	buf, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryClassWhitelistedAccounts(), []string{classID})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &accountsResp))
+	requireT.Equal([]string{account.String()}, accountsResp.Accounts)
+
+	// unwhitelist
+	args = []string{classID, account.String()}
+	args = append(args, txValidator1Args(testNetwork)...)
+	requireT.NoError(coreumclitestutil.ExecTestCLICmd(ctx, cli.CmdTxClassUnwhitelist(), args))
+
+	// query whitelisted
+	buf, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryClassWhitelisted(), []string{classID, account.String()})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &whitelistedResp))
+	requireT.False(whitelistedResp.Whitelisted)
+}
+
 func TestCmdWhitelist(t *testing.T) {
 	requireT := require.New(t)
 	testNetwork := network.New(t)
@@ -170,6 +324,57 @@ func TestCmdWhitelist(t *testing.T) {
 	requireT.False(whitelistedResp.Whitelisted)
 }
 
+func TestCmdUpdate(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	symbol := "nft" + uuid.NewString()[:4]
+	validator := testNetwork.Validators[0]
+	ctx := validator.ClientCtx
+
+	// create class
+	classID := issueClass(
+		requireT,
+		ctx,
+		symbol,
+		"class name",
+		"class description",
+		"https://my-class-meta.invalid/1",
+		"",
+		testNetwork,
+		"0.0",
+		types.ClassFeature_updatable,
+	)
+	// mint nft
+	nftID := "nft-1"
+	mint(
+		requireT,
+		ctx,
+		classID,
+		nftID,
+		"https://my-nft-meta.invalid/1",
+		"",
+		testNetwork,
+	)
+
+	// update class
+	args := []string{classID, "https://my-class-meta.invalid/2", "class-hash"}
+	args = append(args, txValidator1Args(testNetwork)...)
+	requireT.NoError(coreumclitestutil.ExecTestCLICmd(ctx, cli.CmdTxUpdateClass(), args))
+
+	var classResp types.QueryClassResponse
+	buf, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryClass(), []string{classID, "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &classResp))
+	requireT.Equal("https://my-class-meta.invalid/2", classResp.Class.URI)
+	requireT.Equal("class-hash", classResp.Class.URIHash)
+
+	// update nft
+	args = []string{classID, nftID, "https://my-nft-meta.invalid/2", "nft-hash"}
+	args = append(args, txValidator1Args(testNetwork)...)
+	requireT.NoError(coreumclitestutil.ExecTestCLICmd(ctx, cli.CmdTxUpdateData(), args))
+}
+
 func txValidator1Args(testNetwork *network.Network) []string {
 	return []string{
 		fmt.Sprintf("--%s=%s", flags.FlagFrom, testNetwork.Validators[0].Address.String()),
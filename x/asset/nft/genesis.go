@@ -28,6 +28,28 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		}
 	}
 
+	for _, classID := range genState.FrozenClasses {
+		if err := k.SetClassFrozen(ctx, classID, true); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, frozen := range genState.ClassFrozenAccounts {
+		if err := frozen.Validate(); err != nil {
+			panic(err)
+		}
+		for _, account := range frozen.Accounts {
+			if err := k.SetClassFrozenForAccount(
+				ctx,
+				frozen.ClassID,
+				sdk.MustAccAddressFromBech32(account),
+				true,
+			); err != nil {
+				panic(err)
+			}
+		}
+	}
+
 	for _, whitelisted := range genState.WhitelistedNFTAccounts {
 		if err := whitelisted.Validate(); err != nil {
 			panic(err)
@@ -45,6 +67,22 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		}
 	}
 
+	for _, whitelisted := range genState.ClassWhitelistedAccounts {
+		if err := whitelisted.Validate(); err != nil {
+			panic(err)
+		}
+		for _, account := range whitelisted.Accounts {
+			if err := k.SetClassWhitelisting(
+				ctx,
+				whitelisted.ClassID,
+				sdk.MustAccAddressFromBech32(account),
+				true,
+			); err != nil {
+				panic(err)
+			}
+		}
+	}
+
 	for _, burnt := range genState.BurntNFTs {
 		if err := burnt.Validate(); err != nil {
 			panic(err)
@@ -69,21 +107,39 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		panic(err)
 	}
 
+	frozenClasses, _, err := k.GetFrozenClasses(ctx, &query.PageRequest{Limit: query.MaxLimit})
+	if err != nil {
+		panic(err)
+	}
+
+	classFrozenAccounts, _, err := k.GetAllClassFrozenAccounts(ctx, &query.PageRequest{Limit: query.MaxLimit})
+	if err != nil {
+		panic(err)
+	}
+
 	whitelisted, _, err := k.GetWhitelistedAccounts(ctx, &query.PageRequest{Limit: query.MaxLimit})
 	if err != nil {
 		panic(err)
 	}
 
+	classWhitelisted, _, err := k.GetAllClassWhitelistedAccounts(ctx, &query.PageRequest{Limit: query.MaxLimit})
+	if err != nil {
+		panic(err)
+	}
+
 	burnt, _, err := k.GetBurntNFTs(ctx, &query.PageRequest{Limit: query.MaxLimit})
 	if err != nil {
 		panic(err)
 	}
 
 	return &types.GenesisState{
-		ClassDefinitions:       classDefinitions,
-		Params:                 k.GetParams(ctx),
-		FrozenNFTs:             frozen,
-		WhitelistedNFTAccounts: whitelisted,
-		BurntNFTs:              burnt,
+		ClassDefinitions:         classDefinitions,
+		Params:                   k.GetParams(ctx),
+		FrozenNFTs:               frozen,
+		WhitelistedNFTAccounts:   whitelisted,
+		BurntNFTs:                burnt,
+		FrozenClasses:            frozenClasses,
+		ClassFrozenAccounts:      classFrozenAccounts,
+		ClassWhitelistedAccounts: classWhitelisted,
 	}
 }
@@ -115,12 +115,45 @@ func TestInitAndExportGenesis(t *testing.T) {
 		})
 	}
 
+	// Frozen classes
+	frozenClasses := []string{
+		fmt.Sprintf("classid0-%s", issuer),
+		fmt.Sprintf("classid1-%s", issuer),
+	}
+
+	// Class frozen accounts
+	var classFrozenAccounts []types.ClassFrozenAccounts
+	for i := 0; i < 3; i++ {
+		classFrozenAccounts = append(classFrozenAccounts, types.ClassFrozenAccounts{
+			ClassID: fmt.Sprintf("classid%d-%s", i, issuer),
+			Accounts: []string{
+				sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+				sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+			},
+		})
+	}
+
+	// Class whitelisted accounts
+	var classWhitelisted []types.ClassWhitelistedAccounts
+	for i := 0; i < 3; i++ {
+		classWhitelisted = append(classWhitelisted, types.ClassWhitelistedAccounts{
+			ClassID: fmt.Sprintf("classid%d-%s", i, issuer),
+			Accounts: []string{
+				sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+				sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+			},
+		})
+	}
+
 	genState := types.GenesisState{
-		Params:                 types.DefaultParams(),
-		ClassDefinitions:       classDefinitions,
-		FrozenNFTs:             frozen,
-		WhitelistedNFTAccounts: whitelisted,
-		BurntNFTs:              burnt,
+		Params:                   types.DefaultParams(),
+		ClassDefinitions:         classDefinitions,
+		FrozenNFTs:               frozen,
+		WhitelistedNFTAccounts:   whitelisted,
+		BurntNFTs:                burnt,
+		FrozenClasses:            frozenClasses,
+		ClassFrozenAccounts:      classFrozenAccounts,
+		ClassWhitelistedAccounts: classWhitelisted,
 	}
 
 	// init the keeper
@@ -153,4 +186,21 @@ func TestInitAndExportGenesis(t *testing.T) {
 	}
 	assertT.ElementsMatch(genState.WhitelistedNFTAccounts, exportedGenState.WhitelistedNFTAccounts)
 	assertT.ElementsMatch(genState.BurntNFTs, exportedGenState.BurntNFTs)
+	assertT.ElementsMatch(genState.FrozenClasses, exportedGenState.FrozenClasses)
+
+	for _, st := range genState.ClassFrozenAccounts {
+		sort.Strings(st.Accounts)
+	}
+	for _, st := range exportedGenState.ClassFrozenAccounts {
+		sort.Strings(st.Accounts)
+	}
+	assertT.ElementsMatch(genState.ClassFrozenAccounts, exportedGenState.ClassFrozenAccounts)
+
+	for _, st := range genState.ClassWhitelistedAccounts {
+		sort.Strings(st.Accounts)
+	}
+	for _, st := range exportedGenState.ClassWhitelistedAccounts {
+		sort.Strings(st.Accounts)
+	}
+	assertT.ElementsMatch(genState.ClassWhitelistedAccounts, exportedGenState.ClassWhitelistedAccounts)
 }
@@ -2,10 +2,40 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	wibctransfertypes "github.com/CoreumFoundation/coreum/v2/x/wibctransfer/types"
 )
 
 // BeforeTransfer includes logic that will be run before the Transfer method of the nft module.
 func (k Keeper) BeforeTransfer(ctx sdk.Context, classID, nftID string, receiver sdk.AccAddress) error {
+	// Context is marked with ACK purpose when IBC transfer has been rejected by the other chain, and with timeout
+	// purpose when it timed out. In both cases the nft is refunded to the sender from the escrow address, and it
+	// must succeed even if the issuer decided, for whatever reason, to freeze the nft or to remove the sender from
+	// the whitelist. Otherwise, the nft would be lost forever, being blocked on the escrow address.
+	if wibctransfertypes.IsPurposeAck(ctx) || wibctransfertypes.IsPurposeTimeout(ctx) {
+		return nil
+	}
+
+	// This check is effective when the nft is sent to the escrow address by the outgoing IBC transfer.
+	// Escrow address is always accepted as a receiver, despite the fact it is not whitelisted, but the sending
+	// rules are applied to the owner.
+	if wibctransfertypes.IsPurposeOut(ctx) {
+		if err := k.isNFTIBCTransferable(ctx, classID); err != nil {
+			return err
+		}
+		return k.isNFTSendable(ctx, classID, nftID)
+	}
+
+	// This check is effective when the nft comes back from the escrow address by the incoming IBC transfer.
+	// The sending rules are not applied to the escrow address, but the nft cannot be received if it or the whole
+	// class is frozen, and the receiver must be whitelisted.
+	if wibctransfertypes.IsPurposeIn(ctx) {
+		if err := k.isNFTIBCReceivable(ctx, classID, nftID); err != nil {
+			return err
+		}
+		return k.isNFTReceivable(ctx, classID, nftID, receiver)
+	}
+
 	if err := k.isNFTSendable(ctx, classID, nftID); err != nil {
 		return err
 	}
@@ -18,10 +18,16 @@ type QueryKeeper interface {
 	GetClass(ctx sdk.Context, classID string) (types.Class, error)
 	GetClasses(ctx sdk.Context, issuer *sdk.AccAddress, pagination *query.PageRequest) ([]types.Class, *query.PageResponse, error)
 	IsFrozen(ctx sdk.Context, classID, nftID string) (bool, error)
+	IsClassFrozen(ctx sdk.Context, classID string) (bool, error)
+	IsClassFrozenForAccount(ctx sdk.Context, classID string, account sdk.AccAddress) (bool, error)
+	GetClassFrozenAccounts(ctx sdk.Context, classID string, q *query.PageRequest) ([]string, *query.PageResponse, error)
+	IsClassWhitelisted(ctx sdk.Context, classID string, account sdk.AccAddress) (bool, error)
+	GetClassWhitelistedAccounts(ctx sdk.Context, classID string, q *query.PageRequest) ([]string, *query.PageResponse, error)
 	IsWhitelisted(ctx sdk.Context, classID, nftID string, account sdk.AccAddress) (bool, error)
 	GetWhitelistedAccountsForNFT(ctx sdk.Context, classID, nftID string, q *query.PageRequest) ([]string, *query.PageResponse, error)
 	GetBurntByClass(ctx sdk.Context, classID string, q *query.PageRequest) (*query.PageResponse, []string, error)
 	IsBurnt(ctx sdk.Context, classID, nftID string) (bool, error)
+	GetClassDefinition(ctx sdk.Context, classID string) (types.ClassDefinition, error)
 }
 
 // QueryService serves grpc query requests for assetsnft module.
@@ -82,6 +88,64 @@ func (qs QueryService) Frozen(ctx context.Context, req *types.QueryFrozenRequest
 	}, err
 }
 
+// ClassFrozen returns whether NFT class is frozen or not.
+func (qs QueryService) ClassFrozen(ctx context.Context, req *types.QueryClassFrozenRequest) (*types.QueryClassFrozenResponse, error) {
+	frozen, err := qs.keeper.IsClassFrozen(sdk.UnwrapSDKContext(ctx), req.ClassId)
+	return &types.QueryClassFrozenResponse{
+		Frozen: frozen,
+	}, err
+}
+
+// ClassFrozenForAccount returns whether NFT class is frozen for the account or not.
+func (qs QueryService) ClassFrozenForAccount(ctx context.Context, req *types.QueryClassFrozenForAccountRequest) (*types.QueryClassFrozenForAccountResponse, error) {
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid account")
+	}
+	frozen, err := qs.keeper.IsClassFrozenForAccount(sdk.UnwrapSDKContext(ctx), req.ClassId, account)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryClassFrozenForAccountResponse{
+		Frozen: frozen,
+	}, nil
+}
+
+// ClassFrozenAccounts returns the list of accounts for which the NFT class is frozen.
+func (qs QueryService) ClassFrozenAccounts(ctx context.Context, req *types.QueryClassFrozenAccountsRequest) (*types.QueryClassFrozenAccountsResponse, error) {
+	accounts, pageRes, err := qs.keeper.GetClassFrozenAccounts(sdk.UnwrapSDKContext(ctx), req.ClassId, req.Pagination)
+	return &types.QueryClassFrozenAccountsResponse{
+		Pagination: pageRes,
+		Accounts:   accounts,
+	}, err
+}
+
+// ClassWhitelisted checks to see if an account is whitelisted for all the NFTs of the class.
+func (qs QueryService) ClassWhitelisted(ctx context.Context, req *types.QueryClassWhitelistedRequest) (*types.QueryClassWhitelistedResponse, error) {
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid account")
+	}
+	whitelisted, err := qs.keeper.IsClassWhitelisted(sdk.UnwrapSDKContext(ctx), req.ClassId, account)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryClassWhitelistedResponse{
+		Whitelisted: whitelisted,
+	}, nil
+}
+
+// ClassWhitelistedAccounts returns the list of accounts which are whitelisted for all the NFTs of the class.
+func (qs QueryService) ClassWhitelistedAccounts(ctx context.Context, req *types.QueryClassWhitelistedAccountsRequest) (*types.QueryClassWhitelistedAccountsResponse, error) {
+	accounts, pageRes, err := qs.keeper.GetClassWhitelistedAccounts(sdk.UnwrapSDKContext(ctx), req.ClassId, req.Pagination)
+	return &types.QueryClassWhitelistedAccountsResponse{
+		Pagination: pageRes,
+		Accounts:   accounts,
+	}, err
+}
+
 // Whitelisted checks to see if an account is whitelisted for an NFT.
 func (qs QueryService) Whitelisted(ctx context.Context, req *types.QueryWhitelistedRequest) (*types.QueryWhitelistedResponse, error) {
 	account, err := sdk.AccAddressFromBech32(req.Account)
@@ -131,3 +195,22 @@ func (qs QueryService) BurntNFTsInClass(ctx context.Context, req *types.QueryBur
 		NftIds:     list,
 	}, nil
 }
+
+// RoyaltyPayout returns the royalty paid to the class issuer and the payout of the seller.
+func (qs QueryService) RoyaltyPayout(ctx context.Context, req *types.QueryRoyaltyPayoutRequest) (*types.QueryRoyaltyPayoutResponse, error) {
+	if err := req.Payment.Validate(); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidInput, "invalid payment: %s", err)
+	}
+
+	definition, err := qs.keeper.GetClassDefinition(sdk.UnwrapSDKContext(ctx), req.ClassId)
+	if err != nil {
+		return nil, err
+	}
+
+	royalty, sellerPayout := definition.RoyaltyPayout(req.Payment)
+	return &types.QueryRoyaltyPayoutResponse{
+		Issuer:       definition.Issuer,
+		Royalty:      royalty,
+		SellerPayout: sellerPayout,
+	}, nil
+}
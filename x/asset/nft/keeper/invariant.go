@@ -23,7 +23,8 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 	ir.RegisterRoute(types.ModuleName, BurntNFTInvariantName, BurntNFTInvariant(k))
 }
 
-// FreezingInvariant checks that all frozen NFTs have counterpart on the original Cosmos SDK nft module.
+// FreezingInvariant checks that all frozen NFTs have counterpart on the original Cosmos SDK nft module
+// and that all frozen classes have the freezing feature enabled.
 func FreezingInvariant(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
 		var (
@@ -57,6 +58,46 @@ func FreezingInvariant(k Keeper) sdk.Invariant {
 			}
 		}
 
+		frozenClasses, _, err := k.GetFrozenClasses(ctx, &query.PageRequest{Limit: query.MaxLimit})
+		if err != nil {
+			panic(err)
+		}
+		for _, classID := range frozenClasses {
+			classDefinition, err := k.GetClassDefinition(ctx, classID)
+			if types.ErrClassNotFound.Is(err) {
+				violationsCount++
+				msg += fmt.Sprintf("\t class definition not found for frozen class %s", classID)
+				continue
+			} else if err != nil {
+				panic(err)
+			}
+
+			if !classDefinition.IsFeatureEnabled(types.ClassFeature_freezing) {
+				violationsCount++
+				msg += fmt.Sprintf("\t freezing is disabled, but class %s is frozen \n", classID)
+			}
+		}
+
+		classFrozenAccounts, _, err := k.GetAllClassFrozenAccounts(ctx, &query.PageRequest{Limit: query.MaxLimit})
+		if err != nil {
+			panic(err)
+		}
+		for _, frozen := range classFrozenAccounts {
+			classDefinition, err := k.GetClassDefinition(ctx, frozen.ClassID)
+			if types.ErrClassNotFound.Is(err) {
+				violationsCount++
+				msg += fmt.Sprintf("\t class definition not found for class %s frozen for accounts", frozen.ClassID)
+				continue
+			} else if err != nil {
+				panic(err)
+			}
+
+			if !classDefinition.IsFeatureEnabled(types.ClassFeature_freezing) {
+				violationsCount++
+				msg += fmt.Sprintf("\t freezing is disabled, but class %s is frozen for accounts \n", frozen.ClassID)
+			}
+		}
+
 		return sdk.FormatInvariant(
 			types.ModuleName, FreezingInvariantName,
 			fmt.Sprintf("number of invariant violation %d\n%s", violationsCount, msg),
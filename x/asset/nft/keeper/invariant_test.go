@@ -89,6 +89,71 @@ func TestFrozenNFTExistsInvariant(t *testing.T) {
 	requireT.True(isBroken)
 }
 
+func TestFrozenClassInvariant(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	assetNFTKeeper := testApp.AssetNFTKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	classID, err := assetNFTKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer:   issuer,
+		Symbol:   "DEF",
+		Features: []types.ClassFeature{types.ClassFeature_freezing},
+	})
+	requireT.NoError(err)
+	requireT.NoError(assetNFTKeeper.ClassFreeze(ctx, issuer, classID))
+	frozenAccount := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	requireT.NoError(assetNFTKeeper.FreezeClassForAccount(ctx, issuer, classID, frozenAccount))
+
+	// invariant is valid
+	_, isBroken := keeper.FreezingInvariant(assetNFTKeeper)(ctx)
+	requireT.False(isBroken)
+
+	// class without freezing feature (invariant is broken)
+	nonFreezableClassID, err := assetNFTKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: issuer,
+		Symbol: "ABC",
+	})
+	requireT.NoError(err)
+	requireT.NoError(assetNFTKeeper.SetClassFrozen(ctx, nonFreezableClassID, true))
+	_, isBroken = keeper.FreezingInvariant(assetNFTKeeper)(ctx)
+	requireT.True(isBroken)
+}
+
+func TestClassFrozenForAccountInvariant(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	assetNFTKeeper := testApp.AssetNFTKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	frozenAccount := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	classID, err := assetNFTKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer:   issuer,
+		Symbol:   "DEF",
+		Features: []types.ClassFeature{types.ClassFeature_freezing},
+	})
+	requireT.NoError(err)
+	requireT.NoError(assetNFTKeeper.FreezeClassForAccount(ctx, issuer, classID, frozenAccount))
+
+	// invariant is valid
+	_, isBroken := keeper.FreezingInvariant(assetNFTKeeper)(ctx)
+	requireT.False(isBroken)
+
+	// class without freezing feature (invariant is broken)
+	nonFreezableClassID, err := assetNFTKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: issuer,
+		Symbol: "ABC",
+	})
+	requireT.NoError(err)
+	requireT.NoError(assetNFTKeeper.SetClassFrozenForAccount(ctx, nonFreezableClassID, frozenAccount, true))
+	_, isBroken = keeper.FreezingInvariant(assetNFTKeeper)(ctx)
+	requireT.True(isBroken)
+}
+
 func TestBurntNFTNotExistsInvariant(t *testing.T) {
 	requireT := require.New(t)
 	testApp := simapp.New()
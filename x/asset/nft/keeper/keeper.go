@@ -16,6 +16,7 @@ import (
 	"github.com/CoreumFoundation/coreum/v2/x/asset"
 	"github.com/CoreumFoundation/coreum/v2/x/asset/nft/types"
 	"github.com/CoreumFoundation/coreum/v2/x/nft"
+	nfttransfertypes "github.com/CoreumFoundation/coreum/v2/x/nfttransfer/types"
 )
 
 // ParamSubspace represents a subscope of methods exposed by param module to store and retrieve parameters.
@@ -180,6 +181,10 @@ func (k Keeper) IssueClass(ctx sdk.Context, settings types.IssueClassSettings) (
 
 // GetClassDefinition reruns the ClassDefinition.
 func (k Keeper) GetClassDefinition(ctx sdk.Context, classID string) (types.ClassDefinition, error) {
+	// the voucher classes received over IBC are not issued by the asset nft module
+	if nfttransfertypes.IsVoucherClassID(classID) {
+		return types.ClassDefinition{}, sdkerrors.Wrapf(types.ErrClassNotFound, "classID: %s", classID)
+	}
 	if _, _, err := types.DeconstructClassID(classID); err != nil {
 		return types.ClassDefinition{}, err
 	}
@@ -363,8 +368,203 @@ func (k Keeper) Burn(ctx sdk.Context, owner sdk.AccAddress, classID, id string)
 	return k.SetBurnt(ctx, classID, id)
 }
 
+// MintBatch mints multiple non-fungible tokens. Either all the tokens are minted or none of them.
+func (k Keeper) MintBatch(ctx sdk.Context, settings []types.MintSettings) error {
+	for _, s := range settings {
+		if err := k.Mint(ctx, s); err != nil {
+			return sdkerrors.Wrapf(err, "failed to mint nft with classID:%s and ID:%s", s.ClassID, s.ID)
+		}
+	}
+
+	return nil
+}
+
+// BurnBatch burns multiple non-fungible tokens of the class. Either all the tokens are burnt or none of them.
+func (k Keeper) BurnBatch(ctx sdk.Context, owner sdk.AccAddress, classID string, ids []string) error {
+	for _, id := range ids {
+		if err := k.Burn(ctx, owner, classID, id); err != nil {
+			return sdkerrors.Wrapf(err, "failed to burn nft with classID:%s and ID:%s", classID, id)
+		}
+	}
+
+	return nil
+}
+
+// SendWithPayment transfers the NFT from the sender to the receiver paying for it. The royalty computed using
+// the royalty rate of the class is paid by the receiver to the class issuer and the rest of the payment is sent
+// to the sender.
+func (k Keeper) SendWithPayment(
+	ctx sdk.Context,
+	sender, receiver sdk.AccAddress,
+	classID, nftID string,
+	payment sdk.Coin,
+) error {
+	definition, err := k.GetClassDefinition(ctx, classID)
+	if err != nil {
+		return err
+	}
+
+	if !k.nftKeeper.HasNFT(ctx, classID, nftID) {
+		return sdkerrors.Wrapf(types.ErrNFTNotFound, "nft with classID:%s and ID:%s not found", classID, nftID)
+	}
+
+	if !k.nftKeeper.GetOwner(ctx, classID, nftID).Equals(sender) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only owner can send the nft")
+	}
+
+	if err := k.BeforeTransfer(ctx, classID, nftID, receiver); err != nil {
+		return err
+	}
+
+	royalty, sellerPayout := definition.RoyaltyPayout(payment)
+	issuer := sdk.MustAccAddressFromBech32(definition.Issuer)
+	if royalty.IsPositive() {
+		if err := k.bankKeeper.SendCoins(ctx, receiver, issuer, sdk.NewCoins(royalty)); err != nil {
+			return sdkerrors.Wrapf(err, "can't pay royalty %s to the issuer %s", royalty, issuer)
+		}
+	}
+	if sellerPayout.IsPositive() {
+		if err := k.bankKeeper.SendCoins(ctx, receiver, sender, sdk.NewCoins(sellerPayout)); err != nil {
+			return sdkerrors.Wrapf(err, "can't pay %s to the sender %s", sellerPayout, sender)
+		}
+	}
+
+	if err := k.nftKeeper.Transfer(ctx, classID, nftID, receiver); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "can't transfer non-fungible token: %s", err)
+	}
+
+	if err = ctx.EventManager().EmitTypedEvent(&types.EventRoyaltyPaid{
+		ClassId:  classID,
+		Id:       nftID,
+		Sender:   sender.String(),
+		Receiver: receiver.String(),
+		Issuer:   definition.Issuer,
+		Payment:  payment,
+		Royalty:  royalty,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit event EventRoyaltyPaid: %s", err)
+	}
+
+	return nil
+}
+
+// UpdateClass updates the metadata of the non-fungible token class.
+func (k Keeper) UpdateClass(ctx sdk.Context, settings types.UpdateClassSettings) error {
+	if err := types.ValidateData(settings.Data); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidInput, err.Error())
+	}
+
+	definition, err := k.GetClassDefinition(ctx, settings.ClassID)
+	if err != nil {
+		return err
+	}
+
+	if err = definition.CheckFeatureAllowed(settings.Sender, types.ClassFeature_updatable); err != nil {
+		return err
+	}
+
+	class, found := k.nftKeeper.GetClass(ctx, settings.ClassID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrClassNotFound, "nft class with ID:%s not found", settings.ClassID)
+	}
+
+	class.Uri = settings.URI
+	class.UriHash = settings.URIHash
+	class.Data = settings.Data
+	if err := k.nftKeeper.UpdateClass(ctx, class); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "can't update non-fungible token class: %s", err)
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventClassUpdated{
+		ClassId: settings.ClassID,
+		Sender:  settings.Sender.String(),
+		URI:     settings.URI,
+		URIHash: settings.URIHash,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit event EventClassUpdated: %s", err)
+	}
+
+	return nil
+}
+
+// UpdateData updates the metadata of the non-fungible token.
+func (k Keeper) UpdateData(ctx sdk.Context, settings types.UpdateDataSettings) error {
+	if err := types.ValidateData(settings.Data); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidInput, err.Error())
+	}
+
+	definition, err := k.GetClassDefinition(ctx, settings.ClassID)
+	if err != nil {
+		return err
+	}
+
+	token, found := k.nftKeeper.GetNFT(ctx, settings.ClassID, settings.ID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrNFTNotFound, "nft with classID:%s and ID:%s not found", settings.ClassID, settings.ID)
+	}
+
+	if err := k.checkUpdatable(ctx, settings.Sender, definition, settings.ClassID, settings.ID); err != nil {
+		return err
+	}
+
+	token.Uri = settings.URI
+	token.UriHash = settings.URIHash
+	token.Data = settings.Data
+	if err := k.nftKeeper.Update(ctx, token); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "can't update non-fungible token: %s", err)
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventDataUpdated{
+		ClassId: settings.ClassID,
+		Id:      settings.ID,
+		Sender:  settings.Sender.String(),
+		URI:     settings.URI,
+		URIHash: settings.URIHash,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit event EventDataUpdated: %s", err)
+	}
+
+	return nil
+}
+
+func (k Keeper) checkUpdatable(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	definition types.ClassDefinition,
+	classID, nftID string,
+) error {
+	isUpdatable := definition.IsFeatureEnabled(types.ClassFeature_updatable)
+	isOwnerUpdatable := definition.IsFeatureEnabled(types.ClassFeature_owner_updatable)
+	if !isUpdatable && !isOwnerUpdatable {
+		return sdkerrors.Wrapf(
+			types.ErrFeatureDisabled,
+			"features %s and %s are disabled",
+			types.ClassFeature_updatable.String(),
+			types.ClassFeature_owner_updatable.String(),
+		)
+	}
+
+	// the issuer is allowed to update the frozen token
+	if isUpdatable && definition.IsIssuer(sender) {
+		return nil
+	}
+
+	if isOwnerUpdatable && k.nftKeeper.GetOwner(ctx, classID, nftID).Equals(sender) {
+		frozen, err := k.isNFTOrClassFrozen(ctx, classID, nftID)
+		if err != nil && !errors.Is(err, types.ErrFeatureDisabled) {
+			return err
+		}
+		if frozen {
+			return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "frozen token cannot be updated")
+		}
+		return nil
+	}
+
+	return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "address %s is unauthorized to update the nft", sender.String())
+}
+
 func (k Keeper) checkBurnable(ctx sdk.Context, owner sdk.AccAddress, ndfd types.ClassDefinition, classID, nftID string) error {
-	frozen, err := k.IsFrozen(ctx, classID, nftID)
+	frozen, err := k.isNFTOrClassFrozen(ctx, classID, nftID)
 	if err != nil && !errors.Is(err, types.ErrFeatureDisabled) {
 		return err
 	}
@@ -550,6 +750,174 @@ func (k Keeper) GetFrozenNFTs(ctx sdk.Context, q *query.PageRequest) ([]types.Fr
 	return frozen, pageRes, nil
 }
 
+// ClassFreeze freezes all the non-fungible tokens of the class.
+func (k Keeper) ClassFreeze(ctx sdk.Context, sender sdk.AccAddress, classID string) error {
+	return k.classFreezeOrUnfreeze(ctx, sender, classID, true)
+}
+
+// ClassUnfreeze unfreezes the non-fungible token class.
+func (k Keeper) ClassUnfreeze(ctx sdk.Context, sender sdk.AccAddress, classID string) error {
+	return k.classFreezeOrUnfreeze(ctx, sender, classID, false)
+}
+
+// SetClassFrozen marks the nft class frozen, but does not make any checks
+// should not be used directly outside the module except for genesis.
+func (k Keeper) SetClassFrozen(ctx sdk.Context, classID string, frozen bool) error {
+	key, err := types.CreateClassFreezingKey(classID)
+	if err != nil {
+		return err
+	}
+	s := ctx.KVStore(k.storeKey)
+	if frozen {
+		s.Set(key, asset.StoreTrue)
+	} else {
+		s.Delete(key)
+	}
+	return nil
+}
+
+// IsClassFrozen return whether a non-fungible token class is frozen or not.
+func (k Keeper) IsClassFrozen(ctx sdk.Context, classID string) (bool, error) {
+	classDefinition, err := k.GetClassDefinition(ctx, classID)
+	if err != nil {
+		return false, err
+	}
+
+	if !classDefinition.IsFeatureEnabled(types.ClassFeature_freezing) {
+		return false, sdkerrors.Wrapf(types.ErrFeatureDisabled, `feature "freezing" is disabled`)
+	}
+
+	key, err := types.CreateClassFreezingKey(classID)
+	if err != nil {
+		return false, err
+	}
+
+	return bytes.Equal(ctx.KVStore(k.storeKey).Get(key), asset.StoreTrue), nil
+}
+
+// GetFrozenClasses return paginated frozen classes.
+func (k Keeper) GetFrozenClasses(ctx sdk.Context, q *query.PageRequest) ([]string, *query.PageResponse, error) {
+	classIDs := make([]string, 0)
+	pageRes, err := query.Paginate(prefix.NewStore(ctx.KVStore(k.storeKey), types.NFTClassFreezingKeyPrefix),
+		q, func(key, value []byte) error {
+			if !bytes.Equal(value, asset.StoreTrue) {
+				return sdkerrors.Wrapf(types.ErrInvalidState, "value stored in class freezing store is not %x, value %x", asset.StoreTrue, value)
+			}
+			classID, err := types.ParseClassFreezingKey(key)
+			if err != nil {
+				return err
+			}
+
+			classIDs = append(classIDs, classID)
+			return nil
+		})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return classIDs, pageRes, nil
+}
+
+// FreezeClassForAccount freezes all the non-fungible tokens of the class held by the account.
+func (k Keeper) FreezeClassForAccount(ctx sdk.Context, sender sdk.AccAddress, classID string, account sdk.AccAddress) error {
+	return k.classFreezeOrUnfreezeForAccount(ctx, sender, classID, account, true)
+}
+
+// UnfreezeClassForAccount unfreezes the non-fungible token class for the account.
+func (k Keeper) UnfreezeClassForAccount(ctx sdk.Context, sender sdk.AccAddress, classID string, account sdk.AccAddress) error {
+	return k.classFreezeOrUnfreezeForAccount(ctx, sender, classID, account, false)
+}
+
+// SetClassFrozenForAccount marks the nft class frozen for the account, but does not make any checks
+// should not be used directly outside the module except for genesis.
+func (k Keeper) SetClassFrozenForAccount(ctx sdk.Context, classID string, account sdk.AccAddress, frozen bool) error {
+	key, err := types.CreateClassAccountFreezingKey(classID, account)
+	if err != nil {
+		return err
+	}
+	s := ctx.KVStore(k.storeKey)
+	if frozen {
+		s.Set(key, asset.StoreTrue)
+	} else {
+		s.Delete(key)
+	}
+	return nil
+}
+
+// IsClassFrozenForAccount return whether a non-fungible token class is frozen for the account or not.
+func (k Keeper) IsClassFrozenForAccount(ctx sdk.Context, classID string, account sdk.AccAddress) (bool, error) {
+	classDefinition, err := k.GetClassDefinition(ctx, classID)
+	if err != nil {
+		return false, err
+	}
+
+	if !classDefinition.IsFeatureEnabled(types.ClassFeature_freezing) {
+		return false, sdkerrors.Wrapf(types.ErrFeatureDisabled, `feature "freezing" is disabled`)
+	}
+
+	key, err := types.CreateClassAccountFreezingKey(classID, account)
+	if err != nil {
+		return false, err
+	}
+
+	return bytes.Equal(ctx.KVStore(k.storeKey).Get(key), asset.StoreTrue), nil
+}
+
+// GetClassFrozenAccounts returns paginated accounts for which the class is frozen.
+func (k Keeper) GetClassFrozenAccounts(ctx sdk.Context, classID string, q *query.PageRequest) ([]string, *query.PageResponse, error) {
+	if _, err := k.GetClassDefinition(ctx, classID); err != nil {
+		return nil, nil, err
+	}
+
+	key, err := types.CreateClassAccountFreezingPrefix(classID)
+	if err != nil {
+		return nil, nil, err
+	}
+	accounts := []string{}
+	pageRes, err := query.Paginate(prefix.NewStore(ctx.KVStore(k.storeKey), key),
+		q, func(key, value []byte) error {
+			if !bytes.Equal(value, asset.StoreTrue) {
+				return sdkerrors.Wrapf(types.ErrInvalidState, "value stored in class account freezing store is not %x, value %x", asset.StoreTrue, value)
+			}
+
+			account := sdk.AccAddress(key[1:]) // the first byte contains the length prefix
+			accounts = append(accounts, account.String())
+			return nil
+		})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return accounts, pageRes, nil
+}
+
+// GetAllClassFrozenAccounts returns paginated accounts for which the classes are frozen.
+func (k Keeper) GetAllClassFrozenAccounts(ctx sdk.Context, q *query.PageRequest) ([]types.ClassFrozenAccounts, *query.PageResponse, error) {
+	frozen := make([]types.ClassFrozenAccounts, 0)
+	pageRes, err := query.Paginate(prefix.NewStore(ctx.KVStore(k.storeKey), types.NFTClassAccountFreezingKeyPrefix),
+		q, func(key, value []byte) error {
+			if !bytes.Equal(value, asset.StoreTrue) {
+				return sdkerrors.Wrapf(types.ErrInvalidState, "value stored in class account freezing store is not %x, value %x", asset.StoreTrue, value)
+			}
+			classID, account, err := types.ParseClassAccountFreezingKey(key)
+			if err != nil {
+				return err
+			}
+
+			// keys are sorted by the class ID, so all the accounts of the class are next to each other
+			if len(frozen) == 0 || frozen[len(frozen)-1].ClassID != classID {
+				frozen = append(frozen, types.ClassFrozenAccounts{ClassID: classID})
+			}
+			frozen[len(frozen)-1].Accounts = append(frozen[len(frozen)-1].Accounts, account.String())
+			return nil
+		})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return frozen, pageRes, nil
+}
+
 // IsWhitelisted checks to see if an account is whitelisted for an NFT.
 func (k Keeper) IsWhitelisted(ctx sdk.Context, classID, nftID string, account sdk.AccAddress) (bool, error) {
 	classDefinition, err := k.GetClassDefinition(ctx, classID)
@@ -673,6 +1041,106 @@ func (k Keeper) SetWhitelisting(ctx sdk.Context, classID, nftID string, account
 	return nil
 }
 
+// AddToClassWhitelist adds an account to the whitelisted list of accounts for all the NFTs of the class.
+func (k Keeper) AddToClassWhitelist(ctx sdk.Context, classID string, sender, account sdk.AccAddress) error {
+	return k.addToClassWhitelistOrRemoveFromClassWhitelist(ctx, classID, sender, account, true)
+}
+
+// RemoveFromClassWhitelist removes an account from the whitelisted list of accounts for the class.
+func (k Keeper) RemoveFromClassWhitelist(ctx sdk.Context, classID string, sender, account sdk.AccAddress) error {
+	return k.addToClassWhitelistOrRemoveFromClassWhitelist(ctx, classID, sender, account, false)
+}
+
+// SetClassWhitelisting adds an account to the whitelisting of the class, if whitelisting is true
+// and removes it, if whitelisting is false.
+func (k Keeper) SetClassWhitelisting(ctx sdk.Context, classID string, account sdk.AccAddress, whitelisting bool) error {
+	key, err := types.CreateClassWhitelistingKey(classID, account)
+	if err != nil {
+		return err
+	}
+	s := ctx.KVStore(k.storeKey)
+	if whitelisting {
+		s.Set(key, asset.StoreTrue)
+	} else {
+		s.Delete(key)
+	}
+	return nil
+}
+
+// IsClassWhitelisted checks to see if an account is whitelisted for all the NFTs of the class.
+func (k Keeper) IsClassWhitelisted(ctx sdk.Context, classID string, account sdk.AccAddress) (bool, error) {
+	classDefinition, err := k.GetClassDefinition(ctx, classID)
+	if err != nil {
+		return false, err
+	}
+
+	if !classDefinition.IsFeatureEnabled(types.ClassFeature_whitelisting) {
+		return false, sdkerrors.Wrapf(types.ErrFeatureDisabled, `feature "whitelisting" is disabled`)
+	}
+
+	key, err := types.CreateClassWhitelistingKey(classID, account)
+	if err != nil {
+		return false, err
+	}
+
+	return bytes.Equal(ctx.KVStore(k.storeKey).Get(key), asset.StoreTrue), nil
+}
+
+// GetClassWhitelistedAccounts returns paginated accounts whitelisted for the class.
+func (k Keeper) GetClassWhitelistedAccounts(ctx sdk.Context, classID string, q *query.PageRequest) ([]string, *query.PageResponse, error) {
+	if _, err := k.GetClassDefinition(ctx, classID); err != nil {
+		return nil, nil, err
+	}
+
+	key, err := types.CreateClassWhitelistingPrefix(classID)
+	if err != nil {
+		return nil, nil, err
+	}
+	accounts := []string{}
+	pageRes, err := query.Paginate(prefix.NewStore(ctx.KVStore(k.storeKey), key),
+		q, func(key, value []byte) error {
+			if !bytes.Equal(value, asset.StoreTrue) {
+				return sdkerrors.Wrapf(types.ErrInvalidState, "value stored in class whitelisting store is not %x, value %x", asset.StoreTrue, value)
+			}
+
+			account := sdk.AccAddress(key[1:]) // the first byte contains the length prefix
+			accounts = append(accounts, account.String())
+			return nil
+		})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return accounts, pageRes, nil
+}
+
+// GetAllClassWhitelistedAccounts returns paginated accounts whitelisted for all the classes.
+func (k Keeper) GetAllClassWhitelistedAccounts(ctx sdk.Context, q *query.PageRequest) ([]types.ClassWhitelistedAccounts, *query.PageResponse, error) {
+	whitelisted := make([]types.ClassWhitelistedAccounts, 0)
+	pageRes, err := query.Paginate(prefix.NewStore(ctx.KVStore(k.storeKey), types.NFTClassWhitelistingKeyPrefix),
+		q, func(key, value []byte) error {
+			if !bytes.Equal(value, asset.StoreTrue) {
+				return sdkerrors.Wrapf(types.ErrInvalidState, "value stored in class whitelisting store is not %x, value %x", asset.StoreTrue, value)
+			}
+			classID, account, err := types.ParseClassWhitelistingKey(key)
+			if err != nil {
+				return err
+			}
+
+			// keys are sorted by the class ID, so all the accounts of the class are next to each other
+			if len(whitelisted) == 0 || whitelisted[len(whitelisted)-1].ClassID != classID {
+				whitelisted = append(whitelisted, types.ClassWhitelistedAccounts{ClassID: classID})
+			}
+			whitelisted[len(whitelisted)-1].Accounts = append(whitelisted[len(whitelisted)-1].Accounts, account.String())
+			return nil
+		})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return whitelisted, pageRes, nil
+}
+
 func (k Keeper) isNFTSendable(ctx sdk.Context, classID, nftID string) error {
 	classDefinition, err := k.GetClassDefinition(ctx, classID)
 	// we return nil here, since we want the original tests of the nft module to pass, but they
@@ -695,7 +1163,7 @@ func (k Keeper) isNFTSendable(ctx sdk.Context, classID, nftID string) error {
 		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "nft with classID:%s and ID:%s has sending disabled", classID, nftID)
 	}
 
-	frozen, err := k.IsFrozen(ctx, classID, nftID)
+	frozen, err := k.isNFTOrClassFrozen(ctx, classID, nftID)
 	if err != nil {
 		if errors.Is(err, types.ErrFeatureDisabled) {
 			return nil
@@ -708,6 +1176,56 @@ func (k Keeper) isNFTSendable(ctx sdk.Context, classID, nftID string) error {
 	return nil
 }
 
+// isNFTOrClassFrozen returns true if the nft, the whole class or the class for the nft owner is frozen.
+func (k Keeper) isNFTOrClassFrozen(ctx sdk.Context, classID, nftID string) (bool, error) {
+	frozen, err := k.IsFrozen(ctx, classID, nftID)
+	if err != nil || frozen {
+		return frozen, err
+	}
+
+	frozen, err = k.IsClassFrozen(ctx, classID)
+	if err != nil || frozen {
+		return frozen, err
+	}
+
+	return k.IsClassFrozenForAccount(ctx, classID, k.nftKeeper.GetOwner(ctx, classID, nftID))
+}
+
+func (k Keeper) isNFTIBCTransferable(ctx sdk.Context, classID string) error {
+	classDefinition, err := k.GetClassDefinition(ctx, classID)
+	// the same as for isNFTSendable, the classes not registered on asset are not restricted here
+	if types.ErrClassNotFound.Is(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if !classDefinition.IsFeatureEnabled(types.ClassFeature_ibc) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "ibc transfers are disabled for classID:%s", classID)
+	}
+	return nil
+}
+
+// isNFTIBCReceivable checks that neither the nft nor the whole class is frozen. The class frozen for the
+// escrow address is intentionally not checked, so the issuer can't block the nfts coming back.
+func (k Keeper) isNFTIBCReceivable(ctx sdk.Context, classID, nftID string) error {
+	frozen, err := k.IsFrozen(ctx, classID, nftID)
+	if err == nil && !frozen {
+		frozen, err = k.IsClassFrozen(ctx, classID)
+	}
+	if err != nil {
+		if types.ErrClassNotFound.Is(err) || errors.Is(err, types.ErrFeatureDisabled) {
+			return nil
+		}
+		return err
+	}
+	if frozen {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "nft with classID:%s and ID:%s is frozen", classID, nftID)
+	}
+	return nil
+}
+
 func (k Keeper) isNFTReceivable(ctx sdk.Context, classID, nftID string, receiver sdk.AccAddress) error {
 	classDefinition, err := k.GetClassDefinition(ctx, classID)
 	// we return nil here, since we want the original tests of the nft module to pass, but they
@@ -736,6 +1254,12 @@ func (k Keeper) isNFTReceivable(ctx sdk.Context, classID, nftID string, receiver
 		}
 		return err
 	}
+	if !whitelisted {
+		whitelisted, err = k.IsClassWhitelisted(ctx, classID, receiver)
+		if err != nil {
+			return err
+		}
+	}
 	if !whitelisted {
 		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "nft with classID:%s and ID:%s is not whitelisted for account %s", classID, nftID, receiver)
 	}
@@ -784,6 +1308,125 @@ func (k Keeper) freezeOrUnfreeze(ctx sdk.Context, sender sdk.AccAddress, classID
 	return nil
 }
 
+func (k Keeper) classFreezeOrUnfreeze(ctx sdk.Context, sender sdk.AccAddress, classID string, setFrozen bool) error {
+	classDefinition, err := k.GetClassDefinition(ctx, classID)
+	if err != nil {
+		return err
+	}
+
+	if err = classDefinition.CheckFeatureAllowed(sender, types.ClassFeature_freezing); err != nil {
+		return err
+	}
+
+	if err := k.SetClassFrozen(ctx, classID, setFrozen); err != nil {
+		return err
+	}
+
+	var event proto.Message
+	if setFrozen {
+		event = &types.EventClassFrozen{
+			ClassId: classID,
+		}
+	} else {
+		event = &types.EventClassUnfrozen{
+			ClassId: classID,
+		}
+	}
+
+	if err = ctx.EventManager().EmitTypedEvent(event); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit event: %v, err: %s", event, err)
+	}
+
+	return nil
+}
+
+func (k Keeper) classFreezeOrUnfreezeForAccount(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	classID string,
+	account sdk.AccAddress,
+	setFrozen bool,
+) error {
+	classDefinition, err := k.GetClassDefinition(ctx, classID)
+	if err != nil {
+		return err
+	}
+
+	if err = classDefinition.CheckFeatureAllowed(sender, types.ClassFeature_freezing); err != nil {
+		return err
+	}
+
+	if classDefinition.IsIssuer(account) {
+		return sdkerrors.Wrap(types.ErrInvalidInput, "the class can't be frozen for the issuer")
+	}
+
+	if err := k.SetClassFrozenForAccount(ctx, classID, account, setFrozen); err != nil {
+		return err
+	}
+
+	var event proto.Message
+	if setFrozen {
+		event = &types.EventClassFrozenForAccount{
+			ClassId: classID,
+			Account: account.String(),
+		}
+	} else {
+		event = &types.EventClassUnfrozenForAccount{
+			ClassId: classID,
+			Account: account.String(),
+		}
+	}
+
+	if err = ctx.EventManager().EmitTypedEvent(event); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit event: %v, err: %s", event, err)
+	}
+
+	return nil
+}
+
+func (k Keeper) addToClassWhitelistOrRemoveFromClassWhitelist(
+	ctx sdk.Context,
+	classID string,
+	sender, account sdk.AccAddress,
+	setWhitelisted bool,
+) error {
+	classDefinition, err := k.GetClassDefinition(ctx, classID)
+	if err != nil {
+		return err
+	}
+
+	if err = classDefinition.CheckFeatureAllowed(sender, types.ClassFeature_whitelisting); err != nil {
+		return err
+	}
+
+	if classDefinition.Issuer == account.String() {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "setting class whitelisting for the nft class issuer is forbidden")
+	}
+
+	if err := k.SetClassWhitelisting(ctx, classID, account, setWhitelisted); err != nil {
+		return err
+	}
+
+	var event proto.Message
+	if setWhitelisted {
+		event = &types.EventAddedToClassWhitelist{
+			ClassId: classID,
+			Account: account.String(),
+		}
+	} else {
+		event = &types.EventRemovedFromClassWhitelist{
+			ClassId: classID,
+			Account: account.String(),
+		}
+	}
+
+	if err = ctx.EventManager().EmitTypedEvent(event); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit event: %v, err: %s", event, err)
+	}
+
+	return nil
+}
+
 func (k Keeper) addToWhitelistOrRemoveFromWhitelist(ctx sdk.Context, classID, nftID string, sender, account sdk.AccAddress, setWhitelisted bool) error {
 	classDefinition, err := k.GetClassDefinition(ctx, classID)
 	if err != nil {
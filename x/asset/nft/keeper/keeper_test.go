@@ -18,6 +18,8 @@ import (
 	"github.com/CoreumFoundation/coreum/v2/testutil/event"
 	"github.com/CoreumFoundation/coreum/v2/testutil/simapp"
 	"github.com/CoreumFoundation/coreum/v2/x/asset/nft/types"
+	nfttypes "github.com/CoreumFoundation/coreum/v2/x/nft"
+	wibctransfertypes "github.com/CoreumFoundation/coreum/v2/x/wibctransfer/types"
 )
 
 func TestKeeper_IssueClass(t *testing.T) {
@@ -708,6 +710,110 @@ func TestKeeper_Whitelist(t *testing.T) {
 	}, incrementallyQueriedAccounts)
 }
 
+func TestKeeper_ClassWhitelist(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	assetNFTKeeper := testApp.AssetNFTKeeper
+	nftKeeper := testApp.NFTKeeper
+
+	assetNFTKeeper.SetParams(ctx, types.Params{
+		MintFee: sdk.NewInt64Coin(constant.DenomDev, 0),
+	})
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	classID, err := assetNFTKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: issuer,
+		Symbol: "symbol",
+		Features: []types.ClassFeature{
+			types.ClassFeature_whitelisting,
+		},
+	})
+	requireT.NoError(err)
+
+	for _, nftID := range []string{"my-id-1", "my-id-2", "my-id-3"} {
+		requireT.NoError(assetNFTKeeper.Mint(ctx, types.MintSettings{
+			Sender:  issuer,
+			ClassID: classID,
+			ID:      nftID,
+		}))
+	}
+
+	recipient := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	// try to whitelist the account by non-issuer
+	err = assetNFTKeeper.AddToClassWhitelist(ctx, classID, recipient, recipient)
+	requireT.True(sdkerrors.ErrUnauthorized.Is(err))
+
+	// try to whitelist the issuer
+	err = assetNFTKeeper.AddToClassWhitelist(ctx, classID, issuer, issuer)
+	requireT.True(sdkerrors.ErrUnauthorized.Is(err))
+
+	// transfer to non whitelisted account, it should fail
+	err = nftKeeper.Transfer(ctx, classID, "my-id-1", recipient)
+	requireT.True(sdkerrors.ErrUnauthorized.Is(err))
+
+	// whitelist the account for the class
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	requireT.NoError(assetNFTKeeper.AddToClassWhitelist(ctx, classID, issuer, recipient))
+	isWhitelisted, err := assetNFTKeeper.IsClassWhitelisted(ctx, classID, recipient)
+	requireT.NoError(err)
+	requireT.True(isWhitelisted)
+
+	events, err := event.FindTypedEvents[*types.EventAddedToClassWhitelist](ctx.EventManager().ABCIEvents())
+	requireT.NoError(err)
+	requireT.Equal(&types.EventAddedToClassWhitelist{
+		ClassId: classID,
+		Account: recipient.String(),
+	}, events[0])
+
+	// the nft itself is not whitelisted for the account, but all the tokens of the class can be received
+	isWhitelisted, err = assetNFTKeeper.IsWhitelisted(ctx, classID, "my-id-1", recipient)
+	requireT.NoError(err)
+	requireT.False(isWhitelisted)
+	requireT.NoError(nftKeeper.Transfer(ctx, classID, "my-id-1", recipient))
+	requireT.NoError(nftKeeper.Transfer(ctx, classID, "my-id-2", recipient))
+
+	// query accounts
+	recipient2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	requireT.NoError(assetNFTKeeper.AddToClassWhitelist(ctx, classID, issuer, recipient2))
+	accounts, _, err := assetNFTKeeper.GetClassWhitelistedAccounts(ctx, classID, &query.PageRequest{Limit: query.MaxLimit})
+	requireT.NoError(err)
+	requireT.ElementsMatch([]string{recipient.String(), recipient2.String()}, accounts)
+
+	// remove the account from the class whitelist
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	requireT.NoError(assetNFTKeeper.RemoveFromClassWhitelist(ctx, classID, issuer, recipient))
+	isWhitelisted, err = assetNFTKeeper.IsClassWhitelisted(ctx, classID, recipient)
+	requireT.NoError(err)
+	requireT.False(isWhitelisted)
+
+	removeEvents, err := event.FindTypedEvents[*types.EventRemovedFromClassWhitelist](ctx.EventManager().ABCIEvents())
+	requireT.NoError(err)
+	requireT.Equal(&types.EventRemovedFromClassWhitelist{
+		ClassId: classID,
+		Account: recipient.String(),
+	}, removeEvents[0])
+
+	err = nftKeeper.Transfer(ctx, classID, "my-id-3", recipient)
+	requireT.True(sdkerrors.ErrUnauthorized.Is(err))
+
+	// per nft whitelisting still works
+	requireT.NoError(assetNFTKeeper.AddToWhitelist(ctx, classID, "my-id-3", issuer, recipient))
+	requireT.NoError(nftKeeper.Transfer(ctx, classID, "my-id-3", recipient))
+
+	// try to whitelist the account without the feature
+	nonWhitelistableClassID, err := assetNFTKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: issuer,
+		Symbol: "symbol2",
+	})
+	requireT.NoError(err)
+	err = assetNFTKeeper.AddToClassWhitelist(ctx, nonWhitelistableClassID, issuer, recipient)
+	requireT.True(types.ErrFeatureDisabled.Is(err))
+	_, err = assetNFTKeeper.IsClassWhitelisted(ctx, nonWhitelistableClassID, recipient)
+	requireT.True(types.ErrFeatureDisabled.Is(err))
+}
+
 func TestKeeper_Whitelist_Unwhitelistable(t *testing.T) {
 	requireT := require.New(t)
 	testApp := simapp.New()
@@ -811,3 +917,630 @@ func requireClassSettingsEqualClass(requireT *require.Assertions, settings types
 	requireT.Equal(string(settings.Data.Value), string(class.Data.Value))
 	requireT.Equal(settings.Features, class.Features)
 }
+
+func TestKeeper_SendWithPayment(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	assetNFTKeeper := testApp.AssetNFTKeeper
+	nftKeeper := testApp.NFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	nftParams := types.Params{
+		MintFee: sdk.NewInt64Coin(constant.DenomDev, 0),
+	}
+	assetNFTKeeper.SetParams(ctx, nftParams)
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	classSettings := types.IssueClassSettings{
+		Issuer:      issuer,
+		Symbol:      "symbol",
+		RoyaltyRate: sdk.MustNewDecFromStr("0.1"),
+		Features: []types.ClassFeature{
+			types.ClassFeature_freezing,
+		},
+	}
+
+	classID, err := assetNFTKeeper.IssueClass(ctx, classSettings)
+	requireT.NoError(err)
+
+	settings := types.MintSettings{
+		Sender:  issuer,
+		ClassID: classID,
+		ID:      "my-id",
+	}
+	requireT.NoError(assetNFTKeeper.Mint(ctx, settings))
+	nftID := settings.ID
+
+	seller := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	requireT.NoError(nftKeeper.Transfer(ctx, classID, nftID, seller))
+
+	buyer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	payment := sdk.NewInt64Coin(constant.DenomDev, 1005)
+	requireT.NoError(testApp.FundAccount(ctx, buyer, sdk.NewCoins(payment)))
+
+	// try to send the nft by non-owner
+	err = assetNFTKeeper.SendWithPayment(ctx, issuer, buyer, classID, nftID, payment)
+	requireT.True(sdkerrors.ErrUnauthorized.Is(err))
+
+	// try to send the frozen nft
+	requireT.NoError(assetNFTKeeper.Freeze(ctx, issuer, classID, nftID))
+	err = assetNFTKeeper.SendWithPayment(ctx, seller, buyer, classID, nftID, payment)
+	requireT.True(sdkerrors.ErrUnauthorized.Is(err))
+	requireT.NoError(assetNFTKeeper.Unfreeze(ctx, issuer, classID, nftID))
+
+	// try to pay more than the buyer has, cached context is used because the failed message is reverted
+	cacheCtx, _ := ctx.CacheContext()
+	err = assetNFTKeeper.SendWithPayment(cacheCtx, seller, buyer, classID, nftID, payment.AddAmount(sdk.OneInt()))
+	requireT.True(sdkerrors.ErrInsufficientFunds.Is(err))
+
+	// send the nft, the royalty is rounded down
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	requireT.NoError(assetNFTKeeper.SendWithPayment(ctx, seller, buyer, classID, nftID, payment))
+	requireT.Equal(buyer.String(), nftKeeper.GetOwner(ctx, classID, nftID).String())
+	requireT.Equal("100", bankKeeper.GetBalance(ctx, issuer, constant.DenomDev).Amount.String())
+	requireT.Equal("905", bankKeeper.GetBalance(ctx, seller, constant.DenomDev).Amount.String())
+	requireT.True(bankKeeper.GetBalance(ctx, buyer, constant.DenomDev).IsZero())
+
+	events, err := event.FindTypedEvents[*types.EventRoyaltyPaid](ctx.EventManager().ABCIEvents())
+	requireT.NoError(err)
+	requireT.Equal(&types.EventRoyaltyPaid{
+		ClassId:  classID,
+		Id:       nftID,
+		Sender:   seller.String(),
+		Receiver: buyer.String(),
+		Issuer:   issuer.String(),
+		Payment:  payment,
+		Royalty:  sdk.NewInt64Coin(constant.DenomDev, 100),
+	}, events[0])
+
+	// send the nft for free
+	requireT.NoError(assetNFTKeeper.SendWithPayment(ctx, buyer, seller, classID, nftID, sdk.NewInt64Coin(constant.DenomDev, 0)))
+	requireT.Equal(seller.String(), nftKeeper.GetOwner(ctx, classID, nftID).String())
+}
+
+func TestKeeper_UpdateClass(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	assetNFTKeeper := testApp.AssetNFTKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	classID, err := assetNFTKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: issuer,
+		Symbol: "symbol",
+		URI:    "https://my-class-meta.invalid/1",
+		Features: []types.ClassFeature{
+			types.ClassFeature_updatable,
+		},
+	})
+	requireT.NoError(err)
+
+	nonUpdatableClassID, err := assetNFTKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: issuer,
+		Symbol: "symbol2",
+	})
+	requireT.NoError(err)
+
+	data, err := codectypes.NewAnyWithValue(&types.DataBytes{Data: []byte{0x01, 0x02}})
+	requireT.NoError(err)
+	settings := types.UpdateClassSettings{
+		Sender:  issuer,
+		ClassID: classID,
+		URI:     "https://my-class-meta.invalid/2",
+		URIHash: "content-hash",
+		Data:    data,
+	}
+
+	// try to update the class by non-issuer
+	nonIssuerSettings := settings
+	nonIssuerSettings.Sender = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	err = assetNFTKeeper.UpdateClass(ctx, nonIssuerSettings)
+	requireT.True(sdkerrors.ErrUnauthorized.Is(err))
+
+	// try to update the class without the feature
+	nonUpdatableSettings := settings
+	nonUpdatableSettings.ClassID = nonUpdatableClassID
+	err = assetNFTKeeper.UpdateClass(ctx, nonUpdatableSettings)
+	requireT.True(types.ErrFeatureDisabled.Is(err))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	requireT.NoError(assetNFTKeeper.UpdateClass(ctx, settings))
+
+	class, err := assetNFTKeeper.GetClass(ctx, classID)
+	requireT.NoError(err)
+	requireT.Equal(settings.URI, class.URI)
+	requireT.Equal(settings.URIHash, class.URIHash)
+	requireT.Equal(data.Value, class.Data.Value)
+
+	events, err := event.FindTypedEvents[*types.EventClassUpdated](ctx.EventManager().ABCIEvents())
+	requireT.NoError(err)
+	requireT.Equal(&types.EventClassUpdated{
+		ClassId: classID,
+		Sender:  issuer.String(),
+		URI:     settings.URI,
+		URIHash: settings.URIHash,
+	}, events[0])
+}
+
+func TestKeeper_UpdateData(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	assetNFTKeeper := testApp.AssetNFTKeeper
+	nftKeeper := testApp.NFTKeeper
+
+	assetNFTKeeper.SetParams(ctx, types.Params{
+		MintFee: sdk.NewInt64Coin(constant.DenomDev, 0),
+	})
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	owner := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	issueAndMint := func(symbol string, features ...types.ClassFeature) string {
+		classID, err := assetNFTKeeper.IssueClass(ctx, types.IssueClassSettings{
+			Issuer:   issuer,
+			Symbol:   symbol,
+			Features: features,
+		})
+		requireT.NoError(err)
+		requireT.NoError(assetNFTKeeper.Mint(ctx, types.MintSettings{
+			Sender:  issuer,
+			ClassID: classID,
+			ID:      "my-id",
+			URI:     "https://my-nft-meta.invalid/1",
+		}))
+		requireT.NoError(nftKeeper.Transfer(ctx, classID, "my-id", owner))
+		return classID
+	}
+
+	data, err := codectypes.NewAnyWithValue(&types.DataBytes{Data: []byte{0x01, 0x02}})
+	requireT.NoError(err)
+	updateSettings := func(sender sdk.AccAddress, classID string) types.UpdateDataSettings {
+		return types.UpdateDataSettings{
+			Sender:  sender,
+			ClassID: classID,
+			ID:      "my-id",
+			URI:     "https://my-nft-meta.invalid/2",
+			URIHash: "content-hash",
+			Data:    data,
+		}
+	}
+
+	// class without the features
+	classID := issueAndMint("symbol")
+	err = assetNFTKeeper.UpdateData(ctx, updateSettings(issuer, classID))
+	requireT.True(types.ErrFeatureDisabled.Is(err))
+	err = assetNFTKeeper.UpdateData(ctx, updateSettings(owner, classID))
+	requireT.True(types.ErrFeatureDisabled.Is(err))
+
+	// class updatable by the issuer
+	classID = issueAndMint("symbol2", types.ClassFeature_updatable)
+	err = assetNFTKeeper.UpdateData(ctx, updateSettings(owner, classID))
+	requireT.True(sdkerrors.ErrUnauthorized.Is(err))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	settings := updateSettings(issuer, classID)
+	requireT.NoError(assetNFTKeeper.UpdateData(ctx, settings))
+	token, found := nftKeeper.GetNFT(ctx, classID, settings.ID)
+	requireT.True(found)
+	requireT.Equal(settings.URI, token.Uri)
+	requireT.Equal(settings.URIHash, token.UriHash)
+	requireT.Equal(data.Value, token.Data.Value)
+
+	events, err := event.FindTypedEvents[*types.EventDataUpdated](ctx.EventManager().ABCIEvents())
+	requireT.NoError(err)
+	requireT.Equal(&types.EventDataUpdated{
+		ClassId: classID,
+		Id:      settings.ID,
+		Sender:  issuer.String(),
+		URI:     settings.URI,
+		URIHash: settings.URIHash,
+	}, events[0])
+
+	// class updatable by the owner
+	classID = issueAndMint("symbol3", types.ClassFeature_owner_updatable, types.ClassFeature_freezing)
+	err = assetNFTKeeper.UpdateData(ctx, updateSettings(issuer, classID))
+	requireT.True(sdkerrors.ErrUnauthorized.Is(err))
+	requireT.NoError(assetNFTKeeper.UpdateData(ctx, updateSettings(owner, classID)))
+
+	// frozen token can't be updated by the owner
+	requireT.NoError(assetNFTKeeper.Freeze(ctx, issuer, classID, "my-id"))
+	err = assetNFTKeeper.UpdateData(ctx, updateSettings(owner, classID))
+	requireT.True(sdkerrors.ErrUnauthorized.Is(err))
+
+	// nonexistent token
+	settings = updateSettings(issuer, classID)
+	settings.ID = "nonexistent"
+	err = assetNFTKeeper.UpdateData(ctx, settings)
+	requireT.True(types.ErrNFTNotFound.Is(err))
+}
+
+func TestKeeper_ClassFreeze(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	assetNFTKeeper := testApp.AssetNFTKeeper
+	nftKeeper := testApp.NFTKeeper
+
+	assetNFTKeeper.SetParams(ctx, types.Params{
+		MintFee: sdk.NewInt64Coin(constant.DenomDev, 0),
+	})
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	classID, err := assetNFTKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: issuer,
+		Symbol: "symbol",
+		Features: []types.ClassFeature{
+			types.ClassFeature_freezing,
+			types.ClassFeature_burning,
+		},
+	})
+	requireT.NoError(err)
+
+	for _, nftID := range []string{"my-id-1", "my-id-2", "my-id-3"} {
+		requireT.NoError(assetNFTKeeper.Mint(ctx, types.MintSettings{
+			Sender:  issuer,
+			ClassID: classID,
+			ID:      nftID,
+		}))
+	}
+
+	owner := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	requireT.NoError(nftKeeper.Transfer(ctx, classID, "my-id-1", owner))
+	requireT.NoError(nftKeeper.Transfer(ctx, classID, "my-id-2", owner))
+
+	// try to freeze the class by non-issuer
+	err = assetNFTKeeper.ClassFreeze(ctx, owner, classID)
+	requireT.True(sdkerrors.ErrUnauthorized.Is(err))
+
+	// freeze the class
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	requireT.NoError(assetNFTKeeper.ClassFreeze(ctx, issuer, classID))
+	frozen, err := assetNFTKeeper.IsClassFrozen(ctx, classID)
+	requireT.NoError(err)
+	requireT.True(frozen)
+
+	events, err := event.FindTypedEvents[*types.EventClassFrozen](ctx.EventManager().ABCIEvents())
+	requireT.NoError(err)
+	requireT.Equal(&types.EventClassFrozen{ClassId: classID}, events[0])
+
+	// the nft itself is not frozen, but none of the tokens can be sent or burnt by the owner
+	frozen, err = assetNFTKeeper.IsFrozen(ctx, classID, "my-id-1")
+	requireT.NoError(err)
+	requireT.False(frozen)
+	for _, nftID := range []string{"my-id-1", "my-id-2"} {
+		err = nftKeeper.Transfer(ctx, classID, nftID, recipient)
+		requireT.True(sdkerrors.ErrUnauthorized.Is(err))
+		err = assetNFTKeeper.Burn(ctx, owner, classID, nftID)
+		requireT.True(sdkerrors.ErrUnauthorized.Is(err))
+	}
+
+	// the issuer is still allowed to send the token
+	requireT.NoError(nftKeeper.Transfer(ctx, classID, "my-id-3", recipient))
+
+	// unfreeze the class
+	requireT.NoError(assetNFTKeeper.ClassUnfreeze(ctx, issuer, classID))
+	frozen, err = assetNFTKeeper.IsClassFrozen(ctx, classID)
+	requireT.NoError(err)
+	requireT.False(frozen)
+	requireT.NoError(nftKeeper.Transfer(ctx, classID, "my-id-1", recipient))
+
+	// try to freeze the class without the feature
+	nonFreezableClassID, err := assetNFTKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: issuer,
+		Symbol: "symbol2",
+	})
+	requireT.NoError(err)
+	err = assetNFTKeeper.ClassFreeze(ctx, issuer, nonFreezableClassID)
+	requireT.True(types.ErrFeatureDisabled.Is(err))
+	_, err = assetNFTKeeper.IsClassFrozen(ctx, nonFreezableClassID)
+	requireT.True(types.ErrFeatureDisabled.Is(err))
+}
+
+func TestKeeper_FreezeClassForAccount(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	assetNFTKeeper := testApp.AssetNFTKeeper
+	nftKeeper := testApp.NFTKeeper
+
+	assetNFTKeeper.SetParams(ctx, types.Params{
+		MintFee: sdk.NewInt64Coin(constant.DenomDev, 0),
+	})
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	classID, err := assetNFTKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: issuer,
+		Symbol: "symbol",
+		Features: []types.ClassFeature{
+			types.ClassFeature_freezing,
+			types.ClassFeature_burning,
+		},
+	})
+	requireT.NoError(err)
+
+	for _, nftID := range []string{"my-id-1", "my-id-2", "my-id-3"} {
+		requireT.NoError(assetNFTKeeper.Mint(ctx, types.MintSettings{
+			Sender:  issuer,
+			ClassID: classID,
+			ID:      nftID,
+		}))
+	}
+
+	frozenAccount := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	otherAccount := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	requireT.NoError(nftKeeper.Transfer(ctx, classID, "my-id-1", frozenAccount))
+	requireT.NoError(nftKeeper.Transfer(ctx, classID, "my-id-2", otherAccount))
+
+	// try to freeze the class for the account by non-issuer
+	err = assetNFTKeeper.FreezeClassForAccount(ctx, otherAccount, classID, frozenAccount)
+	requireT.True(sdkerrors.ErrUnauthorized.Is(err))
+
+	// try to freeze the class for the issuer
+	err = assetNFTKeeper.FreezeClassForAccount(ctx, issuer, classID, issuer)
+	requireT.True(types.ErrInvalidInput.Is(err))
+
+	// freeze the class for the account
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	requireT.NoError(assetNFTKeeper.FreezeClassForAccount(ctx, issuer, classID, frozenAccount))
+	frozen, err := assetNFTKeeper.IsClassFrozenForAccount(ctx, classID, frozenAccount)
+	requireT.NoError(err)
+	requireT.True(frozen)
+	frozen, err = assetNFTKeeper.IsClassFrozenForAccount(ctx, classID, otherAccount)
+	requireT.NoError(err)
+	requireT.False(frozen)
+
+	events, err := event.FindTypedEvents[*types.EventClassFrozenForAccount](ctx.EventManager().ABCIEvents())
+	requireT.NoError(err)
+	requireT.Equal(&types.EventClassFrozenForAccount{
+		ClassId: classID,
+		Account: frozenAccount.String(),
+	}, events[0])
+
+	accounts, _, err := assetNFTKeeper.GetClassFrozenAccounts(ctx, classID, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Equal([]string{frozenAccount.String()}, accounts)
+
+	// the frozen account can't send or burn the token
+	err = nftKeeper.Transfer(ctx, classID, "my-id-1", otherAccount)
+	requireT.True(sdkerrors.ErrUnauthorized.Is(err))
+	err = assetNFTKeeper.Burn(ctx, frozenAccount, classID, "my-id-1")
+	requireT.True(sdkerrors.ErrUnauthorized.Is(err))
+
+	// other accounts are not affected, and the frozen account can still receive tokens
+	requireT.NoError(nftKeeper.Transfer(ctx, classID, "my-id-2", frozenAccount))
+	requireT.NoError(nftKeeper.Transfer(ctx, classID, "my-id-3", otherAccount))
+
+	// the token received by the frozen account is frozen too
+	err = nftKeeper.Transfer(ctx, classID, "my-id-2", otherAccount)
+	requireT.True(sdkerrors.ErrUnauthorized.Is(err))
+
+	// unfreeze the class for the account
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	requireT.NoError(assetNFTKeeper.UnfreezeClassForAccount(ctx, issuer, classID, frozenAccount))
+	frozen, err = assetNFTKeeper.IsClassFrozenForAccount(ctx, classID, frozenAccount)
+	requireT.NoError(err)
+	requireT.False(frozen)
+
+	unfreezeEvents, err := event.FindTypedEvents[*types.EventClassUnfrozenForAccount](ctx.EventManager().ABCIEvents())
+	requireT.NoError(err)
+	requireT.Equal(&types.EventClassUnfrozenForAccount{
+		ClassId: classID,
+		Account: frozenAccount.String(),
+	}, unfreezeEvents[0])
+
+	accounts, _, err = assetNFTKeeper.GetClassFrozenAccounts(ctx, classID, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Empty(accounts)
+	requireT.NoError(nftKeeper.Transfer(ctx, classID, "my-id-1", otherAccount))
+
+	// try to freeze the class for the account without the feature
+	nonFreezableClassID, err := assetNFTKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: issuer,
+		Symbol: "symbol2",
+	})
+	requireT.NoError(err)
+	err = assetNFTKeeper.FreezeClassForAccount(ctx, issuer, nonFreezableClassID, frozenAccount)
+	requireT.True(types.ErrFeatureDisabled.Is(err))
+	_, err = assetNFTKeeper.IsClassFrozenForAccount(ctx, nonFreezableClassID, frozenAccount)
+	requireT.True(types.ErrFeatureDisabled.Is(err))
+}
+
+func TestKeeper_MintBatchAndBurnBatch(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	assetNFTKeeper := testApp.AssetNFTKeeper
+	nftKeeper := testApp.NFTKeeper
+
+	assetNFTKeeper.SetParams(ctx, types.Params{
+		MintFee: sdk.NewInt64Coin(constant.DenomDev, 0),
+	})
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	classID, err := assetNFTKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: issuer,
+		Symbol: "symbol",
+	})
+	requireT.NoError(err)
+
+	settings := []types.MintSettings{
+		{Sender: issuer, ClassID: classID, ID: "my-id-1", URI: "https://my-nft-meta.invalid/1"},
+		{Sender: issuer, ClassID: classID, ID: "my-id-2", URI: "https://my-nft-meta.invalid/2"},
+		{Sender: issuer, ClassID: classID, ID: "my-id-3", URI: "https://my-nft-meta.invalid/3"},
+	}
+	requireT.NoError(assetNFTKeeper.MintBatch(ctx, settings))
+	for _, s := range settings {
+		nft, found := nftKeeper.GetNFT(ctx, classID, s.ID)
+		requireT.True(found)
+		requireT.Equal(s.URI, nft.Uri)
+		requireT.Equal(issuer, nftKeeper.GetOwner(ctx, classID, s.ID))
+	}
+
+	// minting batch with already existing nft fails and nothing is minted
+	cacheCtx, _ := ctx.CacheContext()
+	err = assetNFTKeeper.MintBatch(cacheCtx, []types.MintSettings{
+		{Sender: issuer, ClassID: classID, ID: "my-id-4"},
+		{Sender: issuer, ClassID: classID, ID: "my-id-1"},
+	})
+	requireT.True(types.ErrInvalidInput.Is(err))
+
+	// burning batch by non-issuer fails since the burning feature is disabled
+	nonIssuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	err = assetNFTKeeper.BurnBatch(ctx, nonIssuer, classID, []string{"my-id-1", "my-id-2"})
+	requireT.True(types.ErrFeatureDisabled.Is(err))
+
+	// burn batch
+	requireT.NoError(assetNFTKeeper.BurnBatch(ctx, issuer, classID, []string{"my-id-1", "my-id-2"}))
+	for _, nftID := range []string{"my-id-1", "my-id-2"} {
+		requireT.False(nftKeeper.HasNFT(ctx, classID, nftID))
+		burnt, err := assetNFTKeeper.IsBurnt(ctx, classID, nftID)
+		requireT.NoError(err)
+		requireT.True(burnt)
+	}
+	requireT.True(nftKeeper.HasNFT(ctx, classID, "my-id-3"))
+}
+
+func TestKeeper_SendBatch(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	assetNFTKeeper := testApp.AssetNFTKeeper
+	nftKeeper := testApp.NFTKeeper
+
+	assetNFTKeeper.SetParams(ctx, types.Params{
+		MintFee: sdk.NewInt64Coin(constant.DenomDev, 0),
+	})
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	classID, err := assetNFTKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: issuer,
+		Symbol: "symbol",
+		Features: []types.ClassFeature{
+			types.ClassFeature_freezing,
+		},
+	})
+	requireT.NoError(err)
+
+	owner := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	recipient1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	recipient2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	for _, nftID := range []string{"my-id-1", "my-id-2", "my-id-3"} {
+		requireT.NoError(assetNFTKeeper.Mint(ctx, types.MintSettings{
+			Sender:  issuer,
+			ClassID: classID,
+			ID:      nftID,
+		}))
+		requireT.NoError(nftKeeper.Transfer(ctx, classID, nftID, owner))
+	}
+	requireT.NoError(assetNFTKeeper.Freeze(ctx, issuer, classID, "my-id-3"))
+
+	// send batch containing frozen nft fails
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = nftKeeper.SendBatch(sdk.WrapSDKContext(cacheCtx), &nfttypes.MsgSendBatch{
+		Sender: owner.String(),
+		Nfts: []*nfttypes.SendBatchItem{
+			{ClassId: classID, Id: "my-id-1", Receiver: recipient1.String()},
+			{ClassId: classID, Id: "my-id-3", Receiver: recipient1.String()},
+		},
+	})
+	requireT.True(sdkerrors.ErrUnauthorized.Is(err))
+
+	// send batch
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = nftKeeper.SendBatch(sdk.WrapSDKContext(ctx), &nfttypes.MsgSendBatch{
+		Sender: owner.String(),
+		Nfts: []*nfttypes.SendBatchItem{
+			{ClassId: classID, Id: "my-id-1", Receiver: recipient1.String()},
+			{ClassId: classID, Id: "my-id-2", Receiver: recipient2.String()},
+		},
+	})
+	requireT.NoError(err)
+	requireT.Equal(recipient1, nftKeeper.GetOwner(ctx, classID, "my-id-1"))
+	requireT.Equal(recipient2, nftKeeper.GetOwner(ctx, classID, "my-id-2"))
+
+	events, err := event.FindTypedEvents[*nfttypes.EventSend](ctx.EventManager().ABCIEvents())
+	requireT.NoError(err)
+	requireT.Len(events, 2)
+}
+
+func TestKeeper_IBCTransfer(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	assetNFTKeeper := testApp.AssetNFTKeeper
+	nftKeeper := testApp.NFTKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	owner := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	escrow := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	ibcClassID, err := assetNFTKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: issuer,
+		Symbol: "symbol",
+		Features: []types.ClassFeature{
+			types.ClassFeature_freezing,
+			types.ClassFeature_whitelisting,
+			types.ClassFeature_ibc,
+		},
+	})
+	requireT.NoError(err)
+
+	nonIBCClassID, err := assetNFTKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: issuer,
+		Symbol: "symbol2",
+	})
+	requireT.NoError(err)
+
+	for _, classID := range []string{ibcClassID, nonIBCClassID} {
+		requireT.NoError(assetNFTKeeper.Mint(ctx, types.MintSettings{
+			Sender:  issuer,
+			ClassID: classID,
+			ID:      "my-id",
+		}))
+	}
+	requireT.NoError(assetNFTKeeper.AddToWhitelist(ctx, ibcClassID, "my-id", issuer, owner))
+	requireT.NoError(nftKeeper.Transfer(ctx, ibcClassID, "my-id", owner))
+
+	outCtx := wibctransfertypes.WithPurpose(ctx, wibctransfertypes.PurposeOut)
+	inCtx := wibctransfertypes.WithPurpose(ctx, wibctransfertypes.PurposeIn)
+	timeoutCtx := wibctransfertypes.WithPurpose(ctx, wibctransfertypes.PurposeTimeout)
+
+	// ibc transfer of the class without ibc feature is rejected
+	err = nftKeeper.Transfer(outCtx, nonIBCClassID, "my-id", escrow)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// frozen nft can't be transferred over ibc
+	requireT.NoError(assetNFTKeeper.Freeze(ctx, issuer, ibcClassID, "my-id"))
+	err = nftKeeper.Transfer(outCtx, ibcClassID, "my-id", escrow)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	requireT.NoError(assetNFTKeeper.Unfreeze(ctx, issuer, ibcClassID, "my-id"))
+
+	// escrow address doesn't need to be whitelisted
+	requireT.NoError(nftKeeper.Transfer(outCtx, ibcClassID, "my-id", escrow))
+
+	// refund succeeds even if the nft is frozen and the sender is not whitelisted anymore
+	requireT.NoError(assetNFTKeeper.Freeze(ctx, issuer, ibcClassID, "my-id"))
+	requireT.NoError(assetNFTKeeper.RemoveFromWhitelist(ctx, ibcClassID, "my-id", issuer, owner))
+	requireT.NoError(nftKeeper.Transfer(timeoutCtx, ibcClassID, "my-id", owner))
+
+	// send it out again
+	requireT.NoError(assetNFTKeeper.Unfreeze(ctx, issuer, ibcClassID, "my-id"))
+	requireT.NoError(assetNFTKeeper.AddToWhitelist(ctx, ibcClassID, "my-id", issuer, owner))
+	requireT.NoError(nftKeeper.Transfer(outCtx, ibcClassID, "my-id", escrow))
+
+	// frozen nft can't be received back over ibc
+	requireT.NoError(assetNFTKeeper.Freeze(ctx, issuer, ibcClassID, "my-id"))
+	err = nftKeeper.Transfer(inCtx, ibcClassID, "my-id", owner)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	requireT.NoError(assetNFTKeeper.Unfreeze(ctx, issuer, ibcClassID, "my-id"))
+
+	// receiver must be whitelisted
+	requireT.NoError(assetNFTKeeper.RemoveFromWhitelist(ctx, ibcClassID, "my-id", issuer, owner))
+	err = nftKeeper.Transfer(inCtx, ibcClassID, "my-id", owner)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	requireT.NoError(assetNFTKeeper.AddToWhitelist(ctx, ibcClassID, "my-id", issuer, owner))
+	requireT.NoError(nftKeeper.Transfer(inCtx, ibcClassID, "my-id", owner))
+}
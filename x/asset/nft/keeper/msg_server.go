@@ -16,10 +16,21 @@ type MsgKeeper interface {
 	IssueClass(ctx sdk.Context, settings types.IssueClassSettings) (string, error)
 	Mint(ctx sdk.Context, settings types.MintSettings) error
 	Burn(ctx sdk.Context, owner sdk.AccAddress, classID, ID string) error
+	MintBatch(ctx sdk.Context, settings []types.MintSettings) error
+	BurnBatch(ctx sdk.Context, owner sdk.AccAddress, classID string, ids []string) error
 	Freeze(ctx sdk.Context, sender sdk.AccAddress, classID, nftID string) error
 	Unfreeze(ctx sdk.Context, sender sdk.AccAddress, classID, nftID string) error
 	AddToWhitelist(ctx sdk.Context, classID, nftID string, sender, account sdk.AccAddress) error
 	RemoveFromWhitelist(ctx sdk.Context, classID, nftID string, sender, account sdk.AccAddress) error
+	SendWithPayment(ctx sdk.Context, sender, receiver sdk.AccAddress, classID, nftID string, payment sdk.Coin) error
+	UpdateClass(ctx sdk.Context, settings types.UpdateClassSettings) error
+	UpdateData(ctx sdk.Context, settings types.UpdateDataSettings) error
+	ClassFreeze(ctx sdk.Context, sender sdk.AccAddress, classID string) error
+	ClassUnfreeze(ctx sdk.Context, sender sdk.AccAddress, classID string) error
+	FreezeClassForAccount(ctx sdk.Context, sender sdk.AccAddress, classID string, account sdk.AccAddress) error
+	UnfreezeClassForAccount(ctx sdk.Context, sender sdk.AccAddress, classID string, account sdk.AccAddress) error
+	AddToClassWhitelist(ctx sdk.Context, classID string, sender, account sdk.AccAddress) error
+	RemoveFromClassWhitelist(ctx sdk.Context, classID string, sender, account sdk.AccAddress) error
 }
 
 // MsgServer serves grpc tx requests for assets module.
@@ -170,3 +181,224 @@ func (ms MsgServer) RemoveFromWhitelist(ctx context.Context, req *types.MsgRemov
 
 	return &types.EmptyResponse{}, nil
 }
+
+// SendWithPayment sends the non-fungible token to the receiver paying for it.
+func (ms MsgServer) SendWithPayment(ctx context.Context, req *types.MsgSendWithPayment) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid sender")
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(req.Receiver)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid receiver")
+	}
+
+	if err := ms.keeper.SendWithPayment(
+		sdk.UnwrapSDKContext(ctx),
+		sender,
+		receiver,
+		req.ClassID,
+		req.ID,
+		req.Payment,
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// UpdateClass updates the metadata of the non-fungible token class.
+func (ms MsgServer) UpdateClass(ctx context.Context, req *types.MsgUpdateClass) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid sender")
+	}
+	if err := ms.keeper.UpdateClass(
+		sdk.UnwrapSDKContext(ctx),
+		types.UpdateClassSettings{
+			Sender:  sender,
+			ClassID: req.ClassID,
+			URI:     req.URI,
+			URIHash: req.URIHash,
+			Data:    req.Data,
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// UpdateData updates the metadata of the non-fungible token.
+func (ms MsgServer) UpdateData(ctx context.Context, req *types.MsgUpdateData) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid sender")
+	}
+	if err := ms.keeper.UpdateData(
+		sdk.UnwrapSDKContext(ctx),
+		types.UpdateDataSettings{
+			Sender:  sender,
+			ClassID: req.ClassID,
+			ID:      req.ID,
+			URI:     req.URI,
+			URIHash: req.URIHash,
+			Data:    req.Data,
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// ClassFreeze freezes all the non-fungible tokens of the class.
+func (ms MsgServer) ClassFreeze(ctx context.Context, req *types.MsgClassFreeze) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid sender")
+	}
+
+	err = ms.keeper.ClassFreeze(sdk.UnwrapSDKContext(ctx), sender, req.ClassID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// ClassUnfreeze unfreezes the non-fungible token class.
+func (ms MsgServer) ClassUnfreeze(ctx context.Context, req *types.MsgClassUnfreeze) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid sender")
+	}
+
+	err = ms.keeper.ClassUnfreeze(sdk.UnwrapSDKContext(ctx), sender, req.ClassID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// FreezeClassForAccount freezes all the non-fungible tokens of the class held by the account.
+func (ms MsgServer) FreezeClassForAccount(ctx context.Context, req *types.MsgFreezeClassForAccount) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid sender")
+	}
+
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid account")
+	}
+
+	err = ms.keeper.FreezeClassForAccount(sdk.UnwrapSDKContext(ctx), sender, req.ClassID, account)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// UnfreezeClassForAccount unfreezes the non-fungible token class for the account.
+func (ms MsgServer) UnfreezeClassForAccount(ctx context.Context, req *types.MsgUnfreezeClassForAccount) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid sender")
+	}
+
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid account")
+	}
+
+	err = ms.keeper.UnfreezeClassForAccount(sdk.UnwrapSDKContext(ctx), sender, req.ClassID, account)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// AddToClassWhitelist adds an account to the whitelisted list of accounts for all the NFTs of the class.
+func (ms MsgServer) AddToClassWhitelist(ctx context.Context, req *types.MsgAddToClassWhitelist) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid sender")
+	}
+
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid account")
+	}
+
+	err = ms.keeper.AddToClassWhitelist(sdk.UnwrapSDKContext(ctx), req.ClassID, sender, account)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// RemoveFromClassWhitelist removes an account from the whitelisted list of accounts for the class.
+func (ms MsgServer) RemoveFromClassWhitelist(ctx context.Context, req *types.MsgRemoveFromClassWhitelist) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid sender")
+	}
+
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid account")
+	}
+
+	err = ms.keeper.RemoveFromClassWhitelist(sdk.UnwrapSDKContext(ctx), req.ClassID, sender, account)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// MintBatch mints multiple non-fungible tokens.
+func (ms MsgServer) MintBatch(ctx context.Context, req *types.MsgMintBatch) (*types.EmptyResponse, error) {
+	owner, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid sender")
+	}
+
+	settings := make([]types.MintSettings, 0, len(req.NFTs))
+	for _, item := range req.NFTs {
+		settings = append(settings, types.MintSettings{
+			Sender:  owner,
+			ClassID: req.ClassID,
+			ID:      item.ID,
+			URI:     item.URI,
+			URIHash: item.URIHash,
+			Data:    item.Data,
+		})
+	}
+
+	if err := ms.keeper.MintBatch(sdk.UnwrapSDKContext(ctx), settings); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// BurnBatch burns multiple non-fungible tokens.
+func (ms MsgServer) BurnBatch(ctx context.Context, req *types.MsgBurnBatch) (*types.EmptyResponse, error) {
+	owner, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid sender")
+	}
+
+	if err := ms.keeper.BurnBatch(sdk.UnwrapSDKContext(ctx), owner, req.ClassID, req.IDs); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
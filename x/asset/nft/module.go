@@ -19,6 +19,7 @@ import (
 
 	"github.com/CoreumFoundation/coreum/v2/x/asset/nft/client/cli"
 	"github.com/CoreumFoundation/coreum/v2/x/asset/nft/keeper"
+	"github.com/CoreumFoundation/coreum/v2/x/asset/nft/simulation"
 	"github.com/CoreumFoundation/coreum/v2/x/asset/nft/types"
 )
 
@@ -99,21 +100,27 @@ func (AppModuleBasic) GetQueryCmd() *cobra.Command {
 type AppModule struct {
 	AppModuleBasic
 
-	keeper     keeper.Keeper
-	nftKeeper  types.NFTKeeper
-	wasmKeeper types.WasmKeeper
+	keeper        keeper.Keeper
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
+	nftKeeper     types.NFTKeeper
+	wasmKeeper    types.WasmKeeper
 }
 
 // NewAppModule returns the new instance of the AppModule.
 func NewAppModule(
 	cdc codec.Codec,
 	keeper keeper.Keeper,
+	accountKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper,
 	nftKeeper types.NFTKeeper,
 	wasmKeeper types.WasmKeeper,
 ) AppModule {
 	return AppModule{
 		AppModuleBasic: NewAppModuleBasic(cdc),
 		keeper:         keeper,
+		accountKeeper:  accountKeeper,
+		bankKeeper:     bankKeeper,
 		nftKeeper:      nftKeeper,
 		wasmKeeper:     wasmKeeper,
 	}
@@ -187,7 +194,9 @@ func (am AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.Valid
 // AppModuleSimulation functions
 
 // GenerateGenesisState creates a randomized GenState of the assetnft module.
-func (AppModule) GenerateGenesisState(_ *module.SimulationState) {}
+func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	simulation.RandomizedGenState(simState)
+}
 
 // ProposalContents doesn't return any content functions for governance proposals.
 func (AppModule) ProposalContents(_ module.SimulationState) []simtypes.WeightedProposalContent {
@@ -200,9 +209,18 @@ func (AppModule) RandomizedParams(_ *rand.Rand) []simtypes.ParamChange {
 }
 
 // RegisterStoreDecoder registers a decoder for assetnft module's types.
-func (am AppModule) RegisterStoreDecoder(_ sdk.StoreDecoderRegistry) {}
+func (am AppModule) RegisterStoreDecoder(sdr sdk.StoreDecoderRegistry) {
+	sdr[types.StoreKey] = simulation.NewDecodeStore(am.cdc)
+}
 
 // WeightedOperations returns the all the assetnft module operations with their respective weights.
-func (am AppModule) WeightedOperations(_ module.SimulationState) []simtypes.WeightedOperation {
-	return nil
+func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	return simulation.WeightedOperations(
+		simState.AppParams,
+		simState.Cdc,
+		am.accountKeeper,
+		am.bankKeeper,
+		am.nftKeeper,
+		am.keeper,
+	)
 }
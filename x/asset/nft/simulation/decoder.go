@@ -0,0 +1,34 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/CoreumFoundation/coreum/v2/x/asset/nft/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding asset nft type.
+func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.NFTClassKeyPrefix):
+			var definitionA, definitionB types.ClassDefinition
+			cdc.MustUnmarshal(kvA.Value, &definitionA)
+			cdc.MustUnmarshal(kvB.Value, &definitionB)
+			return fmt.Sprintf("%v\n%v", definitionA, definitionB)
+		case bytes.Equal(kvA.Key[:1], types.NFTFreezingKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.NFTWhitelistingKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.NFTBurningKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.NFTClassFreezingKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.NFTClassAccountFreezingKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.NFTClassWhitelistingKeyPrefix):
+			return fmt.Sprintf("%v\n%v", kvA.Value, kvB.Value)
+		default:
+			panic(fmt.Sprintf("invalid asset nft key %X", kvA.Key))
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v2/app"
+	"github.com/CoreumFoundation/coreum/v2/pkg/config"
+	"github.com/CoreumFoundation/coreum/v2/x/asset"
+	"github.com/CoreumFoundation/coreum/v2/x/asset/nft/simulation"
+	"github.com/CoreumFoundation/coreum/v2/x/asset/nft/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := config.NewEncodingConfig(app.ModuleBasics).Codec
+	dec := simulation.NewDecodeStore(cdc)
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	account := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	classID := types.BuildClassID("abc", issuer)
+
+	definition := types.ClassDefinition{
+		ID:          classID,
+		Issuer:      issuer.String(),
+		Features:    []types.ClassFeature{types.ClassFeature_freezing},
+		RoyaltyRate: sdk.ZeroDec(),
+	}
+	definitionBz, err := cdc.Marshal(&definition)
+	require.NoError(t, err)
+
+	classKey, err := types.CreateClassKey(classID)
+	require.NoError(t, err)
+	freezingKey, err := types.CreateFreezingKey(classID, "nft1")
+	require.NoError(t, err)
+	whitelistingKey, err := types.CreateWhitelistingKey(classID, "nft1", account)
+	require.NoError(t, err)
+	burningKey, err := types.CreateBurningKey(classID, "nft1")
+	require.NoError(t, err)
+	classFreezingKey, err := types.CreateClassFreezingKey(classID)
+	require.NoError(t, err)
+	classAccountFreezingKey, err := types.CreateClassAccountFreezingKey(classID, account)
+	require.NoError(t, err)
+	classWhitelistingKey, err := types.CreateClassWhitelistingKey(classID, account)
+	require.NoError(t, err)
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: classKey, Value: definitionBz},
+			{Key: freezingKey, Value: asset.StoreTrue},
+			{Key: whitelistingKey, Value: asset.StoreTrue},
+			{Key: burningKey, Value: asset.StoreTrue},
+			{Key: classFreezingKey, Value: asset.StoreTrue},
+			{Key: classAccountFreezingKey, Value: asset.StoreTrue},
+			{Key: classWhitelistingKey, Value: asset.StoreTrue},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectErr   bool
+		expectedLog string
+	}{
+		{"ClassDefinition", false, fmt.Sprintf("%v\n%v", definition, definition)},
+		{"Freezing", false, fmt.Sprintf("%v\n%v", asset.StoreTrue, asset.StoreTrue)},
+		{"Whitelisting", false, fmt.Sprintf("%v\n%v", asset.StoreTrue, asset.StoreTrue)},
+		{"Burning", false, fmt.Sprintf("%v\n%v", asset.StoreTrue, asset.StoreTrue)},
+		{"ClassFreezing", false, fmt.Sprintf("%v\n%v", asset.StoreTrue, asset.StoreTrue)},
+		{"ClassAccountFreezing", false, fmt.Sprintf("%v\n%v", asset.StoreTrue, asset.StoreTrue)},
+		{"ClassWhitelisting", false, fmt.Sprintf("%v\n%v", asset.StoreTrue, asset.StoreTrue)},
+		{"other", true, ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.expectErr {
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			} else {
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
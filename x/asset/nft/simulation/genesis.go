@@ -0,0 +1,190 @@
+package simulation
+
+import (
+	"math/rand"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/samber/lo"
+
+	"github.com/CoreumFoundation/coreum/v2/x/asset/nft/types"
+	"github.com/CoreumFoundation/coreum/v2/x/nft"
+)
+
+// Simulation parameter constants.
+const (
+	MintFee          = "mint_fee"
+	ClassDefinitions = "class_definitions"
+)
+
+// genMintFee returns randomized mint fee. In half of the cases minting is free.
+func genMintFee(r *rand.Rand, bondDenom string) sdk.Coin {
+	if r.Intn(2) == 0 {
+		return sdk.NewInt64Coin(bondDenom, 0)
+	}
+	return sdk.NewInt64Coin(bondDenom, r.Int63n(1_000_000))
+}
+
+// genClassDefinitions returns randomized class definitions issued by the part of the accounts.
+func genClassDefinitions(r *rand.Rand, accounts []simtypes.Account) []types.ClassDefinition {
+	definitions := make([]types.ClassDefinition, 0, len(accounts))
+	for _, acc := range accounts {
+		if r.Intn(10) != 0 {
+			continue
+		}
+		definitions = append(definitions, types.ClassDefinition{
+			ID:          types.BuildClassID(randSymbol(r), acc.Address),
+			Issuer:      acc.Address.String(),
+			Features:    randFeatures(r),
+			RoyaltyRate: randRoyaltyRate(r),
+		})
+	}
+	return definitions
+}
+
+// genFrozenClasses returns randomized frozen classes having the freezing feature enabled.
+func genFrozenClasses(r *rand.Rand, definitions []types.ClassDefinition) []string {
+	classIDs := make([]string, 0)
+	for _, definition := range definitions {
+		if !definition.IsFeatureEnabled(types.ClassFeature_freezing) || r.Intn(5) != 0 {
+			continue
+		}
+		classIDs = append(classIDs, definition.ID)
+	}
+	return classIDs
+}
+
+// genClassFrozenAccounts returns randomized accounts the classes having the freezing feature enabled are frozen for.
+func genClassFrozenAccounts(
+	r *rand.Rand,
+	accounts []simtypes.Account,
+	definitions []types.ClassDefinition,
+) []types.ClassFrozenAccounts {
+	frozen := make([]types.ClassFrozenAccounts, 0)
+	for _, definition := range definitions {
+		if !definition.IsFeatureEnabled(types.ClassFeature_freezing) {
+			continue
+		}
+		frozenAccounts := randClassAccounts(r, accounts, definition)
+		if len(frozenAccounts) == 0 {
+			continue
+		}
+		frozen = append(frozen, types.ClassFrozenAccounts{
+			ClassID:  definition.ID,
+			Accounts: frozenAccounts,
+		})
+	}
+	return frozen
+}
+
+// genClassWhitelistedAccounts returns randomized accounts whitelisted for the classes having the whitelisting
+// feature enabled.
+func genClassWhitelistedAccounts(
+	r *rand.Rand,
+	accounts []simtypes.Account,
+	definitions []types.ClassDefinition,
+) []types.ClassWhitelistedAccounts {
+	whitelisted := make([]types.ClassWhitelistedAccounts, 0)
+	for _, definition := range definitions {
+		if !definition.IsFeatureEnabled(types.ClassFeature_whitelisting) {
+			continue
+		}
+		whitelistedAccounts := randClassAccounts(r, accounts, definition)
+		if len(whitelistedAccounts) == 0 {
+			continue
+		}
+		whitelisted = append(whitelisted, types.ClassWhitelistedAccounts{
+			ClassID:  definition.ID,
+			Accounts: whitelistedAccounts,
+		})
+	}
+	return whitelisted
+}
+
+// RandomizedGenState generates a random GenesisState for the asset nft module.
+func RandomizedGenState(simState *module.SimulationState) {
+	var mintFee sdk.Coin
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MintFee, &mintFee, simState.Rand,
+		func(r *rand.Rand) { mintFee = genMintFee(r, sdk.DefaultBondDenom) },
+	)
+
+	var definitions []types.ClassDefinition
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, ClassDefinitions, &definitions, simState.Rand,
+		func(r *rand.Rand) { definitions = genClassDefinitions(r, simState.Accounts) },
+	)
+
+	genState := &types.GenesisState{
+		Params: types.Params{
+			MintFee: mintFee,
+		},
+		ClassDefinitions:         definitions,
+		FrozenClasses:            genFrozenClasses(simState.Rand, definitions),
+		ClassFrozenAccounts:      genClassFrozenAccounts(simState.Rand, simState.Accounts, definitions),
+		ClassWhitelistedAccounts: genClassWhitelistedAccounts(simState.Rand, simState.Accounts, definitions),
+	}
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genState)
+
+	// Each class definition must have the counterpart class stored by the nft module, so the classes are added to
+	// the nft genesis state generated before.
+	addNFTClasses(simState, definitions)
+}
+
+func addNFTClasses(simState *module.SimulationState, definitions []types.ClassDefinition) {
+	nftGenState := nft.DefaultGenesisState()
+	if bz, exists := simState.GenState[nft.ModuleName]; exists {
+		simState.Cdc.MustUnmarshalJSON(bz, nftGenState)
+	}
+	for _, definition := range definitions {
+		symbol, _, err := types.DeconstructClassID(definition.ID)
+		if err != nil {
+			panic(err)
+		}
+		nftGenState.Classes = append(nftGenState.Classes, &nft.Class{
+			Id:          definition.ID,
+			Symbol:      symbol,
+			Name:        simtypes.RandStringOfLength(simState.Rand, 10),
+			Description: simtypes.RandStringOfLength(simState.Rand, simtypes.RandIntBetween(simState.Rand, 0, 50)),
+			Uri:         simtypes.RandStringOfLength(simState.Rand, 10),
+		})
+	}
+	simState.GenState[nft.ModuleName] = simState.Cdc.MustMarshalJSON(nftGenState)
+}
+
+// randClassAccounts returns a few random accounts other than the issuer of the class.
+func randClassAccounts(r *rand.Rand, accounts []simtypes.Account, definition types.ClassDefinition) []string {
+	classAccounts := make([]string, 0)
+	for i := r.Intn(4); i > 0; i-- {
+		acc, _ := simtypes.RandomAcc(r, accounts)
+		if definition.IsIssuer(acc.Address) || lo.Contains(classAccounts, acc.Address.String()) {
+			continue
+		}
+		classAccounts = append(classAccounts, acc.Address.String())
+	}
+	return classAccounts
+}
+
+func randSymbol(r *rand.Rand) string {
+	return "sim" + strings.ToLower(simtypes.RandStringOfLength(r, 10))
+}
+
+func randFeatures(r *rand.Rand) []types.ClassFeature {
+	features := make([]types.ClassFeature, 0, len(types.ClassFeature_name))
+	for i := 0; i < len(types.ClassFeature_name); i++ {
+		if r.Intn(2) == 0 {
+			features = append(features, types.ClassFeature(i))
+		}
+	}
+	return features
+}
+
+// randRoyaltyRate returns zero rate in half of the cases and the rate with at most 4 decimal places otherwise.
+func randRoyaltyRate(r *rand.Rand) sdk.Dec {
+	if r.Intn(2) == 0 {
+		return sdk.ZeroDec()
+	}
+	return sdk.NewDecWithPrec(int64(r.Intn(10_001)), 4)
+}
@@ -0,0 +1,49 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v2/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/v2/x/asset/nft/simulation"
+	"github.com/CoreumFoundation/coreum/v2/x/asset/nft/types"
+	"github.com/CoreumFoundation/coreum/v2/x/nft"
+)
+
+func TestRandomizedGenState(t *testing.T) {
+	app := simapp.New()
+
+	s := rand.NewSource(1)
+	r := rand.New(s)
+
+	simState := module.SimulationState{
+		AppParams:    make(simtypes.AppParams),
+		Cdc:          app.AppCodec(),
+		Rand:         r,
+		NumBonded:    3,
+		Accounts:     simtypes.RandomAccounts(r, 10),
+		InitialStake: 1000,
+		GenState:     make(map[string]json.RawMessage),
+		GenTimestamp: time.Now().UTC(),
+	}
+
+	simulation.RandomizedGenState(&simState)
+	var nftGenesis types.GenesisState
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &nftGenesis)
+	require.NoError(t, nftGenesis.Validate())
+	require.NotEmpty(t, nftGenesis.ClassDefinitions)
+
+	var originalGenesis nft.GenesisState
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[nft.ModuleName], &originalGenesis)
+	require.NoError(t, nft.ValidateGenesis(originalGenesis))
+	require.Len(t, originalGenesis.Classes, len(nftGenesis.ClassDefinitions))
+	for i, definition := range nftGenesis.ClassDefinitions {
+		require.Equal(t, definition.ID, originalGenesis.Classes[i].Id)
+	}
+}
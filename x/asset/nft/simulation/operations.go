@@ -0,0 +1,958 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/CoreumFoundation/coreum/v2/x/asset/nft/keeper"
+	"github.com/CoreumFoundation/coreum/v2/x/asset/nft/types"
+	"github.com/CoreumFoundation/coreum/v2/x/nft"
+)
+
+// Simulation operation weights constants.
+//
+//nolint:gosec // these are not hardcoded credentials
+const (
+	OpWeightMsgIssueClass               = "op_weight_msg_issue_class"
+	OpWeightMsgMint                     = "op_weight_msg_mint"
+	OpWeightMsgBurn                     = "op_weight_msg_burn"
+	OpWeightMsgFreeze                   = "op_weight_msg_freeze"
+	OpWeightMsgUnfreeze                 = "op_weight_msg_unfreeze"
+	OpWeightMsgAddToWhitelist           = "op_weight_msg_add_to_whitelist"
+	OpWeightMsgRemoveFromWhitelist      = "op_weight_msg_remove_from_whitelist"
+	OpWeightMsgSendWithPayment          = "op_weight_msg_send_with_payment"
+	OpWeightMsgUpdateClass              = "op_weight_msg_update_class"
+	OpWeightMsgUpdateData               = "op_weight_msg_update_data"
+	OpWeightMsgClassFreeze              = "op_weight_msg_class_freeze"
+	OpWeightMsgClassUnfreeze            = "op_weight_msg_class_unfreeze"
+	OpWeightMsgFreezeClassForAccount    = "op_weight_msg_freeze_class_for_account"
+	OpWeightMsgUnfreezeClassForAccount  = "op_weight_msg_unfreeze_class_for_account"
+	OpWeightMsgAddToClassWhitelist      = "op_weight_msg_add_to_class_whitelist"
+	OpWeightMsgRemoveFromClassWhitelist = "op_weight_msg_remove_from_class_whitelist"
+	OpWeightMsgMintBatch                = "op_weight_msg_mint_batch"
+	OpWeightMsgBurnBatch                = "op_weight_msg_burn_batch"
+)
+
+// Default asset nft operations weights.
+const (
+	WeightIssueClass               = 30
+	WeightMint                     = 50
+	WeightBurn                     = 20
+	WeightFreeze                   = 20
+	WeightUnfreeze                 = 10
+	WeightAddToWhitelist           = 20
+	WeightRemoveFromWhitelist      = 10
+	WeightSendWithPayment          = 40
+	WeightUpdateClass              = 10
+	WeightUpdateData               = 20
+	WeightClassFreeze              = 5
+	WeightClassUnfreeze            = 5
+	WeightFreezeClassForAccount    = 10
+	WeightUnfreezeClassForAccount  = 5
+	WeightAddToClassWhitelist      = 20
+	WeightRemoveFromClassWhitelist = 5
+	WeightMintBatch                = 10
+	WeightBurnBatch                = 5
+)
+
+// maxSimBatchSize is the upper bound of the batch size used by the batch operations.
+const maxSimBatchSize = 5
+
+// WeightedOperations returns all the operations from the module with their respective weights.
+// The operations don't set the data of the classes and the nfts, because the messages containing the packed data
+// can't be encoded to amino JSON required by the simulation to report the operations.
+func WeightedOperations(
+	appParams simtypes.AppParams,
+	cdc codec.JSONCodec,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	nk types.NFTKeeper,
+	k keeper.Keeper,
+) simulation.WeightedOperations {
+	weight := func(key string, defaultWeight int) int {
+		var w int
+		appParams.GetOrGenerate(cdc, key, &w, nil, func(_ *rand.Rand) { w = defaultWeight })
+		return w
+	}
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgIssueClass, WeightIssueClass),
+			SimulateMsgIssueClass(ak, bk, nk, k),
+		),
+		simulation.NewWeightedOperation(weight(OpWeightMsgMint, WeightMint), SimulateMsgMint(ak, bk, nk, k)),
+		simulation.NewWeightedOperation(weight(OpWeightMsgBurn, WeightBurn), SimulateMsgBurn(ak, bk, nk, k)),
+		simulation.NewWeightedOperation(weight(OpWeightMsgFreeze, WeightFreeze), SimulateMsgFreeze(ak, bk, nk, k)),
+		simulation.NewWeightedOperation(weight(OpWeightMsgUnfreeze, WeightUnfreeze), SimulateMsgUnfreeze(ak, bk, nk, k)),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgAddToWhitelist, WeightAddToWhitelist),
+			SimulateMsgAddToWhitelist(ak, bk, nk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgRemoveFromWhitelist, WeightRemoveFromWhitelist),
+			SimulateMsgRemoveFromWhitelist(ak, bk, nk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgSendWithPayment, WeightSendWithPayment),
+			SimulateMsgSendWithPayment(ak, bk, nk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgUpdateClass, WeightUpdateClass),
+			SimulateMsgUpdateClass(ak, bk, nk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgUpdateData, WeightUpdateData),
+			SimulateMsgUpdateData(ak, bk, nk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgClassFreeze, WeightClassFreeze),
+			SimulateMsgClassFreeze(ak, bk, nk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgClassUnfreeze, WeightClassUnfreeze),
+			SimulateMsgClassUnfreeze(ak, bk, nk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgFreezeClassForAccount, WeightFreezeClassForAccount),
+			SimulateMsgFreezeClassForAccount(ak, bk, nk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgUnfreezeClassForAccount, WeightUnfreezeClassForAccount),
+			SimulateMsgUnfreezeClassForAccount(ak, bk, nk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgAddToClassWhitelist, WeightAddToClassWhitelist),
+			SimulateMsgAddToClassWhitelist(ak, bk, nk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgRemoveFromClassWhitelist, WeightRemoveFromClassWhitelist),
+			SimulateMsgRemoveFromClassWhitelist(ak, bk, nk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgMintBatch, WeightMintBatch),
+			SimulateMsgMintBatch(ak, bk, nk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgBurnBatch, WeightBurnBatch),
+			SimulateMsgBurnBatch(ak, bk, nk, k),
+		),
+	}
+}
+
+// SimulateMsgIssueClass generates a MsgIssueClass with random values.
+func SimulateMsgIssueClass(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	_ types.NFTKeeper,
+	_ keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		issuer, _ := simtypes.RandomAcc(r, accs)
+
+		msg := &types.MsgIssueClass{
+			Issuer:      issuer.Address.String(),
+			Symbol:      randSymbol(r),
+			Name:        simtypes.RandStringOfLength(r, simtypes.RandIntBetween(r, 1, 50)),
+			Description: simtypes.RandStringOfLength(r, simtypes.RandIntBetween(r, 0, 50)),
+			URI:         simtypes.RandStringOfLength(r, 10),
+			URIHash:     simtypes.RandStringOfLength(r, 10),
+			Features:    randFeatures(r),
+			RoyaltyRate: randRoyaltyRate(r),
+		}
+
+		return deliver(r, app, ctx, ak, bk, issuer, msg, types.TypeMsgIssueClass, nil)
+	}
+}
+
+// SimulateMsgMint generates a MsgMint with random values.
+func SimulateMsgMint(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	_ types.NFTKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		def, found := randDefinition(ctx, r, k, func(types.ClassDefinition) bool { return true })
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgMint, "no class found"), nil, nil
+		}
+
+		issuer, found := findIssuer(accs, def)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgMint, "issuer not found"), nil, nil
+		}
+
+		msg := &types.MsgMint{
+			Sender:  issuer.Address.String(),
+			ClassID: def.ID,
+			ID:      randNFTID(r),
+			URI:     simtypes.RandStringOfLength(r, 10),
+			URIHash: simtypes.RandStringOfLength(r, 10),
+		}
+
+		return deliver(r, app, ctx, ak, bk, issuer, msg, types.TypeMsgMint, mintFee(ctx, k, 1))
+	}
+}
+
+// SimulateMsgBurn generates a MsgBurn with random values.
+func SimulateMsgBurn(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	nk types.NFTKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		token, found := randOwnedNFT(ctx, r, k, nk, accs, func(token ownedNFT) bool {
+			return isBurnable(ctx, k, token)
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBurn, "no burnable nft found"), nil, nil
+		}
+
+		msg := &types.MsgBurn{
+			Sender:  token.owner.Address.String(),
+			ClassID: token.nft.ClassId,
+			ID:      token.nft.Id,
+		}
+
+		return deliver(r, app, ctx, ak, bk, token.owner, msg, types.TypeMsgBurn, nil)
+	}
+}
+
+// SimulateMsgFreeze generates a MsgFreeze with random values.
+func SimulateMsgFreeze(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	nk types.NFTKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return simulateFreezing(ak, bk, nk, k, true)
+}
+
+// SimulateMsgUnfreeze generates a MsgUnfreeze with random values.
+func SimulateMsgUnfreeze(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	nk types.NFTKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return simulateFreezing(ak, bk, nk, k, false)
+}
+
+func simulateFreezing(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	nk types.NFTKeeper,
+	k keeper.Keeper,
+	freeze bool,
+) simtypes.Operation {
+	msgType := types.TypeMsgUnfreeze
+	if freeze {
+		msgType = types.TypeMsgFreeze
+	}
+
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		token, found := randOwnedNFT(ctx, r, k, nk, accs, func(token ownedNFT) bool {
+			frozen, err := k.IsFrozen(ctx, token.nft.ClassId, token.nft.Id)
+			return err == nil && frozen != freeze
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no nft to change the freezing found"), nil, nil
+		}
+
+		issuer, found := findIssuer(accs, token.definition)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "issuer not found"), nil, nil
+		}
+
+		var msg sdk.Msg = &types.MsgUnfreeze{
+			Sender:  issuer.Address.String(),
+			ClassID: token.nft.ClassId,
+			ID:      token.nft.Id,
+		}
+		if freeze {
+			msg = &types.MsgFreeze{
+				Sender:  issuer.Address.String(),
+				ClassID: token.nft.ClassId,
+				ID:      token.nft.Id,
+			}
+		}
+
+		return deliver(r, app, ctx, ak, bk, issuer, msg, msgType, nil)
+	}
+}
+
+// SimulateMsgAddToWhitelist generates a MsgAddToWhitelist with random values.
+func SimulateMsgAddToWhitelist(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	nk types.NFTKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		token, found := randOwnedNFT(ctx, r, k, nk, accs, func(token ownedNFT) bool {
+			return token.definition.IsFeatureEnabled(types.ClassFeature_whitelisting)
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddToWhitelist, "no whitelistable nft found"), nil, nil
+		}
+
+		issuer, found := findIssuer(accs, token.definition)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddToWhitelist, "issuer not found"), nil, nil
+		}
+
+		account, found := randRegularAccount(r, accs, token.definition)
+		if !found {
+			return simtypes.NoOpMsg(
+				types.ModuleName, types.TypeMsgAddToWhitelist, "no account to whitelist found",
+			), nil, nil
+		}
+
+		msg := &types.MsgAddToWhitelist{
+			Sender:  issuer.Address.String(),
+			ClassID: token.nft.ClassId,
+			ID:      token.nft.Id,
+			Account: account.Address.String(),
+		}
+
+		return deliver(r, app, ctx, ak, bk, issuer, msg, types.TypeMsgAddToWhitelist, nil)
+	}
+}
+
+// SimulateMsgRemoveFromWhitelist generates a MsgRemoveFromWhitelist with random values.
+func SimulateMsgRemoveFromWhitelist(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	nk types.NFTKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		token, found := randOwnedNFT(ctx, r, k, nk, accs, func(token ownedNFT) bool {
+			if !token.definition.IsFeatureEnabled(types.ClassFeature_whitelisting) {
+				return false
+			}
+			accounts, _, err := k.GetWhitelistedAccountsForNFT(
+				ctx, token.nft.ClassId, token.nft.Id, &query.PageRequest{Limit: 1},
+			)
+			return err == nil && len(accounts) > 0
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRemoveFromWhitelist, "no whitelisted nft found"), nil, nil
+		}
+
+		whitelisted, _, err := k.GetWhitelistedAccountsForNFT(
+			ctx, token.nft.ClassId, token.nft.Id, &query.PageRequest{Limit: query.MaxLimit},
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRemoveFromWhitelist, err.Error()), nil, err
+		}
+
+		issuer, found := findIssuer(accs, token.definition)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRemoveFromWhitelist, "issuer not found"), nil, nil
+		}
+
+		msg := &types.MsgRemoveFromWhitelist{
+			Sender:  issuer.Address.String(),
+			ClassID: token.nft.ClassId,
+			ID:      token.nft.Id,
+			Account: whitelisted[r.Intn(len(whitelisted))],
+		}
+
+		return deliver(r, app, ctx, ak, bk, issuer, msg, types.TypeMsgRemoveFromWhitelist, nil)
+	}
+}
+
+// SimulateMsgSendWithPayment generates a MsgSendWithPayment with random values.
+func SimulateMsgSendWithPayment(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	nk types.NFTKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		receiver, _ := simtypes.RandomAcc(r, accs)
+		token, found := randOwnedNFT(ctx, r, k, nk, accs, func(token ownedNFT) bool {
+			return !token.owner.Address.Equals(receiver.Address) &&
+				k.BeforeTransfer(ctx, token.nft.ClassId, token.nft.Id, receiver.Address) == nil
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSendWithPayment, "no transferable nft found"), nil, nil
+		}
+
+		balance := bk.SpendableCoins(ctx, receiver.Address).AmountOf(sdk.DefaultBondDenom)
+		payment := sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt())
+		if balance.IsPositive() {
+			payment.Amount = simtypes.RandomAmount(r, balance.QuoRaw(2))
+		}
+
+		msg := &types.MsgSendWithPayment{
+			Sender:   token.owner.Address.String(),
+			ClassID:  token.nft.ClassId,
+			ID:       token.nft.Id,
+			Receiver: receiver.Address.String(),
+			Payment:  payment,
+		}
+
+		// the message requires the signatures of both the sender and the receiver, the sender pays the fees
+		sender := ak.GetAccount(ctx, token.owner.Address)
+		receiverAcc := ak.GetAccount(ctx, receiver.Address)
+		fees, err := simtypes.RandomFees(r, ctx, bk.SpendableCoins(ctx, token.owner.Address))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSendWithPayment, "unable to generate fees"), nil, err
+		}
+
+		txCfg := simappparams.MakeTestEncodingConfig().TxConfig
+		tx, err := helpers.GenSignedMockTx(
+			r,
+			txCfg,
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{sender.GetAccountNumber(), receiverAcc.GetAccountNumber()},
+			[]uint64{sender.GetSequence(), receiverAcc.GetSequence()},
+			token.owner.PrivKey,
+			receiver.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSendWithPayment, "unable to generate mock tx"), nil, err
+		}
+
+		if _, _, err := app.Deliver(txCfg.TxEncoder(), tx); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSendWithPayment, "unable to deliver tx"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, "", nil), nil, nil
+	}
+}
+
+// SimulateMsgUpdateClass generates a MsgUpdateClass with random values.
+func SimulateMsgUpdateClass(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	_ types.NFTKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		def, found := randDefinition(ctx, r, k, func(def types.ClassDefinition) bool {
+			return def.IsFeatureEnabled(types.ClassFeature_updatable)
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUpdateClass, "no updatable class found"), nil, nil
+		}
+
+		issuer, found := findIssuer(accs, def)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUpdateClass, "issuer not found"), nil, nil
+		}
+
+		msg := &types.MsgUpdateClass{
+			Sender:  issuer.Address.String(),
+			ClassID: def.ID,
+			URI:     simtypes.RandStringOfLength(r, 10),
+			URIHash: simtypes.RandStringOfLength(r, 10),
+		}
+
+		return deliver(r, app, ctx, ak, bk, issuer, msg, types.TypeMsgUpdateClass, nil)
+	}
+}
+
+// SimulateMsgUpdateData generates a MsgUpdateData with random values.
+func SimulateMsgUpdateData(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	nk types.NFTKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		token, found := randOwnedNFT(ctx, r, k, nk, accs, func(token ownedNFT) bool {
+			if token.definition.IsFeatureEnabled(types.ClassFeature_updatable) {
+				_, found := findIssuer(accs, token.definition)
+				return found
+			}
+			return token.definition.IsFeatureEnabled(types.ClassFeature_owner_updatable) && !isFrozen(ctx, k, token)
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUpdateData, "no updatable nft found"), nil, nil
+		}
+
+		// the issuer updates the data if the class is updatable, the owner otherwise
+		sender := token.owner
+		if token.definition.IsFeatureEnabled(types.ClassFeature_updatable) {
+			sender, _ = findIssuer(accs, token.definition)
+		}
+
+		msg := &types.MsgUpdateData{
+			Sender:  sender.Address.String(),
+			ClassID: token.nft.ClassId,
+			ID:      token.nft.Id,
+			URI:     simtypes.RandStringOfLength(r, 10),
+			URIHash: simtypes.RandStringOfLength(r, 10),
+		}
+
+		return deliver(r, app, ctx, ak, bk, sender, msg, types.TypeMsgUpdateData, nil)
+	}
+}
+
+// SimulateMsgClassFreeze generates a MsgClassFreeze with random values.
+func SimulateMsgClassFreeze(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	nk types.NFTKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return simulateClassFreezing(ak, bk, nk, k, true)
+}
+
+// SimulateMsgClassUnfreeze generates a MsgClassUnfreeze with random values.
+func SimulateMsgClassUnfreeze(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	nk types.NFTKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return simulateClassFreezing(ak, bk, nk, k, false)
+}
+
+func simulateClassFreezing(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	_ types.NFTKeeper,
+	k keeper.Keeper,
+	freeze bool,
+) simtypes.Operation {
+	msgType := types.TypeMsgClassUnfreeze
+	if freeze {
+		msgType = types.TypeMsgClassFreeze
+	}
+
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		def, found := randDefinition(ctx, r, k, func(def types.ClassDefinition) bool {
+			frozen, err := k.IsClassFrozen(ctx, def.ID)
+			return err == nil && frozen != freeze
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no class to change the freezing found"), nil, nil
+		}
+
+		issuer, found := findIssuer(accs, def)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "issuer not found"), nil, nil
+		}
+
+		var msg sdk.Msg = &types.MsgClassUnfreeze{
+			Sender:  issuer.Address.String(),
+			ClassID: def.ID,
+		}
+		if freeze {
+			msg = &types.MsgClassFreeze{
+				Sender:  issuer.Address.String(),
+				ClassID: def.ID,
+			}
+		}
+
+		return deliver(r, app, ctx, ak, bk, issuer, msg, msgType, nil)
+	}
+}
+
+// SimulateMsgFreezeClassForAccount generates a MsgFreezeClassForAccount with random values.
+func SimulateMsgFreezeClassForAccount(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	_ types.NFTKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		def, found := randDefinition(ctx, r, k, func(def types.ClassDefinition) bool {
+			return def.IsFeatureEnabled(types.ClassFeature_freezing)
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgFreezeClassForAccount, "no freezable class found"), nil, nil
+		}
+
+		issuer, found := findIssuer(accs, def)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgFreezeClassForAccount, "issuer not found"), nil, nil
+		}
+
+		account, found := randRegularAccount(r, accs, def)
+		if !found {
+			return simtypes.NoOpMsg(
+				types.ModuleName, types.TypeMsgFreezeClassForAccount, "no account to freeze found",
+			), nil, nil
+		}
+
+		msg := &types.MsgFreezeClassForAccount{
+			Sender:  issuer.Address.String(),
+			ClassID: def.ID,
+			Account: account.Address.String(),
+		}
+
+		return deliver(r, app, ctx, ak, bk, issuer, msg, types.TypeMsgFreezeClassForAccount, nil)
+	}
+}
+
+// SimulateMsgUnfreezeClassForAccount generates a MsgUnfreezeClassForAccount with random values.
+func SimulateMsgUnfreezeClassForAccount(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	_ types.NFTKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		frozen, _, err := k.GetAllClassFrozenAccounts(ctx, &query.PageRequest{Limit: query.MaxLimit})
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUnfreezeClassForAccount, err.Error()), nil, err
+		}
+		if len(frozen) == 0 {
+			return simtypes.NoOpMsg(
+				types.ModuleName, types.TypeMsgUnfreezeClassForAccount, "no class frozen for account found",
+			), nil, nil
+		}
+
+		classFrozen := frozen[r.Intn(len(frozen))]
+		def, err := k.GetClassDefinition(ctx, classFrozen.ClassID)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUnfreezeClassForAccount, err.Error()), nil, err
+		}
+
+		issuer, found := findIssuer(accs, def)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUnfreezeClassForAccount, "issuer not found"), nil, nil
+		}
+
+		msg := &types.MsgUnfreezeClassForAccount{
+			Sender:  issuer.Address.String(),
+			ClassID: def.ID,
+			Account: classFrozen.Accounts[r.Intn(len(classFrozen.Accounts))],
+		}
+
+		return deliver(r, app, ctx, ak, bk, issuer, msg, types.TypeMsgUnfreezeClassForAccount, nil)
+	}
+}
+
+// SimulateMsgAddToClassWhitelist generates a MsgAddToClassWhitelist with random values.
+func SimulateMsgAddToClassWhitelist(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	_ types.NFTKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		def, found := randDefinition(ctx, r, k, func(def types.ClassDefinition) bool {
+			return def.IsFeatureEnabled(types.ClassFeature_whitelisting)
+		})
+		if !found {
+			return simtypes.NoOpMsg(
+				types.ModuleName, types.TypeMsgAddToClassWhitelist, "no whitelistable class found",
+			), nil, nil
+		}
+
+		issuer, found := findIssuer(accs, def)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddToClassWhitelist, "issuer not found"), nil, nil
+		}
+
+		account, found := randRegularAccount(r, accs, def)
+		if !found {
+			return simtypes.NoOpMsg(
+				types.ModuleName, types.TypeMsgAddToClassWhitelist, "no account to whitelist found",
+			), nil, nil
+		}
+
+		msg := &types.MsgAddToClassWhitelist{
+			Sender:  issuer.Address.String(),
+			ClassID: def.ID,
+			Account: account.Address.String(),
+		}
+
+		return deliver(r, app, ctx, ak, bk, issuer, msg, types.TypeMsgAddToClassWhitelist, nil)
+	}
+}
+
+// SimulateMsgRemoveFromClassWhitelist generates a MsgRemoveFromClassWhitelist with random values.
+func SimulateMsgRemoveFromClassWhitelist(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	_ types.NFTKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		whitelisted, _, err := k.GetAllClassWhitelistedAccounts(ctx, &query.PageRequest{Limit: query.MaxLimit})
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRemoveFromClassWhitelist, err.Error()), nil, err
+		}
+		if len(whitelisted) == 0 {
+			return simtypes.NoOpMsg(
+				types.ModuleName, types.TypeMsgRemoveFromClassWhitelist, "no class whitelisted accounts found",
+			), nil, nil
+		}
+
+		classWhitelisted := whitelisted[r.Intn(len(whitelisted))]
+		def, err := k.GetClassDefinition(ctx, classWhitelisted.ClassID)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRemoveFromClassWhitelist, err.Error()), nil, err
+		}
+
+		issuer, found := findIssuer(accs, def)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRemoveFromClassWhitelist, "issuer not found"), nil, nil
+		}
+
+		msg := &types.MsgRemoveFromClassWhitelist{
+			Sender:  issuer.Address.String(),
+			ClassID: def.ID,
+			Account: classWhitelisted.Accounts[r.Intn(len(classWhitelisted.Accounts))],
+		}
+
+		return deliver(r, app, ctx, ak, bk, issuer, msg, types.TypeMsgRemoveFromClassWhitelist, nil)
+	}
+}
+
+// SimulateMsgMintBatch generates a MsgMintBatch with random values.
+func SimulateMsgMintBatch(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	_ types.NFTKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		def, found := randDefinition(ctx, r, k, func(types.ClassDefinition) bool { return true })
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgMintBatch, "no class found"), nil, nil
+		}
+
+		issuer, found := findIssuer(accs, def)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgMintBatch, "issuer not found"), nil, nil
+		}
+
+		items := make([]types.MintBatchItem, simtypes.RandIntBetween(r, 1, maxSimBatchSize+1))
+		for i := range items {
+			items[i] = types.MintBatchItem{
+				ID:      randNFTID(r),
+				URI:     simtypes.RandStringOfLength(r, 10),
+				URIHash: simtypes.RandStringOfLength(r, 10),
+			}
+		}
+
+		msg := &types.MsgMintBatch{
+			Sender:  issuer.Address.String(),
+			ClassID: def.ID,
+			NFTs:    items,
+		}
+
+		return deliver(r, app, ctx, ak, bk, issuer, msg, types.TypeMsgMintBatch, mintFee(ctx, k, int64(len(items))))
+	}
+}
+
+// SimulateMsgBurnBatch generates a MsgBurnBatch with random values.
+func SimulateMsgBurnBatch(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	nk types.NFTKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		token, found := randOwnedNFT(ctx, r, k, nk, accs, func(token ownedNFT) bool {
+			return isBurnable(ctx, k, token)
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBurnBatch, "no burnable nft found"), nil, nil
+		}
+
+		// the batch is built from the burnable nfts of the same class and owner
+		ids := make([]string, 0, maxSimBatchSize)
+		for _, n := range nk.GetNFTsOfClass(ctx, token.definition.ID) {
+			if len(ids) == maxSimBatchSize {
+				break
+			}
+			candidate := ownedNFT{definition: token.definition, nft: n, owner: token.owner}
+			if nk.GetOwner(ctx, n.ClassId, n.Id).Equals(token.owner.Address) && isBurnable(ctx, k, candidate) {
+				ids = append(ids, n.Id)
+			}
+		}
+
+		msg := &types.MsgBurnBatch{
+			Sender:  token.owner.Address.String(),
+			ClassID: token.definition.ID,
+			IDs:     ids,
+		}
+
+		return deliver(r, app, ctx, ak, bk, token.owner, msg, types.TypeMsgBurnBatch, nil)
+	}
+}
+
+func deliver(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	signer simtypes.Account,
+	msg sdk.Msg,
+	msgType string,
+	coinsSpentInMsg sdk.Coins,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	return simulation.GenAndDeliverTxWithRandFees(simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+		Cdc:             nil,
+		Msg:             msg,
+		MsgType:         msgType,
+		CoinsSpentInMsg: coinsSpentInMsg,
+		Context:         ctx,
+		SimAccount:      signer,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      types.ModuleName,
+	})
+}
+
+// ownedNFT is the nft owned by one of the simulation accounts.
+type ownedNFT struct {
+	definition types.ClassDefinition
+	nft        nft.NFT
+	owner      simtypes.Account
+}
+
+func randOwnedNFT(
+	ctx sdk.Context,
+	r *rand.Rand,
+	k keeper.Keeper,
+	nk types.NFTKeeper,
+	accs []simtypes.Account,
+	filter func(ownedNFT) bool,
+) (ownedNFT, bool) {
+	tokens := make([]ownedNFT, 0)
+	if err := k.IterateAllClassDefinitions(ctx, func(def types.ClassDefinition) (bool, error) {
+		for _, n := range nk.GetNFTsOfClass(ctx, def.ID) {
+			owner, found := simtypes.FindAccount(accs, nk.GetOwner(ctx, n.ClassId, n.Id))
+			if !found {
+				continue
+			}
+			token := ownedNFT{definition: def, nft: n, owner: owner}
+			if filter(token) {
+				tokens = append(tokens, token)
+			}
+		}
+		return false, nil
+	}); err != nil {
+		panic(err)
+	}
+	if len(tokens) == 0 {
+		return ownedNFT{}, false
+	}
+	return tokens[r.Intn(len(tokens))], true
+}
+
+func randDefinition(
+	ctx sdk.Context,
+	r *rand.Rand,
+	k keeper.Keeper,
+	filter func(types.ClassDefinition) bool,
+) (types.ClassDefinition, bool) {
+	definitions := make([]types.ClassDefinition, 0)
+	if err := k.IterateAllClassDefinitions(ctx, func(def types.ClassDefinition) (bool, error) {
+		if filter(def) {
+			definitions = append(definitions, def)
+		}
+		return false, nil
+	}); err != nil {
+		panic(err)
+	}
+	if len(definitions) == 0 {
+		return types.ClassDefinition{}, false
+	}
+	return definitions[r.Intn(len(definitions))], true
+}
+
+// isFrozen returns true if the nft, the whole class or the class for the nft owner is frozen.
+func isFrozen(ctx sdk.Context, k keeper.Keeper, token ownedNFT) bool {
+	if !token.definition.IsFeatureEnabled(types.ClassFeature_freezing) {
+		return false
+	}
+	frozen, err := k.IsFrozen(ctx, token.nft.ClassId, token.nft.Id)
+	if err != nil || frozen {
+		return true
+	}
+	frozen, err = k.IsClassFrozen(ctx, token.nft.ClassId)
+	if err != nil || frozen {
+		return true
+	}
+	frozen, err = k.IsClassFrozenForAccount(ctx, token.nft.ClassId, token.owner.Address)
+	return err != nil || frozen
+}
+
+// isBurnable returns true if the owner is allowed to burn the nft.
+func isBurnable(ctx sdk.Context, k keeper.Keeper, token ownedNFT) bool {
+	if token.definition.IsIssuer(token.owner.Address) {
+		return true
+	}
+	return token.definition.IsFeatureEnabled(types.ClassFeature_burning) && !isFrozen(ctx, k, token)
+}
+
+// randRegularAccount returns the account which is not the issuer of the class.
+func randRegularAccount(r *rand.Rand, accs []simtypes.Account, def types.ClassDefinition) (simtypes.Account, bool) {
+	account, _ := simtypes.RandomAcc(r, accs)
+	if def.IsIssuer(account.Address) {
+		return simtypes.Account{}, false
+	}
+	return account, true
+}
+
+func findIssuer(accs []simtypes.Account, def types.ClassDefinition) (simtypes.Account, bool) {
+	return simtypes.FindAccount(accs, sdk.MustAccAddressFromBech32(def.Issuer))
+}
+
+func mintFee(ctx sdk.Context, k keeper.Keeper, count int64) sdk.Coins {
+	fee := k.GetParams(ctx).MintFee
+	return sdk.NewCoins(sdk.NewCoin(fee.Denom, fee.Amount.MulRaw(count)))
+}
+
+func randNFTID(r *rand.Rand) string {
+	return "sim" + simtypes.RandStringOfLength(r, 10)
+}
@@ -0,0 +1,159 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/v2/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/v2/x/asset/nft/simulation"
+	"github.com/CoreumFoundation/coreum/v2/x/asset/nft/types"
+)
+
+type SimTestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+	app *simapp.App
+}
+
+func (suite *SimTestSuite) SetupTest() {
+	app := simapp.New()
+	suite.app = app
+	suite.ctx = app.BaseApp.NewContext(false, tmproto.Header{})
+}
+
+func (suite *SimTestSuite) TestWeightedOperations() {
+	weightedOps := simulation.WeightedOperations(
+		make(simtypes.AppParams),
+		suite.app.AppCodec(),
+		suite.app.AccountKeeper,
+		suite.app.BankKeeper,
+		suite.app.NFTKeeper.Keeper,
+		suite.app.AssetNFTKeeper,
+	)
+
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accs := suite.getTestingAccounts(r, 3)
+
+	expected := []struct {
+		weight    int
+		opMsgName string
+	}{
+		{simulation.WeightIssueClass, types.TypeMsgIssueClass},
+		{simulation.WeightMint, types.TypeMsgMint},
+		{simulation.WeightBurn, types.TypeMsgBurn},
+		{simulation.WeightFreeze, types.TypeMsgFreeze},
+		{simulation.WeightUnfreeze, types.TypeMsgUnfreeze},
+		{simulation.WeightAddToWhitelist, types.TypeMsgAddToWhitelist},
+		{simulation.WeightRemoveFromWhitelist, types.TypeMsgRemoveFromWhitelist},
+		{simulation.WeightSendWithPayment, types.TypeMsgSendWithPayment},
+		{simulation.WeightUpdateClass, types.TypeMsgUpdateClass},
+		{simulation.WeightUpdateData, types.TypeMsgUpdateData},
+		{simulation.WeightClassFreeze, types.TypeMsgClassFreeze},
+		{simulation.WeightClassUnfreeze, types.TypeMsgClassUnfreeze},
+		{simulation.WeightFreezeClassForAccount, types.TypeMsgFreezeClassForAccount},
+		{simulation.WeightUnfreezeClassForAccount, types.TypeMsgUnfreezeClassForAccount},
+		{simulation.WeightAddToClassWhitelist, types.TypeMsgAddToClassWhitelist},
+		{simulation.WeightRemoveFromClassWhitelist, types.TypeMsgRemoveFromClassWhitelist},
+		{simulation.WeightMintBatch, types.TypeMsgMintBatch},
+		{simulation.WeightBurnBatch, types.TypeMsgBurnBatch},
+	}
+
+	suite.Require().Len(weightedOps, len(expected))
+	for i, w := range weightedOps {
+		operationMsg, _, _ := w.Op()(r, suite.app.BaseApp, suite.ctx, accs, "")
+		// the following checks are very much dependent from the ordering of the output given
+		// by WeightedOperations. if the ordering in WeightedOperations changes some tests
+		// will fail
+		suite.Require().Equal(expected[i].weight, w.Weight(), "weight should be the same")
+		suite.Require().Equal(types.RouterKey, operationMsg.Route, "route should be the same")
+		suite.Require().Equal(expected[i].opMsgName, operationMsg.Name, "operation Msg name should be the same")
+	}
+}
+
+func (suite *SimTestSuite) TestSimulateMsgMintSendAndBurn() {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 3)
+
+	ak, bk, nk, k := suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.NFTKeeper.Keeper, suite.app.AssetNFTKeeper
+	classID, err := k.IssueClass(suite.ctx, types.IssueClassSettings{
+		Issuer:      accounts[0].Address,
+		Symbol:      "ABC",
+		Features:    []types.ClassFeature{types.ClassFeature_burning},
+		RoyaltyRate: sdk.NewDecWithPrec(1, 1),
+	})
+	suite.Require().NoError(err)
+
+	suite.app.BeginBlock(abci.RequestBeginBlock{
+		Header: tmproto.Header{
+			Height:  suite.app.LastBlockHeight() + 1,
+			AppHash: suite.app.LastCommitID().Hash,
+		},
+	})
+
+	operationMsg, futureOperations, err := simulation.SimulateMsgMint(ak, bk, nk, k)(
+		r, suite.app.BaseApp, suite.ctx, accounts, "",
+	)
+	suite.Require().NoError(err)
+	suite.Require().True(operationMsg.OK)
+	suite.Require().Len(futureOperations, 0)
+
+	var mintMsg types.MsgMint
+	suite.app.LegacyAmino().MustUnmarshalJSON(operationMsg.Msg, &mintMsg)
+	suite.Require().Equal(classID, mintMsg.ClassID)
+	suite.Require().True(nk.HasNFT(suite.ctx, classID, mintMsg.ID))
+
+	var sent bool
+	for i := 0; i < 10 && !sent; i++ {
+		operationMsg, _, err = simulation.SimulateMsgSendWithPayment(ak, bk, nk, k)(
+			r, suite.app.BaseApp, suite.ctx, accounts, "",
+		)
+		suite.Require().NoError(err)
+		sent = operationMsg.OK
+	}
+	suite.Require().True(sent)
+	suite.Require().NotEqual(accounts[0].Address, nk.GetOwner(suite.ctx, classID, mintMsg.ID))
+
+	operationMsg, _, err = simulation.SimulateMsgBurn(ak, bk, nk, k)(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+	suite.Require().True(operationMsg.OK)
+	suite.Require().Equal(types.TypeMsgBurn, operationMsg.Name)
+	suite.Require().False(nk.HasNFT(suite.ctx, classID, mintMsg.ID))
+}
+
+func TestSimTestSuite(t *testing.T) {
+	suite.Run(t, new(SimTestSuite))
+}
+
+func (suite *SimTestSuite) getTestingAccounts(r *rand.Rand, n int) []simtypes.Account {
+	accounts := simtypes.RandomAccounts(r, n)
+
+	initAmt := suite.app.StakingKeeper.TokensFromConsensusPower(suite.ctx, 200000)
+	initCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initAmt))
+
+	for _, account := range accounts {
+		acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, account.Address)
+		suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+		suite.Require().NoError(fundAccount(suite.app.BankKeeper, suite.ctx, account.Address, initCoins))
+	}
+
+	return accounts
+}
+
+func fundAccount(bankKeeper bankkeeper.Keeper, ctx sdk.Context, addr sdk.AccAddress, amounts sdk.Coins) error {
+	if err := bankKeeper.MintCoins(ctx, minttypes.ModuleName, amounts); err != nil {
+		return err
+	}
+
+	return bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr, amounts)
+}
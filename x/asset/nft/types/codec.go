@@ -18,6 +18,17 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgUnfreeze{},
 		&MsgAddToWhitelist{},
 		&MsgRemoveFromWhitelist{},
+		&MsgSendWithPayment{},
+		&MsgUpdateClass{},
+		&MsgUpdateData{},
+		&MsgClassFreeze{},
+		&MsgClassUnfreeze{},
+		&MsgFreezeClassForAccount{},
+		&MsgUnfreezeClassForAccount{},
+		&MsgAddToClassWhitelist{},
+		&MsgRemoveFromClassWhitelist{},
+		&MsgMintBatch{},
+		&MsgBurnBatch{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -25,6 +25,24 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	for _, classID := range gs.FrozenClasses {
+		if _, _, err := DeconstructClassID(classID); err != nil {
+			return err
+		}
+	}
+
+	for _, frozen := range gs.ClassFrozenAccounts {
+		if err := frozen.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, whitelisted := range gs.ClassWhitelistedAccounts {
+		if err := whitelisted.Validate(); err != nil {
+			return err
+		}
+	}
+
 	for _, whitelisted := range gs.WhitelistedNFTAccounts {
 		if err := whitelisted.Validate(); err != nil {
 			return err
@@ -64,6 +82,34 @@ func (f FrozenNFT) Validate() error {
 	return nil
 }
 
+// Validate performs basic validation on the fields of ClassFrozenAccounts.
+func (c ClassFrozenAccounts) Validate() error {
+	if _, _, err := DeconstructClassID(c.ClassID); err != nil {
+		return err
+	}
+
+	for _, acc := range c.Accounts {
+		if _, err := sdk.AccAddressFromBech32(acc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate performs basic validation on the fields of ClassWhitelistedAccounts.
+func (c ClassWhitelistedAccounts) Validate() error {
+	if _, _, err := DeconstructClassID(c.ClassID); err != nil {
+		return err
+	}
+
+	for _, acc := range c.Accounts {
+		if _, err := sdk.AccAddressFromBech32(acc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Validate performs basic validation on the fields of WhitelistedNFTAccounts.
 func (w WhitelistedNFTAccounts) Validate() error {
 	if _, _, err := DeconstructClassID(w.ClassID); err != nil {
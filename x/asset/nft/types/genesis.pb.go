@@ -32,6 +32,12 @@ type GenesisState struct {
 	FrozenNFTs             []FrozenNFT              `protobuf:"bytes,3,rep,name=frozen_nfts,json=frozenNfts,proto3" json:"frozen_nfts"`
 	WhitelistedNFTAccounts []WhitelistedNFTAccounts `protobuf:"bytes,4,rep,name=whitelisted_nft_accounts,json=whitelistedNftAccounts,proto3" json:"whitelisted_nft_accounts"`
 	BurntNFTs              []BurntNFT               `protobuf:"bytes,5,rep,name=burnt_nfts,json=burntNfts,proto3" json:"burnt_nfts"`
+	// frozen_classes keep the IDs of the frozen non-fungible token classes
+	FrozenClasses []string `protobuf:"bytes,6,rep,name=frozen_classes,json=frozenClasses,proto3" json:"frozen_classes,omitempty"`
+	// class_frozen_accounts keep the accounts for which the non-fungible token classes are frozen
+	ClassFrozenAccounts []ClassFrozenAccounts `protobuf:"bytes,7,rep,name=class_frozen_accounts,json=classFrozenAccounts,proto3" json:"class_frozen_accounts"`
+	// class_whitelisted_accounts keep the accounts whitelisted to hold all the NFTs of the classes
+	ClassWhitelistedAccounts []ClassWhitelistedAccounts `protobuf:"bytes,8,rep,name=class_whitelisted_accounts,json=classWhitelistedAccounts,proto3" json:"class_whitelisted_accounts"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -102,6 +108,27 @@ func (m *GenesisState) GetBurntNFTs() []BurntNFT {
 	return nil
 }
 
+func (m *GenesisState) GetFrozenClasses() []string {
+	if m != nil {
+		return m.FrozenClasses
+	}
+	return nil
+}
+
+func (m *GenesisState) GetClassFrozenAccounts() []ClassFrozenAccounts {
+	if m != nil {
+		return m.ClassFrozenAccounts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetClassWhitelistedAccounts() []ClassWhitelistedAccounts {
+	if m != nil {
+		return m.ClassWhitelistedAccounts
+	}
+	return nil
+}
+
 type FrozenNFT struct {
 	ClassID string   `protobuf:"bytes,1,opt,name=classID,proto3" json:"classID,omitempty"`
 	NftIDs  []string `protobuf:"bytes,2,rep,name=nftIDs,proto3" json:"nftIDs,omitempty"`
@@ -154,6 +181,58 @@ func (m *FrozenNFT) GetNftIDs() []string {
 	return nil
 }
 
+type ClassFrozenAccounts struct {
+	ClassID  string   `protobuf:"bytes,1,opt,name=classID,proto3" json:"classID,omitempty"`
+	Accounts []string `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+}
+
+func (m *ClassFrozenAccounts) Reset()         { *m = ClassFrozenAccounts{} }
+func (m *ClassFrozenAccounts) String() string { return proto.CompactTextString(m) }
+func (*ClassFrozenAccounts) ProtoMessage()    {}
+func (*ClassFrozenAccounts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3abcf08d60f6fbfd, []int{2}
+}
+func (m *ClassFrozenAccounts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClassFrozenAccounts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClassFrozenAccounts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClassFrozenAccounts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClassFrozenAccounts.Merge(m, src)
+}
+func (m *ClassFrozenAccounts) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClassFrozenAccounts) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClassFrozenAccounts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClassFrozenAccounts proto.InternalMessageInfo
+
+func (m *ClassFrozenAccounts) GetClassID() string {
+	if m != nil {
+		return m.ClassID
+	}
+	return ""
+}
+
+func (m *ClassFrozenAccounts) GetAccounts() []string {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
 type WhitelistedNFTAccounts struct {
 	ClassID  string   `protobuf:"bytes,1,opt,name=classID,proto3" json:"classID,omitempty"`
 	NftID    string   `protobuf:"bytes,2,opt,name=nftID,proto3" json:"nftID,omitempty"`
@@ -164,7 +243,7 @@ func (m *WhitelistedNFTAccounts) Reset()         { *m = WhitelistedNFTAccounts{}
 func (m *WhitelistedNFTAccounts) String() string { return proto.CompactTextString(m) }
 func (*WhitelistedNFTAccounts) ProtoMessage()    {}
 func (*WhitelistedNFTAccounts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3abcf08d60f6fbfd, []int{2}
+	return fileDescriptor_3abcf08d60f6fbfd, []int{3}
 }
 func (m *WhitelistedNFTAccounts) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -214,6 +293,58 @@ func (m *WhitelistedNFTAccounts) GetAccounts() []string {
 	return nil
 }
 
+type ClassWhitelistedAccounts struct {
+	ClassID  string   `protobuf:"bytes,1,opt,name=classID,proto3" json:"classID,omitempty"`
+	Accounts []string `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+}
+
+func (m *ClassWhitelistedAccounts) Reset()         { *m = ClassWhitelistedAccounts{} }
+func (m *ClassWhitelistedAccounts) String() string { return proto.CompactTextString(m) }
+func (*ClassWhitelistedAccounts) ProtoMessage()    {}
+func (*ClassWhitelistedAccounts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3abcf08d60f6fbfd, []int{4}
+}
+func (m *ClassWhitelistedAccounts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClassWhitelistedAccounts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClassWhitelistedAccounts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClassWhitelistedAccounts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClassWhitelistedAccounts.Merge(m, src)
+}
+func (m *ClassWhitelistedAccounts) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClassWhitelistedAccounts) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClassWhitelistedAccounts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClassWhitelistedAccounts proto.InternalMessageInfo
+
+func (m *ClassWhitelistedAccounts) GetClassID() string {
+	if m != nil {
+		return m.ClassID
+	}
+	return ""
+}
+
+func (m *ClassWhitelistedAccounts) GetAccounts() []string {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
 type BurntNFT struct {
 	ClassID string   `protobuf:"bytes,1,opt,name=classID,proto3" json:"classID,omitempty"`
 	NftIDs  []string `protobuf:"bytes,2,rep,name=nftIDs,proto3" json:"nftIDs,omitempty"`
@@ -223,7 +354,7 @@ func (m *BurntNFT) Reset()         { *m = BurntNFT{} }
 func (m *BurntNFT) String() string { return proto.CompactTextString(m) }
 func (*BurntNFT) ProtoMessage()    {}
 func (*BurntNFT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3abcf08d60f6fbfd, []int{3}
+	return fileDescriptor_3abcf08d60f6fbfd, []int{5}
 }
 func (m *BurntNFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -269,44 +400,51 @@ func (m *BurntNFT) GetNftIDs() []string {
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "coreum.asset.nft.v1.GenesisState")
 	proto.RegisterType((*FrozenNFT)(nil), "coreum.asset.nft.v1.FrozenNFT")
+	proto.RegisterType((*ClassFrozenAccounts)(nil), "coreum.asset.nft.v1.ClassFrozenAccounts")
 	proto.RegisterType((*WhitelistedNFTAccounts)(nil), "coreum.asset.nft.v1.WhitelistedNFTAccounts")
+	proto.RegisterType((*ClassWhitelistedAccounts)(nil), "coreum.asset.nft.v1.ClassWhitelistedAccounts")
 	proto.RegisterType((*BurntNFT)(nil), "coreum.asset.nft.v1.BurntNFT")
 }
 
 func init() { proto.RegisterFile("coreum/asset/nft/v1/genesis.proto", fileDescriptor_3abcf08d60f6fbfd) }
 
 var fileDescriptor_3abcf08d60f6fbfd = []byte{
-	// 469 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x4d, 0x6b, 0xdb, 0x40,
-	0x10, 0x86, 0xad, 0x38, 0x71, 0xa3, 0x71, 0x0f, 0xcd, 0x36, 0x18, 0xe1, 0x12, 0xc5, 0x35, 0x3d,
-	0x04, 0x0a, 0x12, 0x71, 0x0f, 0xa5, 0xd0, 0x1e, 0xaa, 0x18, 0x97, 0x5c, 0xdc, 0x56, 0x09, 0x04,
-	0x7a, 0x31, 0xb2, 0xbc, 0x72, 0x04, 0xf1, 0xae, 0xd1, 0x8e, 0xdc, 0x8f, 0x7b, 0xef, 0xfd, 0x59,
-	0x39, 0xfa, 0xd8, 0x53, 0x28, 0xf6, 0x1f, 0x29, 0xfb, 0x61, 0x35, 0x86, 0x6d, 0xa1, 0x37, 0xcd,
-	0xec, 0x3b, 0xcf, 0xbb, 0x33, 0xab, 0x81, 0xa7, 0x29, 0x2f, 0x68, 0x39, 0x0b, 0x13, 0x21, 0x28,
-	0x86, 0x2c, 0xc3, 0x70, 0x71, 0x1a, 0x4e, 0x29, 0xa3, 0x22, 0x17, 0xc1, 0xbc, 0xe0, 0xc8, 0xc9,
-	0x63, 0x2d, 0x09, 0x94, 0x24, 0x60, 0x19, 0x06, 0x8b, 0xd3, 0xf6, 0xe1, 0x94, 0x4f, 0xb9, 0x3a,
-	0x0f, 0xe5, 0x97, 0x96, 0xb6, 0x3b, 0x36, 0xda, 0x3c, 0x29, 0x92, 0x99, 0x81, 0xb5, 0x8f, 0x6c,
-	0x0a, 0xc9, 0x54, 0xc7, 0xdd, 0x65, 0x1d, 0x1e, 0xbe, 0xd3, 0xee, 0x17, 0x98, 0x20, 0x25, 0xaf,
-	0xa0, 0xa1, 0xeb, 0x3d, 0xa7, 0xe3, 0x9c, 0x34, 0x7b, 0x4f, 0x02, 0xcb, 0x6d, 0x82, 0x0f, 0x4a,
-	0x12, 0xed, 0xde, 0xde, 0x1d, 0xd7, 0x62, 0x53, 0x40, 0xae, 0xe0, 0x20, 0xbd, 0x49, 0x84, 0x18,
-	0x4d, 0x68, 0x96, 0xb3, 0x1c, 0x73, 0xce, 0x84, 0xb7, 0xd3, 0xa9, 0x9f, 0x34, 0x7b, 0xcf, 0xac,
-	0x94, 0x33, 0xa9, 0xee, 0x57, 0x62, 0x83, 0x7b, 0x94, 0x6e, 0xa7, 0x05, 0xb9, 0x80, 0x66, 0x56,
-	0xf0, 0x6f, 0x94, 0x8d, 0x58, 0x86, 0xc2, 0xab, 0x2b, 0xa4, 0x6f, 0x45, 0x0e, 0x94, 0x6e, 0x38,
-	0xb8, 0x8c, 0x88, 0x84, 0xad, 0xee, 0x8e, 0xa1, 0x4a, 0x89, 0x18, 0x34, 0x66, 0x98, 0xa1, 0x20,
-	0xdf, 0x1d, 0xf0, 0x3e, 0x5f, 0xe7, 0x48, 0x6f, 0x72, 0x81, 0x74, 0x22, 0xd1, 0xa3, 0x24, 0x4d,
-	0x79, 0xc9, 0x50, 0x78, 0xbb, 0xca, 0xe2, 0xb9, 0xd5, 0xe2, 0xea, 0x4f, 0xd1, 0x70, 0x70, 0xf9,
-	0xd6, 0x94, 0x44, 0xbe, 0xf1, 0x6b, 0xd9, 0xcf, 0xe3, 0xd6, 0x3d, 0xb3, 0x61, 0x86, 0x9b, 0x3c,
-	0x79, 0x0f, 0x30, 0x2e, 0x0b, 0x86, 0xba, 0xb7, 0x3d, 0x65, 0x7c, 0x64, 0x35, 0x8e, 0xa4, 0x4c,
-	0xb6, 0x76, 0x60, 0xac, 0xdc, 0x4d, 0x46, 0xc4, 0xae, 0x62, 0xc8, 0xc6, 0xba, 0x6f, 0xc0, 0xad,
-	0x5a, 0x26, 0x1e, 0x3c, 0x50, 0xe3, 0x3c, 0xef, 0xab, 0xf7, 0x74, 0xe3, 0x4d, 0x48, 0x5a, 0xd0,
-	0x60, 0x19, 0x9e, 0xf7, 0xf5, 0x13, 0xb9, 0xb1, 0x89, 0xba, 0x13, 0xf8, 0x4b, 0x07, 0xff, 0x60,
-	0x1d, 0xc2, 0x9e, 0xaa, 0xf6, 0x76, 0x54, 0x5e, 0x07, 0xa4, 0x0d, 0xfb, 0x5b, 0x03, 0x75, 0xe3,
-	0x2a, 0xee, 0xbe, 0x86, 0xfd, 0xcd, 0xe5, 0xff, 0xff, 0x8e, 0xd1, 0xc7, 0xdb, 0x95, 0xef, 0x2c,
-	0x57, 0xbe, 0xf3, 0x6b, 0xe5, 0x3b, 0x3f, 0xd6, 0x7e, 0x6d, 0xb9, 0xf6, 0x6b, 0x3f, 0xd7, 0x7e,
-	0xed, 0xd3, 0xcb, 0x69, 0x8e, 0xd7, 0xe5, 0x38, 0x48, 0xf9, 0x2c, 0x3c, 0x53, 0x33, 0x1c, 0xf0,
-	0x92, 0x4d, 0x12, 0xf9, 0x23, 0x85, 0x66, 0x15, 0x16, 0xbd, 0xf0, 0xcb, 0xbd, 0x7d, 0xc0, 0xaf,
-	0x73, 0x2a, 0xc6, 0x0d, 0xb5, 0x0f, 0x2f, 0x7e, 0x07, 0x00, 0x00, 0xff, 0xff, 0x64, 0xb1, 0xf6,
-	0xe8, 0xa0, 0x03, 0x00, 0x00,
+	// 556 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x5d, 0x6b, 0x13, 0x4d,
+	0x14, 0xce, 0x36, 0x1f, 0xcd, 0x9e, 0xbc, 0xaf, 0xd8, 0x49, 0x0d, 0x43, 0xa4, 0xdb, 0x18, 0x14,
+	0x02, 0xe2, 0x2e, 0x8d, 0x17, 0x22, 0xe8, 0x85, 0x49, 0x88, 0x14, 0x21, 0xd6, 0x6d, 0xa1, 0xe0,
+	0x4d, 0xd8, 0x6c, 0x66, 0xd3, 0x85, 0x66, 0x26, 0x66, 0x66, 0xe3, 0xc7, 0xbd, 0xf7, 0xfe, 0xac,
+	0x5e, 0x49, 0x2f, 0xbd, 0x2a, 0x92, 0xfc, 0x11, 0xd9, 0x99, 0xc9, 0x9a, 0xd6, 0xdd, 0x82, 0xde,
+	0xed, 0x39, 0xe7, 0x39, 0xcf, 0x73, 0xbe, 0x76, 0xe0, 0x81, 0xcf, 0xe6, 0x24, 0x9a, 0x3a, 0x1e,
+	0xe7, 0x44, 0x38, 0x34, 0x10, 0xce, 0xe2, 0xc0, 0x99, 0x10, 0x4a, 0x78, 0xc8, 0xed, 0xd9, 0x9c,
+	0x09, 0x86, 0xaa, 0x0a, 0x62, 0x4b, 0x88, 0x4d, 0x03, 0x61, 0x2f, 0x0e, 0xea, 0xbb, 0x13, 0x36,
+	0x61, 0x32, 0xee, 0xc4, 0x5f, 0x0a, 0x5a, 0x6f, 0xa4, 0xb1, 0xcd, 0xbc, 0xb9, 0x37, 0xd5, 0x64,
+	0xf5, 0xbd, 0x34, 0x44, 0xcc, 0x29, 0xc3, 0xcd, 0xef, 0x45, 0xf8, 0xef, 0xb5, 0x52, 0x3f, 0x16,
+	0x9e, 0x20, 0xe8, 0x39, 0x94, 0x54, 0x3e, 0x36, 0x1a, 0x46, 0xab, 0xd2, 0xbe, 0x6f, 0xa7, 0x54,
+	0x63, 0x1f, 0x49, 0x48, 0xa7, 0x70, 0x71, 0xb5, 0x9f, 0x73, 0x75, 0x02, 0x3a, 0x85, 0x1d, 0xff,
+	0xdc, 0xe3, 0x7c, 0x38, 0x26, 0x41, 0x48, 0x43, 0x11, 0x32, 0xca, 0xf1, 0x56, 0x23, 0xdf, 0xaa,
+	0xb4, 0x1f, 0xa6, 0xb2, 0x74, 0x63, 0x74, 0x2f, 0x01, 0x6b, 0xba, 0xbb, 0xfe, 0x75, 0x37, 0x47,
+	0xc7, 0x50, 0x09, 0xe6, 0xec, 0x0b, 0xa1, 0x43, 0x1a, 0x08, 0x8e, 0xf3, 0x92, 0xd2, 0x4a, 0xa5,
+	0xec, 0x4b, 0xdc, 0xa0, 0x7f, 0xd2, 0x41, 0x31, 0xd9, 0xf2, 0x6a, 0x1f, 0x12, 0x17, 0x77, 0x41,
+	0xd1, 0x0c, 0x02, 0xc1, 0xd1, 0x57, 0x03, 0xf0, 0xc7, 0xb3, 0x50, 0x90, 0xf3, 0x90, 0x0b, 0x32,
+	0x8e, 0xa9, 0x87, 0x9e, 0xef, 0xb3, 0x88, 0x0a, 0x8e, 0x0b, 0x52, 0xe2, 0x71, 0xaa, 0xc4, 0xe9,
+	0xef, 0xa4, 0x41, 0xff, 0xe4, 0x95, 0x4e, 0xe9, 0x58, 0x5a, 0xaf, 0x96, 0x1e, 0x77, 0x6b, 0x1b,
+	0x62, 0x83, 0x40, 0xac, 0xfd, 0xe8, 0x2d, 0xc0, 0x28, 0x9a, 0x53, 0xa1, 0x7a, 0x2b, 0x4a, 0xe1,
+	0xbd, 0x54, 0xe1, 0x4e, 0x0c, 0x8b, 0x5b, 0xdb, 0xd1, 0x52, 0xe6, 0xda, 0xc3, 0x5d, 0x53, 0x72,
+	0xc8, 0xc6, 0x1e, 0xc1, 0x1d, 0x3d, 0x2d, 0x39, 0x48, 0xc2, 0x71, 0xa9, 0x91, 0x6f, 0x99, 0xee,
+	0xff, 0xca, 0xdb, 0x55, 0x4e, 0x34, 0x82, 0x7b, 0x6a, 0x5b, 0x1a, 0x9c, 0xf4, 0xbe, 0x2d, 0x4b,
+	0x68, 0x65, 0x6f, 0x4c, 0x0d, 0x34, 0x69, 0x5c, 0x6d, 0xad, 0xea, 0xff, 0x19, 0x42, 0x1f, 0xa0,
+	0xae, 0x34, 0x36, 0x07, 0x9d, 0x08, 0x95, 0xa5, 0xd0, 0x93, 0x6c, 0xa1, 0x8d, 0x49, 0xde, 0x50,
+	0xc3, 0x7e, 0x46, 0xbc, 0xf9, 0x12, 0xcc, 0x64, 0xe1, 0x08, 0xc3, 0xb6, 0x04, 0x1e, 0xf6, 0xe4,
+	0x35, 0x9b, 0xee, 0xda, 0x44, 0x35, 0x28, 0xd1, 0x40, 0x1c, 0xf6, 0xd4, 0x81, 0x9a, 0xae, 0xb6,
+	0x9a, 0x6f, 0xa0, 0x9a, 0xd2, 0xe3, 0x2d, 0x44, 0x75, 0x28, 0x27, 0x0d, 0x29, 0xaa, 0xc4, 0x6e,
+	0x8e, 0x21, 0xe3, 0x18, 0x6e, 0xe1, 0xdb, 0x85, 0xa2, 0x2c, 0x05, 0x6f, 0x49, 0xbf, 0x32, 0xae,
+	0xa9, 0x14, 0x6e, 0xa8, 0x1c, 0x01, 0xce, 0x9a, 0xd6, 0x3f, 0xd6, 0xfd, 0x02, 0xca, 0xeb, 0xcb,
+	0xfa, 0xfb, 0x11, 0x76, 0xde, 0x5d, 0x2c, 0x2d, 0xe3, 0x72, 0x69, 0x19, 0x3f, 0x97, 0x96, 0xf1,
+	0x6d, 0x65, 0xe5, 0x2e, 0x57, 0x56, 0xee, 0xc7, 0xca, 0xca, 0xbd, 0x7f, 0x36, 0x09, 0xc5, 0x59,
+	0x34, 0xb2, 0x7d, 0x36, 0x75, 0xba, 0x72, 0xe9, 0x7d, 0x16, 0xd1, 0xb1, 0x17, 0xff, 0xe5, 0x8e,
+	0x7e, 0xa7, 0x16, 0x6d, 0xe7, 0xd3, 0xc6, 0x63, 0x25, 0x3e, 0xcf, 0x08, 0x1f, 0x95, 0xe4, 0x63,
+	0xf5, 0xf4, 0xd7, 0x00, 0xcd, 0x0a, 0xc1, 0xfc, 0x3d, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -329,6 +467,43 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ClassWhitelistedAccounts) > 0 {
+		for iNdEx := len(m.ClassWhitelistedAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClassWhitelistedAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ClassFrozenAccounts) > 0 {
+		for iNdEx := len(m.ClassFrozenAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClassFrozenAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.FrozenClasses) > 0 {
+		for iNdEx := len(m.FrozenClasses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FrozenClasses[iNdEx])
+			copy(dAtA[i:], m.FrozenClasses[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.FrozenClasses[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.BurntNFTs) > 0 {
 		for iNdEx := len(m.BurntNFTs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -437,6 +612,45 @@ func (m *FrozenNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ClassFrozenAccounts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClassFrozenAccounts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClassFrozenAccounts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Accounts[iNdEx])
+			copy(dAtA[i:], m.Accounts[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Accounts[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ClassID) > 0 {
+		i -= len(m.ClassID)
+		copy(dAtA[i:], m.ClassID)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ClassID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *WhitelistedNFTAccounts) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -483,6 +697,45 @@ func (m *WhitelistedNFTAccounts) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ClassWhitelistedAccounts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClassWhitelistedAccounts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClassWhitelistedAccounts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Accounts[iNdEx])
+			copy(dAtA[i:], m.Accounts[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Accounts[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ClassID) > 0 {
+		i -= len(m.ClassID)
+		copy(dAtA[i:], m.ClassID)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ClassID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *BurntNFT) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -565,6 +818,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.FrozenClasses) > 0 {
+		for _, s := range m.FrozenClasses {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ClassFrozenAccounts) > 0 {
+		for _, e := range m.ClassFrozenAccounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ClassWhitelistedAccounts) > 0 {
+		for _, e := range m.ClassWhitelistedAccounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -587,6 +858,25 @@ func (m *FrozenNFT) Size() (n int) {
 	return n
 }
 
+func (m *ClassFrozenAccounts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassID)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Accounts) > 0 {
+		for _, s := range m.Accounts {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *WhitelistedNFTAccounts) Size() (n int) {
 	if m == nil {
 		return 0
@@ -610,6 +900,25 @@ func (m *WhitelistedNFTAccounts) Size() (n int) {
 	return n
 }
 
+func (m *ClassWhitelistedAccounts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassID)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Accounts) > 0 {
+		for _, s := range m.Accounts {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *BurntNFT) Size() (n int) {
 	if m == nil {
 		return 0
@@ -833,17 +1142,117 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenClasses", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenClasses = append(m.FrozenClasses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassFrozenAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassFrozenAccounts = append(m.ClassFrozenAccounts, ClassFrozenAccounts{})
+			if err := m.ClassFrozenAccounts[len(m.ClassFrozenAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassWhitelistedAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassWhitelistedAccounts = append(m.ClassWhitelistedAccounts, ClassWhitelistedAccounts{})
+			if err := m.ClassWhitelistedAccounts[len(m.ClassWhitelistedAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -968,6 +1377,120 @@ func (m *FrozenNFT) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ClassFrozenAccounts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClassFrozenAccounts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClassFrozenAccounts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *WhitelistedNFTAccounts) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1114,6 +1637,120 @@ func (m *WhitelistedNFTAccounts) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ClassWhitelistedAccounts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClassWhitelistedAccounts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClassWhitelistedAccounts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *BurntNFT) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -31,6 +31,12 @@ var (
 	NFTWhitelistingKeyPrefix = []byte{0x03}
 	// NFTBurningKeyPrefix defines the key prefix to track burnt NFTs.
 	NFTBurningKeyPrefix = []byte{0x04}
+	// NFTClassFreezingKeyPrefix defines the key prefix to track frozen classes.
+	NFTClassFreezingKeyPrefix = []byte{0x05}
+	// NFTClassAccountFreezingKeyPrefix defines the key prefix to track accounts for which classes are frozen.
+	NFTClassAccountFreezingKeyPrefix = []byte{0x06}
+	// NFTClassWhitelistingKeyPrefix defines the key prefix to track accounts whitelisted for the whole class.
+	NFTClassWhitelistingKeyPrefix = []byte{0x07}
 )
 
 // CreateClassKey constructs the key for the non-fungible token class.
@@ -80,6 +86,63 @@ func ParseFreezingKey(key []byte) (string, string, error) {
 	return string(parsedKeys[0]), string(parsedKeys[1]), nil
 }
 
+// CreateClassFreezingKey constructs the key for the freezing of non-fungible token class.
+func CreateClassFreezingKey(classID string) ([]byte, error) {
+	compositeKey, err := store.JoinKeysWithLength([]byte(classID))
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidKey, "failed to create a class freezing key, err: %s", err)
+	}
+
+	return store.JoinKeys(NFTClassFreezingKeyPrefix, compositeKey), nil
+}
+
+// ParseClassFreezingKey parses class freezing key back to class id.
+func ParseClassFreezingKey(key []byte) (string, error) {
+	parsedKeys, err := store.ParseLengthPrefixedKeys(key)
+	if err != nil {
+		return "", sdkerrors.Wrapf(ErrInvalidKey, "failed to parse a class freezing key, err: %s", err)
+	}
+	if len(parsedKeys) != 1 {
+		err = sdkerrors.Wrapf(ErrInvalidKey, "class freezing key must be composed of 1 length prefixed key")
+		return "", err
+	}
+	return string(parsedKeys[0]), nil
+}
+
+// CreateClassAccountFreezingKey constructs the key for the freezing of non-fungible token class for the account.
+func CreateClassAccountFreezingKey(classID string, account sdk.AccAddress) ([]byte, error) {
+	compositeKey, err := store.JoinKeysWithLength([]byte(classID), account)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidKey, "failed to create a class account freezing key, err: %s", err)
+	}
+
+	return store.JoinKeys(NFTClassAccountFreezingKeyPrefix, compositeKey), nil
+}
+
+// CreateClassAccountFreezingPrefix constructs the key prefix for the freezing of non-fungible token class
+// for the accounts.
+func CreateClassAccountFreezingPrefix(classID string) ([]byte, error) {
+	compositeKey, err := store.JoinKeysWithLength([]byte(classID))
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidKey, "failed to create a class account freezing prefix, err: %s", err)
+	}
+
+	return store.JoinKeys(NFTClassAccountFreezingKeyPrefix, compositeKey), nil
+}
+
+// ParseClassAccountFreezingKey parses class account freezing key back to class id and account.
+func ParseClassAccountFreezingKey(key []byte) (string, sdk.AccAddress, error) {
+	parsedKeys, err := store.ParseLengthPrefixedKeys(key)
+	if err != nil {
+		return "", nil, sdkerrors.Wrapf(ErrInvalidKey, "failed to parse a class account freezing key, err: %s", err)
+	}
+	if len(parsedKeys) != 2 {
+		err = sdkerrors.Wrapf(ErrInvalidKey, "class account freezing key must be composed of 2 length prefixed keys")
+		return "", nil, err
+	}
+	return string(parsedKeys[0]), parsedKeys[1], nil
+}
+
 // CreateWhitelistingKey constructs the key for the whitelisting of non-fungible token.
 func CreateWhitelistingKey(classID, nftID string, account sdk.AccAddress) ([]byte, error) {
 	compositeKey, err := store.JoinKeysWithLength([]byte(classID), []byte(nftID), account)
@@ -103,6 +166,39 @@ func ParseWhitelistingKey(key []byte) (string, string, sdk.AccAddress, error) {
 	return string(parsedKeys[0]), string(parsedKeys[1]), parsedKeys[2], nil
 }
 
+// CreateClassWhitelistingKey constructs the key for the whitelisting of non-fungible token class.
+func CreateClassWhitelistingKey(classID string, account sdk.AccAddress) ([]byte, error) {
+	compositeKey, err := store.JoinKeysWithLength([]byte(classID), account)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidKey, "failed to create a class whitelisting key, err: %s", err)
+	}
+
+	return store.JoinKeys(NFTClassWhitelistingKeyPrefix, compositeKey), nil
+}
+
+// CreateClassWhitelistingPrefix constructs the key prefix for the whitelisting of non-fungible token class.
+func CreateClassWhitelistingPrefix(classID string) ([]byte, error) {
+	compositeKey, err := store.JoinKeysWithLength([]byte(classID))
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidKey, "failed to create a class whitelisting prefix, err: %s", err)
+	}
+
+	return store.JoinKeys(NFTClassWhitelistingKeyPrefix, compositeKey), nil
+}
+
+// ParseClassWhitelistingKey parses class whitelisting key back to class id and account.
+func ParseClassWhitelistingKey(key []byte) (string, sdk.AccAddress, error) {
+	parsedKeys, err := store.ParseLengthPrefixedKeys(key)
+	if err != nil {
+		return "", nil, sdkerrors.Wrapf(ErrInvalidKey, "failed to parse a class whitelisting key, err: %s", err)
+	}
+	if len(parsedKeys) != 2 {
+		err = sdkerrors.Wrapf(ErrInvalidKey, "class whitelisting key must be composed of 2 length prefixed keys")
+		return "", nil, err
+	}
+	return string(parsedKeys[0]), parsedKeys[1], nil
+}
+
 // CreateBurningKey constructs the key for the burning of non-fungible token.
 func CreateBurningKey(classID, nftID string) ([]byte, error) {
 	compositeKey, err := store.JoinKeysWithLength([]byte(classID), []byte(nftID))
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -13,13 +14,24 @@ import (
 
 // Type of messages for amino.
 const (
-	TypeMsgIssueClass          = "issue-class"
-	TypeMsgMint                = "mint"
-	TypeMsgBurn                = "burn"
-	TypeMsgFreeze              = "freeze"
-	TypeMsgUnfreeze            = "unfreeze"
-	TypeMsgAddToWhitelist      = "whitelist"
-	TypeMsgRemoveFromWhitelist = "remove-from-whitelist"
+	TypeMsgIssueClass               = "issue-class"
+	TypeMsgMint                     = "mint"
+	TypeMsgBurn                     = "burn"
+	TypeMsgFreeze                   = "freeze"
+	TypeMsgUnfreeze                 = "unfreeze"
+	TypeMsgAddToWhitelist           = "whitelist"
+	TypeMsgRemoveFromWhitelist      = "remove-from-whitelist"
+	TypeMsgSendWithPayment          = "send-with-payment"
+	TypeMsgUpdateClass              = "update-class"
+	TypeMsgUpdateData               = "update-data"
+	TypeMsgClassFreeze              = "class-freeze"
+	TypeMsgClassUnfreeze            = "class-unfreeze"
+	TypeMsgFreezeClassForAccount    = "freeze-class-for-account"
+	TypeMsgUnfreezeClassForAccount  = "unfreeze-class-for-account"
+	TypeMsgAddToClassWhitelist      = "add-to-class-whitelist"
+	TypeMsgRemoveFromClassWhitelist = "remove-from-class-whitelist"
+	TypeMsgMintBatch                = "mint-batch"
+	TypeMsgBurnBatch                = "burn-batch"
 )
 
 var (
@@ -37,6 +49,28 @@ var (
 	_ legacytx.LegacyMsg = &MsgAddToWhitelist{}
 	_ sdk.Msg            = &MsgRemoveFromWhitelist{}
 	_ legacytx.LegacyMsg = &MsgRemoveFromWhitelist{}
+	_ sdk.Msg            = &MsgSendWithPayment{}
+	_ legacytx.LegacyMsg = &MsgSendWithPayment{}
+	_ sdk.Msg            = &MsgUpdateClass{}
+	_ legacytx.LegacyMsg = &MsgUpdateClass{}
+	_ sdk.Msg            = &MsgUpdateData{}
+	_ legacytx.LegacyMsg = &MsgUpdateData{}
+	_ sdk.Msg            = &MsgClassFreeze{}
+	_ legacytx.LegacyMsg = &MsgClassFreeze{}
+	_ sdk.Msg            = &MsgClassUnfreeze{}
+	_ legacytx.LegacyMsg = &MsgClassUnfreeze{}
+	_ sdk.Msg            = &MsgFreezeClassForAccount{}
+	_ legacytx.LegacyMsg = &MsgFreezeClassForAccount{}
+	_ sdk.Msg            = &MsgUnfreezeClassForAccount{}
+	_ legacytx.LegacyMsg = &MsgUnfreezeClassForAccount{}
+	_ sdk.Msg            = &MsgAddToClassWhitelist{}
+	_ legacytx.LegacyMsg = &MsgAddToClassWhitelist{}
+	_ sdk.Msg            = &MsgRemoveFromClassWhitelist{}
+	_ legacytx.LegacyMsg = &MsgRemoveFromClassWhitelist{}
+	_ sdk.Msg            = &MsgMintBatch{}
+	_ legacytx.LegacyMsg = &MsgMintBatch{}
+	_ sdk.Msg            = &MsgBurnBatch{}
+	_ legacytx.LegacyMsg = &MsgBurnBatch{}
 )
 
 // Constraints.
@@ -46,6 +80,7 @@ const (
 	MaxURILength              = 256
 	MaxURIHashLength          = 128
 	MaxDataSize               = 5 * 1024 // 5KB
+	MaxBatchSize              = 100
 )
 
 // RegisterLegacyAminoCodec registers the amino types and interfaces.
@@ -57,6 +92,17 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUnfreeze{}, fmt.Sprintf("%s/MsgUnfreeze", ModuleName), nil)
 	cdc.RegisterConcrete(&MsgAddToWhitelist{}, fmt.Sprintf("%s/MsgAddToWhitelist", ModuleName), nil)
 	cdc.RegisterConcrete(&MsgRemoveFromWhitelist{}, fmt.Sprintf("%s/MsgRemoveFromWhitelist", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgSendWithPayment{}, fmt.Sprintf("%s/MsgSendWithPayment", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgUpdateClass{}, fmt.Sprintf("%s/MsgUpdateClass", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgUpdateData{}, fmt.Sprintf("%s/MsgUpdateData", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgClassFreeze{}, fmt.Sprintf("%s/MsgClassFreeze", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgClassUnfreeze{}, fmt.Sprintf("%s/MsgClassUnfreeze", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgFreezeClassForAccount{}, fmt.Sprintf("%s/MsgFreezeClassForAccount", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgUnfreezeClassForAccount{}, fmt.Sprintf("%s/MsgUnfreezeClassForAccount", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgAddToClassWhitelist{}, fmt.Sprintf("%s/MsgAddToClassWhitelist", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgRemoveFromClassWhitelist{}, fmt.Sprintf("%s/MsgRemoveFromClassWhitelist", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgMintBatch{}, fmt.Sprintf("%s/MsgMintBatch", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgBurnBatch{}, fmt.Sprintf("%s/MsgBurnBatch", ModuleName), nil)
 }
 
 // ValidateBasic checks that message fields are valid.
@@ -377,6 +423,218 @@ func (m MsgRemoveFromWhitelist) Type() string {
 	return TypeMsgRemoveFromWhitelist
 }
 
+// ValidateBasic checks that message fields are valid.
+func (m *MsgSendWithPayment) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender account %s", m.Sender)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Receiver); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver account %s", m.Receiver)
+	}
+
+	if m.Sender == m.Receiver {
+		return sdkerrors.Wrap(ErrInvalidInput, "sender and receiver must be different")
+	}
+
+	if err := ValidateTokenID(m.ID); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+	}
+
+	if _, _, err := DeconstructClassID(m.ClassID); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+	}
+
+	if err := m.Payment.Validate(); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid payment: %s", err)
+	}
+
+	return nil
+}
+
+// GetSigners returns the required signers of this message type.
+func (m *MsgSendWithPayment) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+		sdk.MustAccAddressFromBech32(m.Receiver),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgSendWithPayment) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgSendWithPayment) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgSendWithPayment) Type() string {
+	return TypeMsgSendWithPayment
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgUpdateClass) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender account %s", m.Sender)
+	}
+
+	if _, _, err := DeconstructClassID(m.ClassID); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+	}
+
+	return validateMetadata(m.URI, m.URIHash, m.Data)
+}
+
+// GetSigners returns the required signers of this message type.
+func (m *MsgUpdateClass) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgUpdateClass) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgUpdateClass) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgUpdateClass) Type() string {
+	return TypeMsgUpdateClass
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgUpdateData) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender account %s", m.Sender)
+	}
+
+	if err := ValidateTokenID(m.ID); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+	}
+
+	if _, _, err := DeconstructClassID(m.ClassID); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+	}
+
+	return validateMetadata(m.URI, m.URIHash, m.Data)
+}
+
+// GetSigners returns the required signers of this message type.
+func (m *MsgUpdateData) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgUpdateData) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgUpdateData) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgUpdateData) Type() string {
+	return TypeMsgUpdateData
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgClassFreeze) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender account %s", m.Sender)
+	}
+
+	if _, _, err := DeconstructClassID(m.ClassID); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+	}
+
+	return nil
+}
+
+// GetSigners returns the required signers of this message type.
+func (m *MsgClassFreeze) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgClassFreeze) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgClassFreeze) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgClassFreeze) Type() string {
+	return TypeMsgClassFreeze
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgClassUnfreeze) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender account %s", m.Sender)
+	}
+
+	if _, _, err := DeconstructClassID(m.ClassID); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+	}
+
+	return nil
+}
+
+// GetSigners returns the required signers of this message type.
+func (m *MsgClassUnfreeze) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgClassUnfreeze) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgClassUnfreeze) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgClassUnfreeze) Type() string {
+	return TypeMsgClassUnfreeze
+}
+
+func validateMetadata(uri, uriHash string, data *codectypes.Any) error {
+	if err := ValidateData(data); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+	}
+
+	if len(uri) > MaxURILength {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid URI %q, the length must be less than or equal %d", len(uri), MaxURILength)
+	}
+
+	if len(uriHash) > MaxURIHashLength {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid URI hash %q, the length must be less than or equal %d", len(uriHash), MaxURIHashLength)
+	}
+
+	return nil
+}
+
 var (
 	amino          = codec.NewLegacyAmino()
 	moduleAminoCdc = codec.NewAminoCodec(amino)
@@ -387,3 +645,285 @@ func init() {
 	cryptocodec.RegisterCrypto(amino)
 	amino.Seal()
 }
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgFreezeClassForAccount) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender account %s", m.Sender)
+	}
+
+	if _, _, err := DeconstructClassID(m.ClassID); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Account); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid account %s", m.Account)
+	}
+
+	return nil
+}
+
+// GetSigners returns the required signers of this message type.
+func (m *MsgFreezeClassForAccount) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgFreezeClassForAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgFreezeClassForAccount) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgFreezeClassForAccount) Type() string {
+	return TypeMsgFreezeClassForAccount
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgUnfreezeClassForAccount) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender account %s", m.Sender)
+	}
+
+	if _, _, err := DeconstructClassID(m.ClassID); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Account); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid account %s", m.Account)
+	}
+
+	return nil
+}
+
+// GetSigners returns the required signers of this message type.
+func (m *MsgUnfreezeClassForAccount) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgUnfreezeClassForAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgUnfreezeClassForAccount) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgUnfreezeClassForAccount) Type() string {
+	return TypeMsgUnfreezeClassForAccount
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgAddToClassWhitelist) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender account %s", m.Sender)
+	}
+
+	if _, _, err := DeconstructClassID(m.ClassID); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Account); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid account %s", m.Account)
+	}
+
+	return nil
+}
+
+// GetSigners returns the required signers of this message type.
+func (m *MsgAddToClassWhitelist) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgAddToClassWhitelist) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgAddToClassWhitelist) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgAddToClassWhitelist) Type() string {
+	return TypeMsgAddToClassWhitelist
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgRemoveFromClassWhitelist) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender account %s", m.Sender)
+	}
+
+	if _, _, err := DeconstructClassID(m.ClassID); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Account); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid account %s", m.Account)
+	}
+
+	return nil
+}
+
+// GetSigners returns the required signers of this message type.
+func (m *MsgRemoveFromClassWhitelist) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgRemoveFromClassWhitelist) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgRemoveFromClassWhitelist) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgRemoveFromClassWhitelist) Type() string {
+	return TypeMsgRemoveFromClassWhitelist
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgMintBatch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender account %s", m.Sender)
+	}
+
+	if _, _, err := DeconstructClassID(m.ClassID); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+	}
+
+	if err := validateBatchSize(len(m.NFTs)); err != nil {
+		return err
+	}
+
+	ids := make(map[string]struct{}, len(m.NFTs))
+	for _, item := range m.NFTs {
+		if err := ValidateTokenID(item.ID); err != nil {
+			return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+		}
+
+		if _, ok := ids[item.ID]; ok {
+			return sdkerrors.Wrapf(ErrInvalidInput, "duplicated nft ID %q", item.ID)
+		}
+		ids[item.ID] = struct{}{}
+
+		if err := ValidateData(item.Data); err != nil {
+			return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+		}
+
+		if len(item.URI) > MaxURILength {
+			return sdkerrors.Wrapf(ErrInvalidInput, "invalid URI %q, the length must be less than or equal %d", len(item.URI), MaxURILength)
+		}
+
+		if len(item.URIHash) > MaxURIHashLength {
+			return sdkerrors.Wrapf(ErrInvalidInput, "invalid URI hash %q, the length must be less than or equal %d", len(item.URIHash), MaxURIHashLength)
+		}
+	}
+
+	return nil
+}
+
+// GetSigners returns the required signers of this message type.
+func (m *MsgMintBatch) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgMintBatch) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgMintBatch) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgMintBatch) Type() string {
+	return TypeMsgMintBatch
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgBurnBatch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender account %s", m.Sender)
+	}
+
+	if _, _, err := DeconstructClassID(m.ClassID); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+	}
+
+	if err := validateBatchSize(len(m.IDs)); err != nil {
+		return err
+	}
+
+	ids := make(map[string]struct{}, len(m.IDs))
+	for _, id := range m.IDs {
+		if err := ValidateTokenID(id); err != nil {
+			return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+		}
+
+		if _, ok := ids[id]; ok {
+			return sdkerrors.Wrapf(ErrInvalidInput, "duplicated nft ID %q", id)
+		}
+		ids[id] = struct{}{}
+	}
+
+	return nil
+}
+
+// GetSigners returns the required signers of this message type.
+func (m *MsgBurnBatch) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgBurnBatch) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgBurnBatch) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgBurnBatch) Type() string {
+	return TypeMsgBurnBatch
+}
+
+func validateBatchSize(size int) error {
+	if size == 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "batch must not be empty")
+	}
+
+	if size > MaxBatchSize {
+		return sdkerrors.Wrapf(ErrInvalidInput, "batch size must be less than or equal %d", MaxBatchSize)
+	}
+
+	return nil
+}
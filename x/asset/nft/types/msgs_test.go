@@ -2,10 +2,12 @@ package types_test
 
 import (
 	"bytes"
+	"fmt"
 	"strings"
 	"testing"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
 	"github.com/gogo/protobuf/proto"
@@ -653,6 +655,647 @@ func TestMsgRemoveFromWhitelist_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgSendWithPayment_ValidateBasic(t *testing.T) {
+	validMessage := types.MsgSendWithPayment{
+		Sender:   "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		ClassID:  "symbol-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		ID:       "my-id",
+		Receiver: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+		Payment:  sdk.NewInt64Coin("ucore", 100),
+	}
+	testCases := []struct {
+		name          string
+		messageFunc   func() *types.MsgSendWithPayment
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			messageFunc: func() *types.MsgSendWithPayment {
+				msg := validMessage
+				return &msg
+			},
+		},
+		{
+			name: "valid msg with zero payment",
+			messageFunc: func() *types.MsgSendWithPayment {
+				msg := validMessage
+				msg.Payment = sdk.NewInt64Coin("ucore", 0)
+				return &msg
+			},
+		},
+		{
+			name: "invalid id",
+			messageFunc: func() *types.MsgSendWithPayment {
+				msg := validMessage
+				msg.ID = invalidNFTID
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid sender",
+			messageFunc: func() *types.MsgSendWithPayment {
+				msg := validMessage
+				msg.Sender = invalidAccount
+				return &msg
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid receiver",
+			messageFunc: func() *types.MsgSendWithPayment {
+				msg := validMessage
+				msg.Receiver = "devcore172"
+				return &msg
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "receiver is the sender",
+			messageFunc: func() *types.MsgSendWithPayment {
+				msg := validMessage
+				msg.Receiver = msg.Sender
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid classID",
+			messageFunc: func() *types.MsgSendWithPayment {
+				msg := validMessage
+				msg.ClassID = "x"
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid payment",
+			messageFunc: func() *types.MsgSendWithPayment {
+				msg := validMessage
+				msg.Payment = sdk.Coin{Denom: "ucore", Amount: sdk.NewInt(-1)}
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.messageFunc().ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgUpdateClass_ValidateBasic(t *testing.T) {
+	requireT := require.New(t)
+
+	dataValue, err := codectypes.NewAnyWithValue(&types.DataBytes{Data: []byte("metadata")})
+	requireT.NoError(err)
+
+	validMessage := types.MsgUpdateClass{
+		Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		ClassID: "symbol-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		URI:     "https://my.invalid",
+		URIHash: "content-hash",
+		Data:    dataValue,
+	}
+	testCases := []struct {
+		name          string
+		messageFunc   func() *types.MsgUpdateClass
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			messageFunc: func() *types.MsgUpdateClass {
+				msg := validMessage
+				return &msg
+			},
+		},
+		{
+			name: "valid msg with empty metadata",
+			messageFunc: func() *types.MsgUpdateClass {
+				msg := validMessage
+				msg.URI = ""
+				msg.URIHash = ""
+				msg.Data = nil
+				return &msg
+			},
+		},
+		{
+			name: "invalid sender",
+			messageFunc: func() *types.MsgUpdateClass {
+				msg := validMessage
+				msg.Sender = invalidAccount
+				return &msg
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid classID",
+			messageFunc: func() *types.MsgUpdateClass {
+				msg := validMessage
+				msg.ClassID = "x"
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid uri",
+			messageFunc: func() *types.MsgUpdateClass {
+				msg := validMessage
+				msg.URI = string(make([]byte, 257))
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid uri hash",
+			messageFunc: func() *types.MsgUpdateClass {
+				msg := validMessage
+				msg.URIHash = strings.Repeat("x", 129)
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid data - too long",
+			messageFunc: func() *types.MsgUpdateClass {
+				msg := validMessage
+				msg.Data = &codectypes.Any{
+					TypeUrl: "/" + proto.MessageName((*types.DataBytes)(nil)),
+					Value:   bytes.Repeat([]byte{0x01}, types.MaxDataSize+1),
+				}
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.messageFunc().ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgUpdateData_ValidateBasic(t *testing.T) {
+	requireT := require.New(t)
+
+	dataValue, err := codectypes.NewAnyWithValue(&types.DataBytes{Data: []byte("metadata")})
+	requireT.NoError(err)
+
+	validMessage := types.MsgUpdateData{
+		Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		ClassID: "symbol-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		ID:      "my-id",
+		URI:     "https://my.invalid",
+		URIHash: "content-hash",
+		Data:    dataValue,
+	}
+	testCases := []struct {
+		name          string
+		messageFunc   func() *types.MsgUpdateData
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			messageFunc: func() *types.MsgUpdateData {
+				msg := validMessage
+				return &msg
+			},
+		},
+		{
+			name: "invalid id",
+			messageFunc: func() *types.MsgUpdateData {
+				msg := validMessage
+				msg.ID = invalidNFTID
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid sender",
+			messageFunc: func() *types.MsgUpdateData {
+				msg := validMessage
+				msg.Sender = invalidAccount
+				return &msg
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid classID",
+			messageFunc: func() *types.MsgUpdateData {
+				msg := validMessage
+				msg.ClassID = "x"
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid uri",
+			messageFunc: func() *types.MsgUpdateData {
+				msg := validMessage
+				msg.URI = string(make([]byte, 257))
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid data - wrong type",
+			messageFunc: func() *types.MsgUpdateData {
+				dataValue, err := codectypes.NewAnyWithValue(&types.MsgIssueClass{})
+				requireT.NoError(err)
+				msg := validMessage
+				msg.Data = dataValue
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.messageFunc().ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgClassFreeze_ValidateBasic(t *testing.T) {
+	validMessage := types.MsgClassFreeze{
+		Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		ClassID: "symbol-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+	}
+	testCases := []struct {
+		name          string
+		messageFunc   func() *types.MsgClassFreeze
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			messageFunc: func() *types.MsgClassFreeze {
+				msg := validMessage
+				return &msg
+			},
+		},
+		{
+			name: "invalid sender",
+			messageFunc: func() *types.MsgClassFreeze {
+				msg := validMessage
+				msg.Sender = invalidAccount
+				return &msg
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid classID",
+			messageFunc: func() *types.MsgClassFreeze {
+				msg := validMessage
+				msg.ClassID = "x"
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.messageFunc().ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgFreezeClassForAccount_ValidateBasic(t *testing.T) {
+	validMessage := types.MsgFreezeClassForAccount{
+		Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		ClassID: "symbol-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+	}
+	testCases := []struct {
+		name          string
+		messageFunc   func() *types.MsgFreezeClassForAccount
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			messageFunc: func() *types.MsgFreezeClassForAccount {
+				msg := validMessage
+				return &msg
+			},
+		},
+		{
+			name: "invalid sender",
+			messageFunc: func() *types.MsgFreezeClassForAccount {
+				msg := validMessage
+				msg.Sender = invalidAccount
+				return &msg
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid classID",
+			messageFunc: func() *types.MsgFreezeClassForAccount {
+				msg := validMessage
+				msg.ClassID = "x"
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid account",
+			messageFunc: func() *types.MsgFreezeClassForAccount {
+				msg := validMessage
+				msg.Account = invalidAccount
+				return &msg
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.messageFunc().ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgAddToClassWhitelist_ValidateBasic(t *testing.T) {
+	validMessage := types.MsgAddToClassWhitelist{
+		Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		ClassID: "symbol-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+	}
+	testCases := []struct {
+		name          string
+		messageFunc   func() *types.MsgAddToClassWhitelist
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			messageFunc: func() *types.MsgAddToClassWhitelist {
+				msg := validMessage
+				return &msg
+			},
+		},
+		{
+			name: "invalid sender",
+			messageFunc: func() *types.MsgAddToClassWhitelist {
+				msg := validMessage
+				msg.Sender = invalidAccount
+				return &msg
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid classID",
+			messageFunc: func() *types.MsgAddToClassWhitelist {
+				msg := validMessage
+				msg.ClassID = "x"
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid account",
+			messageFunc: func() *types.MsgAddToClassWhitelist {
+				msg := validMessage
+				msg.Account = invalidAccount
+				return &msg
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.messageFunc().ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgMintBatch_ValidateBasic(t *testing.T) {
+	validMessage := types.MsgMintBatch{
+		Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		ClassID: "symbol-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		NFTs: []types.MintBatchItem{
+			{ID: "my-id-1", URI: "https://my.invalid", URIHash: "content-hash"},
+			{ID: "my-id-2"},
+		},
+	}
+	testCases := []struct {
+		name          string
+		messageFunc   func() *types.MsgMintBatch
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			messageFunc: func() *types.MsgMintBatch {
+				msg := validMessage
+				return &msg
+			},
+		},
+		{
+			name: "invalid sender",
+			messageFunc: func() *types.MsgMintBatch {
+				msg := validMessage
+				msg.Sender = invalidAccount
+				return &msg
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid classID",
+			messageFunc: func() *types.MsgMintBatch {
+				msg := validMessage
+				msg.ClassID = "x"
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "empty batch",
+			messageFunc: func() *types.MsgMintBatch {
+				msg := validMessage
+				msg.NFTs = nil
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "batch too large",
+			messageFunc: func() *types.MsgMintBatch {
+				msg := validMessage
+				msg.NFTs = make([]types.MintBatchItem, 0, types.MaxBatchSize+1)
+				for i := 0; i <= types.MaxBatchSize; i++ {
+					msg.NFTs = append(msg.NFTs, types.MintBatchItem{ID: fmt.Sprintf("my-id-%d", i)})
+				}
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid id",
+			messageFunc: func() *types.MsgMintBatch {
+				msg := validMessage
+				msg.NFTs = []types.MintBatchItem{{ID: invalidNFTID}}
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "duplicated id",
+			messageFunc: func() *types.MsgMintBatch {
+				msg := validMessage
+				msg.NFTs = []types.MintBatchItem{{ID: "my-id"}, {ID: "my-id"}}
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid uri",
+			messageFunc: func() *types.MsgMintBatch {
+				msg := validMessage
+				msg.NFTs = []types.MintBatchItem{{ID: "my-id", URI: string(make([]byte, 257))}}
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.messageFunc().ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgBurnBatch_ValidateBasic(t *testing.T) {
+	validMessage := types.MsgBurnBatch{
+		Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		ClassID: "symbol-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		IDs:     []string{"my-id-1", "my-id-2"},
+	}
+	testCases := []struct {
+		name          string
+		messageFunc   func() *types.MsgBurnBatch
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			messageFunc: func() *types.MsgBurnBatch {
+				msg := validMessage
+				return &msg
+			},
+		},
+		{
+			name: "invalid sender",
+			messageFunc: func() *types.MsgBurnBatch {
+				msg := validMessage
+				msg.Sender = invalidAccount
+				return &msg
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid classID",
+			messageFunc: func() *types.MsgBurnBatch {
+				msg := validMessage
+				msg.ClassID = "x"
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "empty batch",
+			messageFunc: func() *types.MsgBurnBatch {
+				msg := validMessage
+				msg.IDs = nil
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid id",
+			messageFunc: func() *types.MsgBurnBatch {
+				msg := validMessage
+				msg.IDs = []string{invalidNFTID}
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "duplicated id",
+			messageFunc: func() *types.MsgBurnBatch {
+				msg := validMessage
+				msg.IDs = []string{"my-id", "my-id"}
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.messageFunc().ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
 func TestAmino(t *testing.T) {
 	const address = "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"
 
@@ -686,6 +1329,24 @@ func TestAmino(t *testing.T) {
 			},
 			wantAminoJSON: `{"type":"assetnft/MsgBurn","value":{"class_id":"classID","id":"nftID","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
 		},
+		{
+			name: types.TypeMsgMintBatch,
+			msg: &types.MsgMintBatch{
+				Sender:  address,
+				ClassID: "classID",
+				NFTs:    []types.MintBatchItem{{ID: "nftID"}},
+			},
+			wantAminoJSON: `{"type":"assetnft/MsgMintBatch","value":{"class_id":"classID","nfts":[{"id":"nftID"}],"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: types.TypeMsgBurnBatch,
+			msg: &types.MsgBurnBatch{
+				Sender:  address,
+				ClassID: "classID",
+				IDs:     []string{"nftID"},
+			},
+			wantAminoJSON: `{"type":"assetnft/MsgBurnBatch","value":{"class_id":"classID","ids":["nftID"],"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
 		{
 			name: types.TypeMsgFreeze,
 			msg: &types.MsgFreeze{
@@ -722,6 +1383,87 @@ func TestAmino(t *testing.T) {
 			},
 			wantAminoJSON: `{"type":"assetnft/MsgRemoveFromWhitelist","value":{"class_id":"classID","id":"nftID","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
 		},
+		{
+			name: types.TypeMsgSendWithPayment,
+			msg: &types.MsgSendWithPayment{
+				Sender:  address,
+				ClassID: "classID",
+				ID:      "nftID",
+				Payment: sdk.NewInt64Coin("ucore", 100),
+			},
+			wantAminoJSON: `{"type":"assetnft/MsgSendWithPayment","value":{"class_id":"classID","id":"nftID","payment":{"amount":"100","denom":"ucore"},"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: types.TypeMsgUpdateClass,
+			msg: &types.MsgUpdateClass{
+				Sender:  address,
+				ClassID: "classID",
+				URI:     "uri",
+			},
+			wantAminoJSON: `{"type":"assetnft/MsgUpdateClass","value":{"class_id":"classID","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","uri":"uri"}}`,
+		},
+		{
+			name: types.TypeMsgUpdateData,
+			msg: &types.MsgUpdateData{
+				Sender:  address,
+				ClassID: "classID",
+				ID:      "nftID",
+				URI:     "uri",
+			},
+			wantAminoJSON: `{"type":"assetnft/MsgUpdateData","value":{"class_id":"classID","id":"nftID","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","uri":"uri"}}`,
+		},
+		{
+			name: types.TypeMsgClassFreeze,
+			msg: &types.MsgClassFreeze{
+				Sender:  address,
+				ClassID: "classID",
+			},
+			wantAminoJSON: `{"type":"assetnft/MsgClassFreeze","value":{"class_id":"classID","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: types.TypeMsgClassUnfreeze,
+			msg: &types.MsgClassUnfreeze{
+				Sender:  address,
+				ClassID: "classID",
+			},
+			wantAminoJSON: `{"type":"assetnft/MsgClassUnfreeze","value":{"class_id":"classID","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: types.TypeMsgFreezeClassForAccount,
+			msg: &types.MsgFreezeClassForAccount{
+				Sender:  address,
+				ClassID: "classID",
+				Account: address,
+			},
+			wantAminoJSON: `{"type":"assetnft/MsgFreezeClassForAccount","value":{"account":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","class_id":"classID","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: types.TypeMsgUnfreezeClassForAccount,
+			msg: &types.MsgUnfreezeClassForAccount{
+				Sender:  address,
+				ClassID: "classID",
+				Account: address,
+			},
+			wantAminoJSON: `{"type":"assetnft/MsgUnfreezeClassForAccount","value":{"account":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","class_id":"classID","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: types.TypeMsgAddToClassWhitelist,
+			msg: &types.MsgAddToClassWhitelist{
+				Sender:  address,
+				ClassID: "classID",
+				Account: address,
+			},
+			wantAminoJSON: `{"type":"assetnft/MsgAddToClassWhitelist","value":{"account":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","class_id":"classID","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: types.TypeMsgRemoveFromClassWhitelist,
+			msg: &types.MsgRemoveFromClassWhitelist{
+				Sender:  address,
+				ClassID: "classID",
+				Account: address,
+			},
+			wantAminoJSON: `{"type":"assetnft/MsgRemoveFromClassWhitelist","value":{"account":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","class_id":"classID","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -33,6 +33,12 @@ const (
 	ClassFeature_freezing        ClassFeature = 1
 	ClassFeature_whitelisting    ClassFeature = 2
 	ClassFeature_disable_sending ClassFeature = 3
+	// updatable allows the issuer to update the metadata of the class and its NFTs.
+	ClassFeature_updatable ClassFeature = 4
+	// owner_updatable allows the owner to update the metadata of the NFT.
+	ClassFeature_owner_updatable ClassFeature = 5
+	// ibc allows the non-fungible tokens of the class to be transferred to other chains over ICS-721.
+	ClassFeature_ibc ClassFeature = 6
 )
 
 var ClassFeature_name = map[int32]string{
@@ -40,6 +46,9 @@ var ClassFeature_name = map[int32]string{
 	1: "freezing",
 	2: "whitelisting",
 	3: "disable_sending",
+	4: "updatable",
+	5: "owner_updatable",
+	6: "ibc",
 }
 
 var ClassFeature_value = map[string]int32{
@@ -47,6 +56,9 @@ var ClassFeature_value = map[string]int32{
 	"freezing":        1,
 	"whitelisting":    2,
 	"disable_sending": 3,
+	"updatable":       4,
+	"owner_updatable": 5,
+	"ibc":             6,
 }
 
 func (x ClassFeature) String() string {
@@ -244,39 +256,41 @@ func init() {
 func init() { proto.RegisterFile("coreum/asset/nft/v1/nft.proto", fileDescriptor_5b9231d6a69d6d06) }
 
 var fileDescriptor_5b9231d6a69d6d06 = []byte{
-	// 507 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0x8d, 0xed, 0x36, 0x49, 0x37, 0x51, 0x5b, 0x6d, 0xab, 0xca, 0xad, 0x84, 0x13, 0x7a, 0xa8,
-	0x22, 0x24, 0xd6, 0x6a, 0x38, 0x70, 0xe2, 0x40, 0x1b, 0x55, 0xe4, 0x46, 0x57, 0xea, 0x85, 0x4b,
-	0xb4, 0x8e, 0x37, 0xce, 0x0a, 0x67, 0x37, 0xda, 0x8f, 0x80, 0xf9, 0x15, 0xfc, 0xac, 0x1e, 0x2b,
-	0x71, 0x41, 0x1c, 0x22, 0xe4, 0xfc, 0x0d, 0x0e, 0x68, 0xd7, 0x06, 0x82, 0x84, 0xb8, 0xf4, 0xb4,
-	0x33, 0xef, 0x8d, 0x3d, 0xf3, 0xde, 0x68, 0xc0, 0x93, 0xa9, 0x90, 0xd4, 0x2c, 0x62, 0xa2, 0x14,
-	0xd5, 0x31, 0x9f, 0xe9, 0x78, 0x75, 0x69, 0x1f, 0xb4, 0x94, 0x42, 0x0b, 0x78, 0x54, 0xd1, 0xc8,
-	0xd1, 0xc8, 0xe2, 0xab, 0xcb, 0xb3, 0xe3, 0x4c, 0x64, 0xc2, 0xf1, 0xb1, 0x8d, 0xaa, 0xd2, 0xb3,
-	0xd3, 0x4c, 0x88, 0x2c, 0xa7, 0xb1, 0xcb, 0x12, 0x33, 0x8b, 0x09, 0x2f, 0x2a, 0xea, 0xfc, 0x8b,
-	0x07, 0x0e, 0xae, 0x73, 0xa2, 0xd4, 0x88, 0xce, 0x18, 0x67, 0x9a, 0x09, 0x0e, 0x4f, 0x80, 0xcf,
-	0xd2, 0xd0, 0xeb, 0x7b, 0x83, 0xbd, 0xab, 0x66, 0xb9, 0xee, 0xf9, 0xe3, 0x11, 0xf6, 0x59, 0x0a,
-	0x4f, 0x40, 0x93, 0x29, 0x65, 0xa8, 0x0c, 0x7d, 0xcb, 0xe1, 0x3a, 0x83, 0xaf, 0x40, 0x7b, 0x46,
-	0x89, 0x36, 0x92, 0xaa, 0x30, 0xe8, 0x07, 0x83, 0xfd, 0xe1, 0x53, 0xf4, 0x8f, 0xe1, 0x90, 0xeb,
-	0x73, 0x53, 0x55, 0xe2, 0xdf, 0x9f, 0xc0, 0x5b, 0xd0, 0x95, 0xa2, 0x20, 0xb9, 0x2e, 0x26, 0x92,
-	0x68, 0x1a, 0xee, 0xb8, 0xc6, 0xe8, 0x7e, 0xdd, 0x6b, 0x7c, 0x5b, 0xf7, 0x2e, 0x32, 0xa6, 0xe7,
-	0x26, 0x41, 0x53, 0xb1, 0x88, 0xa7, 0x42, 0x2d, 0x84, 0xaa, 0x9f, 0xe7, 0x2a, 0x7d, 0x1f, 0xeb,
-	0x62, 0x49, 0x15, 0x1a, 0xd1, 0x29, 0xee, 0xd4, 0xff, 0xc0, 0x44, 0xd3, 0xf3, 0x1f, 0x3e, 0xd8,
-	0x75, 0xdd, 0xe0, 0xfe, 0x1f, 0x2d, 0xff, 0xd5, 0x00, 0xc1, 0x0e, 0x27, 0x0b, 0x1a, 0x06, 0x0e,
-	0x75, 0xb1, 0xad, 0x55, 0xc5, 0x22, 0x11, 0x79, 0x35, 0x12, 0xae, 0x33, 0xd8, 0x07, 0x9d, 0x94,
-	0xaa, 0xa9, 0x64, 0x4b, 0x6b, 0x57, 0xb8, 0xeb, 0xc8, 0x6d, 0x08, 0x9e, 0x82, 0xc0, 0x48, 0x16,
-	0x36, 0x9d, 0x92, 0x56, 0xb9, 0xee, 0x05, 0x77, 0x78, 0x8c, 0x2d, 0x06, 0x2f, 0x40, 0xdb, 0x48,
-	0x36, 0x99, 0x13, 0x35, 0x0f, 0x5b, 0x8e, 0xef, 0x94, 0xeb, 0x5e, 0xeb, 0x0e, 0x8f, 0xdf, 0x10,
-	0x35, 0xc7, 0x2d, 0x23, 0x99, 0x0d, 0xe0, 0x00, 0xec, 0xa4, 0x44, 0x93, 0xb0, 0xdd, 0xf7, 0x06,
-	0x9d, 0xe1, 0x31, 0xaa, 0x56, 0x88, 0x7e, 0xad, 0x10, 0xbd, 0xe6, 0x05, 0x76, 0x15, 0x7f, 0xd9,
-	0xbf, 0xf7, 0x78, 0xfb, 0xc1, 0xa3, 0xed, 0x7f, 0xf6, 0x16, 0x74, 0xb7, 0x9b, 0xc1, 0x0e, 0x68,
-	0x25, 0x46, 0x72, 0xc6, 0xb3, 0xc3, 0x06, 0xec, 0x82, 0xf6, 0x4c, 0x52, 0xfa, 0xc9, 0x66, 0x1e,
-	0x3c, 0x04, 0xdd, 0x0f, 0x73, 0xa6, 0x69, 0xce, 0x94, 0xb6, 0x88, 0x0f, 0x8f, 0xc0, 0x41, 0xca,
-	0x14, 0x49, 0x72, 0x3a, 0x51, 0x94, 0xa7, 0x16, 0x0c, 0xae, 0x6e, 0xef, 0xcb, 0xc8, 0x7b, 0x28,
-	0x23, 0xef, 0x7b, 0x19, 0x79, 0x9f, 0x37, 0x51, 0xe3, 0x61, 0x13, 0x35, 0xbe, 0x6e, 0xa2, 0xc6,
-	0xbb, 0x97, 0x5b, 0x03, 0x5e, 0x3b, 0xd5, 0x37, 0xc2, 0xf0, 0x94, 0xd8, 0x3d, 0xc4, 0xf5, 0x05,
-	0xad, 0x86, 0xf1, 0xc7, 0xad, 0x33, 0x72, 0x53, 0x27, 0x4d, 0x67, 0xe5, 0x8b, 0x9f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x0e, 0x60, 0xac, 0xe1, 0x67, 0x03, 0x00, 0x00,
+	// 530 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xbf, 0x6b, 0xdb, 0x40,
+	0x18, 0xb5, 0x24, 0xdb, 0xb2, 0xcf, 0x6e, 0x62, 0x2e, 0x21, 0x28, 0x81, 0xca, 0x6e, 0x86, 0x60,
+	0x0a, 0x95, 0x88, 0x3b, 0x74, 0xea, 0xd0, 0xc4, 0x84, 0x7a, 0x8c, 0x20, 0x4b, 0x17, 0x73, 0x92,
+	0xce, 0xf2, 0x51, 0xf9, 0xce, 0xdc, 0x0f, 0xa7, 0x2a, 0xf4, 0x7f, 0xe8, 0x9f, 0x95, 0x31, 0xd0,
+	0xa5, 0x74, 0x30, 0x45, 0xfe, 0x37, 0x3a, 0x94, 0x3b, 0xb9, 0x8d, 0x0b, 0x25, 0x4b, 0xa6, 0xfb,
+	0xbe, 0xf7, 0x9e, 0xf4, 0xdd, 0x7b, 0x1f, 0x07, 0x9e, 0x27, 0x8c, 0x63, 0xb5, 0x08, 0x91, 0x10,
+	0x58, 0x86, 0x74, 0x26, 0xc3, 0xd5, 0xb9, 0x3e, 0x82, 0x25, 0x67, 0x92, 0xc1, 0x83, 0x8a, 0x0e,
+	0x0c, 0x1d, 0x68, 0x7c, 0x75, 0x7e, 0x72, 0x98, 0xb1, 0x8c, 0x19, 0x3e, 0xd4, 0x55, 0x25, 0x3d,
+	0x39, 0xce, 0x18, 0xcb, 0x72, 0x1c, 0x9a, 0x2e, 0x56, 0xb3, 0x10, 0xd1, 0xa2, 0xa2, 0x4e, 0xbf,
+	0x59, 0x60, 0xff, 0x32, 0x47, 0x42, 0x8c, 0xf1, 0x8c, 0x50, 0x22, 0x09, 0xa3, 0xf0, 0x08, 0xd8,
+	0x24, 0xf5, 0xac, 0x81, 0x35, 0x6c, 0x5f, 0x34, 0xcb, 0x75, 0xdf, 0x9e, 0x8c, 0x23, 0x9b, 0xa4,
+	0xf0, 0x08, 0x34, 0x89, 0x10, 0x0a, 0x73, 0xcf, 0xd6, 0x5c, 0xb4, 0xed, 0xe0, 0x5b, 0xd0, 0x9a,
+	0x61, 0x24, 0x15, 0xc7, 0xc2, 0x73, 0x06, 0xce, 0x70, 0x6f, 0xf4, 0x22, 0xf8, 0xcf, 0xe5, 0x02,
+	0x33, 0xe7, 0xaa, 0x52, 0x46, 0x7f, 0x3f, 0x81, 0xd7, 0xa0, 0xcb, 0x59, 0x81, 0x72, 0x59, 0x4c,
+	0x39, 0x92, 0xd8, 0xab, 0x9b, 0xc1, 0xc1, 0xdd, 0xba, 0x5f, 0xfb, 0xb1, 0xee, 0x9f, 0x65, 0x44,
+	0xce, 0x55, 0x1c, 0x24, 0x6c, 0x11, 0x26, 0x4c, 0x2c, 0x98, 0xd8, 0x1e, 0xaf, 0x44, 0xfa, 0x31,
+	0x94, 0xc5, 0x12, 0x8b, 0x60, 0x8c, 0x93, 0xa8, 0xb3, 0xfd, 0x47, 0x84, 0x24, 0x3e, 0xfd, 0x65,
+	0x83, 0x86, 0x99, 0x06, 0xf7, 0x1e, 0xbc, 0x3c, 0xea, 0x01, 0x82, 0x3a, 0x45, 0x0b, 0xec, 0x39,
+	0x06, 0x35, 0xb5, 0xd6, 0x8a, 0x62, 0x11, 0xb3, 0xbc, 0xba, 0x52, 0xb4, 0xed, 0xe0, 0x00, 0x74,
+	0x52, 0x2c, 0x12, 0x4e, 0x96, 0x3a, 0x2e, 0xaf, 0x61, 0xc8, 0x5d, 0x08, 0x1e, 0x03, 0x47, 0x71,
+	0xe2, 0x35, 0x8d, 0x13, 0xb7, 0x5c, 0xf7, 0x9d, 0x9b, 0x68, 0x12, 0x69, 0x0c, 0x9e, 0x81, 0x96,
+	0xe2, 0x64, 0x3a, 0x47, 0x62, 0xee, 0xb9, 0x86, 0xef, 0x94, 0xeb, 0xbe, 0x7b, 0x13, 0x4d, 0xde,
+	0x23, 0x31, 0x8f, 0x5c, 0xc5, 0x89, 0x2e, 0xe0, 0x10, 0xd4, 0x53, 0x24, 0x91, 0xd7, 0x1a, 0x58,
+	0xc3, 0xce, 0xe8, 0x30, 0xa8, 0x56, 0x18, 0xfc, 0x59, 0x61, 0xf0, 0x8e, 0x16, 0x91, 0x51, 0xfc,
+	0x13, 0x7f, 0xfb, 0xe9, 0xf1, 0x83, 0x27, 0xc7, 0xff, 0xf2, 0x0b, 0xe8, 0xee, 0x0e, 0x83, 0x1d,
+	0xe0, 0xc6, 0x8a, 0x53, 0x42, 0xb3, 0x5e, 0x0d, 0x76, 0x41, 0x6b, 0xc6, 0x31, 0xfe, 0xac, 0x3b,
+	0x0b, 0xf6, 0x40, 0xf7, 0x76, 0x4e, 0x24, 0xce, 0x89, 0x90, 0x1a, 0xb1, 0xe1, 0x01, 0xd8, 0x4f,
+	0x89, 0x40, 0x71, 0x8e, 0xa7, 0x02, 0xd3, 0x54, 0x83, 0x0e, 0x7c, 0x06, 0xda, 0x6a, 0xa9, 0xdd,
+	0xc6, 0x39, 0xee, 0xd5, 0xb5, 0x86, 0xdd, 0x52, 0xcc, 0xa7, 0x0f, 0x60, 0x03, 0xba, 0xc0, 0x21,
+	0x71, 0xd2, 0x6b, 0x5e, 0x5c, 0xdf, 0x95, 0xbe, 0x75, 0x5f, 0xfa, 0xd6, 0xcf, 0xd2, 0xb7, 0xbe,
+	0x6e, 0xfc, 0xda, 0xfd, 0xc6, 0xaf, 0x7d, 0xdf, 0xf8, 0xb5, 0x0f, 0x6f, 0x76, 0xdc, 0x5c, 0x9a,
+	0x88, 0xae, 0x98, 0xa2, 0x29, 0xd2, 0x4b, 0x0b, 0xb7, 0xcf, 0x6d, 0x35, 0x0a, 0x3f, 0xed, 0xbc,
+	0x39, 0x63, 0x31, 0x6e, 0x9a, 0xdc, 0x5f, 0xff, 0x1e, 0x00, 0x36, 0xc5, 0xc7, 0xef, 0x94, 0x03,
+	0x00, 0x00,
 }
 
 func (m *ClassDefinition) Marshal() (dAtA []byte, err error) {
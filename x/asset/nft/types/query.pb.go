@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -406,6 +407,296 @@ func (m *QueryFrozenResponse) GetFrozen() bool {
 	return false
 }
 
+type QueryClassFrozenRequest struct {
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+}
+
+func (m *QueryClassFrozenRequest) Reset()         { *m = QueryClassFrozenRequest{} }
+func (m *QueryClassFrozenRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClassFrozenRequest) ProtoMessage()    {}
+func (*QueryClassFrozenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97b36b7d05006cb3, []int{8}
+}
+func (m *QueryClassFrozenRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClassFrozenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClassFrozenRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClassFrozenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClassFrozenRequest.Merge(m, src)
+}
+func (m *QueryClassFrozenRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClassFrozenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClassFrozenRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClassFrozenRequest proto.InternalMessageInfo
+
+func (m *QueryClassFrozenRequest) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+type QueryClassFrozenResponse struct {
+	Frozen bool `protobuf:"varint,1,opt,name=frozen,proto3" json:"frozen,omitempty"`
+}
+
+func (m *QueryClassFrozenResponse) Reset()         { *m = QueryClassFrozenResponse{} }
+func (m *QueryClassFrozenResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClassFrozenResponse) ProtoMessage()    {}
+func (*QueryClassFrozenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97b36b7d05006cb3, []int{9}
+}
+func (m *QueryClassFrozenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClassFrozenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClassFrozenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClassFrozenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClassFrozenResponse.Merge(m, src)
+}
+func (m *QueryClassFrozenResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClassFrozenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClassFrozenResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClassFrozenResponse proto.InternalMessageInfo
+
+func (m *QueryClassFrozenResponse) GetFrozen() bool {
+	if m != nil {
+		return m.Frozen
+	}
+	return false
+}
+
+type QueryClassFrozenForAccountRequest struct {
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (m *QueryClassFrozenForAccountRequest) Reset()         { *m = QueryClassFrozenForAccountRequest{} }
+func (m *QueryClassFrozenForAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClassFrozenForAccountRequest) ProtoMessage()    {}
+func (*QueryClassFrozenForAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97b36b7d05006cb3, []int{10}
+}
+func (m *QueryClassFrozenForAccountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClassFrozenForAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClassFrozenForAccountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClassFrozenForAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClassFrozenForAccountRequest.Merge(m, src)
+}
+func (m *QueryClassFrozenForAccountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClassFrozenForAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClassFrozenForAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClassFrozenForAccountRequest proto.InternalMessageInfo
+
+func (m *QueryClassFrozenForAccountRequest) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *QueryClassFrozenForAccountRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+type QueryClassFrozenForAccountResponse struct {
+	Frozen bool `protobuf:"varint,1,opt,name=frozen,proto3" json:"frozen,omitempty"`
+}
+
+func (m *QueryClassFrozenForAccountResponse) Reset()         { *m = QueryClassFrozenForAccountResponse{} }
+func (m *QueryClassFrozenForAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClassFrozenForAccountResponse) ProtoMessage()    {}
+func (*QueryClassFrozenForAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97b36b7d05006cb3, []int{11}
+}
+func (m *QueryClassFrozenForAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClassFrozenForAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClassFrozenForAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClassFrozenForAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClassFrozenForAccountResponse.Merge(m, src)
+}
+func (m *QueryClassFrozenForAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClassFrozenForAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClassFrozenForAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClassFrozenForAccountResponse proto.InternalMessageInfo
+
+func (m *QueryClassFrozenForAccountResponse) GetFrozen() bool {
+	if m != nil {
+		return m.Frozen
+	}
+	return false
+}
+
+type QueryClassFrozenAccountsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	ClassId    string             `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+}
+
+func (m *QueryClassFrozenAccountsRequest) Reset()         { *m = QueryClassFrozenAccountsRequest{} }
+func (m *QueryClassFrozenAccountsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClassFrozenAccountsRequest) ProtoMessage()    {}
+func (*QueryClassFrozenAccountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97b36b7d05006cb3, []int{12}
+}
+func (m *QueryClassFrozenAccountsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClassFrozenAccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClassFrozenAccountsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClassFrozenAccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClassFrozenAccountsRequest.Merge(m, src)
+}
+func (m *QueryClassFrozenAccountsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClassFrozenAccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClassFrozenAccountsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClassFrozenAccountsRequest proto.InternalMessageInfo
+
+func (m *QueryClassFrozenAccountsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryClassFrozenAccountsRequest) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+type QueryClassFrozenAccountsResponse struct {
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Accounts   []string            `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+}
+
+func (m *QueryClassFrozenAccountsResponse) Reset()         { *m = QueryClassFrozenAccountsResponse{} }
+func (m *QueryClassFrozenAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClassFrozenAccountsResponse) ProtoMessage()    {}
+func (*QueryClassFrozenAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97b36b7d05006cb3, []int{13}
+}
+func (m *QueryClassFrozenAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClassFrozenAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClassFrozenAccountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClassFrozenAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClassFrozenAccountsResponse.Merge(m, src)
+}
+func (m *QueryClassFrozenAccountsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClassFrozenAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClassFrozenAccountsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClassFrozenAccountsResponse proto.InternalMessageInfo
+
+func (m *QueryClassFrozenAccountsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryClassFrozenAccountsResponse) GetAccounts() []string {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
 type QueryWhitelistedRequest struct {
 	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	ClassId string `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
@@ -416,7 +707,7 @@ func (m *QueryWhitelistedRequest) Reset()         { *m = QueryWhitelistedRequest
 func (m *QueryWhitelistedRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryWhitelistedRequest) ProtoMessage()    {}
 func (*QueryWhitelistedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_97b36b7d05006cb3, []int{8}
+	return fileDescriptor_97b36b7d05006cb3, []int{14}
 }
 func (m *QueryWhitelistedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -474,7 +765,7 @@ func (m *QueryWhitelistedResponse) Reset()         { *m = QueryWhitelistedRespon
 func (m *QueryWhitelistedResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryWhitelistedResponse) ProtoMessage()    {}
 func (*QueryWhitelistedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_97b36b7d05006cb3, []int{9}
+	return fileDescriptor_97b36b7d05006cb3, []int{15}
 }
 func (m *QueryWhitelistedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -521,7 +812,7 @@ func (m *QueryWhitelistedAccountsForNFTRequest) Reset()         { *m = QueryWhit
 func (m *QueryWhitelistedAccountsForNFTRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryWhitelistedAccountsForNFTRequest) ProtoMessage()    {}
 func (*QueryWhitelistedAccountsForNFTRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_97b36b7d05006cb3, []int{10}
+	return fileDescriptor_97b36b7d05006cb3, []int{16}
 }
 func (m *QueryWhitelistedAccountsForNFTRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -583,7 +874,7 @@ func (m *QueryWhitelistedAccountsForNFTResponse) Reset() {
 func (m *QueryWhitelistedAccountsForNFTResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryWhitelistedAccountsForNFTResponse) ProtoMessage()    {}
 func (*QueryWhitelistedAccountsForNFTResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_97b36b7d05006cb3, []int{11}
+	return fileDescriptor_97b36b7d05006cb3, []int{17}
 }
 func (m *QueryWhitelistedAccountsForNFTResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -626,23 +917,23 @@ func (m *QueryWhitelistedAccountsForNFTResponse) GetAccounts() []string {
 	return nil
 }
 
-type QueryBurntNFTRequest struct {
+type QueryClassWhitelistedRequest struct {
 	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
-	NftId   string `protobuf:"bytes,2,opt,name=nft_id,json=nftId,proto3" json:"nft_id,omitempty"`
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
 }
 
-func (m *QueryBurntNFTRequest) Reset()         { *m = QueryBurntNFTRequest{} }
-func (m *QueryBurntNFTRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryBurntNFTRequest) ProtoMessage()    {}
-func (*QueryBurntNFTRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_97b36b7d05006cb3, []int{12}
+func (m *QueryClassWhitelistedRequest) Reset()         { *m = QueryClassWhitelistedRequest{} }
+func (m *QueryClassWhitelistedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClassWhitelistedRequest) ProtoMessage()    {}
+func (*QueryClassWhitelistedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97b36b7d05006cb3, []int{18}
 }
-func (m *QueryBurntNFTRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryClassWhitelistedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryBurntNFTRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryClassWhitelistedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryBurntNFTRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryClassWhitelistedRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -652,48 +943,48 @@ func (m *QueryBurntNFTRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *QueryBurntNFTRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryBurntNFTRequest.Merge(m, src)
+func (m *QueryClassWhitelistedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClassWhitelistedRequest.Merge(m, src)
 }
-func (m *QueryBurntNFTRequest) XXX_Size() int {
+func (m *QueryClassWhitelistedRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryBurntNFTRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryBurntNFTRequest.DiscardUnknown(m)
+func (m *QueryClassWhitelistedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClassWhitelistedRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryBurntNFTRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryClassWhitelistedRequest proto.InternalMessageInfo
 
-func (m *QueryBurntNFTRequest) GetClassId() string {
+func (m *QueryClassWhitelistedRequest) GetClassId() string {
 	if m != nil {
 		return m.ClassId
 	}
 	return ""
 }
 
-func (m *QueryBurntNFTRequest) GetNftId() string {
+func (m *QueryClassWhitelistedRequest) GetAccount() string {
 	if m != nil {
-		return m.NftId
+		return m.Account
 	}
 	return ""
 }
 
-type QueryBurntNFTResponse struct {
-	Burnt bool `protobuf:"varint,1,opt,name=burnt,proto3" json:"burnt,omitempty"`
+type QueryClassWhitelistedResponse struct {
+	Whitelisted bool `protobuf:"varint,1,opt,name=whitelisted,proto3" json:"whitelisted,omitempty"`
 }
 
-func (m *QueryBurntNFTResponse) Reset()         { *m = QueryBurntNFTResponse{} }
-func (m *QueryBurntNFTResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryBurntNFTResponse) ProtoMessage()    {}
-func (*QueryBurntNFTResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_97b36b7d05006cb3, []int{13}
+func (m *QueryClassWhitelistedResponse) Reset()         { *m = QueryClassWhitelistedResponse{} }
+func (m *QueryClassWhitelistedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClassWhitelistedResponse) ProtoMessage()    {}
+func (*QueryClassWhitelistedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97b36b7d05006cb3, []int{19}
 }
-func (m *QueryBurntNFTResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryClassWhitelistedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryBurntNFTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryClassWhitelistedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryBurntNFTResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryClassWhitelistedResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -703,42 +994,43 @@ func (m *QueryBurntNFTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *QueryBurntNFTResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryBurntNFTResponse.Merge(m, src)
+func (m *QueryClassWhitelistedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClassWhitelistedResponse.Merge(m, src)
 }
-func (m *QueryBurntNFTResponse) XXX_Size() int {
+func (m *QueryClassWhitelistedResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryBurntNFTResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryBurntNFTResponse.DiscardUnknown(m)
+func (m *QueryClassWhitelistedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClassWhitelistedResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryBurntNFTResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryClassWhitelistedResponse proto.InternalMessageInfo
 
-func (m *QueryBurntNFTResponse) GetBurnt() bool {
+func (m *QueryClassWhitelistedResponse) GetWhitelisted() bool {
 	if m != nil {
-		return m.Burnt
+		return m.Whitelisted
 	}
 	return false
 }
 
-type QueryBurntNFTsInClassRequest struct {
+type QueryClassWhitelistedAccountsRequest struct {
+	// pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	ClassId    string             `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
 }
 
-func (m *QueryBurntNFTsInClassRequest) Reset()         { *m = QueryBurntNFTsInClassRequest{} }
-func (m *QueryBurntNFTsInClassRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryBurntNFTsInClassRequest) ProtoMessage()    {}
-func (*QueryBurntNFTsInClassRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_97b36b7d05006cb3, []int{14}
+func (m *QueryClassWhitelistedAccountsRequest) Reset()         { *m = QueryClassWhitelistedAccountsRequest{} }
+func (m *QueryClassWhitelistedAccountsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClassWhitelistedAccountsRequest) ProtoMessage()    {}
+func (*QueryClassWhitelistedAccountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97b36b7d05006cb3, []int{20}
 }
-func (m *QueryBurntNFTsInClassRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryClassWhitelistedAccountsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryBurntNFTsInClassRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryClassWhitelistedAccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryBurntNFTsInClassRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryClassWhitelistedAccountsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -748,13 +1040,214 @@ func (m *QueryBurntNFTsInClassRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryBurntNFTsInClassRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryBurntNFTsInClassRequest.Merge(m, src)
+func (m *QueryClassWhitelistedAccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClassWhitelistedAccountsRequest.Merge(m, src)
 }
-func (m *QueryBurntNFTsInClassRequest) XXX_Size() int {
+func (m *QueryClassWhitelistedAccountsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryBurntNFTsInClassRequest) XXX_DiscardUnknown() {
+func (m *QueryClassWhitelistedAccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClassWhitelistedAccountsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClassWhitelistedAccountsRequest proto.InternalMessageInfo
+
+func (m *QueryClassWhitelistedAccountsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryClassWhitelistedAccountsRequest) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+type QueryClassWhitelistedAccountsResponse struct {
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Accounts   []string            `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+}
+
+func (m *QueryClassWhitelistedAccountsResponse) Reset()         { *m = QueryClassWhitelistedAccountsResponse{} }
+func (m *QueryClassWhitelistedAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClassWhitelistedAccountsResponse) ProtoMessage()    {}
+func (*QueryClassWhitelistedAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97b36b7d05006cb3, []int{21}
+}
+func (m *QueryClassWhitelistedAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClassWhitelistedAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClassWhitelistedAccountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClassWhitelistedAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClassWhitelistedAccountsResponse.Merge(m, src)
+}
+func (m *QueryClassWhitelistedAccountsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClassWhitelistedAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClassWhitelistedAccountsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClassWhitelistedAccountsResponse proto.InternalMessageInfo
+
+func (m *QueryClassWhitelistedAccountsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryClassWhitelistedAccountsResponse) GetAccounts() []string {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+type QueryBurntNFTRequest struct {
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	NftId   string `protobuf:"bytes,2,opt,name=nft_id,json=nftId,proto3" json:"nft_id,omitempty"`
+}
+
+func (m *QueryBurntNFTRequest) Reset()         { *m = QueryBurntNFTRequest{} }
+func (m *QueryBurntNFTRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBurntNFTRequest) ProtoMessage()    {}
+func (*QueryBurntNFTRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97b36b7d05006cb3, []int{22}
+}
+func (m *QueryBurntNFTRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurntNFTRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurntNFTRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBurntNFTRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurntNFTRequest.Merge(m, src)
+}
+func (m *QueryBurntNFTRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurntNFTRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurntNFTRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurntNFTRequest proto.InternalMessageInfo
+
+func (m *QueryBurntNFTRequest) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *QueryBurntNFTRequest) GetNftId() string {
+	if m != nil {
+		return m.NftId
+	}
+	return ""
+}
+
+type QueryBurntNFTResponse struct {
+	Burnt bool `protobuf:"varint,1,opt,name=burnt,proto3" json:"burnt,omitempty"`
+}
+
+func (m *QueryBurntNFTResponse) Reset()         { *m = QueryBurntNFTResponse{} }
+func (m *QueryBurntNFTResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBurntNFTResponse) ProtoMessage()    {}
+func (*QueryBurntNFTResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97b36b7d05006cb3, []int{23}
+}
+func (m *QueryBurntNFTResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurntNFTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurntNFTResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBurntNFTResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurntNFTResponse.Merge(m, src)
+}
+func (m *QueryBurntNFTResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurntNFTResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurntNFTResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurntNFTResponse proto.InternalMessageInfo
+
+func (m *QueryBurntNFTResponse) GetBurnt() bool {
+	if m != nil {
+		return m.Burnt
+	}
+	return false
+}
+
+type QueryBurntNFTsInClassRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	ClassId    string             `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+}
+
+func (m *QueryBurntNFTsInClassRequest) Reset()         { *m = QueryBurntNFTsInClassRequest{} }
+func (m *QueryBurntNFTsInClassRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBurntNFTsInClassRequest) ProtoMessage()    {}
+func (*QueryBurntNFTsInClassRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97b36b7d05006cb3, []int{24}
+}
+func (m *QueryBurntNFTsInClassRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurntNFTsInClassRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurntNFTsInClassRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBurntNFTsInClassRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurntNFTsInClassRequest.Merge(m, src)
+}
+func (m *QueryBurntNFTsInClassRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurntNFTsInClassRequest) XXX_DiscardUnknown() {
 	xxx_messageInfo_QueryBurntNFTsInClassRequest.DiscardUnknown(m)
 }
 
@@ -783,7 +1276,7 @@ func (m *QueryBurntNFTsInClassResponse) Reset()         { *m = QueryBurntNFTsInC
 func (m *QueryBurntNFTsInClassResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBurntNFTsInClassResponse) ProtoMessage()    {}
 func (*QueryBurntNFTsInClassResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_97b36b7d05006cb3, []int{15}
+	return fileDescriptor_97b36b7d05006cb3, []int{25}
 }
 func (m *QueryBurntNFTsInClassResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -826,6 +1319,118 @@ func (m *QueryBurntNFTsInClassResponse) GetNftIds() []string {
 	return nil
 }
 
+type QueryRoyaltyPayoutRequest struct {
+	ClassId string     `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Payment types.Coin `protobuf:"bytes,2,opt,name=payment,proto3" json:"payment"`
+}
+
+func (m *QueryRoyaltyPayoutRequest) Reset()         { *m = QueryRoyaltyPayoutRequest{} }
+func (m *QueryRoyaltyPayoutRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRoyaltyPayoutRequest) ProtoMessage()    {}
+func (*QueryRoyaltyPayoutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97b36b7d05006cb3, []int{26}
+}
+func (m *QueryRoyaltyPayoutRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRoyaltyPayoutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRoyaltyPayoutRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRoyaltyPayoutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRoyaltyPayoutRequest.Merge(m, src)
+}
+func (m *QueryRoyaltyPayoutRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRoyaltyPayoutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRoyaltyPayoutRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRoyaltyPayoutRequest proto.InternalMessageInfo
+
+func (m *QueryRoyaltyPayoutRequest) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *QueryRoyaltyPayoutRequest) GetPayment() types.Coin {
+	if m != nil {
+		return m.Payment
+	}
+	return types.Coin{}
+}
+
+type QueryRoyaltyPayoutResponse struct {
+	Issuer       string     `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	Royalty      types.Coin `protobuf:"bytes,2,opt,name=royalty,proto3" json:"royalty"`
+	SellerPayout types.Coin `protobuf:"bytes,3,opt,name=seller_payout,json=sellerPayout,proto3" json:"seller_payout"`
+}
+
+func (m *QueryRoyaltyPayoutResponse) Reset()         { *m = QueryRoyaltyPayoutResponse{} }
+func (m *QueryRoyaltyPayoutResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRoyaltyPayoutResponse) ProtoMessage()    {}
+func (*QueryRoyaltyPayoutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97b36b7d05006cb3, []int{27}
+}
+func (m *QueryRoyaltyPayoutResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRoyaltyPayoutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRoyaltyPayoutResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRoyaltyPayoutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRoyaltyPayoutResponse.Merge(m, src)
+}
+func (m *QueryRoyaltyPayoutResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRoyaltyPayoutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRoyaltyPayoutResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRoyaltyPayoutResponse proto.InternalMessageInfo
+
+func (m *QueryRoyaltyPayoutResponse) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *QueryRoyaltyPayoutResponse) GetRoyalty() types.Coin {
+	if m != nil {
+		return m.Royalty
+	}
+	return types.Coin{}
+}
+
+func (m *QueryRoyaltyPayoutResponse) GetSellerPayout() types.Coin {
+	if m != nil {
+		return m.SellerPayout
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "coreum.asset.nft.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "coreum.asset.nft.v1.QueryParamsResponse")
@@ -835,77 +1440,111 @@ func init() {
 	proto.RegisterType((*QueryClassesResponse)(nil), "coreum.asset.nft.v1.QueryClassesResponse")
 	proto.RegisterType((*QueryFrozenRequest)(nil), "coreum.asset.nft.v1.QueryFrozenRequest")
 	proto.RegisterType((*QueryFrozenResponse)(nil), "coreum.asset.nft.v1.QueryFrozenResponse")
+	proto.RegisterType((*QueryClassFrozenRequest)(nil), "coreum.asset.nft.v1.QueryClassFrozenRequest")
+	proto.RegisterType((*QueryClassFrozenResponse)(nil), "coreum.asset.nft.v1.QueryClassFrozenResponse")
+	proto.RegisterType((*QueryClassFrozenForAccountRequest)(nil), "coreum.asset.nft.v1.QueryClassFrozenForAccountRequest")
+	proto.RegisterType((*QueryClassFrozenForAccountResponse)(nil), "coreum.asset.nft.v1.QueryClassFrozenForAccountResponse")
+	proto.RegisterType((*QueryClassFrozenAccountsRequest)(nil), "coreum.asset.nft.v1.QueryClassFrozenAccountsRequest")
+	proto.RegisterType((*QueryClassFrozenAccountsResponse)(nil), "coreum.asset.nft.v1.QueryClassFrozenAccountsResponse")
 	proto.RegisterType((*QueryWhitelistedRequest)(nil), "coreum.asset.nft.v1.QueryWhitelistedRequest")
 	proto.RegisterType((*QueryWhitelistedResponse)(nil), "coreum.asset.nft.v1.QueryWhitelistedResponse")
 	proto.RegisterType((*QueryWhitelistedAccountsForNFTRequest)(nil), "coreum.asset.nft.v1.QueryWhitelistedAccountsForNFTRequest")
 	proto.RegisterType((*QueryWhitelistedAccountsForNFTResponse)(nil), "coreum.asset.nft.v1.QueryWhitelistedAccountsForNFTResponse")
+	proto.RegisterType((*QueryClassWhitelistedRequest)(nil), "coreum.asset.nft.v1.QueryClassWhitelistedRequest")
+	proto.RegisterType((*QueryClassWhitelistedResponse)(nil), "coreum.asset.nft.v1.QueryClassWhitelistedResponse")
+	proto.RegisterType((*QueryClassWhitelistedAccountsRequest)(nil), "coreum.asset.nft.v1.QueryClassWhitelistedAccountsRequest")
+	proto.RegisterType((*QueryClassWhitelistedAccountsResponse)(nil), "coreum.asset.nft.v1.QueryClassWhitelistedAccountsResponse")
 	proto.RegisterType((*QueryBurntNFTRequest)(nil), "coreum.asset.nft.v1.QueryBurntNFTRequest")
 	proto.RegisterType((*QueryBurntNFTResponse)(nil), "coreum.asset.nft.v1.QueryBurntNFTResponse")
 	proto.RegisterType((*QueryBurntNFTsInClassRequest)(nil), "coreum.asset.nft.v1.QueryBurntNFTsInClassRequest")
 	proto.RegisterType((*QueryBurntNFTsInClassResponse)(nil), "coreum.asset.nft.v1.QueryBurntNFTsInClassResponse")
+	proto.RegisterType((*QueryRoyaltyPayoutRequest)(nil), "coreum.asset.nft.v1.QueryRoyaltyPayoutRequest")
+	proto.RegisterType((*QueryRoyaltyPayoutResponse)(nil), "coreum.asset.nft.v1.QueryRoyaltyPayoutResponse")
 }
 
 func init() { proto.RegisterFile("coreum/asset/nft/v1/query.proto", fileDescriptor_97b36b7d05006cb3) }
 
 var fileDescriptor_97b36b7d05006cb3 = []byte{
-	// 911 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0x38, 0x78, 0x93, 0xbe, 0x48, 0x08, 0xa6, 0x69, 0xeb, 0x6e, 0x13, 0x37, 0x6c, 0x20,
-	0x49, 0x2b, 0xb2, 0xa3, 0x04, 0x51, 0x4a, 0xf8, 0x51, 0x48, 0x85, 0x4b, 0x24, 0x04, 0xa9, 0x85,
-	0x84, 0xc4, 0x01, 0xb4, 0xb6, 0xc7, 0xee, 0x4a, 0xf1, 0x8e, 0xbb, 0x33, 0x6b, 0x28, 0x91, 0x25,
-	0x0a, 0x5c, 0x91, 0x90, 0xb8, 0x81, 0x38, 0x70, 0xe5, 0xc4, 0x9d, 0x7f, 0xa0, 0xc7, 0x48, 0x5c,
-	0x90, 0x90, 0x10, 0x4a, 0xf8, 0x43, 0xd0, 0xce, 0xbc, 0x4d, 0x76, 0x9d, 0xf5, 0x2f, 0xf0, 0x2d,
-	0x33, 0xf3, 0xde, 0xf7, 0x7d, 0xef, 0x47, 0xbe, 0x35, 0x5c, 0xaf, 0x8b, 0x90, 0x47, 0x6d, 0xe6,
-	0x49, 0xc9, 0x15, 0x0b, 0x9a, 0x8a, 0x75, 0xb7, 0xd8, 0xc3, 0x88, 0x87, 0x8f, 0xdc, 0x4e, 0x28,
-	0x94, 0xa0, 0x17, 0x4d, 0x80, 0xab, 0x03, 0xdc, 0xa0, 0xa9, 0xdc, 0xee, 0x96, 0xbd, 0xd8, 0x12,
-	0x2d, 0xa1, 0xdf, 0x59, 0xfc, 0x97, 0x09, 0xb5, 0x97, 0x5a, 0x42, 0xb4, 0x0e, 0x38, 0xf3, 0x3a,
-	0x3e, 0xf3, 0x82, 0x40, 0x28, 0x4f, 0xf9, 0x22, 0x90, 0xf8, 0xba, 0x9c, 0xc7, 0x14, 0xe3, 0x99,
-	0xe7, 0x95, 0xbc, 0xe7, 0x8e, 0x17, 0x7a, 0xed, 0x04, 0xe0, 0x66, 0x5d, 0xc8, 0xb6, 0x90, 0xac,
-	0xe6, 0x49, 0x6e, 0x24, 0xb2, 0xee, 0x56, 0x8d, 0x2b, 0x2f, 0x8e, 0x6b, 0xf9, 0x81, 0x66, 0x33,
-	0xb1, 0xce, 0x22, 0xd0, 0xfb, 0x71, 0xc4, 0xbe, 0x06, 0xa8, 0xf2, 0x87, 0x11, 0x97, 0xca, 0xd9,
-	0x87, 0x8b, 0x99, 0x5b, 0xd9, 0x11, 0x81, 0xe4, 0xf4, 0x55, 0xb0, 0x0c, 0x51, 0x89, 0xac, 0x90,
-	0x8d, 0x85, 0xed, 0x6b, 0x6e, 0x4e, 0xcd, 0xae, 0x49, 0xda, 0x7d, 0xea, 0xc9, 0x5f, 0xd7, 0x67,
-	0xaa, 0x98, 0xe0, 0xac, 0xc2, 0xb3, 0x1a, 0xf1, 0xee, 0x81, 0x27, 0x13, 0x1a, 0xfa, 0x34, 0x14,
-	0xfc, 0x86, 0xc6, 0xba, 0x50, 0x2d, 0xf8, 0x0d, 0xe7, 0x3d, 0x14, 0x83, 0x41, 0xc8, 0x7a, 0x0b,
-	0x8a, 0xf5, 0xf8, 0x02, 0x49, 0xed, 0x5c, 0x52, 0x9d, 0x82, 0x9c, 0x26, 0xdc, 0x89, 0xb0, 0x08,
-	0xfd, 0xc4, 0x4f, 0x49, 0x2b, 0x00, 0x67, 0x5d, 0x40, 0xcc, 0x35, 0xd7, 0xb4, 0xcc, 0x8d, 0x5b,
-	0xe6, 0x9a, 0xa9, 0x62, 0xcb, 0xdc, 0x7d, 0xaf, 0xc5, 0x31, 0xb7, 0x9a, 0xca, 0xa4, 0x97, 0xc1,
-	0xf2, 0xa5, 0x8c, 0x78, 0x58, 0x2a, 0xe8, 0x02, 0xf0, 0xe4, 0xfc, 0x48, 0x60, 0x31, 0xcb, 0x8b,
-	0x75, 0xdc, 0xcb, 0x21, 0x5e, 0x1f, 0x49, 0x6c, 0x92, 0x33, 0xcc, 0x3b, 0x30, 0x57, 0x37, 0xd8,
-	0xa5, 0xc2, 0xca, 0xec, 0x58, 0x2d, 0x49, 0x12, 0x9c, 0x3b, 0xd8, 0xe2, 0x4a, 0x28, 0xbe, 0xe0,
-	0xc1, 0x80, 0x41, 0xd0, 0xab, 0x30, 0xaf, 0x13, 0x3e, 0xf5, 0x1b, 0x58, 0x9d, 0x01, 0xd8, 0x6b,
-	0x38, 0x9b, 0xd8, 0xd5, 0x04, 0x00, 0x8b, 0xbb, 0x0c, 0x56, 0x53, 0xdf, 0x68, 0x94, 0xf9, 0x2a,
-	0x9e, 0x9c, 0x4f, 0xe0, 0x8a, 0x0e, 0xff, 0xe8, 0x81, 0xaf, 0xf8, 0x81, 0x2f, 0x15, 0x6f, 0x4c,
-	0x4e, 0x4a, 0x4b, 0x30, 0xe7, 0xd5, 0xeb, 0x22, 0x0a, 0x54, 0x69, 0xd6, 0xbc, 0xe0, 0xd1, 0x79,
-	0x1d, 0x4a, 0xe7, 0xf1, 0x51, 0xd3, 0x0a, 0x2c, 0x7c, 0x76, 0x76, 0x8d, 0xc2, 0xd2, 0x57, 0xce,
-	0x0f, 0x04, 0x5e, 0xe8, 0x4f, 0x7f, 0xdb, 0x20, 0xcb, 0x8a, 0x08, 0xdf, 0xaf, 0x7c, 0x38, 0xed,
-	0xad, 0x31, 0x45, 0x17, 0x72, 0x8b, 0x9e, 0xcd, 0x76, 0xfa, 0x5b, 0x02, 0x6b, 0xa3, 0xc4, 0x4d,
-	0x7b, 0xb5, 0x6c, 0x98, 0xc7, 0xce, 0x9a, 0xdd, 0xba, 0x50, 0x3d, 0x3d, 0x3b, 0xef, 0xe2, 0x5e,
-	0xef, 0x46, 0x61, 0xa0, 0x52, 0xad, 0x49, 0x97, 0x40, 0xb2, 0x73, 0xbb, 0x04, 0x56, 0xd0, 0x54,
-	0x67, 0x03, 0x2d, 0x06, 0x4d, 0xa5, 0x77, 0xe8, 0x52, 0x1f, 0x12, 0xd6, 0xb1, 0x08, 0xc5, 0x5a,
-	0x7c, 0x87, 0xb3, 0x32, 0x07, 0xe7, 0x31, 0x81, 0xa5, 0x4c, 0xbc, 0xdc, 0x0b, 0x32, 0x3e, 0x32,
-	0xad, 0xe1, 0x0c, 0x59, 0xfb, 0xc7, 0x04, 0x96, 0x07, 0x68, 0x98, 0xf6, 0x0c, 0xae, 0xc0, 0x9c,
-	0x69, 0x5a, 0x32, 0x02, 0x4b, 0x77, 0x4d, 0x6e, 0xff, 0x02, 0x50, 0xd4, 0x1a, 0xe8, 0x97, 0x04,
-	0x2c, 0x63, 0xb3, 0x74, 0x3d, 0xf7, 0x7f, 0xff, 0xbc, 0xa7, 0xdb, 0x1b, 0xa3, 0x03, 0x8d, 0x18,
-	0x67, 0xf5, 0xab, 0xdf, 0xff, 0xf9, 0xbe, 0xb0, 0x4c, 0xaf, 0xb1, 0xc1, 0x9f, 0x1a, 0xfa, 0x35,
-	0x81, 0xa2, 0x6e, 0x00, 0x5d, 0x1b, 0x0c, 0x9c, 0x9e, 0x92, 0xbd, 0x3e, 0x32, 0x0e, 0xf9, 0x6f,
-	0x68, 0xfe, 0x55, 0xfa, 0x5c, 0x2e, 0x3f, 0x3a, 0x19, 0x3b, 0xf4, 0x1b, 0x3d, 0xfa, 0x0d, 0x81,
-	0x39, 0xf4, 0x59, 0xba, 0x31, 0x02, 0xff, 0xf4, 0x13, 0x60, 0xdf, 0x18, 0x23, 0x12, 0xb5, 0x3c,
-	0xaf, 0xb5, 0x94, 0xe9, 0xd2, 0x30, 0x2d, 0xf4, 0x27, 0x02, 0x96, 0x31, 0xc4, 0x61, 0xf3, 0xc8,
-	0x78, 0xee, 0xb0, 0x79, 0x64, 0xbd, 0xd5, 0x79, 0x4b, 0x6b, 0xd8, 0xa1, 0xb7, 0x87, 0xf7, 0x23,
-	0x59, 0xdd, 0x5e, 0xfc, 0x62, 0xfa, 0xc3, 0x8c, 0x0b, 0xd3, 0xdf, 0x08, 0x2c, 0xa4, 0x5c, 0x84,
-	0xbe, 0x38, 0x98, 0xfb, 0xbc, 0x51, 0xdb, 0x9b, 0x63, 0x46, 0xa3, 0xdc, 0x0f, 0xb4, 0xdc, 0x3d,
-	0x7a, 0x6f, 0x72, 0xb9, 0x29, 0x6f, 0x66, 0x87, 0x68, 0x3c, 0x3d, 0xfa, 0x27, 0x81, 0xab, 0x03,
-	0x3d, 0x90, 0xee, 0x8c, 0xa5, 0x2e, 0xd7, 0xd5, 0xed, 0xd7, 0xfe, 0x53, 0x2e, 0xd6, 0xf9, 0x8e,
-	0xae, 0xf3, 0x0e, 0x7d, 0xe3, 0x7f, 0xd5, 0x49, 0x7f, 0x26, 0x30, 0x9f, 0x98, 0x0a, 0x1d, 0xb2,
-	0x99, 0x7d, 0xb6, 0x6b, 0xdf, 0x1c, 0x27, 0x14, 0xa5, 0xbe, 0xa9, 0xa5, 0xde, 0xa6, 0xb7, 0xc6,
-	0x95, 0xaa, 0x8d, 0x97, 0x1d, 0x1a, 0x1f, 0xea, 0xd1, 0x5f, 0x09, 0x3c, 0xd3, 0x6f, 0x7c, 0x74,
-	0x6b, 0xb4, 0x80, 0x3e, 0xa3, 0xb6, 0xb7, 0x27, 0x49, 0x41, 0xed, 0x2f, 0x6b, 0xed, 0x8c, 0x6e,
-	0x4e, 0xa4, 0x7d, 0xf7, 0xfe, 0x93, 0xe3, 0x32, 0x39, 0x3a, 0x2e, 0x93, 0xbf, 0x8f, 0xcb, 0xe4,
-	0xbb, 0x93, 0xf2, 0xcc, 0xd1, 0x49, 0x79, 0xe6, 0x8f, 0x93, 0xf2, 0xcc, 0xc7, 0xaf, 0xb4, 0x7c,
-	0xf5, 0x20, 0xaa, 0xb9, 0x75, 0xd1, 0x66, 0x77, 0x35, 0x64, 0x45, 0x44, 0x41, 0x43, 0x9b, 0x6f,
-	0xc2, 0xd1, 0xdd, 0x66, 0x9f, 0xa7, 0x88, 0xd4, 0xa3, 0x0e, 0x97, 0x35, 0x4b, 0xff, 0x64, 0x7e,
-	0xe9, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7a, 0x54, 0xda, 0xa6, 0x0b, 0x0c, 0x00, 0x00,
+	// 1252 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xc1, 0x6f, 0xdb, 0x64,
+	0x14, 0xef, 0x97, 0xd2, 0xb4, 0x7b, 0x65, 0x68, 0x7c, 0x6d, 0xb7, 0xd4, 0x6b, 0xd3, 0xce, 0x1d,
+	0x6d, 0x37, 0x11, 0x9b, 0x66, 0x6b, 0xbb, 0x76, 0x40, 0x59, 0x0b, 0x19, 0x95, 0x10, 0x74, 0x01,
+	0x09, 0xc4, 0x81, 0xc9, 0x4d, 0xdc, 0xcc, 0x52, 0xea, 0x2f, 0xb5, 0x9d, 0x42, 0xa8, 0x2a, 0x31,
+	0x98, 0x84, 0x90, 0x40, 0x42, 0xe2, 0x06, 0xe2, 0x00, 0x37, 0x6e, 0x5c, 0x38, 0x71, 0x41, 0xe2,
+	0x32, 0x71, 0x40, 0x93, 0xb8, 0x20, 0x21, 0x21, 0xd4, 0xf2, 0x87, 0xa0, 0x7c, 0xdf, 0x73, 0x63,
+	0xbb, 0x76, 0xec, 0x84, 0xaa, 0xbb, 0xc5, 0xfe, 0xde, 0x7b, 0xbf, 0xdf, 0xef, 0xbd, 0x67, 0x7d,
+	0x3f, 0x05, 0x26, 0x4a, 0xcc, 0xd2, 0xeb, 0xdb, 0xaa, 0x66, 0xdb, 0xba, 0xa3, 0x9a, 0x5b, 0x8e,
+	0xba, 0x3b, 0xa7, 0xee, 0xd4, 0x75, 0xab, 0xa1, 0xd4, 0x2c, 0xe6, 0x30, 0x3a, 0x24, 0x02, 0x14,
+	0x1e, 0xa0, 0x98, 0x5b, 0x8e, 0xb2, 0x3b, 0x27, 0x0d, 0x57, 0x58, 0x85, 0xf1, 0x73, 0xb5, 0xf9,
+	0x4b, 0x84, 0x4a, 0x63, 0x15, 0xc6, 0x2a, 0x55, 0x5d, 0xd5, 0x6a, 0x86, 0xaa, 0x99, 0x26, 0x73,
+	0x34, 0xc7, 0x60, 0xa6, 0x8d, 0xa7, 0xe3, 0x61, 0x48, 0xcd, 0x7a, 0xe2, 0x78, 0x32, 0xec, 0xb8,
+	0xa6, 0x59, 0xda, 0xb6, 0x5b, 0xe0, 0x6a, 0x89, 0xd9, 0xdb, 0xcc, 0x56, 0x37, 0x35, 0x5b, 0x17,
+	0x14, 0xd5, 0xdd, 0xb9, 0x4d, 0xdd, 0xd1, 0x9a, 0x71, 0x15, 0xc3, 0xe4, 0x68, 0x18, 0x9b, 0xf5,
+	0xc6, 0xba, 0x51, 0x25, 0x66, 0xe0, 0xb9, 0x3c, 0x0c, 0xf4, 0x4e, 0xb3, 0xc2, 0x06, 0x07, 0x28,
+	0xea, 0x3b, 0x75, 0xdd, 0x76, 0xe4, 0x0d, 0x18, 0xf2, 0xbd, 0xb5, 0x6b, 0xcc, 0xb4, 0x75, 0xba,
+	0x04, 0x69, 0x41, 0x24, 0x43, 0x26, 0xc9, 0xec, 0x60, 0xfe, 0xa2, 0x12, 0xd2, 0x13, 0x45, 0x24,
+	0xad, 0x3e, 0xf1, 0xf0, 0xef, 0x89, 0x9e, 0x22, 0x26, 0xc8, 0x53, 0xf0, 0x34, 0xaf, 0xb8, 0x56,
+	0xd5, 0x6c, 0x17, 0x86, 0x3e, 0x05, 0x29, 0xa3, 0xcc, 0x6b, 0x9d, 0x29, 0xa6, 0x8c, 0xb2, 0xfc,
+	0x1a, 0x50, 0x6f, 0x10, 0xa2, 0x2e, 0x40, 0x5f, 0xa9, 0xf9, 0x02, 0x41, 0xa5, 0x50, 0x50, 0x9e,
+	0x82, 0x98, 0x22, 0x5c, 0xae, 0xa3, 0x08, 0x7e, 0xa4, 0x1f, 0x81, 0x16, 0x00, 0x5a, 0x5d, 0xc2,
+	0x9a, 0xd3, 0x8a, 0x68, 0x93, 0xd2, 0x6c, 0x93, 0x22, 0xa6, 0x8e, 0xcd, 0x52, 0x36, 0xb4, 0x8a,
+	0x8e, 0xb9, 0x45, 0x4f, 0x26, 0x3d, 0x0f, 0x69, 0xc3, 0xb6, 0xeb, 0xba, 0x95, 0x49, 0x71, 0x01,
+	0xf8, 0x24, 0x7f, 0x43, 0x60, 0xd8, 0x8f, 0x8b, 0x3a, 0x6e, 0x87, 0x00, 0xcf, 0xc4, 0x02, 0x8b,
+	0x64, 0x1f, 0xf2, 0x32, 0xf4, 0x97, 0x44, 0xed, 0x4c, 0x6a, 0xb2, 0x37, 0x51, 0x4b, 0xdc, 0x04,
+	0x79, 0x05, 0x5b, 0x5c, 0xb0, 0xd8, 0x87, 0xba, 0x19, 0x31, 0x08, 0x3a, 0x0a, 0x03, 0x3c, 0xe1,
+	0xae, 0x51, 0x46, 0x75, 0xa2, 0xc0, 0x7a, 0x59, 0xce, 0xc1, 0x90, 0xaf, 0x00, 0x8a, 0x3b, 0x0f,
+	0xe9, 0x2d, 0xfe, 0x86, 0x57, 0x19, 0x28, 0xe2, 0x93, 0x7c, 0x1d, 0x2e, 0xb4, 0x9a, 0xe1, 0x07,
+	0xf5, 0x82, 0x10, 0x3f, 0x48, 0x1e, 0x32, 0xc7, 0xb3, 0x62, 0x90, 0xde, 0x81, 0x4b, 0xc1, 0x9c,
+	0x02, 0xb3, 0x6e, 0x95, 0x4a, 0xac, 0x6e, 0x3a, 0xf1, 0x98, 0x34, 0x03, 0xfd, 0x9a, 0x08, 0x76,
+	0x25, 0xe3, 0xa3, 0xfc, 0x3c, 0xc8, 0xed, 0x2a, 0xc7, 0xf0, 0x7a, 0x40, 0x60, 0x22, 0x98, 0x8e,
+	0xb9, 0x27, 0xbe, 0x93, 0x6d, 0xe6, 0xf6, 0x29, 0x81, 0xc9, 0x68, 0x1a, 0x27, 0xbd, 0xa2, 0x12,
+	0x0c, 0x60, 0xf7, 0xc4, 0x8e, 0x9e, 0x29, 0x1e, 0x3d, 0xcb, 0xef, 0xe1, 0x4a, 0xbc, 0x7d, 0xcf,
+	0x70, 0xf4, 0xaa, 0x61, 0x3b, 0x7a, 0xb9, 0xf3, 0x3d, 0xf4, 0x8e, 0xab, 0x37, 0x38, 0xae, 0xcc,
+	0xf1, 0xfa, 0x28, 0x70, 0x12, 0x06, 0xdf, 0x6f, 0xbd, 0xc6, 0x49, 0x79, 0x5f, 0xc9, 0x5f, 0x13,
+	0x78, 0x26, 0x98, 0xee, 0xf6, 0xa9, 0xc0, 0xac, 0xd7, 0x0b, 0x6f, 0x9d, 0xf4, 0xd0, 0x84, 0xe8,
+	0x54, 0xa8, 0xe8, 0x5e, 0xff, 0x10, 0xbf, 0x20, 0x30, 0x1d, 0x47, 0xee, 0x34, 0x47, 0xf9, 0x26,
+	0x8c, 0xb5, 0x76, 0x2a, 0x64, 0x9e, 0x5d, 0x7d, 0x6e, 0xb7, 0x60, 0x3c, 0xa2, 0x68, 0xe2, 0x21,
+	0x7e, 0x46, 0xe0, 0x72, 0x68, 0x8d, 0xc7, 0xf0, 0xe1, 0x7d, 0xee, 0x2e, 0x54, 0x34, 0x97, 0xd3,
+	0x1c, 0xd9, 0xab, 0x78, 0x3b, 0xad, 0xd6, 0x2d, 0xd3, 0xf1, 0x6c, 0x73, 0x9b, 0x51, 0x8d, 0x40,
+	0xda, 0xdc, 0x72, 0x5a, 0xd2, 0xfa, 0xcc, 0x2d, 0x87, 0xdf, 0x04, 0x23, 0x81, 0x4a, 0xa8, 0x63,
+	0x18, 0xfa, 0x36, 0x9b, 0xef, 0x70, 0x32, 0xe2, 0x41, 0xbe, 0x4f, 0x60, 0xcc, 0x17, 0x6f, 0xaf,
+	0x9b, 0x3e, 0x37, 0x70, 0x0a, 0xb3, 0xb8, 0x4f, 0x60, 0x3c, 0x82, 0xc3, 0x49, 0xcf, 0xe0, 0x02,
+	0xf4, 0x8b, 0xa6, 0xb9, 0x23, 0x48, 0xf3, 0xae, 0xd9, 0xf2, 0x0e, 0x8c, 0x72, 0x0a, 0x45, 0xd6,
+	0xd0, 0xaa, 0x4e, 0x63, 0x43, 0x6b, 0xb0, 0x7a, 0x92, 0xfb, 0x69, 0x09, 0xfa, 0x6b, 0x5a, 0x63,
+	0x5b, 0xc7, 0x0f, 0x66, 0x30, 0x3f, 0xea, 0xa3, 0xe5, 0x12, 0x5a, 0x63, 0x86, 0xe9, 0x5e, 0xfa,
+	0x18, 0x2f, 0xff, 0x44, 0x40, 0x0a, 0xc3, 0x6c, 0xdd, 0x5c, 0xe8, 0x64, 0x88, 0xd7, 0xc9, 0x34,
+	0x11, 0x2d, 0x91, 0x90, 0x18, 0x11, 0xe3, 0xe9, 0xcb, 0x70, 0xd6, 0xd6, 0xab, 0x55, 0xdd, 0xba,
+	0x5b, 0xe3, 0x58, 0x99, 0xde, 0x64, 0x05, 0x9e, 0x14, 0x59, 0x82, 0x60, 0xfe, 0x87, 0x21, 0xe8,
+	0xe3, 0xbc, 0xe9, 0x47, 0x04, 0xd2, 0xc2, 0x57, 0xd2, 0x99, 0x50, 0xb3, 0x73, 0xdc, 0xc4, 0x4a,
+	0xb3, 0xf1, 0x81, 0xa2, 0x01, 0xf2, 0xd4, 0xc7, 0x7f, 0xfc, 0xfb, 0x55, 0x6a, 0x9c, 0x5e, 0x54,
+	0xa3, 0xbd, 0x37, 0xfd, 0x84, 0x40, 0x1f, 0xdf, 0x15, 0x3a, 0x1d, 0x5d, 0xd8, 0xbb, 0xd0, 0xd2,
+	0x4c, 0x6c, 0x1c, 0xe2, 0x5f, 0xe1, 0xf8, 0x53, 0xf4, 0x52, 0x28, 0x3e, 0x5a, 0x37, 0x75, 0xcf,
+	0x28, 0xef, 0xd3, 0x07, 0x04, 0xfa, 0xd1, 0x58, 0xd2, 0xd9, 0x98, 0xfa, 0x47, 0x9e, 0x57, 0xba,
+	0x92, 0x20, 0x12, 0xb9, 0x5c, 0xe6, 0x5c, 0xb2, 0x74, 0xac, 0x1d, 0x17, 0xfa, 0x2d, 0x81, 0xb4,
+	0xf0, 0x10, 0xed, 0xe6, 0xe1, 0xf3, 0x7b, 0xd2, 0x6c, 0x7c, 0x20, 0x72, 0x78, 0x89, 0x73, 0x58,
+	0xa6, 0x37, 0xda, 0xf7, 0xc3, 0xfd, 0x52, 0xf6, 0x9b, 0x27, 0xa2, 0x3f, 0xaa, 0x30, 0x5d, 0xf4,
+	0x7b, 0x02, 0x83, 0x1e, 0xa3, 0x43, 0x9f, 0x8d, 0x69, 0x80, 0x9f, 0x69, 0x2e, 0x61, 0x34, 0xd2,
+	0x5d, 0xe0, 0x74, 0x9f, 0xa3, 0x4a, 0x52, 0xba, 0x48, 0xf2, 0x37, 0x02, 0x23, 0xa1, 0x9e, 0x92,
+	0x2e, 0x24, 0x22, 0x70, 0xcc, 0xde, 0x4a, 0x8b, 0x1d, 0xe7, 0x75, 0xdb, 0x71, 0x21, 0x41, 0xdd,
+	0xc3, 0x7b, 0x65, 0x9f, 0xfe, 0x4a, 0x60, 0x28, 0xc4, 0x5a, 0xd2, 0xeb, 0x89, 0x28, 0x05, 0xee,
+	0x65, 0x69, 0xbe, 0xc3, 0x2c, 0x94, 0xb1, 0xc2, 0x65, 0x2c, 0xd1, 0xc5, 0xce, 0x64, 0xe4, 0x34,
+	0x97, 0xed, 0xcf, 0x04, 0x06, 0x3d, 0x57, 0x74, 0xbb, 0xbd, 0x39, 0x6e, 0x77, 0xa4, 0x5c, 0xc2,
+	0x68, 0x64, 0xfb, 0x06, 0x67, 0xbb, 0x4e, 0x6f, 0x77, 0xbe, 0xe6, 0x1e, 0xb3, 0xe3, 0x99, 0xc1,
+	0x5f, 0x04, 0x46, 0x23, 0x9d, 0x21, 0x5d, 0x4e, 0xc4, 0x2e, 0xd4, 0xeb, 0x4a, 0x37, 0xbb, 0xca,
+	0x45, 0x9d, 0xaf, 0x70, 0x9d, 0x2b, 0xf4, 0x85, 0xff, 0xa5, 0x93, 0xfe, 0x42, 0xe0, 0x5c, 0xd0,
+	0x43, 0xd1, 0xb9, 0x98, 0x45, 0x09, 0x99, 0x52, 0xbe, 0x93, 0x94, 0x6e, 0x25, 0x84, 0x0f, 0xe8,
+	0x77, 0x02, 0x99, 0x28, 0x1b, 0x48, 0x97, 0x92, 0xf3, 0x0a, 0x7e, 0x2e, 0xcb, 0xdd, 0xa4, 0xa2,
+	0xb4, 0x9b, 0x5c, 0xda, 0x3c, 0xbd, 0xd6, 0x85, 0x34, 0xfa, 0x1d, 0x81, 0x01, 0xd7, 0x4b, 0xd1,
+	0x36, 0xb7, 0x4c, 0xc0, 0x6d, 0x4a, 0x57, 0x93, 0x84, 0x22, 0xc1, 0x17, 0x39, 0xc1, 0x1b, 0x74,
+	0x21, 0x29, 0x41, 0xee, 0x37, 0xd5, 0x3d, 0x61, 0xbf, 0xf6, 0xe9, 0x8f, 0x04, 0xce, 0x05, 0xfd,
+	0x5e, 0xbb, 0xbd, 0x89, 0xf0, 0xa7, 0x52, 0xbe, 0x93, 0x14, 0xe4, 0x3e, 0xcf, 0xb9, 0xab, 0x34,
+	0xd7, 0x11, 0xf7, 0x26, 0xe5, 0xb3, 0x3e, 0xaf, 0x46, 0x95, 0x68, 0xf0, 0x30, 0x23, 0x29, 0xa9,
+	0x89, 0xe3, 0xbb, 0xed, 0x32, 0x5a, 0xbd, 0x9c, 0x30, 0x78, 0xab, 0x77, 0x1e, 0x1e, 0x64, 0xc9,
+	0xa3, 0x83, 0x2c, 0xf9, 0xe7, 0x20, 0x4b, 0xbe, 0x3c, 0xcc, 0xf6, 0x3c, 0x3a, 0xcc, 0xf6, 0xfc,
+	0x79, 0x98, 0xed, 0x79, 0x77, 0xb1, 0x62, 0x38, 0xf7, 0xea, 0x9b, 0x4a, 0x89, 0x6d, 0xab, 0x6b,
+	0xbc, 0x76, 0x81, 0xd5, 0xcd, 0x32, 0xb7, 0xc9, 0x2e, 0xd8, 0x6e, 0x5e, 0xfd, 0xc0, 0x83, 0xe8,
+	0x34, 0x6a, 0xba, 0xbd, 0x99, 0xe6, 0x7f, 0x51, 0x5e, 0xfb, 0x6f, 0x00, 0x4a, 0xfc, 0x06, 0x92,
+	0x9b, 0x15, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -928,14 +1567,27 @@ type QueryClient interface {
 	Classes(ctx context.Context, in *QueryClassesRequest, opts ...grpc.CallOption) (*QueryClassesResponse, error)
 	// Frozen queries to check if an NFT is frozen or not.
 	Frozen(ctx context.Context, in *QueryFrozenRequest, opts ...grpc.CallOption) (*QueryFrozenResponse, error)
+	// ClassFrozen queries to check if the NFT class is frozen or not.
+	ClassFrozen(ctx context.Context, in *QueryClassFrozenRequest, opts ...grpc.CallOption) (*QueryClassFrozenResponse, error)
+	// ClassFrozenForAccount queries to check if the NFT class is frozen for the account or not.
+	ClassFrozenForAccount(ctx context.Context, in *QueryClassFrozenForAccountRequest, opts ...grpc.CallOption) (*QueryClassFrozenForAccountResponse, error)
+	// ClassFrozenAccounts returns the list of accounts for which the NFT class is frozen.
+	ClassFrozenAccounts(ctx context.Context, in *QueryClassFrozenAccountsRequest, opts ...grpc.CallOption) (*QueryClassFrozenAccountsResponse, error)
 	// Whitelisted queries to check if an account is whitelited to hold an NFT or not.
 	Whitelisted(ctx context.Context, in *QueryWhitelistedRequest, opts ...grpc.CallOption) (*QueryWhitelistedResponse, error)
 	// WhitelistedAccountsForNFT returns the list of accounts which are whitelisted to hold this NFT.
 	WhitelistedAccountsForNFT(ctx context.Context, in *QueryWhitelistedAccountsForNFTRequest, opts ...grpc.CallOption) (*QueryWhitelistedAccountsForNFTResponse, error)
+	// ClassWhitelisted queries to check if an account is whitelisted to hold all the NFTs of the class or not.
+	ClassWhitelisted(ctx context.Context, in *QueryClassWhitelistedRequest, opts ...grpc.CallOption) (*QueryClassWhitelistedResponse, error)
+	// ClassWhitelistedAccounts returns the list of accounts which are whitelisted to hold all the NFTs of the class.
+	ClassWhitelistedAccounts(ctx context.Context, in *QueryClassWhitelistedAccountsRequest, opts ...grpc.CallOption) (*QueryClassWhitelistedAccountsResponse, error)
 	// BurntNFTsInClass checks if an nft if is in burnt NFTs list.
 	BurntNFT(ctx context.Context, in *QueryBurntNFTRequest, opts ...grpc.CallOption) (*QueryBurntNFTResponse, error)
 	// BurntNFTsInClass returns the list of burnt nfts in a class.
 	BurntNFTsInClass(ctx context.Context, in *QueryBurntNFTsInClassRequest, opts ...grpc.CallOption) (*QueryBurntNFTsInClassResponse, error)
+	// RoyaltyPayout returns the royalty paid to the class issuer and the payout of the seller
+	// when an NFT of the class is sold for the payment.
+	RoyaltyPayout(ctx context.Context, in *QueryRoyaltyPayoutRequest, opts ...grpc.CallOption) (*QueryRoyaltyPayoutResponse, error)
 }
 
 type queryClient struct {
@@ -982,36 +1634,90 @@ func (c *queryClient) Frozen(ctx context.Context, in *QueryFrozenRequest, opts .
 	return out, nil
 }
 
-func (c *queryClient) Whitelisted(ctx context.Context, in *QueryWhitelistedRequest, opts ...grpc.CallOption) (*QueryWhitelistedResponse, error) {
-	out := new(QueryWhitelistedResponse)
-	err := c.cc.Invoke(ctx, "/coreum.asset.nft.v1.Query/Whitelisted", in, out, opts...)
+func (c *queryClient) ClassFrozen(ctx context.Context, in *QueryClassFrozenRequest, opts ...grpc.CallOption) (*QueryClassFrozenResponse, error) {
+	out := new(QueryClassFrozenResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.nft.v1.Query/ClassFrozen", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *queryClient) WhitelistedAccountsForNFT(ctx context.Context, in *QueryWhitelistedAccountsForNFTRequest, opts ...grpc.CallOption) (*QueryWhitelistedAccountsForNFTResponse, error) {
-	out := new(QueryWhitelistedAccountsForNFTResponse)
-	err := c.cc.Invoke(ctx, "/coreum.asset.nft.v1.Query/WhitelistedAccountsForNFT", in, out, opts...)
+func (c *queryClient) ClassFrozenForAccount(ctx context.Context, in *QueryClassFrozenForAccountRequest, opts ...grpc.CallOption) (*QueryClassFrozenForAccountResponse, error) {
+	out := new(QueryClassFrozenForAccountResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.nft.v1.Query/ClassFrozenForAccount", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *queryClient) BurntNFT(ctx context.Context, in *QueryBurntNFTRequest, opts ...grpc.CallOption) (*QueryBurntNFTResponse, error) {
-	out := new(QueryBurntNFTResponse)
-	err := c.cc.Invoke(ctx, "/coreum.asset.nft.v1.Query/BurntNFT", in, out, opts...)
+func (c *queryClient) ClassFrozenAccounts(ctx context.Context, in *QueryClassFrozenAccountsRequest, opts ...grpc.CallOption) (*QueryClassFrozenAccountsResponse, error) {
+	out := new(QueryClassFrozenAccountsResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.nft.v1.Query/ClassFrozenAccounts", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *queryClient) BurntNFTsInClass(ctx context.Context, in *QueryBurntNFTsInClassRequest, opts ...grpc.CallOption) (*QueryBurntNFTsInClassResponse, error) {
-	out := new(QueryBurntNFTsInClassResponse)
-	err := c.cc.Invoke(ctx, "/coreum.asset.nft.v1.Query/BurntNFTsInClass", in, out, opts...)
+func (c *queryClient) Whitelisted(ctx context.Context, in *QueryWhitelistedRequest, opts ...grpc.CallOption) (*QueryWhitelistedResponse, error) {
+	out := new(QueryWhitelistedResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.nft.v1.Query/Whitelisted", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WhitelistedAccountsForNFT(ctx context.Context, in *QueryWhitelistedAccountsForNFTRequest, opts ...grpc.CallOption) (*QueryWhitelistedAccountsForNFTResponse, error) {
+	out := new(QueryWhitelistedAccountsForNFTResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.nft.v1.Query/WhitelistedAccountsForNFT", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClassWhitelisted(ctx context.Context, in *QueryClassWhitelistedRequest, opts ...grpc.CallOption) (*QueryClassWhitelistedResponse, error) {
+	out := new(QueryClassWhitelistedResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.nft.v1.Query/ClassWhitelisted", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClassWhitelistedAccounts(ctx context.Context, in *QueryClassWhitelistedAccountsRequest, opts ...grpc.CallOption) (*QueryClassWhitelistedAccountsResponse, error) {
+	out := new(QueryClassWhitelistedAccountsResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.nft.v1.Query/ClassWhitelistedAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BurntNFT(ctx context.Context, in *QueryBurntNFTRequest, opts ...grpc.CallOption) (*QueryBurntNFTResponse, error) {
+	out := new(QueryBurntNFTResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.nft.v1.Query/BurntNFT", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BurntNFTsInClass(ctx context.Context, in *QueryBurntNFTsInClassRequest, opts ...grpc.CallOption) (*QueryBurntNFTsInClassResponse, error) {
+	out := new(QueryBurntNFTsInClassResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.nft.v1.Query/BurntNFTsInClass", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RoyaltyPayout(ctx context.Context, in *QueryRoyaltyPayoutRequest, opts ...grpc.CallOption) (*QueryRoyaltyPayoutResponse, error) {
+	out := new(QueryRoyaltyPayoutResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.nft.v1.Query/RoyaltyPayout", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1028,14 +1734,27 @@ type QueryServer interface {
 	Classes(context.Context, *QueryClassesRequest) (*QueryClassesResponse, error)
 	// Frozen queries to check if an NFT is frozen or not.
 	Frozen(context.Context, *QueryFrozenRequest) (*QueryFrozenResponse, error)
+	// ClassFrozen queries to check if the NFT class is frozen or not.
+	ClassFrozen(context.Context, *QueryClassFrozenRequest) (*QueryClassFrozenResponse, error)
+	// ClassFrozenForAccount queries to check if the NFT class is frozen for the account or not.
+	ClassFrozenForAccount(context.Context, *QueryClassFrozenForAccountRequest) (*QueryClassFrozenForAccountResponse, error)
+	// ClassFrozenAccounts returns the list of accounts for which the NFT class is frozen.
+	ClassFrozenAccounts(context.Context, *QueryClassFrozenAccountsRequest) (*QueryClassFrozenAccountsResponse, error)
 	// Whitelisted queries to check if an account is whitelited to hold an NFT or not.
 	Whitelisted(context.Context, *QueryWhitelistedRequest) (*QueryWhitelistedResponse, error)
 	// WhitelistedAccountsForNFT returns the list of accounts which are whitelisted to hold this NFT.
 	WhitelistedAccountsForNFT(context.Context, *QueryWhitelistedAccountsForNFTRequest) (*QueryWhitelistedAccountsForNFTResponse, error)
+	// ClassWhitelisted queries to check if an account is whitelisted to hold all the NFTs of the class or not.
+	ClassWhitelisted(context.Context, *QueryClassWhitelistedRequest) (*QueryClassWhitelistedResponse, error)
+	// ClassWhitelistedAccounts returns the list of accounts which are whitelisted to hold all the NFTs of the class.
+	ClassWhitelistedAccounts(context.Context, *QueryClassWhitelistedAccountsRequest) (*QueryClassWhitelistedAccountsResponse, error)
 	// BurntNFTsInClass checks if an nft if is in burnt NFTs list.
 	BurntNFT(context.Context, *QueryBurntNFTRequest) (*QueryBurntNFTResponse, error)
 	// BurntNFTsInClass returns the list of burnt nfts in a class.
 	BurntNFTsInClass(context.Context, *QueryBurntNFTsInClassRequest) (*QueryBurntNFTsInClassResponse, error)
+	// RoyaltyPayout returns the royalty paid to the class issuer and the payout of the seller
+	// when an NFT of the class is sold for the payment.
+	RoyaltyPayout(context.Context, *QueryRoyaltyPayoutRequest) (*QueryRoyaltyPayoutResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1054,18 +1773,36 @@ func (*UnimplementedQueryServer) Classes(ctx context.Context, req *QueryClassesR
 func (*UnimplementedQueryServer) Frozen(ctx context.Context, req *QueryFrozenRequest) (*QueryFrozenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Frozen not implemented")
 }
+func (*UnimplementedQueryServer) ClassFrozen(ctx context.Context, req *QueryClassFrozenRequest) (*QueryClassFrozenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClassFrozen not implemented")
+}
+func (*UnimplementedQueryServer) ClassFrozenForAccount(ctx context.Context, req *QueryClassFrozenForAccountRequest) (*QueryClassFrozenForAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClassFrozenForAccount not implemented")
+}
+func (*UnimplementedQueryServer) ClassFrozenAccounts(ctx context.Context, req *QueryClassFrozenAccountsRequest) (*QueryClassFrozenAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClassFrozenAccounts not implemented")
+}
 func (*UnimplementedQueryServer) Whitelisted(ctx context.Context, req *QueryWhitelistedRequest) (*QueryWhitelistedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Whitelisted not implemented")
 }
 func (*UnimplementedQueryServer) WhitelistedAccountsForNFT(ctx context.Context, req *QueryWhitelistedAccountsForNFTRequest) (*QueryWhitelistedAccountsForNFTResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WhitelistedAccountsForNFT not implemented")
 }
+func (*UnimplementedQueryServer) ClassWhitelisted(ctx context.Context, req *QueryClassWhitelistedRequest) (*QueryClassWhitelistedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClassWhitelisted not implemented")
+}
+func (*UnimplementedQueryServer) ClassWhitelistedAccounts(ctx context.Context, req *QueryClassWhitelistedAccountsRequest) (*QueryClassWhitelistedAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClassWhitelistedAccounts not implemented")
+}
 func (*UnimplementedQueryServer) BurntNFT(ctx context.Context, req *QueryBurntNFTRequest) (*QueryBurntNFTResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BurntNFT not implemented")
 }
 func (*UnimplementedQueryServer) BurntNFTsInClass(ctx context.Context, req *QueryBurntNFTsInClassRequest) (*QueryBurntNFTsInClassResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BurntNFTsInClass not implemented")
 }
+func (*UnimplementedQueryServer) RoyaltyPayout(ctx context.Context, req *QueryRoyaltyPayoutRequest) (*QueryRoyaltyPayoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RoyaltyPayout not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1143,6 +1880,60 @@ func _Query_Frozen_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ClassFrozen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClassFrozenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClassFrozen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.nft.v1.Query/ClassFrozen",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClassFrozen(ctx, req.(*QueryClassFrozenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClassFrozenForAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClassFrozenForAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClassFrozenForAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.nft.v1.Query/ClassFrozenForAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClassFrozenForAccount(ctx, req.(*QueryClassFrozenForAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClassFrozenAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClassFrozenAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClassFrozenAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.nft.v1.Query/ClassFrozenAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClassFrozenAccounts(ctx, req.(*QueryClassFrozenAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Whitelisted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryWhitelistedRequest)
 	if err := dec(in); err != nil {
@@ -1179,6 +1970,42 @@ func _Query_WhitelistedAccountsForNFT_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ClassWhitelisted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClassWhitelistedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClassWhitelisted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.nft.v1.Query/ClassWhitelisted",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClassWhitelisted(ctx, req.(*QueryClassWhitelistedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClassWhitelistedAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClassWhitelistedAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClassWhitelistedAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.nft.v1.Query/ClassWhitelistedAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClassWhitelistedAccounts(ctx, req.(*QueryClassWhitelistedAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_BurntNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryBurntNFTRequest)
 	if err := dec(in); err != nil {
@@ -1215,6 +2042,24 @@ func _Query_BurntNFTsInClass_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_RoyaltyPayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRoyaltyPayoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RoyaltyPayout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.nft.v1.Query/RoyaltyPayout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RoyaltyPayout(ctx, req.(*QueryRoyaltyPayoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "coreum.asset.nft.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1235,6 +2080,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Frozen",
 			Handler:    _Query_Frozen_Handler,
 		},
+		{
+			MethodName: "ClassFrozen",
+			Handler:    _Query_ClassFrozen_Handler,
+		},
+		{
+			MethodName: "ClassFrozenForAccount",
+			Handler:    _Query_ClassFrozenForAccount_Handler,
+		},
+		{
+			MethodName: "ClassFrozenAccounts",
+			Handler:    _Query_ClassFrozenAccounts_Handler,
+		},
 		{
 			MethodName: "Whitelisted",
 			Handler:    _Query_Whitelisted_Handler,
@@ -1243,6 +2100,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "WhitelistedAccountsForNFT",
 			Handler:    _Query_WhitelistedAccountsForNFT_Handler,
 		},
+		{
+			MethodName: "ClassWhitelisted",
+			Handler:    _Query_ClassWhitelisted_Handler,
+		},
+		{
+			MethodName: "ClassWhitelistedAccounts",
+			Handler:    _Query_ClassWhitelistedAccounts_Handler,
+		},
 		{
 			MethodName: "BurntNFT",
 			Handler:    _Query_BurntNFT_Handler,
@@ -1251,6 +2116,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BurntNFTsInClass",
 			Handler:    _Query_BurntNFTsInClass_Handler,
 		},
+		{
+			MethodName: "RoyaltyPayout",
+			Handler:    _Query_RoyaltyPayout_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "coreum/asset/nft/v1/query.proto",
@@ -1536,7 +2405,7 @@ func (m *QueryFrozenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryWhitelistedRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryClassFrozenRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1546,12 +2415,75 @@ func (m *QueryWhitelistedRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryWhitelistedRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryClassFrozenRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryWhitelistedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryClassFrozenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClassFrozenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClassFrozenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClassFrozenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Frozen {
+		i--
+		if m.Frozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClassFrozenForAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClassFrozenForAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClassFrozenForAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1561,26 +2493,19 @@ func (m *QueryWhitelistedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		copy(dAtA[i:], m.Account)
 		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x12
 	}
 	if len(m.ClassId) > 0 {
 		i -= len(m.ClassId)
 		copy(dAtA[i:], m.ClassId)
 		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryWhitelistedResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryClassFrozenForAccountResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1590,19 +2515,19 @@ func (m *QueryWhitelistedResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryWhitelistedResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryClassFrozenForAccountResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryWhitelistedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryClassFrozenForAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Whitelisted {
+	if m.Frozen {
 		i--
-		if m.Whitelisted {
+		if m.Frozen {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
@@ -1613,7 +2538,7 @@ func (m *QueryWhitelistedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryWhitelistedAccountsForNFTRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryClassFrozenAccountsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1623,12 +2548,12 @@ func (m *QueryWhitelistedAccountsForNFTRequest) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *QueryWhitelistedAccountsForNFTRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryClassFrozenAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryWhitelistedAccountsForNFTRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryClassFrozenAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1638,13 +2563,6 @@ func (m *QueryWhitelistedAccountsForNFTRequest) MarshalToSizedBuffer(dAtA []byte
 		copy(dAtA[i:], m.ClassId)
 		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
 		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
 		dAtA[i] = 0x12
 	}
 	if m.Pagination != nil {
@@ -1662,7 +2580,7 @@ func (m *QueryWhitelistedAccountsForNFTRequest) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryWhitelistedAccountsForNFTResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryClassFrozenAccountsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1672,12 +2590,12 @@ func (m *QueryWhitelistedAccountsForNFTResponse) Marshal() (dAtA []byte, err err
 	return dAtA[:n], nil
 }
 
-func (m *QueryWhitelistedAccountsForNFTResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryClassFrozenAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryWhitelistedAccountsForNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryClassFrozenAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1706,7 +2624,7 @@ func (m *QueryWhitelistedAccountsForNFTResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBurntNFTRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryWhitelistedRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1716,34 +2634,41 @@ func (m *QueryBurntNFTRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBurntNFTRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryWhitelistedRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBurntNFTRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryWhitelistedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.NftId) > 0 {
-		i -= len(m.NftId)
-		copy(dAtA[i:], m.NftId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.NftId)))
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
 	}
 	if len(m.ClassId) > 0 {
 		i -= len(m.ClassId)
 		copy(dAtA[i:], m.ClassId)
 		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
 		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBurntNFTResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryWhitelistedResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1753,19 +2678,19 @@ func (m *QueryBurntNFTResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBurntNFTResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryWhitelistedResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBurntNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryWhitelistedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Burnt {
+	if m.Whitelisted {
 		i--
-		if m.Burnt {
+		if m.Whitelisted {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
@@ -1776,7 +2701,7 @@ func (m *QueryBurntNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBurntNFTsInClassRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryWhitelistedAccountsForNFTRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1786,12 +2711,12 @@ func (m *QueryBurntNFTsInClassRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBurntNFTsInClassRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryWhitelistedAccountsForNFTRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBurntNFTsInClassRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryWhitelistedAccountsForNFTRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1801,6 +2726,13 @@ func (m *QueryBurntNFTsInClassRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 		copy(dAtA[i:], m.ClassId)
 		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
 		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
 		dAtA[i] = 0x12
 	}
 	if m.Pagination != nil {
@@ -1818,7 +2750,7 @@ func (m *QueryBurntNFTsInClassRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBurntNFTsInClassResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryWhitelistedAccountsForNFTResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1828,21 +2760,21 @@ func (m *QueryBurntNFTsInClassResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBurntNFTsInClassResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryWhitelistedAccountsForNFTResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBurntNFTsInClassResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryWhitelistedAccountsForNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.NftIds) > 0 {
-		for iNdEx := len(m.NftIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.NftIds[iNdEx])
-			copy(dAtA[i:], m.NftIds[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.NftIds[iNdEx])))
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Accounts[iNdEx])
+			copy(dAtA[i:], m.Accounts[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Accounts[iNdEx])))
 			i--
 			dAtA[i] = 0x12
 		}
@@ -1862,271 +2794,1732 @@ func (m *QueryBurntNFTsInClassResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryClassWhitelistedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryClassWhitelistedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryClassRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryClassWhitelistedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryClassResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryClassWhitelistedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Class.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryClassesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Issuer)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryClassWhitelistedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryClassesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryClassWhitelistedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.Classes) > 0 {
-		for _, e := range m.Classes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Whitelisted {
+		i--
+		if m.Whitelisted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryFrozenRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ClassId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryClassWhitelistedAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryFrozenResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Frozen {
-		n += 2
-	}
-	return n
+func (m *QueryClassWhitelistedAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryWhitelistedRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryClassWhitelistedAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ClassId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryWhitelistedResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Whitelisted {
-		n += 2
+func (m *QueryClassWhitelistedAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryWhitelistedAccountsForNFTRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryClassWhitelistedAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClassWhitelistedAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Accounts[iNdEx])
+			copy(dAtA[i:], m.Accounts[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Accounts[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ClassId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryWhitelistedAccountsForNFTResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBurntNFTRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurntNFTRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurntNFTRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.NftId) > 0 {
+		i -= len(m.NftId)
+		copy(dAtA[i:], m.NftId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.NftId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Accounts) > 0 {
-		for _, s := range m.Accounts {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurntNFTResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurntNFTResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurntNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Burnt {
+		i--
+		if m.Burnt {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBurntNFTRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBurntNFTsInClassRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurntNFTsInClassRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurntNFTsInClassRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ClassId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.NftId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBurntNFTResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBurntNFTsInClassResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurntNFTsInClassResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurntNFTsInClassResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Burnt {
-		n += 2
+	if len(m.NftIds) > 0 {
+		for iNdEx := len(m.NftIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NftIds[iNdEx])
+			copy(dAtA[i:], m.NftIds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.NftIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	return n
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBurntNFTsInClassRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRoyaltyPayoutRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRoyaltyPayoutRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRoyaltyPayoutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Payment.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	l = len(m.ClassId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBurntNFTsInClassResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRoyaltyPayoutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRoyaltyPayoutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRoyaltyPayoutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.SellerPayout.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if len(m.NftIds) > 0 {
-		for _, s := range m.NftIds {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Royalty.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryClassRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClassResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Class.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryClassesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClassesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Classes) > 0 {
+		for _, e := range m.Classes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFrozenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFrozenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Frozen {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryClassFrozenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClassFrozenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Frozen {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryClassFrozenForAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClassFrozenForAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Frozen {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryClassFrozenAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClassFrozenAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Accounts) > 0 {
+		for _, s := range m.Accounts {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryWhitelistedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWhitelistedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Whitelisted {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryWhitelistedAccountsForNFTRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWhitelistedAccountsForNFTResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Accounts) > 0 {
+		for _, s := range m.Accounts {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryClassWhitelistedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClassWhitelistedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Whitelisted {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryClassWhitelistedAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClassWhitelistedAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Accounts) > 0 {
+		for _, s := range m.Accounts {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBurntNFTRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.NftId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBurntNFTResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Burnt {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryBurntNFTsInClassRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBurntNFTsInClassResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.NftIds) > 0 {
+		for _, s := range m.NftIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRoyaltyPayoutRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Payment.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRoyaltyPayoutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Royalty.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SellerPayout.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClassRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClassRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClassRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClassResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClassResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClassResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Class", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Class.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClassesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClassesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClassesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClassesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClassesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClassesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Classes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Classes = append(m.Classes, Class{})
+			if err := m.Classes[len(m.Classes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFrozenRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFrozenRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFrozenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFrozenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFrozenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFrozenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Frozen = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClassFrozenRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClassFrozenRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClassFrozenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClassFrozenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClassFrozenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClassFrozenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Frozen = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryClassFrozenForAccountRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2149,12 +4542,76 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClassFrozenForAccountRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClassFrozenForAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2176,7 +4633,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryClassFrozenForAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2199,17 +4656,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClassFrozenForAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClassFrozenForAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2219,25 +4676,12 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Frozen = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2259,7 +4703,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClassRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryClassFrozenAccountsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2282,15 +4726,51 @@ func (m *QueryClassRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClassRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClassFrozenAccountsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClassRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClassFrozenAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2318,7 +4798,7 @@ func (m *QueryClassRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.ClassId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2341,7 +4821,7 @@ func (m *QueryClassRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClassResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryClassFrozenAccountsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2364,15 +4844,15 @@ func (m *QueryClassResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClassResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClassFrozenAccountsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClassResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClassFrozenAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Class", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2399,10 +4879,45 @@ func (m *QueryClassResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Class.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2424,7 +4939,7 @@ func (m *QueryClassResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClassesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryWhitelistedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2447,17 +4962,17 @@ func (m *QueryClassesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClassesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWhitelistedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClassesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWhitelistedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2467,31 +4982,59 @@ func (m *QueryClassesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2519,7 +5062,7 @@ func (m *QueryClassesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Issuer = string(dAtA[iNdEx:postIndex])
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2542,7 +5085,7 @@ func (m *QueryClassesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClassesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryWhitelistedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2565,53 +5108,17 @@ func (m *QueryClassesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClassesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWhitelistedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClassesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWhitelistedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Classes", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Whitelisted", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2621,26 +5128,12 @@ func (m *QueryClassesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Classes = append(m.Classes, Class{})
-			if err := m.Classes[len(m.Classes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Whitelisted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2662,7 +5155,7 @@ func (m *QueryClassesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFrozenRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryWhitelistedAccountsForNFTRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2685,13 +5178,49 @@ func (m *QueryFrozenRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFrozenRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWhitelistedAccountsForNFTRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFrozenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWhitelistedAccountsForNFTRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
@@ -2723,7 +5252,7 @@ func (m *QueryFrozenRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
 			}
@@ -2776,7 +5305,7 @@ func (m *QueryFrozenRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFrozenResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryWhitelistedAccountsForNFTResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2799,17 +5328,17 @@ func (m *QueryFrozenResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFrozenResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWhitelistedAccountsForNFTResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFrozenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWhitelistedAccountsForNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2819,12 +5348,60 @@ func (m *QueryFrozenResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Frozen = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2846,7 +5423,7 @@ func (m *QueryFrozenResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWhitelistedRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryClassWhitelistedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2869,45 +5446,13 @@ func (m *QueryWhitelistedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWhitelistedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClassWhitelistedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWhitelistedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClassWhitelistedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
 			}
@@ -2939,7 +5484,7 @@ func (m *QueryWhitelistedRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ClassId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
@@ -2992,7 +5537,7 @@ func (m *QueryWhitelistedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWhitelistedResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryClassWhitelistedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3015,10 +5560,10 @@ func (m *QueryWhitelistedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWhitelistedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClassWhitelistedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWhitelistedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClassWhitelistedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3062,7 +5607,7 @@ func (m *QueryWhitelistedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWhitelistedAccountsForNFTRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryClassWhitelistedAccountsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3085,10 +5630,10 @@ func (m *QueryWhitelistedAccountsForNFTRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWhitelistedAccountsForNFTRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClassWhitelistedAccountsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWhitelistedAccountsForNFTRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClassWhitelistedAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3127,39 +5672,7 @@ func (m *QueryWhitelistedAccountsForNFTRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
 			}
@@ -3212,7 +5725,7 @@ func (m *QueryWhitelistedAccountsForNFTRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWhitelistedAccountsForNFTResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryClassWhitelistedAccountsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3235,10 +5748,10 @@ func (m *QueryWhitelistedAccountsForNFTResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWhitelistedAccountsForNFTResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClassWhitelistedAccountsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWhitelistedAccountsForNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClassWhitelistedAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3750,6 +6263,269 @@ func (m *QueryBurntNFTsInClassResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryRoyaltyPayoutRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRoyaltyPayoutRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRoyaltyPayoutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Payment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRoyaltyPayoutResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRoyaltyPayoutResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRoyaltyPayoutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Royalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellerPayout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SellerPayout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -50,6 +50,25 @@ type MintSettings struct {
 	Data    *codectypes.Any
 }
 
+// UpdateClassSettings is the model which represents the params for the non-fungible token class update.
+type UpdateClassSettings struct {
+	Sender  sdk.AccAddress
+	ClassID string
+	URI     string
+	URIHash string
+	Data    *codectypes.Any
+}
+
+// UpdateDataSettings is the model which represents the params for the non-fungible token update.
+type UpdateDataSettings struct {
+	Sender  sdk.AccAddress
+	ClassID string
+	ID      string
+	URI     string
+	URIHash string
+	Data    *codectypes.Any
+}
+
 // BuildClassID builds the non-fungible token id string from the symbol and issuer address.
 func BuildClassID(symbol string, issuer sdk.AccAddress) string {
 	return strings.ToLower(symbol) + nftClassIDSeparator + issuer.String()
@@ -178,3 +197,14 @@ func (nftd ClassDefinition) IsFeatureEnabled(feature ClassFeature) bool {
 func (nftd ClassDefinition) IsIssuer(addr sdk.Address) bool {
 	return nftd.Issuer == addr.String()
 }
+
+// RoyaltyPayout splits the payment for the NFT of the class between the royalty paid to the issuer
+// and the payout of the seller. The royalty is rounded down.
+func (nftd ClassDefinition) RoyaltyPayout(payment sdk.Coin) (sdk.Coin, sdk.Coin) {
+	royalty := sdk.NewCoin(payment.Denom, sdk.ZeroInt())
+	if !nftd.RoyaltyRate.IsNil() {
+		royalty.Amount = nftd.RoyaltyRate.MulInt(payment.Amount).TruncateInt()
+	}
+
+	return royalty, payment.Sub(royalty)
+}
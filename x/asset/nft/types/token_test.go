@@ -224,3 +224,53 @@ func TestValidateClassFeatures(t *testing.T) {
 		})
 	}
 }
+
+func TestClassDefinition_RoyaltyPayout(t *testing.T) {
+	testCases := []struct {
+		royaltyRate          sdk.Dec
+		payment              sdk.Coin
+		expectedRoyalty      sdk.Coin
+		expectedSellerPayout sdk.Coin
+	}{
+		{
+			royaltyRate:          sdk.Dec{},
+			payment:              sdk.NewInt64Coin("ucore", 100),
+			expectedRoyalty:      sdk.NewInt64Coin("ucore", 0),
+			expectedSellerPayout: sdk.NewInt64Coin("ucore", 100),
+		},
+		{
+			royaltyRate:          sdk.MustNewDecFromStr("0.1"),
+			payment:              sdk.NewInt64Coin("ucore", 100),
+			expectedRoyalty:      sdk.NewInt64Coin("ucore", 10),
+			expectedSellerPayout: sdk.NewInt64Coin("ucore", 90),
+		},
+		{
+			royaltyRate:          sdk.MustNewDecFromStr("0.1"),
+			payment:              sdk.NewInt64Coin("ucore", 19),
+			expectedRoyalty:      sdk.NewInt64Coin("ucore", 1),
+			expectedSellerPayout: sdk.NewInt64Coin("ucore", 18),
+		},
+		{
+			royaltyRate:          sdk.MustNewDecFromStr("1"),
+			payment:              sdk.NewInt64Coin("ucore", 19),
+			expectedRoyalty:      sdk.NewInt64Coin("ucore", 19),
+			expectedSellerPayout: sdk.NewInt64Coin("ucore", 0),
+		},
+		{
+			royaltyRate:          sdk.MustNewDecFromStr("0.5"),
+			payment:              sdk.NewInt64Coin("ucore", 0),
+			expectedRoyalty:      sdk.NewInt64Coin("ucore", 0),
+			expectedSellerPayout: sdk.NewInt64Coin("ucore", 0),
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(fmt.Sprintf("%s-%s", tc.royaltyRate, tc.payment), func(t *testing.T) {
+			definition := types.ClassDefinition{RoyaltyRate: tc.royaltyRate}
+			royalty, sellerPayout := definition.RoyaltyPayout(tc.payment)
+			assert.Equal(t, tc.expectedRoyalty.String(), royalty.String())
+			assert.Equal(t, tc.expectedSellerPayout.String(), sellerPayout.String())
+		})
+	}
+}
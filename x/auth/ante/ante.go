@@ -26,6 +26,7 @@ type HandlerOptions struct {
 	BankKeeper             authtypes.BankKeeper
 	FeegrantKeeper         authante.FeegrantKeeper
 	FeeModelKeeper         feemodelante.Keeper
+	AssetFTKeeper          feemodelante.AssetFTKeeper
 	SignModeHandler        authsigning.SignModeHandler
 	SigGasConsumer         func(meter sdk.GasMeter, sig signing.SignatureV2, params authtypes.Params) error
 	WasmTXCounterStoreKey  sdk.StoreKey
@@ -47,6 +48,10 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "fee model keeper is required for ante builder")
 	}
 
+	if options.AssetFTKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "asset ft keeper is required for ante builder")
+	}
+
 	if options.SignModeHandler == nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for ante builder")
 	}
@@ -100,7 +105,7 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		authante.NewTxTimeoutHeightDecorator(),
 		wasmkeeper.NewCountTXDecorator(options.WasmTXCounterStoreKey),
 		authante.NewValidateMemoDecorator(options.AccountKeeper),
-		feemodelante.NewFeeDecorator(options.FeeModelKeeper),
+		feemodelante.NewFeeDecorator(options.FeeModelKeeper, options.AssetFTKeeper),
 		authante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper),
 		authante.NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
 		authante.NewValidateSigCountDecorator(options.AccountKeeper),
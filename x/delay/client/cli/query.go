@@ -0,0 +1,342 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/CoreumFoundation/coreum/v2/x/delay/types"
+)
+
+// Flags defined on queries.
+const (
+	TypeURLFlag  = "type-url"
+	FromTimeFlag = "from-time"
+	ToTimeFlag   = "to-time"
+)
+
+// GetQueryCmd returns the cli query commands for the module.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdQueryDelayedItems())
+	cmd.AddCommand(CmdQueryDelayedItemsByID())
+	cmd.AddCommand(CmdQueryFailedDelayedItems())
+	cmd.AddCommand(CmdQueryFailedDelayedItemsByID())
+	cmd.AddCommand(CmdQueryRecurringDelayedItems())
+	cmd.AddCommand(CmdQueryRecurringDelayedItem())
+	cmd.AddCommand(CmdQueryParams())
+
+	return cmd
+}
+
+// CmdQueryDelayedItems returns the QueryDelayedItems cobra command.
+func CmdQueryDelayedItems() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delayed-items",
+		Args:  cobra.NoArgs,
+		Short: "Query pending delayed items",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the pending delayed items ordered by the execution time.
+The items might be filtered by the type URL of the data and by the execution time range.
+
+Example:
+$ %[1]s query %s delayed-items --%s=/coreum.asset.ft.v1.DelayedTokenUpgradeV1 --%s=2023-01-02T15:04:05Z
+`,
+				version.AppName, types.ModuleName, TypeURLFlag, ToTimeFlag,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			typeURL, err := cmd.Flags().GetString(TypeURLFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			fromTime, err := readTimeFlag(cmd.Flags(), FromTimeFlag)
+			if err != nil {
+				return err
+			}
+			toTime, err := readTimeFlag(cmd.Flags(), ToTimeFlag)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DelayedItems(cmd.Context(), &types.QueryDelayedItemsRequest{
+				Pagination: pageReq,
+				TypeURL:    typeURL,
+				FromTime:   fromTime,
+				ToTime:     toTime,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(TypeURLFlag, "", "Type URL of the delayed item data")
+	cmd.Flags().String(FromTimeFlag, "", "Earliest execution time of the delayed items in RFC3339 format")
+	cmd.Flags().String(ToTimeFlag, "", "Latest execution time of the delayed items in RFC3339 format")
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "delayed items")
+
+	return cmd
+}
+
+// CmdQueryDelayedItemsByID returns the QueryDelayedItemsByID cobra command.
+func CmdQueryDelayedItemsByID() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delayed-items-by-id [id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query pending delayed items stored under the id",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the pending delayed items stored under the id.
+
+Example:
+$ %[1]s query %s delayed-items-by-id [id]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DelayedItemsByID(cmd.Context(), &types.QueryDelayedItemsByIDRequest{
+				Id: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryFailedDelayedItems returns the QueryFailedDelayedItems cobra command.
+func CmdQueryFailedDelayedItems() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "failed-delayed-items",
+		Args:  cobra.NoArgs,
+		Short: "Query failed delayed items",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the delayed items which handlers failed.
+
+Example:
+$ %[1]s query %s failed-delayed-items
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FailedDelayedItems(cmd.Context(), &types.QueryFailedDelayedItemsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "failed delayed items")
+
+	return cmd
+}
+
+// CmdQueryFailedDelayedItemsByID returns the QueryFailedDelayedItemsByID cobra command.
+func CmdQueryFailedDelayedItemsByID() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "failed-delayed-items-by-id [id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query failed delayed items stored under the id",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the delayed items stored under the id which handlers failed.
+
+Example:
+$ %[1]s query %s failed-delayed-items-by-id [id]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FailedDelayedItemsByID(cmd.Context(), &types.QueryFailedDelayedItemsByIDRequest{
+				Id: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryRecurringDelayedItems returns the QueryRecurringDelayedItems cobra command.
+func CmdQueryRecurringDelayedItems() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "recurring-delayed-items",
+		Args:  cobra.NoArgs,
+		Short: "Query recurring delayed items",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the schedules of the recurring delayed items.
+
+Example:
+$ %[1]s query %s recurring-delayed-items
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.RecurringDelayedItems(cmd.Context(), &types.QueryRecurringDelayedItemsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "recurring delayed items")
+
+	return cmd
+}
+
+// CmdQueryRecurringDelayedItem returns the QueryRecurringDelayedItem cobra command.
+func CmdQueryRecurringDelayedItem() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "recurring-delayed-item [id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query recurring delayed item stored under the id",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the schedule and the next execution time of the recurring delayed item stored under the id.
+
+Example:
+$ %[1]s query %s recurring-delayed-item [id]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RecurringDelayedItem(cmd.Context(), &types.QueryRecurringDelayedItemRequest{
+				Id: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryParams implements the query params command.
+func CmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "Query the current delay parameters",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query parameters of the delay module.
+
+Example:
+$ %[1]s query %s params
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func readTimeFlag(flagSet *pflag.FlagSet, flag string) (*time.Time, error) {
+	value, err := flagSet.GetString(flag)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if value == "" {
+		return nil, nil //nolint:nilnil // nil time means that the filter is not applied
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid %s", flag)
+	}
+	return &t, nil
+}
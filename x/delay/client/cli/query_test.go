@@ -0,0 +1,137 @@
+package cli_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v2/testutil/network"
+	assetfttypes "github.com/CoreumFoundation/coreum/v2/x/asset/ft/types"
+	"github.com/CoreumFoundation/coreum/v2/x/delay/client/cli"
+	"github.com/CoreumFoundation/coreum/v2/x/delay/types"
+)
+
+func TestQueryDelayedItems(t *testing.T) {
+	requireT := require.New(t)
+
+	executionTime := time.Date(2100, 1, 2, 3, 4, 5, 0, time.UTC)
+	data, err := codectypes.NewAnyWithValue(&assetfttypes.DelayedTokenUpgradeV1{Denom: "denom"})
+	requireT.NoError(err)
+	delayedItem := types.DelayedItem{
+		Id:            "delayed-id",
+		ExecutionTime: executionTime,
+		Data:          data,
+	}
+
+	failedDelayedItem := types.FailedDelayedItem{
+		Item: types.DelayedItem{
+			Id:            "failed-delayed-id",
+			ExecutionTime: executionTime.Add(-time.Hour),
+			Data:          data,
+		},
+		Error:  "handler error",
+		Height: 1,
+	}
+
+	recurringDelayedItem := types.RecurringDelayedItem{
+		Id:            delayedItem.Id,
+		Interval:      time.Hour,
+		MaxExecutions: 10,
+	}
+
+	cfg := network.DefaultConfig()
+	cfg.GenesisState[types.ModuleName] = cfg.Codec.MustMarshalJSON(&types.GenesisState{
+		DelayedItems:          []types.DelayedItem{delayedItem},
+		Params:                types.DefaultParams(),
+		FailedDelayedItems:    []types.FailedDelayedItem{failedDelayedItem},
+		RecurringDelayedItems: []types.RecurringDelayedItem{recurringDelayedItem},
+	})
+	testNetwork := network.New(t, cfg)
+
+	ctx := testNetwork.Validators[0].ClientCtx
+
+	var resp types.QueryDelayedItemsResponse
+	buf, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryDelayedItems(), []string{
+		fmt.Sprintf("--%s=%s", cli.TypeURLFlag, data.TypeUrl),
+		fmt.Sprintf("--%s=%s", cli.FromTimeFlag, executionTime.Format(time.RFC3339)),
+		"--output", "json",
+	})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
+	requireT.Len(resp.DelayedItems, 1)
+	requireT.Equal(delayedItem.Id, resp.DelayedItems[0].Id)
+	requireT.Equal(delayedItem.ExecutionTime, resp.DelayedItems[0].ExecutionTime)
+
+	buf, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryDelayedItems(), []string{
+		fmt.Sprintf("--%s=%s", cli.ToTimeFlag, executionTime.Add(-time.Second).Format(time.RFC3339)),
+		"--output", "json",
+	})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
+	requireT.Empty(resp.DelayedItems)
+
+	var byIDResp types.QueryDelayedItemsByIDResponse
+	buf, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryDelayedItemsByID(), []string{delayedItem.Id, "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &byIDResp))
+	requireT.Len(byIDResp.DelayedItems, 1)
+	requireT.Equal(delayedItem.Id, byIDResp.DelayedItems[0].Id)
+
+	_, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryDelayedItemsByID(), []string{"unknown-id", "--output", "json"})
+	requireT.Error(err)
+
+	var failedResp types.QueryFailedDelayedItemsResponse
+	buf, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryFailedDelayedItems(), []string{"--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &failedResp))
+	requireT.Len(failedResp.FailedDelayedItems, 1)
+	requireT.Equal(failedDelayedItem.Item.Id, failedResp.FailedDelayedItems[0].Item.Id)
+	requireT.Equal(failedDelayedItem.Error, failedResp.FailedDelayedItems[0].Error)
+
+	var failedByIDResp types.QueryFailedDelayedItemsByIDResponse
+	buf, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryFailedDelayedItemsByID(), []string{
+		failedDelayedItem.Item.Id, "--output", "json",
+	})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &failedByIDResp))
+	requireT.Len(failedByIDResp.FailedDelayedItems, 1)
+	requireT.Equal(failedDelayedItem.Height, failedByIDResp.FailedDelayedItems[0].Height)
+
+	var recurringResp types.QueryRecurringDelayedItemsResponse
+	buf, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryRecurringDelayedItems(), []string{"--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &recurringResp))
+	requireT.Equal([]types.RecurringDelayedItem{recurringDelayedItem}, recurringResp.RecurringDelayedItems)
+
+	var recurringByIDResp types.QueryRecurringDelayedItemResponse
+	buf, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryRecurringDelayedItem(), []string{
+		recurringDelayedItem.Id, "--output", "json",
+	})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &recurringByIDResp))
+	requireT.Equal(recurringDelayedItem, recurringByIDResp.RecurringDelayedItem)
+	requireT.NotNil(recurringByIDResp.NextExecutionTime)
+	requireT.Equal(executionTime, *recurringByIDResp.NextExecutionTime)
+
+	_, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryRecurringDelayedItem(), []string{"unknown-id", "--output", "json"})
+	requireT.Error(err)
+}
+
+func TestQueryParams(t *testing.T) {
+	requireT := require.New(t)
+
+	testNetwork := network.New(t)
+
+	ctx := testNetwork.Validators[0].ClientCtx
+
+	buf, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryParams(), []string{"--output", "json"})
+	requireT.NoError(err)
+
+	var resp types.QueryParamsResponse
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
+	requireT.Equal(types.DefaultParams(), resp.Params)
+}
@@ -9,14 +9,23 @@ import (
 
 // DefaultGenesis returns the default genesis state.
 func DefaultGenesis() *types.GenesisState {
-	return &types.GenesisState{}
+	return &types.GenesisState{
+		Params: types.DefaultParams(),
+	}
 }
 
 // InitGenesis initializes the state from a provided genesis.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
 	if err := k.ImportDelayedItems(ctx, genState.DelayedItems); err != nil {
 		panic(err)
 	}
+	if err := k.ImportFailedDelayedItems(ctx, genState.FailedDelayedItems); err != nil {
+		panic(err)
+	}
+	if err := k.ImportRecurringDelayedItems(ctx, genState.RecurringDelayedItems); err != nil {
+		panic(err)
+	}
 }
 
 // ExportGenesis returns the asset module's exported genesis.
@@ -25,7 +34,18 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	if err != nil {
 		panic(err)
 	}
+	failedItems, err := k.ExportFailedDelayedItems(ctx)
+	if err != nil {
+		panic(err)
+	}
+	recurringItems, err := k.ExportRecurringDelayedItems(ctx)
+	if err != nil {
+		panic(err)
+	}
 	return &types.GenesisState{
-		DelayedItems: delayedItems,
+		DelayedItems:          delayedItems,
+		Params:                k.GetParams(ctx),
+		FailedDelayedItems:    failedItems,
+		RecurringDelayedItems: recurringItems,
 	}
 }
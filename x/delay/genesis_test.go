@@ -13,6 +13,7 @@ import (
 
 	"github.com/CoreumFoundation/coreum/v2/testutil/simapp"
 	assetfttypes "github.com/CoreumFoundation/coreum/v2/x/asset/ft/types"
+	"github.com/CoreumFoundation/coreum/v2/x/delay"
 	"github.com/CoreumFoundation/coreum/v2/x/delay/types"
 )
 
@@ -76,6 +77,97 @@ func TestInitAndExportGenesis(t *testing.T) {
 	assertT.Equal(newDelayedItemWithoutCache(items[0]), newDelayedItemWithoutCache(itemsExported[2]))
 }
 
+func TestInitAndExportGenesisFailedItems(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	keeper := testApp.DelayKeeper
+
+	anyMsg, err := codectypes.NewAnyWithValue(&assetfttypes.DelayedTokenUpgradeV1{
+		Denom: "denom",
+	})
+	requireT.NoError(err)
+
+	genState := types.GenesisState{
+		Params: types.Params{
+			MaxExecutedItemsPerBlock: 7,
+		},
+		FailedDelayedItems: []types.FailedDelayedItem{
+			{
+				Item: types.DelayedItem{
+					Id:            "item2",
+					ExecutionTime: time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC),
+					Data:          anyMsg,
+				},
+				Error:  "error2",
+				Height: 20,
+			},
+			{
+				Item: types.DelayedItem{
+					Id:            "item1",
+					ExecutionTime: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+					Data:          anyMsg,
+				},
+				Error:  "error1",
+				Height: 10,
+			},
+		},
+	}
+	requireT.NoError(genState.Validate())
+
+	delay.InitGenesis(ctx, keeper, genState)
+	exportedGenState := delay.ExportGenesis(ctx, keeper)
+
+	requireT.Equal(genState.Params, exportedGenState.Params)
+	requireT.Empty(exportedGenState.DelayedItems)
+	requireT.Len(exportedGenState.FailedDelayedItems, 2)
+	// failed items are ordered by id
+	for i, expected := range []types.FailedDelayedItem{genState.FailedDelayedItems[1], genState.FailedDelayedItems[0]} {
+		exported := exportedGenState.FailedDelayedItems[i]
+		requireT.Equal(expected.Error, exported.Error)
+		requireT.Equal(expected.Height, exported.Height)
+		requireT.Equal(newDelayedItemWithoutCache(expected.Item), newDelayedItemWithoutCache(exported.Item))
+	}
+}
+
+func TestInitAndExportGenesisRecurringItems(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	keeper := testApp.DelayKeeper
+
+	endTime := time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)
+	genState := types.GenesisState{
+		Params: types.DefaultParams(),
+		RecurringDelayedItems: []types.RecurringDelayedItem{
+			{
+				Id:         "item1",
+				Interval:   time.Hour,
+				EndTime:    &endTime,
+				Executions: 5,
+			},
+			{
+				Id:            "item2",
+				Interval:      time.Minute,
+				MaxExecutions: 10,
+				Executions:    3,
+			},
+		},
+	}
+	requireT.NoError(genState.Validate())
+
+	delay.InitGenesis(ctx, keeper, genState)
+	exportedGenState := delay.ExportGenesis(ctx, keeper)
+	requireT.Equal(genState.RecurringDelayedItems, exportedGenState.RecurringDelayedItems)
+
+	genState.RecurringDelayedItems = append(genState.RecurringDelayedItems, genState.RecurringDelayedItems[0])
+	requireT.Error(genState.Validate())
+}
+
 func newDelayedItemWithoutCache(item types.DelayedItem) types.DelayedItem {
 	return types.DelayedItem{
 		Id:            item.Id,
@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/pkg/errors"
+
+	"github.com/CoreumFoundation/coreum/v2/x/delay/types"
+)
+
+var _ types.QueryServer = QueryService{}
+
+// QueryKeeper defines subscope of keeper methods required by query service.
+type QueryKeeper interface {
+	GetParams(ctx sdk.Context) types.Params
+	GetDelayedItemsByID(ctx sdk.Context, id string) ([]types.DelayedItem, error)
+	GetDelayedItems(
+		ctx sdk.Context,
+		typeURL string,
+		fromTime, toTime *time.Time,
+		pagination *query.PageRequest,
+	) ([]types.DelayedItem, *query.PageResponse, error)
+	GetFailedDelayedItems(
+		ctx sdk.Context,
+		pagination *query.PageRequest,
+	) ([]types.FailedDelayedItem, *query.PageResponse, error)
+	GetFailedDelayedItemsByID(ctx sdk.Context, id string) ([]types.FailedDelayedItem, error)
+	GetRecurringDelayedItems(
+		ctx sdk.Context,
+		pagination *query.PageRequest,
+	) ([]types.RecurringDelayedItem, *query.PageResponse, error)
+	GetRecurringDelayedItem(ctx sdk.Context, id string) (types.RecurringDelayedItem, error)
+}
+
+// QueryService serves grpc query requests for delay module.
+type QueryService struct {
+	keeper QueryKeeper
+}
+
+// NewQueryService initiates the new instance of query service.
+func NewQueryService(keeper QueryKeeper) QueryService {
+	return QueryService{
+		keeper: keeper,
+	}
+}
+
+// Params queries the parameters of x/delay module.
+func (qs QueryService) Params(ctx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	return &types.QueryParamsResponse{
+		Params: qs.keeper.GetParams(sdk.UnwrapSDKContext(ctx)),
+	}, nil
+}
+
+// DelayedItems queries the pending delayed items.
+func (qs QueryService) DelayedItems(
+	ctx context.Context,
+	req *types.QueryDelayedItemsRequest,
+) (*types.QueryDelayedItemsResponse, error) {
+	delayedItems, pageRes, err := qs.keeper.GetDelayedItems(
+		sdk.UnwrapSDKContext(ctx),
+		req.TypeURL,
+		req.FromTime,
+		req.ToTime,
+		req.Pagination,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDelayedItemsResponse{
+		Pagination:   pageRes,
+		DelayedItems: delayedItems,
+	}, nil
+}
+
+// DelayedItemsByID queries the pending delayed items stored under the id.
+func (qs QueryService) DelayedItemsByID(
+	ctx context.Context,
+	req *types.QueryDelayedItemsByIDRequest,
+) (*types.QueryDelayedItemsByIDResponse, error) {
+	delayedItems, err := qs.keeper.GetDelayedItemsByID(sdk.UnwrapSDKContext(ctx), req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDelayedItemsByIDResponse{
+		DelayedItems: delayedItems,
+	}, nil
+}
+
+// FailedDelayedItems queries the delayed items which handlers failed.
+func (qs QueryService) FailedDelayedItems(
+	ctx context.Context,
+	req *types.QueryFailedDelayedItemsRequest,
+) (*types.QueryFailedDelayedItemsResponse, error) {
+	failedItems, pageRes, err := qs.keeper.GetFailedDelayedItems(sdk.UnwrapSDKContext(ctx), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryFailedDelayedItemsResponse{
+		Pagination:         pageRes,
+		FailedDelayedItems: failedItems,
+	}, nil
+}
+
+// FailedDelayedItemsByID queries the delayed items stored under the id which handlers failed.
+func (qs QueryService) FailedDelayedItemsByID(
+	ctx context.Context,
+	req *types.QueryFailedDelayedItemsByIDRequest,
+) (*types.QueryFailedDelayedItemsByIDResponse, error) {
+	failedItems, err := qs.keeper.GetFailedDelayedItemsByID(sdk.UnwrapSDKContext(ctx), req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryFailedDelayedItemsByIDResponse{
+		FailedDelayedItems: failedItems,
+	}, nil
+}
+
+// RecurringDelayedItems queries the schedules of the recurring delayed items.
+func (qs QueryService) RecurringDelayedItems(
+	ctx context.Context,
+	req *types.QueryRecurringDelayedItemsRequest,
+) (*types.QueryRecurringDelayedItemsResponse, error) {
+	recurringItems, pageRes, err := qs.keeper.GetRecurringDelayedItems(sdk.UnwrapSDKContext(ctx), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRecurringDelayedItemsResponse{
+		Pagination:            pageRes,
+		RecurringDelayedItems: recurringItems,
+	}, nil
+}
+
+// RecurringDelayedItem queries the schedule of the recurring delayed item stored under the id.
+func (qs QueryService) RecurringDelayedItem(
+	ctx context.Context,
+	req *types.QueryRecurringDelayedItemRequest,
+) (*types.QueryRecurringDelayedItemResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	recurringItem, err := qs.keeper.GetRecurringDelayedItem(sdkCtx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// the item is not pending if its last execution failed
+	var nextExecutionTime *time.Time
+	delayedItems, err := qs.keeper.GetDelayedItemsByID(sdkCtx, req.Id)
+	switch {
+	case err == nil:
+		nextExecutionTime = &delayedItems[0].ExecutionTime
+	case !errors.Is(err, sdkerrors.ErrNotFound):
+		return nil, err
+	}
+
+	return &types.QueryRecurringDelayedItemResponse{
+		RecurringDelayedItem: recurringItem,
+		NextExecutionTime:    nextExecutionTime,
+	}, nil
+}
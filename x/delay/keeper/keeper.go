@@ -9,33 +9,55 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	"github.com/CoreumFoundation/coreum/v2/x/delay/types"
 )
 
+// ParamSubspace represents a subscope of methods exposed by param module to store and retrieve parameters.
+type ParamSubspace interface {
+	GetParamSet(ctx sdk.Context, ps paramtypes.ParamSet)
+	SetParamSet(ctx sdk.Context, ps paramtypes.ParamSet)
+}
+
 // Keeper is delay module Keeper.
 type Keeper struct {
-	cdc      codec.BinaryCodec
-	storeKey sdk.StoreKey
-	router   types.Router
-	registry codectypes.InterfaceRegistry
+	cdc           codec.BinaryCodec
+	paramSubspace ParamSubspace
+	storeKey      sdk.StoreKey
+	router        types.Router
+	registry      codectypes.InterfaceRegistry
 }
 
 // NewKeeper returns a new Keeper instance.
 func NewKeeper(
 	cdc codec.BinaryCodec,
+	paramSubspace ParamSubspace,
 	storeKey sdk.StoreKey,
 	router types.Router,
 	registry codectypes.InterfaceRegistry,
 ) Keeper {
 	return Keeper{
-		cdc:      cdc,
-		storeKey: storeKey,
-		router:   router,
-		registry: registry,
+		cdc:           cdc,
+		paramSubspace: paramSubspace,
+		storeKey:      storeKey,
+		router:        router,
+		registry:      registry,
 	}
 }
 
+// GetParams gets the parameters of the module.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	var params types.Params
+	k.paramSubspace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the parameters of the module.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSubspace.SetParamSet(ctx, &params)
+}
+
 // Router returns router.
 func (k Keeper) Router() types.Router {
 	return k.router
@@ -52,6 +74,10 @@ func (k Keeper) StoreDelayedExecution(ctx sdk.Context, id string, data codec.Pro
 	if err != nil {
 		return err
 	}
+	idKey, err := types.CreateDelayedItemIDKey(id, t)
+	if err != nil {
+		return err
+	}
 
 	store := ctx.KVStore(k.storeKey)
 	if store.Has(key) {
@@ -68,21 +94,187 @@ func (k Keeper) StoreDelayedExecution(ctx sdk.Context, id string, data codec.Pro
 		return sdkerrors.Wrapf(types.ErrInvalidData, "marshaling delayed item failed: %s", err.Error())
 	}
 	store.Set(key, b)
+	store.Set(idKey, key)
+	return nil
+}
+
+// StoreRecurringDelayedExecution stores delayed execution item executed first time at the absolute time and then
+// re-scheduled with the interval after each successful execution, until the end time or the max number of executions
+// is reached. The nil end time and zero max executions mean that the corresponding limit is not applied.
+// The id of the recurring item must not be used by the other delayed items.
+func (k Keeper) StoreRecurringDelayedExecution(
+	ctx sdk.Context,
+	id string,
+	data codec.ProtoMarshaler,
+	t time.Time,
+	interval time.Duration,
+	endTime *time.Time,
+	maxExecutions uint64,
+) error {
+	recurringItem := types.RecurringDelayedItem{
+		Id:            id,
+		Interval:      interval,
+		EndTime:       endTime,
+		MaxExecutions: maxExecutions,
+	}
+	if err := recurringItem.Validate(); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "invalid recurring delayed item: %s", err)
+	}
+	if endTime != nil && endTime.Before(t) {
+		return sdkerrors.Wrap(types.ErrInvalidInput, "end time must not be before the first execution time")
+	}
+
+	key, err := types.CreateRecurringDelayedItemKey(id)
+	if err != nil {
+		return err
+	}
+	if ctx.KVStore(k.storeKey).Has(key) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "recurring delayed item is already stored under the id: %s", id)
+	}
+
+	if err := k.StoreDelayedExecution(ctx, id, data, t); err != nil {
+		return err
+	}
+	return k.setRecurringDelayedItem(ctx, recurringItem)
+}
+
+// CancelDelayedExecution removes all the pending and failed delayed execution items stored under the id, so they are
+// never executed. If the item is recurring, its schedule is removed too.
+func (k Keeper) CancelDelayedExecution(ctx sdk.Context, id string) error {
+	idPrefix, err := types.CreateDelayedItemIDPrefix(id)
+	if err != nil {
+		return err
+	}
+	failedIDPrefix, err := types.CreateFailedDelayedItemIDPrefix(id)
+	if err != nil {
+		return err
+	}
+	recurringKey, err := types.CreateRecurringDelayedItemKey(id)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := prefix.NewStore(store, idPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	var idKeys, keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		idKeys = append(idKeys, append(append([]byte{}, idPrefix...), iterator.Key()...))
+		keys = append(keys, iterator.Value())
+	}
+
+	failedIterator := prefix.NewStore(store, failedIDPrefix).Iterator(nil, nil)
+	defer failedIterator.Close()
+
+	var failedKeys [][]byte
+	for ; failedIterator.Valid(); failedIterator.Next() {
+		failedKeys = append(failedKeys, append(append([]byte{}, failedIDPrefix...), failedIterator.Key()...))
+	}
+
+	isRecurring := store.Has(recurringKey)
+	if len(keys) == 0 && len(failedKeys) == 0 && !isRecurring {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "delayed item doesn't exist, id: %s", id)
+	}
+
+	for i := range keys {
+		store.Delete(keys[i])
+		store.Delete(idKeys[i])
+	}
+	for _, key := range failedKeys {
+		store.Delete(key)
+	}
+	if isRecurring {
+		store.Delete(recurringKey)
+	}
 	return nil
 }
 
-// ExecuteDelayedItems executes delayed logic.
+// GetDelayedItemsByID returns the pending delayed items stored under the id ordered by the execution time.
+func (k Keeper) GetDelayedItemsByID(ctx sdk.Context, id string) ([]types.DelayedItem, error) {
+	idPrefix, err := types.CreateDelayedItemIDPrefix(id)
+	if err != nil {
+		return nil, err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := prefix.NewStore(store, idPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	delayedItems := make([]types.DelayedItem, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Value()
+		item, err := k.unmarshalDelayedItem(key[len(types.DelayedItemKeyPrefix):], store.Get(key))
+		if err != nil {
+			return nil, err
+		}
+		delayedItems = append(delayedItems, item)
+	}
+	if len(delayedItems) == 0 {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "delayed item doesn't exist, id: %s", id)
+	}
+
+	return delayedItems, nil
+}
+
+// GetDelayedItems returns the pending delayed items ordered by the execution time. The items might be filtered by
+// the type URL of the data and by the execution time range. Empty type URL and nil times mean that the filter is not
+// applied.
+func (k Keeper) GetDelayedItems(
+	ctx sdk.Context,
+	typeURL string,
+	fromTime, toTime *time.Time,
+	pagination *query.PageRequest,
+) ([]types.DelayedItem, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DelayedItemKeyPrefix)
+	delayedItems := make([]types.DelayedItem, 0)
+	pageRes, err := query.FilteredPaginate(store, pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		item, err := k.unmarshalDelayedItem(key, value)
+		if err != nil {
+			return false, err
+		}
+
+		if typeURL != "" && item.Data.TypeUrl != typeURL {
+			return false, nil
+		}
+		if fromTime != nil && item.ExecutionTime.Before(*fromTime) {
+			return false, nil
+		}
+		if toTime != nil && item.ExecutionTime.After(*toTime) {
+			return false, nil
+		}
+
+		if accumulate {
+			delayedItems = append(delayedItems, item)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, sdkerrors.Wrapf(types.ErrInvalidInput, "failed to paginate: %s", err)
+	}
+
+	return delayedItems, pageRes, nil
+}
+
+// ExecuteDelayedItems executes delayed logic. Each item is executed in the cached context, so if the handler fails,
+// its changes are discarded and the item is moved to the failed items, without affecting the other items.
+// The number of items executed in a single block is limited by the params, the rest of the items ready for the
+// execution are executed in the next blocks.
 func (k Keeper) ExecuteDelayedItems(ctx sdk.Context) error {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DelayedItemKeyPrefix)
 
 	// messages will be returned from this iterator in the execution time ascending order
 	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	maxExecutedItems := k.GetParams(ctx).MaxExecutedItemsPerBlock
+	var executedItems uint32
 
 	blockTime := ctx.BlockTime()
-	for ; iter.Valid(); iter.Next() {
+	for ; iter.Valid() && executedItems < maxExecutedItems; iter.Next() {
 		key := iter.Key()
 
-		execTime, _, err := types.ExtractTimeAndIDFromDelayedItemKey(key)
+		execTime, id, err := types.ExtractTimeAndIDFromDelayedItemKey(key)
 		if err != nil {
 			return err
 		}
@@ -93,29 +285,205 @@ func (k Keeper) ExecuteDelayedItems(ctx sdk.Context) error {
 			return nil
 		}
 
+		// the item is removed before the execution, so the handler is able to store the new item using the same id
+		idKey, err := types.CreateDelayedItemIDKey(id, execTime)
+		if err != nil {
+			return err
+		}
+		value := iter.Value()
+		store.Delete(key)
+		ctx.KVStore(k.storeKey).Delete(idKey)
+		executedItems++
+
 		dataAny := &codectypes.Any{}
-		if err := k.cdc.Unmarshal(iter.Value(), dataAny); err != nil {
-			return sdkerrors.Wrapf(types.ErrInvalidData, "decoding delayed message failed: %s", err.Error())
+		if err := k.cdc.Unmarshal(value, dataAny); err != nil {
+			// the raw value is kept, so the item is not lost
+			dataAny = &codectypes.Any{Value: value}
+			err = sdkerrors.Wrapf(types.ErrInvalidData, "decoding delayed message failed: %s", err.Error())
+			if err := k.failDelayedItem(ctx, id, execTime, dataAny, err); err != nil {
+				return err
+			}
+			continue
 		}
 
+		if err := k.executeDelayedItem(ctx, id, execTime, dataAny); err != nil {
+			if err := k.failDelayedItem(ctx, id, execTime, dataAny, err); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// executeDelayedItem executes the item in the cached context, so the changes are discarded if it fails.
+func (k Keeper) executeDelayedItem(ctx sdk.Context, id string, execTime time.Time, dataAny *codectypes.Any) error {
+	var data codec.ProtoMarshaler
+	if err := k.cdc.UnpackAny(dataAny, &data); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidData, "unpacking delayed message failed: %s", err.Error())
+	}
+
+	handler, err := k.router.Handler(data)
+	if err != nil {
+		return err
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := handler(cacheCtx, data); err != nil {
+		return err
+	}
+	// the item is re-scheduled in the cached context, so if it fails, the whole execution is treated as failed
+	if err := k.rescheduleRecurringDelayedItem(cacheCtx, id, execTime, data); err != nil {
+		return err
+	}
+	writeCache()
+	// the events are emitted to the new event manager of the cached context, so they must be passed to the original one
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return nil
+}
+
+// failDelayedItem moves the item to the failed items. The schedule of the recurring item is removed, so once retried,
+// the item is executed only once.
+func (k Keeper) failDelayedItem(
+	ctx sdk.Context,
+	id string,
+	execTime time.Time,
+	dataAny *codectypes.Any,
+	execErr error,
+) error {
+	recurringKey, err := types.CreateRecurringDelayedItemKey(id)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Delete(recurringKey)
+
+	return k.storeFailedDelayedItem(ctx, types.DelayedItem{
+		Id:            id,
+		ExecutionTime: execTime,
+		Data:          dataAny,
+	}, execErr)
+}
+
+// RetryFailedExecution moves all the failed delayed items stored under the id back to the pending items, using their
+// original execution time, so they are executed again in the next block.
+func (k Keeper) RetryFailedExecution(ctx sdk.Context, id string) error {
+	failedItems, err := k.GetFailedDelayedItemsByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, failedItem := range failedItems {
 		var data codec.ProtoMarshaler
-		if err := k.cdc.UnpackAny(dataAny, &data); err != nil {
+		if err := k.registry.UnpackAny(failedItem.Item.Data, &data); err != nil {
 			return sdkerrors.Wrapf(types.ErrInvalidData, "unpacking delayed message failed: %s", err.Error())
 		}
-
-		handler, err := k.router.Handler(data)
-		if err != nil {
+		if err := k.StoreDelayedExecution(ctx, id, data, failedItem.Item.ExecutionTime); err != nil {
 			return err
 		}
-		if err := handler(ctx, data); err != nil {
+
+		key, err := types.CreateFailedDelayedItemKey(id, failedItem.Item.ExecutionTime)
+		if err != nil {
 			return err
 		}
-
 		store.Delete(key)
 	}
+
 	return nil
 }
 
+// GetFailedDelayedItems returns the delayed items which handlers failed.
+func (k Keeper) GetFailedDelayedItems(
+	ctx sdk.Context,
+	pagination *query.PageRequest,
+) ([]types.FailedDelayedItem, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FailedDelayedItemKeyPrefix)
+	failedItems := make([]types.FailedDelayedItem, 0)
+	pageRes, err := query.Paginate(store, pagination, func(key, value []byte) error {
+		var failedItem types.FailedDelayedItem
+		if err := k.cdc.Unmarshal(value, &failedItem); err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidData, "unpacking failed delayed item failed: %s", err.Error())
+		}
+		failedItems = append(failedItems, failedItem)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, sdkerrors.Wrapf(types.ErrInvalidInput, "failed to paginate: %s", err)
+	}
+
+	return failedItems, pageRes, nil
+}
+
+// GetFailedDelayedItemsByID returns the delayed items stored under the id which handlers failed, ordered by the
+// execution time.
+func (k Keeper) GetFailedDelayedItemsByID(ctx sdk.Context, id string) ([]types.FailedDelayedItem, error) {
+	idPrefix, err := types.CreateFailedDelayedItemIDPrefix(id)
+	if err != nil {
+		return nil, err
+	}
+
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), idPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	failedItems := make([]types.FailedDelayedItem, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var failedItem types.FailedDelayedItem
+		if err := k.cdc.Unmarshal(iterator.Value(), &failedItem); err != nil {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidData, "unpacking failed delayed item failed: %s", err.Error())
+		}
+		failedItems = append(failedItems, failedItem)
+	}
+	if len(failedItems) == 0 {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "failed delayed item doesn't exist, id: %s", id)
+	}
+
+	return failedItems, nil
+}
+
+// GetRecurringDelayedItems returns the schedules of the recurring delayed items.
+func (k Keeper) GetRecurringDelayedItems(
+	ctx sdk.Context,
+	pagination *query.PageRequest,
+) ([]types.RecurringDelayedItem, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RecurringDelayedItemKeyPrefix)
+	recurringItems := make([]types.RecurringDelayedItem, 0)
+	pageRes, err := query.Paginate(store, pagination, func(key, value []byte) error {
+		var recurringItem types.RecurringDelayedItem
+		if err := k.cdc.Unmarshal(value, &recurringItem); err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidData, "unpacking recurring delayed item failed: %s", err.Error())
+		}
+		recurringItems = append(recurringItems, recurringItem)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, sdkerrors.Wrapf(types.ErrInvalidInput, "failed to paginate: %s", err)
+	}
+
+	return recurringItems, pageRes, nil
+}
+
+// GetRecurringDelayedItem returns the schedule of the recurring delayed item stored under the id.
+func (k Keeper) GetRecurringDelayedItem(ctx sdk.Context, id string) (types.RecurringDelayedItem, error) {
+	key, err := types.CreateRecurringDelayedItemKey(id)
+	if err != nil {
+		return types.RecurringDelayedItem{}, err
+	}
+
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if bz == nil {
+		return types.RecurringDelayedItem{}, sdkerrors.Wrapf(
+			sdkerrors.ErrNotFound, "recurring delayed item doesn't exist, id: %s", id,
+		)
+	}
+
+	var recurringItem types.RecurringDelayedItem
+	if err := k.cdc.Unmarshal(bz, &recurringItem); err != nil {
+		return types.RecurringDelayedItem{}, sdkerrors.Wrapf(
+			types.ErrInvalidData, "unpacking recurring delayed item failed: %s", err.Error(),
+		)
+	}
+	return recurringItem, nil
+}
+
 // ImportDelayedItems imports delayed items.
 func (k Keeper) ImportDelayedItems(ctx sdk.Context, items []types.DelayedItem) error {
 	for _, i := range items {
@@ -131,27 +499,48 @@ func (k Keeper) ImportDelayedItems(ctx sdk.Context, items []types.DelayedItem) e
 	return nil
 }
 
+// ImportFailedDelayedItems imports failed delayed items.
+func (k Keeper) ImportFailedDelayedItems(ctx sdk.Context, failedItems []types.FailedDelayedItem) error {
+	for _, failedItem := range failedItems {
+		if err := k.setFailedDelayedItem(ctx, failedItem); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ImportRecurringDelayedItems imports the schedules of the recurring delayed items.
+func (k Keeper) ImportRecurringDelayedItems(ctx sdk.Context, recurringItems []types.RecurringDelayedItem) error {
+	for _, recurringItem := range recurringItems {
+		if err := k.setRecurringDelayedItem(ctx, recurringItem); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExportRecurringDelayedItems exports the schedules of the recurring delayed items.
+func (k Keeper) ExportRecurringDelayedItems(ctx sdk.Context) ([]types.RecurringDelayedItem, error) {
+	recurringItems, _, err := k.GetRecurringDelayedItems(ctx, &query.PageRequest{Limit: query.MaxLimit})
+	return recurringItems, err
+}
+
+// ExportFailedDelayedItems exports failed delayed items.
+func (k Keeper) ExportFailedDelayedItems(ctx sdk.Context) ([]types.FailedDelayedItem, error) {
+	failedItems, _, err := k.GetFailedDelayedItems(ctx, &query.PageRequest{Limit: query.MaxLimit})
+	return failedItems, err
+}
+
 // ExportDelayedItems exports delayed items.
 func (k Keeper) ExportDelayedItems(ctx sdk.Context) ([]types.DelayedItem, error) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DelayedItemKeyPrefix)
 	delayedItems := []types.DelayedItem{}
 	_, err := query.Paginate(store, &query.PageRequest{Limit: query.MaxLimit}, func(key, value []byte) error {
-		executionTime, id, err := types.ExtractTimeAndIDFromDelayedItemKey(key)
+		item, err := k.unmarshalDelayedItem(key, value)
 		if err != nil {
 			return err
 		}
-
-		data := &codectypes.Any{}
-		if err := k.cdc.Unmarshal(value, data); err != nil {
-			return sdkerrors.Wrapf(types.ErrInvalidData, "unpacking delayed message failed: %s", err.Error())
-		}
-
-		delayedItems = append(delayedItems, types.DelayedItem{
-			Id:            id,
-			ExecutionTime: executionTime,
-			Data:          data,
-		})
-
+		delayedItems = append(delayedItems, item)
 		return nil
 	})
 	if err != nil {
@@ -160,3 +549,117 @@ func (k Keeper) ExportDelayedItems(ctx sdk.Context) ([]types.DelayedItem, error)
 
 	return delayedItems, nil
 }
+
+func (k Keeper) unmarshalDelayedItem(key, value []byte) (types.DelayedItem, error) {
+	executionTime, id, err := types.ExtractTimeAndIDFromDelayedItemKey(key)
+	if err != nil {
+		return types.DelayedItem{}, err
+	}
+
+	data := &codectypes.Any{}
+	if err := k.cdc.Unmarshal(value, data); err != nil {
+		return types.DelayedItem{}, sdkerrors.Wrapf(types.ErrInvalidData, "unpacking delayed message failed: %s", err.Error())
+	}
+
+	return types.DelayedItem{
+		Id:            id,
+		ExecutionTime: executionTime,
+		Data:          data,
+	}, nil
+}
+
+func (k Keeper) storeFailedDelayedItem(ctx sdk.Context, item types.DelayedItem, handlerErr error) error {
+	if err := k.setFailedDelayedItem(ctx, types.FailedDelayedItem{
+		Item:   item,
+		Error:  handlerErr.Error(),
+		Height: ctx.BlockHeight(),
+	}); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventDelayedItemFailed{
+		Id:            item.Id,
+		ExecutionTime: item.ExecutionTime,
+		TypeURL:       item.Data.TypeUrl,
+		Error:         handlerErr.Error(),
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventDelayedItemFailed event: %s", err)
+	}
+
+	return nil
+}
+
+func (k Keeper) setFailedDelayedItem(ctx sdk.Context, failedItem types.FailedDelayedItem) error {
+	key, err := types.CreateFailedDelayedItemKey(failedItem.Item.Id, failedItem.Item.ExecutionTime)
+	if err != nil {
+		return err
+	}
+
+	b, err := k.cdc.Marshal(&failedItem)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidData, "marshaling failed delayed item failed: %s", err.Error())
+	}
+	ctx.KVStore(k.storeKey).Set(key, b)
+	return nil
+}
+
+func (k Keeper) rescheduleRecurringDelayedItem(
+	ctx sdk.Context,
+	id string,
+	execTime time.Time,
+	data codec.ProtoMarshaler,
+) error {
+	key, err := types.CreateRecurringDelayedItemKey(id)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(key)
+	if bz == nil {
+		// the item is not recurring
+		return nil
+	}
+
+	var recurringItem types.RecurringDelayedItem
+	if err := k.cdc.Unmarshal(bz, &recurringItem); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidData, "unpacking recurring delayed item failed: %s", err.Error())
+	}
+
+	recurringItem.Executions++
+	if recurringItem.MaxExecutions > 0 && recurringItem.Executions >= recurringItem.MaxExecutions {
+		store.Delete(key)
+		return nil
+	}
+
+	// if the execution was delayed for more than the interval (e.g. because of the limit of items executed in a block),
+	// the missed executions are skipped, so the item is never executed more than once in a block
+	blockTime := ctx.BlockTime()
+	nextExecTime := execTime.Add(recurringItem.Interval)
+	if !nextExecTime.After(blockTime) {
+		nextExecTime = execTime.Add((blockTime.Sub(execTime)/recurringItem.Interval + 1) * recurringItem.Interval)
+	}
+	if recurringItem.EndTime != nil && nextExecTime.After(*recurringItem.EndTime) {
+		store.Delete(key)
+		return nil
+	}
+
+	if err := k.StoreDelayedExecution(ctx, id, data, nextExecTime); err != nil {
+		return err
+	}
+	return k.setRecurringDelayedItem(ctx, recurringItem)
+}
+
+func (k Keeper) setRecurringDelayedItem(ctx sdk.Context, recurringItem types.RecurringDelayedItem) error {
+	key, err := types.CreateRecurringDelayedItemKey(recurringItem.Id)
+	if err != nil {
+		return err
+	}
+
+	b, err := k.cdc.Marshal(&recurringItem)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidData, "marshaling recurring delayed item failed: %s", err.Error())
+	}
+	ctx.KVStore(k.storeKey).Set(key, b)
+	return nil
+}
@@ -1,15 +1,20 @@
 package keeper_test
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/require"
 
+	"github.com/CoreumFoundation/coreum/v2/testutil/event"
 	"github.com/CoreumFoundation/coreum/v2/testutil/simapp"
 	"github.com/CoreumFoundation/coreum/v2/x/delay/types"
 )
@@ -61,6 +66,16 @@ func (di *delayedItem) String() string {
 
 func (di *delayedItem) ProtoMessage() {}
 
+// otherDelayedItem is used to test filtering by the type URL.
+type otherDelayedItem struct {
+	delayedItem
+}
+
+//nolint:revive,stylecheck // underscore is required
+func (di *otherDelayedItem) XXX_MessageName() string {
+	return "test.OtherDummyDelayedItem"
+}
+
 func TestDelayedExecution(t *testing.T) {
 	requireT := require.New(t)
 
@@ -126,16 +141,18 @@ func TestDelayedExecution(t *testing.T) {
 
 	requireT.Equal(expectedDelayedItems, delayedItems)
 
-	// should panic because handler is not registered
-	requireT.Panics(func() {
-		testApp.BeginNextBlock(blockTime.Add(time.Second))
-	})
+	// the handler is not registered, so the item fails without halting the chain
+	ctx = testApp.BeginNextBlock(blockTime.Add(time.Second))
+	failedItems, err := delayKeeper.GetFailedDelayedItemsByID(ctx, "delayed-id-1")
+	requireT.NoError(err)
+	requireT.Len(failedItems, 1)
 
 	executedItems := []*delayedItem{}
 	requireT.NoError(delayKeeper.Router().RegisterHandler(&delayedItem{}, func(ctx sdk.Context, data proto.Message) error {
 		executedItems = append(executedItems, data.(*delayedItem))
 		return nil
 	}))
+	requireT.NoError(delayKeeper.RetryFailedExecution(ctx, "delayed-id-1"))
 
 	// first item should be executed
 	testApp.BeginNextBlock(blockTime.Add(time.Second))
@@ -154,6 +171,439 @@ func TestDelayedExecution(t *testing.T) {
 	delayedItems, err = delayKeeper.ExportDelayedItems(ctx)
 	requireT.NoError(err)
 	requireT.Empty(delayedItems)
+	_, err = delayKeeper.GetDelayedItemsByID(ctx, "delayed-id-1")
+	requireT.ErrorIs(err, sdkerrors.ErrNotFound)
+}
+
+func TestDelayedExecutionFailure(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	testApp.InterfaceRegistry().RegisterImplementations((*codec.ProtoMarshaler)(nil), &delayedItem{})
+
+	blockTime := time.Date(2023, 4, 3, 2, 3, 4, 0, time.UTC)
+	ctx := testApp.BeginNextBlock(blockTime)
+
+	delayKeeper := testApp.DelayKeeper
+	storeKey := testApp.GetKey(types.StoreKey)
+
+	failing := true
+	executedItems := []*delayedItem{}
+	requireT.NoError(delayKeeper.Router().RegisterHandler(&delayedItem{}, func(ctx sdk.Context, data proto.Message) error {
+		item := data.(*delayedItem)
+		// the state changes are stored before the failure to check that they are discarded
+		ctx.KVStore(storeKey).Set([]byte("marker-"+item.Value), []byte{0x01})
+		if failing && item.Value == "fail" {
+			return errors.New("handler failed")
+		}
+		executedItems = append(executedItems, item)
+		return nil
+	}))
+
+	requireT.NoError(delayKeeper.StoreDelayedExecution(ctx, "delayed-id-1", &delayedItem{Value: "value1"}, blockTime))
+	requireT.NoError(delayKeeper.StoreDelayedExecution(ctx, "delayed-id-2", &delayedItem{Value: "fail"}, blockTime))
+	requireT.NoError(delayKeeper.StoreDelayedExecution(ctx, "delayed-id-3", &delayedItem{Value: "value3"}, blockTime))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	requireT.NoError(delayKeeper.ExecuteDelayedItems(ctx))
+
+	// the failure doesn't affect other items
+	requireT.Equal([]*delayedItem{{Value: "value1"}, {Value: "value3"}}, executedItems)
+	requireT.True(ctx.KVStore(storeKey).Has([]byte("marker-value1")))
+	requireT.False(ctx.KVStore(storeKey).Has([]byte("marker-fail")))
+
+	failedEvents, err := event.FindTypedEvents[*types.EventDelayedItemFailed](ctx.EventManager().Events().ToABCIEvents())
+	requireT.NoError(err)
+	requireT.Equal([]*types.EventDelayedItemFailed{
+		{
+			Id:            "delayed-id-2",
+			ExecutionTime: blockTime,
+			TypeURL:       newAny(requireT, &delayedItem{}).TypeUrl,
+			Error:         "handler failed",
+		},
+	}, failedEvents)
+
+	delayedItems, err := delayKeeper.ExportDelayedItems(ctx)
+	requireT.NoError(err)
+	requireT.Empty(delayedItems)
+
+	failedItems, _, err := delayKeeper.GetFailedDelayedItems(ctx, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Equal([]types.FailedDelayedItem{
+		{
+			Item: types.DelayedItem{
+				Id:            "delayed-id-2",
+				ExecutionTime: blockTime,
+				Data:          newAny(requireT, &delayedItem{Value: "fail"}),
+			},
+			Error:  "handler failed",
+			Height: ctx.BlockHeight(),
+		},
+	}, failedItems)
+
+	// retry the failed item
+	_, err = delayKeeper.GetFailedDelayedItemsByID(ctx, "delayed-id-1")
+	requireT.ErrorIs(err, sdkerrors.ErrNotFound)
+	requireT.ErrorIs(delayKeeper.RetryFailedExecution(ctx, "delayed-id-1"), sdkerrors.ErrNotFound)
+	requireT.NoError(delayKeeper.RetryFailedExecution(ctx, "delayed-id-2"))
+
+	failedItems, _, err = delayKeeper.GetFailedDelayedItems(ctx, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Empty(failedItems)
+
+	failing = false
+	executedItems = []*delayedItem{}
+	requireT.NoError(delayKeeper.ExecuteDelayedItems(ctx))
+	requireT.Equal([]*delayedItem{{Value: "fail"}}, executedItems)
+	requireT.True(ctx.KVStore(storeKey).Has([]byte("marker-fail")))
+}
+
+func TestDelayedExecutionInvalidItems(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	testApp.InterfaceRegistry().RegisterImplementations((*codec.ProtoMarshaler)(nil), &delayedItem{})
+
+	blockTime := time.Date(2023, 4, 3, 2, 3, 4, 0, time.UTC)
+	ctx := testApp.BeginNextBlock(blockTime)
+
+	delayKeeper := testApp.DelayKeeper
+
+	executedItems := []*delayedItem{}
+	requireT.NoError(delayKeeper.Router().RegisterHandler(&delayedItem{}, func(ctx sdk.Context, data proto.Message) error {
+		executedItems = append(executedItems, data.(*delayedItem))
+		return nil
+	}))
+
+	// the type of the first item is not registered, so it can't be unpacked
+	requireT.NoError(delayKeeper.StoreRecurringDelayedExecution(
+		ctx, "delayed-id-1", &otherDelayedItem{delayedItem{Value: "other1"}}, blockTime, time.Second, nil, 0,
+	))
+	requireT.NoError(delayKeeper.StoreDelayedExecution(ctx, "delayed-id-2", &delayedItem{Value: "value2"}, blockTime))
+	requireT.NoError(delayKeeper.ExecuteDelayedItems(ctx))
+
+	// the type of the next item is registered, but there is no handler for it
+	testApp.InterfaceRegistry().RegisterImplementations((*codec.ProtoMarshaler)(nil), &otherDelayedItem{})
+	requireT.NoError(delayKeeper.StoreDelayedExecution(
+		ctx, "delayed-id-3", &otherDelayedItem{delayedItem{Value: "other3"}}, blockTime,
+	))
+	requireT.NoError(delayKeeper.StoreDelayedExecution(ctx, "delayed-id-4", &delayedItem{Value: "value4"}, blockTime))
+	requireT.NoError(delayKeeper.ExecuteDelayedItems(ctx))
+
+	// the invalid items don't affect the other items
+	requireT.Equal([]*delayedItem{{Value: "value2"}, {Value: "value4"}}, executedItems)
+
+	delayedItems, err := delayKeeper.ExportDelayedItems(ctx)
+	requireT.NoError(err)
+	requireT.Empty(delayedItems)
+	_, err = delayKeeper.GetRecurringDelayedItem(ctx, "delayed-id-1")
+	requireT.ErrorIs(err, sdkerrors.ErrNotFound)
+
+	failedItems, _, err := delayKeeper.GetFailedDelayedItems(ctx, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Len(failedItems, 2)
+	requireT.Equal("delayed-id-1", failedItems[0].Item.Id)
+	requireT.Contains(failedItems[0].Error, "unpacking delayed message failed")
+	requireT.Equal("delayed-id-3", failedItems[1].Item.Id)
+	requireT.Contains(failedItems[1].Error, "route \"test.OtherDummyDelayedItem\" does not exist")
+
+	// cancellation removes the failed items too
+	requireT.NoError(delayKeeper.CancelDelayedExecution(ctx, "delayed-id-3"))
+	_, err = delayKeeper.GetFailedDelayedItemsByID(ctx, "delayed-id-3")
+	requireT.ErrorIs(err, sdkerrors.ErrNotFound)
+	requireT.ErrorIs(delayKeeper.CancelDelayedExecution(ctx, "delayed-id-3"), sdkerrors.ErrNotFound)
+}
+
+func TestDelayedExecutionLimit(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	testApp.InterfaceRegistry().RegisterImplementations((*codec.ProtoMarshaler)(nil), &delayedItem{})
+
+	blockTime := time.Date(2023, 4, 3, 2, 3, 4, 0, time.UTC)
+	ctx := testApp.BeginNextBlock(blockTime)
+
+	delayKeeper := testApp.DelayKeeper
+
+	params := delayKeeper.GetParams(ctx)
+	params.MaxExecutedItemsPerBlock = 2
+	delayKeeper.SetParams(ctx, params)
+
+	executedItems := []*delayedItem{}
+	requireT.NoError(delayKeeper.Router().RegisterHandler(&delayedItem{}, func(ctx sdk.Context, data proto.Message) error {
+		executedItems = append(executedItems, data.(*delayedItem))
+		return nil
+	}))
+
+	for i := 0; i < 5; i++ {
+		requireT.NoError(delayKeeper.StoreDelayedExecution(
+			ctx,
+			fmt.Sprintf("delayed-id-%d", i),
+			&delayedItem{Value: fmt.Sprintf("value%d", i)},
+			blockTime.Add(time.Duration(i)*time.Second),
+		))
+	}
+
+	// the items are executed in the execution time order, not more than the limit in a single block
+	ctx = ctx.WithBlockTime(blockTime.Add(time.Hour))
+	requireT.NoError(delayKeeper.ExecuteDelayedItems(ctx))
+	requireT.Equal([]*delayedItem{{Value: "value0"}, {Value: "value1"}}, executedItems)
+
+	requireT.NoError(delayKeeper.ExecuteDelayedItems(ctx))
+	requireT.NoError(delayKeeper.ExecuteDelayedItems(ctx))
+	requireT.Equal([]*delayedItem{
+		{Value: "value0"}, {Value: "value1"}, {Value: "value2"}, {Value: "value3"}, {Value: "value4"},
+	}, executedItems)
+}
+
+func TestRecurringDelayedExecution(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	testApp.InterfaceRegistry().RegisterImplementations((*codec.ProtoMarshaler)(nil), &delayedItem{})
+
+	blockTime := time.Date(2023, 4, 3, 2, 3, 4, 0, time.UTC)
+	ctx := testApp.BeginNextBlock(blockTime)
+
+	delayKeeper := testApp.DelayKeeper
+
+	failing := false
+	executedItems := []*delayedItem{}
+	requireT.NoError(delayKeeper.Router().RegisterHandler(&delayedItem{}, func(ctx sdk.Context, data proto.Message) error {
+		if failing {
+			return errors.New("handler failed")
+		}
+		executedItems = append(executedItems, data.(*delayedItem))
+		return nil
+	}))
+
+	endTime := blockTime.Add(25 * time.Second)
+
+	// invalid items
+	requireT.ErrorIs(delayKeeper.StoreRecurringDelayedExecution(
+		ctx, "invalid", &delayedItem{Value: "invalid"}, blockTime, time.Millisecond, nil, 0,
+	), types.ErrInvalidInput)
+	requireT.ErrorIs(delayKeeper.StoreRecurringDelayedExecution(
+		ctx, "invalid", &delayedItem{Value: "invalid"}, endTime.Add(time.Second), time.Second, &endTime, 0,
+	), types.ErrInvalidInput)
+
+	requireT.NoError(delayKeeper.StoreRecurringDelayedExecution(
+		ctx, "count", &delayedItem{Value: "count"}, blockTime.Add(10*time.Second), 10*time.Second, nil, 3,
+	))
+	requireT.NoError(delayKeeper.StoreRecurringDelayedExecution(
+		ctx, "end-time", &delayedItem{Value: "end-time"}, blockTime.Add(10*time.Second), 10*time.Second, &endTime, 0,
+	))
+	requireT.NoError(delayKeeper.StoreRecurringDelayedExecution(
+		ctx, "unlimited", &delayedItem{Value: "unlimited"}, blockTime.Add(10*time.Second), 10*time.Second, nil, 0,
+	))
+	requireT.ErrorIs(delayKeeper.StoreRecurringDelayedExecution(
+		ctx, "unlimited", &delayedItem{Value: "unlimited"}, blockTime.Add(15*time.Second), 10*time.Second, nil, 0,
+	), sdkerrors.ErrUnauthorized)
+
+	recurringItems, _, err := delayKeeper.GetRecurringDelayedItems(ctx, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Len(recurringItems, 3)
+
+	// first execution
+	ctx = ctx.WithBlockTime(blockTime.Add(10 * time.Second))
+	requireT.NoError(delayKeeper.ExecuteDelayedItems(ctx))
+	requireT.Equal([]*delayedItem{{Value: "count"}, {Value: "end-time"}, {Value: "unlimited"}}, executedItems)
+
+	recurringItem, err := delayKeeper.GetRecurringDelayedItem(ctx, "count")
+	requireT.NoError(err)
+	requireT.Equal(types.RecurringDelayedItem{
+		Id:            "count",
+		Interval:      10 * time.Second,
+		MaxExecutions: 3,
+		Executions:    1,
+	}, recurringItem)
+
+	delayedItems, err := delayKeeper.GetDelayedItemsByID(ctx, "count")
+	requireT.NoError(err)
+	requireT.Len(delayedItems, 1)
+	requireT.Equal(blockTime.Add(20*time.Second), delayedItems[0].ExecutionTime)
+
+	// second execution, the end time is reached
+	executedItems = []*delayedItem{}
+	ctx = ctx.WithBlockTime(blockTime.Add(20 * time.Second))
+	requireT.NoError(delayKeeper.ExecuteDelayedItems(ctx))
+	requireT.Equal([]*delayedItem{{Value: "count"}, {Value: "end-time"}, {Value: "unlimited"}}, executedItems)
+
+	_, err = delayKeeper.GetRecurringDelayedItem(ctx, "end-time")
+	requireT.ErrorIs(err, sdkerrors.ErrNotFound)
+	_, err = delayKeeper.GetDelayedItemsByID(ctx, "end-time")
+	requireT.ErrorIs(err, sdkerrors.ErrNotFound)
+
+	// third execution is delayed, the max number of executions is reached and missed execution is skipped
+	executedItems = []*delayedItem{}
+	ctx = ctx.WithBlockTime(blockTime.Add(45 * time.Second))
+	requireT.NoError(delayKeeper.ExecuteDelayedItems(ctx))
+	requireT.Equal([]*delayedItem{{Value: "count"}, {Value: "unlimited"}}, executedItems)
+
+	_, err = delayKeeper.GetRecurringDelayedItem(ctx, "count")
+	requireT.ErrorIs(err, sdkerrors.ErrNotFound)
+	_, err = delayKeeper.GetDelayedItemsByID(ctx, "count")
+	requireT.ErrorIs(err, sdkerrors.ErrNotFound)
+
+	delayedItems, err = delayKeeper.GetDelayedItemsByID(ctx, "unlimited")
+	requireT.NoError(err)
+	requireT.Len(delayedItems, 1)
+	requireT.Equal(blockTime.Add(50*time.Second), delayedItems[0].ExecutionTime)
+
+	// failed item is not re-scheduled anymore, once retried it is executed only once
+	failing = true
+	executedItems = []*delayedItem{}
+	ctx = ctx.WithBlockTime(blockTime.Add(50 * time.Second))
+	requireT.NoError(delayKeeper.ExecuteDelayedItems(ctx))
+	requireT.Empty(executedItems)
+
+	_, err = delayKeeper.GetDelayedItemsByID(ctx, "unlimited")
+	requireT.ErrorIs(err, sdkerrors.ErrNotFound)
+	_, err = delayKeeper.GetRecurringDelayedItem(ctx, "unlimited")
+	requireT.ErrorIs(err, sdkerrors.ErrNotFound)
+
+	failing = false
+	requireT.NoError(delayKeeper.RetryFailedExecution(ctx, "unlimited"))
+	ctx = ctx.WithBlockTime(blockTime.Add(51 * time.Second))
+	requireT.NoError(delayKeeper.ExecuteDelayedItems(ctx))
+	requireT.Equal([]*delayedItem{{Value: "unlimited"}}, executedItems)
+
+	_, err = delayKeeper.GetDelayedItemsByID(ctx, "unlimited")
+	requireT.ErrorIs(err, sdkerrors.ErrNotFound)
+
+	// cancellation removes the schedule
+	requireT.NoError(delayKeeper.StoreRecurringDelayedExecution(
+		ctx, "unlimited", &delayedItem{Value: "unlimited"}, blockTime.Add(60*time.Second), 10*time.Second, nil, 0,
+	))
+	requireT.NoError(delayKeeper.CancelDelayedExecution(ctx, "unlimited"))
+	_, err = delayKeeper.GetRecurringDelayedItem(ctx, "unlimited")
+	requireT.ErrorIs(err, sdkerrors.ErrNotFound)
+	requireT.ErrorIs(delayKeeper.CancelDelayedExecution(ctx, "unlimited"), sdkerrors.ErrNotFound)
+
+	recurringItems, _, err = delayKeeper.GetRecurringDelayedItems(ctx, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Empty(recurringItems)
+}
+
+func TestCancelDelayedExecution(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	testApp.InterfaceRegistry().RegisterImplementations((*codec.ProtoMarshaler)(nil), &delayedItem{})
+
+	blockTime := time.Date(2023, 4, 3, 2, 3, 4, 0, time.UTC)
+	ctx := testApp.BeginNextBlock(blockTime)
+
+	delayed1 := &delayedItem{
+		Value: "value1",
+	}
+	delayed2 := &delayedItem{
+		Value: "value2",
+	}
+	delayed3 := &delayedItem{
+		Value: "value3",
+	}
+
+	delayKeeper := testApp.DelayKeeper
+
+	requireT.NoError(delayKeeper.StoreDelayedExecution(ctx, "delayed-id-1", delayed1, blockTime.Add(time.Second)))
+	requireT.NoError(delayKeeper.StoreDelayedExecution(ctx, "delayed-id-1", delayed2, blockTime.Add(2*time.Second)))
+	requireT.NoError(delayKeeper.StoreDelayedExecution(ctx, "delayed-id-2", delayed3, blockTime.Add(time.Second)))
+
+	// all the items stored under the id are cancelled
+	requireT.NoError(delayKeeper.CancelDelayedExecution(ctx, "delayed-id-1"))
+
+	// cancelling the items again fails
+	requireT.ErrorIs(delayKeeper.CancelDelayedExecution(ctx, "delayed-id-1"), sdkerrors.ErrNotFound)
+	_, err := delayKeeper.GetDelayedItemsByID(ctx, "delayed-id-1")
+	requireT.ErrorIs(err, sdkerrors.ErrNotFound)
+
+	delayedItems, err := delayKeeper.ExportDelayedItems(ctx)
+	requireT.NoError(err)
+	requireT.Equal([]types.DelayedItem{
+		{
+			Id:            "delayed-id-2",
+			ExecutionTime: blockTime.Add(time.Second),
+			Data:          newAny(requireT, delayed3),
+		},
+	}, delayedItems)
+
+	// the id might be used again
+	requireT.NoError(delayKeeper.StoreDelayedExecution(ctx, "delayed-id-1", delayed1, blockTime.Add(time.Second)))
+	delayedItems, err = delayKeeper.GetDelayedItemsByID(ctx, "delayed-id-1")
+	requireT.NoError(err)
+	requireT.Equal([]types.DelayedItem{
+		{
+			Id:            "delayed-id-1",
+			ExecutionTime: blockTime.Add(time.Second),
+			Data:          newAny(requireT, delayed1),
+		},
+	}, delayedItems)
+}
+
+func TestGetDelayedItems(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	testApp.InterfaceRegistry().RegisterImplementations((*codec.ProtoMarshaler)(nil), &delayedItem{})
+	testApp.InterfaceRegistry().RegisterImplementations((*codec.ProtoMarshaler)(nil), &otherDelayedItem{})
+
+	blockTime := time.Date(2023, 4, 3, 2, 3, 4, 0, time.UTC)
+	ctx := testApp.BeginNextBlock(blockTime)
+
+	delayKeeper := testApp.DelayKeeper
+
+	for i := 0; i < 5; i++ {
+		requireT.NoError(delayKeeper.StoreDelayedExecution(
+			ctx,
+			fmt.Sprintf("delayed-id-%d", i),
+			&delayedItem{Value: fmt.Sprintf("value%d", i)},
+			blockTime.Add(time.Duration(i)*time.Second),
+		))
+		requireT.NoError(delayKeeper.StoreDelayedExecution(
+			ctx,
+			fmt.Sprintf("other-delayed-id-%d", i),
+			&otherDelayedItem{delayedItem{Value: fmt.Sprintf("other%d", i)}},
+			blockTime.Add(time.Duration(i)*time.Second),
+		))
+	}
+
+	ids := func(items []types.DelayedItem) []string {
+		res := make([]string, 0, len(items))
+		for _, item := range items {
+			res = append(res, item.Id)
+		}
+		return res
+	}
+
+	// all the items
+	delayedItems, pageRes, err := delayKeeper.GetDelayedItems(ctx, "", nil, nil, &query.PageRequest{CountTotal: true})
+	requireT.NoError(err)
+	requireT.Len(delayedItems, 10)
+	requireT.EqualValues(10, pageRes.Total)
+
+	// filtered by the type URL and paginated
+	typeURL := newAny(requireT, &delayedItem{}).TypeUrl
+	delayedItems, pageRes, err = delayKeeper.GetDelayedItems(ctx, typeURL, nil, nil, &query.PageRequest{Limit: 3})
+	requireT.NoError(err)
+	requireT.Equal([]string{"delayed-id-0", "delayed-id-1", "delayed-id-2"}, ids(delayedItems))
+
+	delayedItems, _, err = delayKeeper.GetDelayedItems(ctx, typeURL, nil, nil, &query.PageRequest{Key: pageRes.NextKey})
+	requireT.NoError(err)
+	requireT.Equal([]string{"delayed-id-3", "delayed-id-4"}, ids(delayedItems))
+
+	// filtered by the time range
+	fromTime := blockTime.Add(time.Second)
+	toTime := blockTime.Add(2 * time.Second)
+	delayedItems, _, err = delayKeeper.GetDelayedItems(ctx, typeURL, &fromTime, &toTime, nil)
+	requireT.NoError(err)
+	requireT.Equal([]string{"delayed-id-1", "delayed-id-2"}, ids(delayedItems))
+
+	delayedItems, _, err = delayKeeper.GetDelayedItems(ctx, "", &toTime, nil, nil)
+	requireT.NoError(err)
+	requireT.Equal([]string{
+		"delayed-id-2", "other-delayed-id-2", "delayed-id-3", "other-delayed-id-3", "delayed-id-4", "other-delayed-id-4",
+	}, ids(delayedItems))
 }
 
 func newAny(requireT *require.Assertions, data codec.ProtoMarshaler) *codectypes.Any {
@@ -0,0 +1,32 @@
+package v1
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CoreumFoundation/coreum/v2/pkg/store"
+	"github.com/CoreumFoundation/coreum/v2/x/delay/types"
+)
+
+// MigrateIDIndex migrates delay store from v1 to v2.
+// It indexes the keys of the pending delayed items by the item id.
+func MigrateIDIndex(ctx sdk.Context, storeKey sdk.StoreKey) error {
+	moduleStore := ctx.KVStore(storeKey)
+	iterator := prefix.NewStore(moduleStore, types.DelayedItemKeyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		executionTime, id, err := types.ExtractTimeAndIDFromDelayedItemKey(iterator.Key())
+		if err != nil {
+			return err
+		}
+
+		idKey, err := types.CreateDelayedItemIDKey(id, executionTime)
+		if err != nil {
+			return err
+		}
+		moduleStore.Set(idKey, store.JoinKeys(types.DelayedItemKeyPrefix, iterator.Key()))
+	}
+
+	return nil
+}
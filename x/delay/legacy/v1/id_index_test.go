@@ -0,0 +1,64 @@
+package v1_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v2/testutil/simapp"
+	assetfttypes "github.com/CoreumFoundation/coreum/v2/x/asset/ft/types"
+	v1 "github.com/CoreumFoundation/coreum/v2/x/delay/legacy/v1"
+	"github.com/CoreumFoundation/coreum/v2/x/delay/types"
+)
+
+func TestMigrateIDIndex(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	blockTime := time.Date(2023, 4, 3, 2, 3, 4, 0, time.UTC)
+	ctx := testApp.BeginNextBlock(blockTime)
+
+	delayKeeper := testApp.DelayKeeper
+	storeKey := testApp.GetKey(types.StoreKey)
+
+	requireT.NoError(delayKeeper.StoreDelayedExecution(
+		ctx, "delayed-id-1", &assetfttypes.DelayedTokenUpgradeV1{Denom: "denom1"}, blockTime.Add(time.Second),
+	))
+	requireT.NoError(delayKeeper.StoreDelayedExecution(
+		ctx, "delayed-id-1", &assetfttypes.DelayedTokenUpgradeV1{Denom: "denom2"}, blockTime.Add(2*time.Second),
+	))
+	requireT.NoError(delayKeeper.StoreDelayedExecution(
+		ctx, "delayed-id-2", &assetfttypes.DelayedTokenUpgradeV1{Denom: "denom3"}, blockTime.Add(time.Second),
+	))
+
+	// bring the store to the state it had before the index was introduced
+	indexStore := prefix.NewStore(ctx.KVStore(storeKey), types.DelayedItemIDKeyPrefix)
+	iterator := indexStore.Iterator(nil, nil)
+	var indexKeys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		indexKeys = append(indexKeys, iterator.Key())
+	}
+	requireT.NoError(iterator.Close())
+	requireT.Len(indexKeys, 3)
+	for _, key := range indexKeys {
+		indexStore.Delete(key)
+	}
+	_, err := delayKeeper.GetDelayedItemsByID(ctx, "delayed-id-1")
+	requireT.ErrorIs(err, sdkerrors.ErrNotFound)
+
+	requireT.NoError(v1.MigrateIDIndex(ctx, storeKey))
+
+	delayedItems, err := delayKeeper.GetDelayedItemsByID(ctx, "delayed-id-1")
+	requireT.NoError(err)
+	requireT.Len(delayedItems, 2)
+	requireT.Equal(blockTime.Add(time.Second), delayedItems[0].ExecutionTime)
+	requireT.Equal(blockTime.Add(2*time.Second), delayedItems[1].ExecutionTime)
+
+	requireT.NoError(delayKeeper.CancelDelayedExecution(ctx, "delayed-id-2"))
+	delayedItems, err = delayKeeper.ExportDelayedItems(ctx)
+	requireT.NoError(err)
+	requireT.Len(delayedItems, 2)
+}
@@ -0,0 +1,18 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CoreumFoundation/coreum/v2/x/delay/types"
+)
+
+// ParamsKeeper specifies methods of the delay keeper required by the migration.
+type ParamsKeeper interface {
+	SetParams(ctx sdk.Context, params types.Params)
+}
+
+// MigrateParams migrates delay params state from v1 to v2.
+// Params were introduced in v2, so they are set to the default values.
+func MigrateParams(ctx sdk.Context, keeper ParamsKeeper) {
+	keeper.SetParams(ctx, types.DefaultParams())
+}
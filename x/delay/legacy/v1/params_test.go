@@ -0,0 +1,26 @@
+package v1_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/v2/testutil/simapp"
+	v1 "github.com/CoreumFoundation/coreum/v2/x/delay/legacy/v1"
+	"github.com/CoreumFoundation/coreum/v2/x/delay/types"
+)
+
+func TestMigrateParams(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+
+	keeper := testApp.DelayKeeper
+	keeper.SetParams(ctx, types.Params{MaxExecutedItemsPerBlock: 1})
+
+	v1.MigrateParams(ctx, keeper)
+
+	requireT.Equal(types.DefaultParams(), keeper.GetParams(ctx))
+}
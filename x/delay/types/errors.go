@@ -11,4 +11,6 @@ var (
 	ErrInvalidInput = sdkerrors.Register(ModuleName, 2, "invalid input")
 	// ErrInvalidConfiguration is returned when something is wrong with the configuration.
 	ErrInvalidConfiguration = sdkerrors.Register(ModuleName, 3, "invalid configuration")
+	// ErrInvalidState is returned when state of the module is invalid.
+	ErrInvalidState = sdkerrors.Register(ModuleName, 4, "invalid state")
 )
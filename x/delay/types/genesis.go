@@ -1,14 +1,36 @@
 package types
 
-import "errors"
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
 
 // Validate performs basic genesis state validation returning an error upon any failure.
 func (gs GenesisState) Validate() error {
+	if err := gs.Params.ValidateBasic(); err != nil {
+		return err
+	}
 	for _, di := range gs.DelayedItems {
 		if err := di.Validate(); err != nil {
 			return err
 		}
 	}
+	for _, fdi := range gs.FailedDelayedItems {
+		if err := fdi.Validate(); err != nil {
+			return err
+		}
+	}
+	recurringIDs := map[string]struct{}{}
+	for _, rdi := range gs.RecurringDelayedItems {
+		if err := rdi.Validate(); err != nil {
+			return err
+		}
+		if _, exists := recurringIDs[rdi.Id]; exists {
+			return errors.Errorf("duplicated recurring delayed item, id: %s", rdi.Id)
+		}
+		recurringIDs[rdi.Id] = struct{}{}
+	}
 	return nil
 }
 
@@ -25,3 +47,35 @@ func (di DelayedItem) Validate() error {
 	}
 	return nil
 }
+
+// Validate checks all the fields are valid.
+func (fdi FailedDelayedItem) Validate() error {
+	if err := fdi.Item.Validate(); err != nil {
+		return err
+	}
+	if fdi.Error == "" {
+		return errors.New("error is empty")
+	}
+	if fdi.Height < 0 {
+		return errors.New("height must be non-negative")
+	}
+	return nil
+}
+
+// Validate checks all the fields are valid.
+func (rdi RecurringDelayedItem) Validate() error {
+	if rdi.Id == "" {
+		return errors.New("id is empty")
+	}
+	// the execution time is stored with the precision of seconds
+	if rdi.Interval < time.Second {
+		return errors.New("interval must be at least one second")
+	}
+	if rdi.EndTime != nil && rdi.EndTime.Unix() < 0 {
+		return errors.New("unix timestamp of the end time must be non-negative")
+	}
+	if rdi.MaxExecutions > 0 && rdi.Executions >= rdi.MaxExecutions {
+		return errors.New("executions must be lower than max executions")
+	}
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -32,6 +33,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// tokens keep the fungible token state
 	DelayedItems []DelayedItem `protobuf:"bytes,1,rep,name=delayed_items,json=delayedItems,proto3" json:"delayed_items"`
+	// params defines all the parameters of the module.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// failed_delayed_items keep the delayed items which handlers failed
+	FailedDelayedItems []FailedDelayedItem `protobuf:"bytes,3,rep,name=failed_delayed_items,json=failedDelayedItems,proto3" json:"failed_delayed_items"`
+	// recurring_delayed_items keep the schedules of the recurring delayed items
+	RecurringDelayedItems []RecurringDelayedItem `protobuf:"bytes,4,rep,name=recurring_delayed_items,json=recurringDelayedItems,proto3" json:"recurring_delayed_items"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -74,6 +81,27 @@ func (m *GenesisState) GetDelayedItems() []DelayedItem {
 	return nil
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetFailedDelayedItems() []FailedDelayedItem {
+	if m != nil {
+		return m.FailedDelayedItems
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRecurringDelayedItems() []RecurringDelayedItem {
+	if m != nil {
+		return m.RecurringDelayedItems
+	}
+	return nil
+}
+
 type DelayedItem struct {
 	Id            string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	ExecutionTime time.Time  `protobuf:"bytes,2,opt,name=execution_time,json=executionTime,proto3,stdtime" json:"execution_time"`
@@ -134,36 +162,195 @@ func (m *DelayedItem) GetData() *types.Any {
 	return nil
 }
 
+// FailedDelayedItem is the delayed item which handler failed.
+type FailedDelayedItem struct {
+	Item DelayedItem `protobuf:"bytes,1,opt,name=item,proto3" json:"item"`
+	// error is the error returned by the handler.
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// height is the height of the block in which the handler failed.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *FailedDelayedItem) Reset()         { *m = FailedDelayedItem{} }
+func (m *FailedDelayedItem) String() string { return proto.CompactTextString(m) }
+func (*FailedDelayedItem) ProtoMessage()    {}
+func (*FailedDelayedItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2af93fbb6951584, []int{2}
+}
+func (m *FailedDelayedItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FailedDelayedItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FailedDelayedItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FailedDelayedItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FailedDelayedItem.Merge(m, src)
+}
+func (m *FailedDelayedItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *FailedDelayedItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_FailedDelayedItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FailedDelayedItem proto.InternalMessageInfo
+
+func (m *FailedDelayedItem) GetItem() DelayedItem {
+	if m != nil {
+		return m.Item
+	}
+	return DelayedItem{}
+}
+
+func (m *FailedDelayedItem) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *FailedDelayedItem) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// RecurringDelayedItem is the schedule of the delayed item re-scheduled after each successful execution.
+type RecurringDelayedItem struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// interval is the period between the executions.
+	Interval time.Duration `protobuf:"bytes,2,opt,name=interval,proto3,stdduration" json:"interval"`
+	// end_time is the time after which the item is not re-scheduled anymore, if set.
+	EndTime *time.Time `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty"`
+	// max_executions is the number of executions after which the item is not re-scheduled anymore, 0 means no limit.
+	MaxExecutions uint64 `protobuf:"varint,4,opt,name=max_executions,json=maxExecutions,proto3" json:"max_executions,omitempty"`
+	// executions is the number of successful executions done so far.
+	Executions uint64 `protobuf:"varint,5,opt,name=executions,proto3" json:"executions,omitempty"`
+}
+
+func (m *RecurringDelayedItem) Reset()         { *m = RecurringDelayedItem{} }
+func (m *RecurringDelayedItem) String() string { return proto.CompactTextString(m) }
+func (*RecurringDelayedItem) ProtoMessage()    {}
+func (*RecurringDelayedItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2af93fbb6951584, []int{3}
+}
+func (m *RecurringDelayedItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecurringDelayedItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecurringDelayedItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecurringDelayedItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecurringDelayedItem.Merge(m, src)
+}
+func (m *RecurringDelayedItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecurringDelayedItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecurringDelayedItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecurringDelayedItem proto.InternalMessageInfo
+
+func (m *RecurringDelayedItem) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *RecurringDelayedItem) GetInterval() time.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *RecurringDelayedItem) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+func (m *RecurringDelayedItem) GetMaxExecutions() uint64 {
+	if m != nil {
+		return m.MaxExecutions
+	}
+	return 0
+}
+
+func (m *RecurringDelayedItem) GetExecutions() uint64 {
+	if m != nil {
+		return m.Executions
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "coreum.delay.v1.GenesisState")
 	proto.RegisterType((*DelayedItem)(nil), "coreum.delay.v1.DelayedItem")
+	proto.RegisterType((*FailedDelayedItem)(nil), "coreum.delay.v1.FailedDelayedItem")
+	proto.RegisterType((*RecurringDelayedItem)(nil), "coreum.delay.v1.RecurringDelayedItem")
 }
 
 func init() { proto.RegisterFile("coreum/delay/genesis.proto", fileDescriptor_b2af93fbb6951584) }
 
 var fileDescriptor_b2af93fbb6951584 = []byte{
-	// 325 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0x4f, 0x4f, 0xc2, 0x30,
-	0x18, 0xc6, 0x57, 0x20, 0x46, 0xcb, 0x1f, 0x93, 0x85, 0xc3, 0x5c, 0xcc, 0x20, 0x9c, 0x76, 0x6a,
-	0x23, 0x7c, 0x02, 0xd1, 0x48, 0x8c, 0xf1, 0x32, 0x4d, 0x4c, 0xbc, 0x90, 0x42, 0x5f, 0x67, 0x13,
-	0xb6, 0x12, 0xda, 0x11, 0xf6, 0x2d, 0x38, 0xf8, 0xa1, 0x38, 0x72, 0xf4, 0xa4, 0x06, 0xbe, 0x88,
-	0x59, 0x0b, 0x6a, 0xf0, 0xd6, 0xf6, 0xf9, 0xf5, 0x7d, 0x9e, 0x37, 0x0f, 0xf6, 0xc7, 0x72, 0x06,
-	0x59, 0x42, 0x39, 0x4c, 0x58, 0x4e, 0x63, 0x48, 0x41, 0x09, 0x45, 0xa6, 0x33, 0xa9, 0xa5, 0x7b,
-	0x6a, 0x35, 0x62, 0x34, 0x32, 0xbf, 0xf0, 0x9b, 0xb1, 0x8c, 0xa5, 0xd1, 0x68, 0x71, 0xb2, 0x98,
-	0xdf, 0x8a, 0xa5, 0x8c, 0x27, 0x40, 0xcd, 0x6d, 0x94, 0xbd, 0x50, 0x2d, 0x12, 0x50, 0x9a, 0x25,
-	0xd3, 0x1d, 0x70, 0x76, 0x08, 0xb0, 0x34, 0xb7, 0x52, 0xe7, 0x09, 0xd7, 0x06, 0xd6, 0xf3, 0x41,
-	0x33, 0x0d, 0xee, 0x00, 0xd7, 0x8d, 0x1b, 0xf0, 0xa1, 0xd0, 0x90, 0x28, 0x0f, 0xb5, 0xcb, 0x61,
-	0xb5, 0x7b, 0x4e, 0x0e, 0xa2, 0x90, 0x6b, 0x4b, 0xdd, 0x6a, 0x48, 0xfa, 0x95, 0xd5, 0x47, 0xcb,
-	0x89, 0x6a, 0xfc, 0xf7, 0x49, 0x75, 0xde, 0x10, 0xae, 0xfe, 0x61, 0xdc, 0x06, 0x2e, 0x09, 0xee,
-	0xa1, 0x36, 0x0a, 0x4f, 0xa2, 0x92, 0xe0, 0xee, 0x1d, 0x6e, 0xc0, 0x02, 0xc6, 0x99, 0x16, 0x32,
-	0x1d, 0x16, 0x81, 0xbd, 0x52, 0x1b, 0x85, 0xd5, 0xae, 0x4f, 0x6c, 0x58, 0xb2, 0x0f, 0x4b, 0x1e,
-	0xf7, 0xdb, 0xf4, 0x8f, 0x0b, 0x9f, 0xe5, 0x67, 0x0b, 0x45, 0xf5, 0x9f, 0xbf, 0x85, 0xea, 0x86,
-	0xb8, 0xc2, 0x99, 0x66, 0x5e, 0xd9, 0x8c, 0x68, 0xfe, 0x1b, 0x71, 0x99, 0xe6, 0x91, 0x21, 0xfa,
-	0xf7, 0xab, 0x4d, 0x80, 0xd6, 0x9b, 0x00, 0x7d, 0x6d, 0x02, 0xb4, 0xdc, 0x06, 0xce, 0x7a, 0x1b,
-	0x38, 0xef, 0xdb, 0xc0, 0x79, 0xee, 0xc5, 0x42, 0xbf, 0x66, 0x23, 0x32, 0x96, 0x09, 0xbd, 0x32,
-	0xcb, 0xde, 0xc8, 0x2c, 0xe5, 0xac, 0x30, 0xa1, 0xbb, 0x92, 0xe6, 0x5d, 0xba, 0xd8, 0x35, 0xa5,
-	0xf3, 0x29, 0xa8, 0xd1, 0x91, 0xb1, 0xe8, 0x7d, 0x07, 0x00, 0x00, 0xff, 0xff, 0x46, 0x3e, 0x7c,
-	0x4d, 0xc6, 0x01, 0x00, 0x00,
+	// 543 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x41, 0x6f, 0x12, 0x41,
+	0x14, 0x66, 0x60, 0x8b, 0x74, 0x28, 0x18, 0x27, 0x68, 0x29, 0x31, 0x0b, 0x21, 0x69, 0xc2, 0x69,
+	0x37, 0xd2, 0xe8, 0xc5, 0x83, 0x11, 0x6b, 0x1b, 0x63, 0x4c, 0xcc, 0xea, 0xa9, 0x17, 0x32, 0x30,
+	0xc3, 0x32, 0x09, 0xbb, 0x43, 0x66, 0x67, 0x09, 0xfc, 0x8b, 0x1e, 0x3c, 0xf8, 0x93, 0x7a, 0xb3,
+	0x47, 0x4f, 0x6a, 0xe0, 0xe8, 0x9f, 0x30, 0xfb, 0x76, 0x40, 0xba, 0xdb, 0x44, 0x6f, 0x3b, 0xef,
+	0xfb, 0xe6, 0x9b, 0xef, 0x7d, 0xef, 0x2d, 0x6e, 0x8d, 0xa5, 0xe2, 0x71, 0xe0, 0x32, 0x3e, 0xa3,
+	0x2b, 0xd7, 0xe7, 0x21, 0x8f, 0x44, 0xe4, 0xcc, 0x95, 0xd4, 0x92, 0x3c, 0x4c, 0x31, 0x07, 0x30,
+	0x67, 0xf1, 0xac, 0xd5, 0xf0, 0xa5, 0x2f, 0x01, 0x73, 0x93, 0xaf, 0x94, 0xd6, 0x6a, 0xfb, 0x52,
+	0xfa, 0x33, 0xee, 0xc2, 0x69, 0x14, 0x4f, 0x5c, 0x2d, 0x02, 0x1e, 0x69, 0x1a, 0xcc, 0x0d, 0xc1,
+	0xce, 0x12, 0x58, 0xac, 0xa8, 0x16, 0x32, 0x34, 0xf8, 0x49, 0x16, 0xa7, 0xe1, 0x6a, 0x0b, 0xdd,
+	0xb1, 0x37, 0xa7, 0x8a, 0x06, 0xc6, 0x5d, 0xf7, 0x5b, 0x11, 0x1f, 0x5d, 0xa6, 0x7e, 0x3f, 0x69,
+	0xaa, 0x39, 0xb9, 0xc4, 0x35, 0xa0, 0x71, 0x36, 0x14, 0x9a, 0x07, 0x51, 0x13, 0x75, 0x4a, 0xbd,
+	0x6a, 0xff, 0xa9, 0x93, 0x69, 0xc3, 0x39, 0x4f, 0x59, 0xef, 0x34, 0x0f, 0x06, 0xd6, 0xcd, 0x8f,
+	0x76, 0xc1, 0x3b, 0x62, 0x7f, 0x4b, 0x11, 0x79, 0x8e, 0xcb, 0xe9, 0x4b, 0xcd, 0x62, 0x07, 0xf5,
+	0xaa, 0xfd, 0xe3, 0x9c, 0xc2, 0x47, 0x80, 0xcd, 0x65, 0x43, 0x26, 0x57, 0xb8, 0x31, 0xa1, 0x62,
+	0xc6, 0xd9, 0xf0, 0xae, 0x8d, 0x12, 0xd8, 0xe8, 0xe6, 0x44, 0x2e, 0x80, 0x9c, 0x37, 0x43, 0x26,
+	0x59, 0x20, 0x22, 0x63, 0x7c, 0xac, 0xf8, 0x38, 0x56, 0x4a, 0x84, 0x7e, 0x46, 0xde, 0x02, 0xf9,
+	0xd3, 0x9c, 0xbc, 0xb7, 0xe5, 0xe7, 0x5f, 0x78, 0xac, 0xee, 0xc1, 0xa2, 0xee, 0x17, 0x84, 0xab,
+	0x7b, 0x05, 0x52, 0xc7, 0x45, 0xc1, 0x9a, 0xa8, 0x83, 0x7a, 0x87, 0x5e, 0x51, 0x30, 0xf2, 0x1e,
+	0xd7, 0xf9, 0x92, 0x8f, 0xe3, 0x64, 0x74, 0xc3, 0x64, 0xc8, 0x26, 0x9f, 0x96, 0x93, 0x0e, 0xd0,
+	0xd9, 0x0e, 0xd0, 0xf9, 0xbc, 0xdd, 0x80, 0x41, 0x25, 0x79, 0xf0, 0xfa, 0x67, 0x1b, 0x79, 0xb5,
+	0xdd, 0xdd, 0x04, 0x25, 0x3d, 0x6c, 0x31, 0xaa, 0x69, 0xb3, 0x04, 0x12, 0x8d, 0x9c, 0xc4, 0xeb,
+	0x70, 0xe5, 0x01, 0xa3, 0xbb, 0xc2, 0x8f, 0x72, 0x51, 0x91, 0x17, 0xd8, 0x4a, 0xda, 0x07, 0x77,
+	0xff, 0x37, 0x63, 0xe0, 0x93, 0x06, 0x3e, 0xe0, 0x4a, 0x49, 0x05, 0xd6, 0x0f, 0xbd, 0xf4, 0x40,
+	0x9e, 0xe0, 0xf2, 0x94, 0x0b, 0x7f, 0xaa, 0xc1, 0x4e, 0xc9, 0x33, 0xa7, 0xee, 0x6f, 0x84, 0x1b,
+	0xf7, 0xe5, 0x98, 0x8b, 0xe6, 0x15, 0xae, 0x88, 0x50, 0x73, 0xb5, 0xa0, 0x33, 0x13, 0xca, 0x49,
+	0xae, 0xa3, 0x73, 0xb3, 0xf5, 0x69, 0x26, 0x5f, 0x93, 0x4c, 0x76, 0x97, 0xc8, 0x4b, 0x5c, 0xe1,
+	0x21, 0x4b, 0x53, 0x2d, 0xfd, 0x33, 0x55, 0x0b, 0x12, 0x7d, 0xc0, 0x43, 0x06, 0x59, 0x9e, 0xe2,
+	0x7a, 0x40, 0x97, 0xc3, 0x5d, 0xc0, 0xc9, 0x52, 0xa0, 0x9e, 0xe5, 0xd5, 0x02, 0xba, 0x7c, 0xbb,
+	0x2b, 0x12, 0x1b, 0xe3, 0x3d, 0xca, 0x01, 0x50, 0xf6, 0x2a, 0x83, 0x0f, 0x37, 0x6b, 0x1b, 0xdd,
+	0xae, 0x6d, 0xf4, 0x6b, 0x6d, 0xa3, 0xeb, 0x8d, 0x5d, 0xb8, 0xdd, 0xd8, 0x85, 0xef, 0x1b, 0xbb,
+	0x70, 0x75, 0xe6, 0x0b, 0x3d, 0x8d, 0x47, 0xce, 0x58, 0x06, 0xee, 0x1b, 0x48, 0xfa, 0x42, 0xc6,
+	0x21, 0x83, 0x76, 0x5c, 0xf3, 0x8b, 0x2e, 0xfa, 0xee, 0xd2, 0xfc, 0xa7, 0x7a, 0x35, 0xe7, 0xd1,
+	0xa8, 0x0c, 0xc6, 0xcf, 0xfe, 0x0c, 0x00, 0x73, 0x41, 0x17, 0x85, 0x63, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -186,6 +373,44 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RecurringDelayedItems) > 0 {
+		for iNdEx := len(m.RecurringDelayedItems) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecurringDelayedItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.FailedDelayedItems) > 0 {
+		for iNdEx := len(m.FailedDelayedItems) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedDelayedItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.DelayedItems) > 0 {
 		for iNdEx := len(m.DelayedItems) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -235,12 +460,115 @@ func (m *DelayedItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1a
 	}
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExecutionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ExecutionTime):])
-	if err2 != nil {
-		return 0, err2
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExecutionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ExecutionTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintGenesis(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FailedDelayedItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FailedDelayedItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FailedDelayedItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Item.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RecurringDelayedItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecurringDelayedItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecurringDelayedItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Executions != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Executions))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxExecutions != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxExecutions))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EndTime != nil {
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintGenesis(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x1a
+	}
+	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Interval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Interval):])
+	if err6 != nil {
+		return 0, err6
 	}
-	i -= n2
-	i = encodeVarintGenesis(dAtA, i, uint64(n2))
+	i -= n6
+	i = encodeVarintGenesis(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0x12
 	if len(m.Id) > 0 {
@@ -276,6 +604,20 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.FailedDelayedItems) > 0 {
+		for _, e := range m.FailedDelayedItems {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RecurringDelayedItems) > 0 {
+		for _, e := range m.RecurringDelayedItems {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -298,6 +640,49 @@ func (m *DelayedItem) Size() (n int) {
 	return n
 }
 
+func (m *FailedDelayedItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Item.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *RecurringDelayedItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Interval)
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.EndTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.MaxExecutions != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxExecutions))
+	}
+	if m.Executions != 0 {
+		n += 1 + sovGenesis(uint64(m.Executions))
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -367,22 +752,123 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedDelayedItems", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedDelayedItems = append(m.FailedDelayedItems, FailedDelayedItem{})
+			if err := m.FailedDelayedItems[len(m.FailedDelayedItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecurringDelayedItems", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecurringDelayedItems = append(m.RecurringDelayedItems, RecurringDelayedItem{})
+			if err := m.RecurringDelayedItems[len(m.RecurringDelayedItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
@@ -539,6 +1025,329 @@ func (m *DelayedItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *FailedDelayedItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FailedDelayedItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FailedDelayedItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecurringDelayedItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecurringDelayedItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecurringDelayedItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Interval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExecutions", wireType)
+			}
+			m.MaxExecutions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExecutions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executions", wireType)
+			}
+			m.Executions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Executions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
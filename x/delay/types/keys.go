@@ -20,8 +20,16 @@ const (
 	RouterKey = ModuleName
 )
 
-// DelayedItemKeyPrefix defines the key prefix for the delayed item.
-var DelayedItemKeyPrefix = []byte{0x01}
+var (
+	// DelayedItemKeyPrefix defines the key prefix for the delayed item.
+	DelayedItemKeyPrefix = []byte{0x01}
+	// DelayedItemIDKeyPrefix defines the key prefix for the index of delayed item keys by the item id.
+	DelayedItemIDKeyPrefix = []byte{0x02}
+	// FailedDelayedItemKeyPrefix defines the key prefix for the delayed item which handler failed.
+	FailedDelayedItemKeyPrefix = []byte{0x03}
+	// RecurringDelayedItemKeyPrefix defines the key prefix for the schedule of the recurring delayed item.
+	RecurringDelayedItemKeyPrefix = []byte{0x04}
+)
 
 const timestampLength = 8
 
@@ -31,6 +39,69 @@ func CreateDelayedItemKey(id string, t time.Time) ([]byte, error) {
 		return nil, sdkerrors.Wrap(ErrInvalidInput, "id cannot be empty")
 	}
 
+	key, err := createTimestampKey(t)
+	if err != nil {
+		return nil, err
+	}
+
+	return store.JoinKeys(DelayedItemKeyPrefix, key, []byte(id)), nil
+}
+
+// CreateDelayedItemIDPrefix creates prefix for the index of delayed item keys stored under the id.
+func CreateDelayedItemIDPrefix(id string) ([]byte, error) {
+	return createIDPrefix(DelayedItemIDKeyPrefix, id)
+}
+
+// CreateDelayedItemIDKey creates key for the index of delayed item keys by the item id.
+func CreateDelayedItemIDKey(id string, t time.Time) ([]byte, error) {
+	idPrefix, err := CreateDelayedItemIDPrefix(id)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := createTimestampKey(t)
+	if err != nil {
+		return nil, err
+	}
+
+	return store.JoinKeys(idPrefix, key), nil
+}
+
+// CreateFailedDelayedItemIDPrefix creates prefix for the failed delayed items stored under the id.
+func CreateFailedDelayedItemIDPrefix(id string) ([]byte, error) {
+	return createIDPrefix(FailedDelayedItemKeyPrefix, id)
+}
+
+// CreateFailedDelayedItemKey creates key for the failed delayed item.
+func CreateFailedDelayedItemKey(id string, t time.Time) ([]byte, error) {
+	idPrefix, err := CreateFailedDelayedItemIDPrefix(id)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := createTimestampKey(t)
+	if err != nil {
+		return nil, err
+	}
+
+	return store.JoinKeys(idPrefix, key), nil
+}
+
+// CreateRecurringDelayedItemKey creates key for the schedule of the recurring delayed item.
+func CreateRecurringDelayedItemKey(id string) ([]byte, error) {
+	return createIDPrefix(RecurringDelayedItemKeyPrefix, id)
+}
+
+// ExtractTimeAndIDFromDelayedItemKey extracts from the key the timestamp and ID of delayed message execution.
+func ExtractTimeAndIDFromDelayedItemKey(key []byte) (time.Time, string, error) {
+	if len(key) < timestampLength+1 {
+		return time.Time{}, "", sdkerrors.Wrap(ErrInvalidInput, "key is too short")
+	}
+
+	return time.Unix(int64(binary.BigEndian.Uint64(key[:timestampLength])), 0).UTC(), string(key[timestampLength:]), nil
+}
+
+func createTimestampKey(t time.Time) ([]byte, error) {
 	execTime := t.Unix()
 	if execTime < 0 {
 		return nil, sdkerrors.Wrap(ErrInvalidInput, "unix timestamp of the execution time must be non-negative")
@@ -40,14 +111,18 @@ func CreateDelayedItemKey(id string, t time.Time) ([]byte, error) {
 	// big endian is used to be sure that results are sortable lexicographically when stored messages are iterated
 	binary.BigEndian.PutUint64(key, uint64(execTime))
 
-	return store.JoinKeys(DelayedItemKeyPrefix, key, []byte(id)), nil
+	return key, nil
 }
 
-// ExtractTimeAndIDFromDelayedItemKey extracts from the key the timestamp and ID of delayed message execution.
-func ExtractTimeAndIDFromDelayedItemKey(key []byte) (time.Time, string, error) {
-	if len(key) < timestampLength+1 {
-		return time.Time{}, "", sdkerrors.Wrap(ErrInvalidInput, "key is too short")
+func createIDPrefix(prefix []byte, id string) ([]byte, error) {
+	if id == "" {
+		return nil, sdkerrors.Wrap(ErrInvalidInput, "id cannot be empty")
 	}
 
-	return time.Unix(int64(binary.BigEndian.Uint64(key[:timestampLength])), 0).UTC(), string(key[timestampLength:]), nil
+	compositeKey, err := store.JoinKeysWithLength([]byte(id))
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidInput, "invalid id: %s", err)
+	}
+
+	return store.JoinKeys(prefix, compositeKey), nil
 }
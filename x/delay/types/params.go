@@ -0,0 +1,43 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// DefaultMaxExecutedItemsPerBlock is the default maximum number of delayed items executed in a single block.
+const DefaultMaxExecutedItemsPerBlock = 100
+
+// KeyMaxExecutedItemsPerBlock represents the max executed items per block param key.
+var KeyMaxExecutedItemsPerBlock = []byte("MaxExecutedItemsPerBlock")
+
+// DefaultParams returns params with default values.
+func DefaultParams() Params {
+	return Params{
+		MaxExecutedItemsPerBlock: DefaultMaxExecutedItemsPerBlock,
+	}
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
+// of module parameters.
+func (m *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMaxExecutedItemsPerBlock, &m.MaxExecutedItemsPerBlock, validateMaxExecutedItemsPerBlock),
+	}
+}
+
+// ValidateBasic validates parameters.
+func (m Params) ValidateBasic() error {
+	return validateMaxExecutedItemsPerBlock(m.MaxExecutedItemsPerBlock)
+}
+
+func validateMaxExecutedItemsPerBlock(i interface{}) error {
+	maxItems, ok := i.(uint32)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid parameter type: %T", i)
+	}
+	if maxItems == 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "max executed items per block must be greater than 0")
+	}
+	return nil
+}
@@ -0,0 +1,3345 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: coreum/delay/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ed231a3e4a06acc, []int{0}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ed231a3e4a06acc, []int{1}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+type QueryDelayedItemsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// type_url filters the items by the type URL of the data, if set.
+	TypeURL string `protobuf:"bytes,2,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty"`
+	// from_time filters the items executed at this time or later, if set.
+	FromTime *time.Time `protobuf:"bytes,3,opt,name=from_time,json=fromTime,proto3,stdtime" json:"from_time,omitempty"`
+	// to_time filters the items executed at this time or earlier, if set.
+	ToTime *time.Time `protobuf:"bytes,4,opt,name=to_time,json=toTime,proto3,stdtime" json:"to_time,omitempty"`
+}
+
+func (m *QueryDelayedItemsRequest) Reset()         { *m = QueryDelayedItemsRequest{} }
+func (m *QueryDelayedItemsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelayedItemsRequest) ProtoMessage()    {}
+func (*QueryDelayedItemsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ed231a3e4a06acc, []int{2}
+}
+func (m *QueryDelayedItemsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelayedItemsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelayedItemsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelayedItemsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelayedItemsRequest.Merge(m, src)
+}
+func (m *QueryDelayedItemsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelayedItemsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelayedItemsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelayedItemsRequest proto.InternalMessageInfo
+
+func (m *QueryDelayedItemsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryDelayedItemsRequest) GetTypeURL() string {
+	if m != nil {
+		return m.TypeURL
+	}
+	return ""
+}
+
+func (m *QueryDelayedItemsRequest) GetFromTime() *time.Time {
+	if m != nil {
+		return m.FromTime
+	}
+	return nil
+}
+
+func (m *QueryDelayedItemsRequest) GetToTime() *time.Time {
+	if m != nil {
+		return m.ToTime
+	}
+	return nil
+}
+
+type QueryDelayedItemsResponse struct {
+	// pagination defines the pagination in the response.
+	Pagination   *query.PageResponse `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	DelayedItems []DelayedItem       `protobuf:"bytes,2,rep,name=delayed_items,json=delayedItems,proto3" json:"delayed_items"`
+}
+
+func (m *QueryDelayedItemsResponse) Reset()         { *m = QueryDelayedItemsResponse{} }
+func (m *QueryDelayedItemsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelayedItemsResponse) ProtoMessage()    {}
+func (*QueryDelayedItemsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ed231a3e4a06acc, []int{3}
+}
+func (m *QueryDelayedItemsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelayedItemsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelayedItemsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelayedItemsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelayedItemsResponse.Merge(m, src)
+}
+func (m *QueryDelayedItemsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelayedItemsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelayedItemsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelayedItemsResponse proto.InternalMessageInfo
+
+func (m *QueryDelayedItemsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryDelayedItemsResponse) GetDelayedItems() []DelayedItem {
+	if m != nil {
+		return m.DelayedItems
+	}
+	return nil
+}
+
+type QueryDelayedItemsByIDRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryDelayedItemsByIDRequest) Reset()         { *m = QueryDelayedItemsByIDRequest{} }
+func (m *QueryDelayedItemsByIDRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelayedItemsByIDRequest) ProtoMessage()    {}
+func (*QueryDelayedItemsByIDRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ed231a3e4a06acc, []int{4}
+}
+func (m *QueryDelayedItemsByIDRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelayedItemsByIDRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelayedItemsByIDRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelayedItemsByIDRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelayedItemsByIDRequest.Merge(m, src)
+}
+func (m *QueryDelayedItemsByIDRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelayedItemsByIDRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelayedItemsByIDRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelayedItemsByIDRequest proto.InternalMessageInfo
+
+func (m *QueryDelayedItemsByIDRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type QueryDelayedItemsByIDResponse struct {
+	DelayedItems []DelayedItem `protobuf:"bytes,1,rep,name=delayed_items,json=delayedItems,proto3" json:"delayed_items"`
+}
+
+func (m *QueryDelayedItemsByIDResponse) Reset()         { *m = QueryDelayedItemsByIDResponse{} }
+func (m *QueryDelayedItemsByIDResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelayedItemsByIDResponse) ProtoMessage()    {}
+func (*QueryDelayedItemsByIDResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ed231a3e4a06acc, []int{5}
+}
+func (m *QueryDelayedItemsByIDResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelayedItemsByIDResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelayedItemsByIDResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelayedItemsByIDResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelayedItemsByIDResponse.Merge(m, src)
+}
+func (m *QueryDelayedItemsByIDResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelayedItemsByIDResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelayedItemsByIDResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelayedItemsByIDResponse proto.InternalMessageInfo
+
+func (m *QueryDelayedItemsByIDResponse) GetDelayedItems() []DelayedItem {
+	if m != nil {
+		return m.DelayedItems
+	}
+	return nil
+}
+
+type QueryFailedDelayedItemsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFailedDelayedItemsRequest) Reset()         { *m = QueryFailedDelayedItemsRequest{} }
+func (m *QueryFailedDelayedItemsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFailedDelayedItemsRequest) ProtoMessage()    {}
+func (*QueryFailedDelayedItemsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ed231a3e4a06acc, []int{6}
+}
+func (m *QueryFailedDelayedItemsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFailedDelayedItemsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFailedDelayedItemsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFailedDelayedItemsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFailedDelayedItemsRequest.Merge(m, src)
+}
+func (m *QueryFailedDelayedItemsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFailedDelayedItemsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFailedDelayedItemsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFailedDelayedItemsRequest proto.InternalMessageInfo
+
+func (m *QueryFailedDelayedItemsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryFailedDelayedItemsResponse struct {
+	// pagination defines the pagination in the response.
+	Pagination         *query.PageResponse `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	FailedDelayedItems []FailedDelayedItem `protobuf:"bytes,2,rep,name=failed_delayed_items,json=failedDelayedItems,proto3" json:"failed_delayed_items"`
+}
+
+func (m *QueryFailedDelayedItemsResponse) Reset()         { *m = QueryFailedDelayedItemsResponse{} }
+func (m *QueryFailedDelayedItemsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFailedDelayedItemsResponse) ProtoMessage()    {}
+func (*QueryFailedDelayedItemsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ed231a3e4a06acc, []int{7}
+}
+func (m *QueryFailedDelayedItemsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFailedDelayedItemsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFailedDelayedItemsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFailedDelayedItemsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFailedDelayedItemsResponse.Merge(m, src)
+}
+func (m *QueryFailedDelayedItemsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFailedDelayedItemsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFailedDelayedItemsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFailedDelayedItemsResponse proto.InternalMessageInfo
+
+func (m *QueryFailedDelayedItemsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryFailedDelayedItemsResponse) GetFailedDelayedItems() []FailedDelayedItem {
+	if m != nil {
+		return m.FailedDelayedItems
+	}
+	return nil
+}
+
+type QueryFailedDelayedItemsByIDRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryFailedDelayedItemsByIDRequest) Reset()         { *m = QueryFailedDelayedItemsByIDRequest{} }
+func (m *QueryFailedDelayedItemsByIDRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFailedDelayedItemsByIDRequest) ProtoMessage()    {}
+func (*QueryFailedDelayedItemsByIDRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ed231a3e4a06acc, []int{8}
+}
+func (m *QueryFailedDelayedItemsByIDRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFailedDelayedItemsByIDRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFailedDelayedItemsByIDRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFailedDelayedItemsByIDRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFailedDelayedItemsByIDRequest.Merge(m, src)
+}
+func (m *QueryFailedDelayedItemsByIDRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFailedDelayedItemsByIDRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFailedDelayedItemsByIDRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFailedDelayedItemsByIDRequest proto.InternalMessageInfo
+
+func (m *QueryFailedDelayedItemsByIDRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type QueryFailedDelayedItemsByIDResponse struct {
+	FailedDelayedItems []FailedDelayedItem `protobuf:"bytes,1,rep,name=failed_delayed_items,json=failedDelayedItems,proto3" json:"failed_delayed_items"`
+}
+
+func (m *QueryFailedDelayedItemsByIDResponse) Reset()         { *m = QueryFailedDelayedItemsByIDResponse{} }
+func (m *QueryFailedDelayedItemsByIDResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFailedDelayedItemsByIDResponse) ProtoMessage()    {}
+func (*QueryFailedDelayedItemsByIDResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ed231a3e4a06acc, []int{9}
+}
+func (m *QueryFailedDelayedItemsByIDResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFailedDelayedItemsByIDResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFailedDelayedItemsByIDResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFailedDelayedItemsByIDResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFailedDelayedItemsByIDResponse.Merge(m, src)
+}
+func (m *QueryFailedDelayedItemsByIDResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFailedDelayedItemsByIDResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFailedDelayedItemsByIDResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFailedDelayedItemsByIDResponse proto.InternalMessageInfo
+
+func (m *QueryFailedDelayedItemsByIDResponse) GetFailedDelayedItems() []FailedDelayedItem {
+	if m != nil {
+		return m.FailedDelayedItems
+	}
+	return nil
+}
+
+type QueryRecurringDelayedItemsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRecurringDelayedItemsRequest) Reset()         { *m = QueryRecurringDelayedItemsRequest{} }
+func (m *QueryRecurringDelayedItemsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRecurringDelayedItemsRequest) ProtoMessage()    {}
+func (*QueryRecurringDelayedItemsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ed231a3e4a06acc, []int{10}
+}
+func (m *QueryRecurringDelayedItemsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecurringDelayedItemsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecurringDelayedItemsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRecurringDelayedItemsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecurringDelayedItemsRequest.Merge(m, src)
+}
+func (m *QueryRecurringDelayedItemsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecurringDelayedItemsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecurringDelayedItemsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecurringDelayedItemsRequest proto.InternalMessageInfo
+
+func (m *QueryRecurringDelayedItemsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryRecurringDelayedItemsResponse struct {
+	// pagination defines the pagination in the response.
+	Pagination            *query.PageResponse    `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	RecurringDelayedItems []RecurringDelayedItem `protobuf:"bytes,2,rep,name=recurring_delayed_items,json=recurringDelayedItems,proto3" json:"recurring_delayed_items"`
+}
+
+func (m *QueryRecurringDelayedItemsResponse) Reset()         { *m = QueryRecurringDelayedItemsResponse{} }
+func (m *QueryRecurringDelayedItemsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRecurringDelayedItemsResponse) ProtoMessage()    {}
+func (*QueryRecurringDelayedItemsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ed231a3e4a06acc, []int{11}
+}
+func (m *QueryRecurringDelayedItemsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecurringDelayedItemsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecurringDelayedItemsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRecurringDelayedItemsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecurringDelayedItemsResponse.Merge(m, src)
+}
+func (m *QueryRecurringDelayedItemsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecurringDelayedItemsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecurringDelayedItemsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecurringDelayedItemsResponse proto.InternalMessageInfo
+
+func (m *QueryRecurringDelayedItemsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryRecurringDelayedItemsResponse) GetRecurringDelayedItems() []RecurringDelayedItem {
+	if m != nil {
+		return m.RecurringDelayedItems
+	}
+	return nil
+}
+
+type QueryRecurringDelayedItemRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryRecurringDelayedItemRequest) Reset()         { *m = QueryRecurringDelayedItemRequest{} }
+func (m *QueryRecurringDelayedItemRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRecurringDelayedItemRequest) ProtoMessage()    {}
+func (*QueryRecurringDelayedItemRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ed231a3e4a06acc, []int{12}
+}
+func (m *QueryRecurringDelayedItemRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecurringDelayedItemRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecurringDelayedItemRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRecurringDelayedItemRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecurringDelayedItemRequest.Merge(m, src)
+}
+func (m *QueryRecurringDelayedItemRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecurringDelayedItemRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecurringDelayedItemRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecurringDelayedItemRequest proto.InternalMessageInfo
+
+func (m *QueryRecurringDelayedItemRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type QueryRecurringDelayedItemResponse struct {
+	RecurringDelayedItem RecurringDelayedItem `protobuf:"bytes,1,opt,name=recurring_delayed_item,json=recurringDelayedItem,proto3" json:"recurring_delayed_item"`
+	// next_execution_time is the time of the next execution, if the item is pending.
+	NextExecutionTime *time.Time `protobuf:"bytes,2,opt,name=next_execution_time,json=nextExecutionTime,proto3,stdtime" json:"next_execution_time,omitempty"`
+}
+
+func (m *QueryRecurringDelayedItemResponse) Reset()         { *m = QueryRecurringDelayedItemResponse{} }
+func (m *QueryRecurringDelayedItemResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRecurringDelayedItemResponse) ProtoMessage()    {}
+func (*QueryRecurringDelayedItemResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ed231a3e4a06acc, []int{13}
+}
+func (m *QueryRecurringDelayedItemResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecurringDelayedItemResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecurringDelayedItemResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRecurringDelayedItemResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecurringDelayedItemResponse.Merge(m, src)
+}
+func (m *QueryRecurringDelayedItemResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecurringDelayedItemResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecurringDelayedItemResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecurringDelayedItemResponse proto.InternalMessageInfo
+
+func (m *QueryRecurringDelayedItemResponse) GetRecurringDelayedItem() RecurringDelayedItem {
+	if m != nil {
+		return m.RecurringDelayedItem
+	}
+	return RecurringDelayedItem{}
+}
+
+func (m *QueryRecurringDelayedItemResponse) GetNextExecutionTime() *time.Time {
+	if m != nil {
+		return m.NextExecutionTime
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "coreum.delay.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "coreum.delay.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryDelayedItemsRequest)(nil), "coreum.delay.v1.QueryDelayedItemsRequest")
+	proto.RegisterType((*QueryDelayedItemsResponse)(nil), "coreum.delay.v1.QueryDelayedItemsResponse")
+	proto.RegisterType((*QueryDelayedItemsByIDRequest)(nil), "coreum.delay.v1.QueryDelayedItemsByIDRequest")
+	proto.RegisterType((*QueryDelayedItemsByIDResponse)(nil), "coreum.delay.v1.QueryDelayedItemsByIDResponse")
+	proto.RegisterType((*QueryFailedDelayedItemsRequest)(nil), "coreum.delay.v1.QueryFailedDelayedItemsRequest")
+	proto.RegisterType((*QueryFailedDelayedItemsResponse)(nil), "coreum.delay.v1.QueryFailedDelayedItemsResponse")
+	proto.RegisterType((*QueryFailedDelayedItemsByIDRequest)(nil), "coreum.delay.v1.QueryFailedDelayedItemsByIDRequest")
+	proto.RegisterType((*QueryFailedDelayedItemsByIDResponse)(nil), "coreum.delay.v1.QueryFailedDelayedItemsByIDResponse")
+	proto.RegisterType((*QueryRecurringDelayedItemsRequest)(nil), "coreum.delay.v1.QueryRecurringDelayedItemsRequest")
+	proto.RegisterType((*QueryRecurringDelayedItemsResponse)(nil), "coreum.delay.v1.QueryRecurringDelayedItemsResponse")
+	proto.RegisterType((*QueryRecurringDelayedItemRequest)(nil), "coreum.delay.v1.QueryRecurringDelayedItemRequest")
+	proto.RegisterType((*QueryRecurringDelayedItemResponse)(nil), "coreum.delay.v1.QueryRecurringDelayedItemResponse")
+}
+
+func init() { proto.RegisterFile("coreum/delay/query.proto", fileDescriptor_0ed231a3e4a06acc) }
+
+var fileDescriptor_0ed231a3e4a06acc = []byte{
+	// 909 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0x33, 0x6e, 0xc8, 0x8f, 0x97, 0xf2, 0x6b, 0xea, 0x36, 0xce, 0x2a, 0xac, 0xcd, 0x86,
+	0xa4, 0xc6, 0xe0, 0xdd, 0x7a, 0xdd, 0x1e, 0x38, 0x70, 0x31, 0x25, 0x55, 0xa5, 0x22, 0x85, 0x55,
+	0x7b, 0xe9, 0xc5, 0x5a, 0x7b, 0xc7, 0x9b, 0x15, 0xf6, 0xce, 0x76, 0x7f, 0x58, 0xb1, 0x10, 0x07,
+	0x10, 0x57, 0xa4, 0x4a, 0xdc, 0x39, 0x72, 0xe3, 0x82, 0x90, 0xf8, 0x0f, 0x50, 0x8f, 0x15, 0x5c,
+	0x7a, 0x2a, 0xc8, 0xe1, 0xdf, 0x40, 0x42, 0x3b, 0x33, 0x6b, 0x6c, 0xef, 0xda, 0x5d, 0xa3, 0xe4,
+	0x96, 0xcc, 0x7b, 0x6f, 0xbe, 0x9f, 0xf7, 0x7d, 0x9b, 0x37, 0x81, 0x52, 0x97, 0xfa, 0x24, 0x1a,
+	0x68, 0x16, 0xe9, 0x9b, 0x23, 0xed, 0x49, 0x44, 0xfc, 0x91, 0xea, 0xf9, 0x34, 0xa4, 0xf8, 0x4d,
+	0x1e, 0x51, 0x59, 0x44, 0x1d, 0x36, 0xa4, 0xa2, 0x4d, 0x6d, 0xca, 0x62, 0x5a, 0xfc, 0x13, 0x4f,
+	0x93, 0xf6, 0x6d, 0x4a, 0xed, 0x3e, 0xd1, 0x4c, 0xcf, 0xd1, 0x4c, 0xd7, 0xa5, 0xa1, 0x19, 0x3a,
+	0xd4, 0x0d, 0x44, 0xb4, 0x2c, 0xa2, 0xec, 0xb7, 0x4e, 0xd4, 0xd3, 0x42, 0x67, 0x40, 0x82, 0xd0,
+	0x1c, 0x78, 0x22, 0xa1, 0xd6, 0xa5, 0xc1, 0x80, 0x06, 0x5a, 0xc7, 0x0c, 0x08, 0x97, 0xd7, 0x86,
+	0x8d, 0x0e, 0x09, 0xcd, 0x86, 0xe6, 0x99, 0xb6, 0xe3, 0xb2, 0xdb, 0x44, 0xae, 0x34, 0xc3, 0x6a,
+	0x13, 0x97, 0x04, 0x4e, 0x22, 0xb4, 0x37, 0x13, 0xf3, 0x4c, 0xdf, 0x1c, 0x88, 0x90, 0x52, 0x04,
+	0xfc, 0x79, 0x7c, 0xf1, 0x09, 0x3b, 0x34, 0xc8, 0x93, 0x88, 0x04, 0xa1, 0xf2, 0x00, 0xae, 0xcd,
+	0x9c, 0x06, 0x1e, 0x75, 0x03, 0x82, 0xef, 0xc0, 0x06, 0x2f, 0x2e, 0xa1, 0x0a, 0xaa, 0xee, 0xe8,
+	0xbb, 0xea, 0x9c, 0x0d, 0x2a, 0x2f, 0x68, 0xad, 0x3f, 0x7b, 0x59, 0x5e, 0x33, 0x44, 0xb2, 0xf2,
+	0x6d, 0x01, 0x4a, 0xec, 0xba, 0xbb, 0x71, 0x1a, 0xb1, 0xee, 0x87, 0x64, 0x22, 0x85, 0x8f, 0x01,
+	0xfe, 0xeb, 0x45, 0xdc, 0x7b, 0xa4, 0xf2, 0xc6, 0xd5, 0xb8, 0x71, 0x95, 0xfb, 0x2e, 0x1a, 0x57,
+	0x4f, 0x4c, 0x9b, 0x88, 0x5a, 0x63, 0xaa, 0x12, 0x1f, 0xc1, 0x56, 0x38, 0xf2, 0x48, 0x3b, 0xf2,
+	0xfb, 0xa5, 0x42, 0x05, 0x55, 0xb7, 0x5b, 0x3b, 0xe3, 0x97, 0xe5, 0xcd, 0x87, 0x23, 0x8f, 0x3c,
+	0x32, 0x1e, 0x18, 0x9b, 0x71, 0xf0, 0x91, 0xdf, 0xc7, 0x1f, 0xc3, 0x76, 0xcf, 0xa7, 0x83, 0x76,
+	0xec, 0x75, 0xe9, 0x0a, 0x93, 0x93, 0x54, 0x3e, 0x08, 0x35, 0x19, 0x84, 0xfa, 0x30, 0x19, 0x44,
+	0x6b, 0xfd, 0xe9, 0x9f, 0x65, 0x64, 0x6c, 0xc5, 0x25, 0xf1, 0x21, 0xfe, 0x08, 0x36, 0x43, 0xca,
+	0x8b, 0xd7, 0x73, 0x16, 0x6f, 0x84, 0x34, 0x3e, 0x52, 0x7e, 0x42, 0xb0, 0x97, 0x61, 0x83, 0xf0,
+	0xf6, 0x5e, 0x86, 0x0f, 0x37, 0x5f, 0xe9, 0x03, 0x2f, 0x9e, 0x31, 0xe2, 0x1e, 0xbc, 0x6e, 0x71,
+	0x81, 0xb6, 0x13, 0x2b, 0x94, 0x0a, 0x95, 0x2b, 0xd5, 0x1d, 0x7d, 0x3f, 0x35, 0xab, 0x29, 0x0c,
+	0x31, 0xb0, 0xab, 0xd6, 0x14, 0x99, 0xa2, 0xc2, 0x7e, 0x0a, 0xb7, 0x35, 0xba, 0x7f, 0x37, 0x99,
+	0xdc, 0x1b, 0x50, 0x70, 0x2c, 0x46, 0xba, 0x6d, 0x14, 0x1c, 0x4b, 0x39, 0x85, 0x77, 0x16, 0xe4,
+	0x4f, 0x5a, 0x9c, 0x23, 0x43, 0xff, 0x93, 0xec, 0x14, 0x64, 0xa6, 0x74, 0x6c, 0x3a, 0x7d, 0x62,
+	0x5d, 0xe2, 0x57, 0xa5, 0xfc, 0x86, 0xa0, 0xbc, 0x50, 0xea, 0xa2, 0x27, 0xf7, 0x18, 0x8a, 0x3d,
+	0x26, 0xd3, 0xce, 0x1a, 0xa0, 0x92, 0xb2, 0x29, 0xc5, 0x24, 0xcc, 0xc2, 0xbd, 0x14, 0xac, 0x72,
+	0x1b, 0x94, 0x05, 0x7d, 0x2c, 0x1b, 0xe9, 0xd7, 0x08, 0x0e, 0x96, 0x96, 0x09, 0x0b, 0x16, 0x91,
+	0xa3, 0x0b, 0x20, 0xff, 0x02, 0xde, 0x65, 0x08, 0x06, 0xe9, 0x46, 0xbe, 0xef, 0xb8, 0xf6, 0x65,
+	0xce, 0xfb, 0x77, 0x04, 0xca, 0x32, 0xb5, 0x8b, 0x1e, 0x79, 0x17, 0x76, 0xfd, 0x44, 0x29, 0x73,
+	0xea, 0x87, 0x29, 0xef, 0xb2, 0xc8, 0x84, 0x7d, 0xd7, 0xfd, 0x2c, 0x6a, 0x45, 0x87, 0xca, 0xc2,
+	0x9e, 0x16, 0x4d, 0xfe, 0x05, 0x5a, 0x62, 0xfb, 0xc4, 0x07, 0x13, 0x6e, 0x64, 0xe3, 0x0b, 0x4f,
+	0x56, 0xa2, 0x2f, 0x66, 0xd1, 0xe3, 0x13, 0xb8, 0xe6, 0x92, 0xb3, 0xb0, 0x4d, 0xce, 0x48, 0x37,
+	0x8a, 0x3d, 0xe3, 0xcb, 0xb7, 0x90, 0x73, 0xf9, 0xbe, 0x1d, 0x17, 0x7f, 0x9a, 0xd4, 0xc6, 0x51,
+	0xfd, 0x9f, 0x2d, 0x78, 0x8d, 0xb5, 0x86, 0x43, 0xd8, 0xe0, 0x0f, 0x16, 0x3e, 0x48, 0x81, 0xa6,
+	0x5f, 0x45, 0xe9, 0xbd, 0xe5, 0x49, 0xdc, 0x13, 0xa5, 0xfc, 0xcd, 0x1f, 0x7f, 0x7f, 0x5f, 0xd8,
+	0xc3, 0xbb, 0xda, 0xcc, 0xab, 0x3b, 0x6c, 0x88, 0x87, 0x17, 0x7f, 0x87, 0xe0, 0xea, 0xf4, 0x7c,
+	0xf0, 0xfb, 0xd9, 0xf7, 0x66, 0x7c, 0xe7, 0x52, 0x2d, 0x4f, 0xaa, 0x00, 0x39, 0x62, 0x20, 0x15,
+	0x2c, 0xa7, 0x40, 0xc4, 0xa4, 0xea, 0xec, 0x43, 0xc3, 0x3f, 0x20, 0x78, 0x6b, 0xfe, 0x2f, 0x1b,
+	0xd7, 0x5f, 0x2d, 0x34, 0xb5, 0x38, 0x24, 0x35, 0x6f, 0xba, 0x60, 0xfb, 0x80, 0xb1, 0x1d, 0xe2,
+	0x83, 0xe5, 0x6c, 0xda, 0x97, 0x8e, 0xf5, 0x15, 0xfe, 0x11, 0x01, 0x4e, 0x2f, 0x20, 0xac, 0x65,
+	0x6b, 0x2e, 0x7c, 0x14, 0xa4, 0x5b, 0xf9, 0x0b, 0x04, 0x66, 0x9d, 0x61, 0xde, 0xc4, 0x87, 0x29,
+	0x4c, 0xbe, 0xa8, 0xea, 0xb3, 0x4e, 0xfe, 0x8a, 0xe0, 0x46, 0xf6, 0xa6, 0xc4, 0xcd, 0xbc, 0xda,
+	0xd3, 0xae, 0xde, 0x5e, 0xad, 0x48, 0x40, 0xeb, 0x0c, 0xfa, 0x43, 0x5c, 0xcb, 0x05, 0xcd, 0x2d,
+	0xfe, 0x19, 0xc1, 0xf5, 0xcc, 0x95, 0x87, 0xf5, 0x6c, 0x86, 0x65, 0xdb, 0x58, 0x6a, 0xae, 0x54,
+	0x23, 0xb0, 0x6f, 0x31, 0xec, 0x1a, 0xae, 0xa6, 0xb0, 0x27, 0x7b, 0x61, 0xce, 0xee, 0x5f, 0x10,
+	0x14, 0xb3, 0xee, 0xc4, 0x8d, 0xfc, 0xfa, 0x09, 0xb2, 0xbe, 0x4a, 0x89, 0x20, 0xbe, 0xc3, 0x88,
+	0x35, 0x5c, 0xcf, 0x4b, 0xcc, 0xbc, 0x6e, 0x7d, 0xf6, 0x6c, 0x2c, 0xa3, 0xe7, 0x63, 0x19, 0xfd,
+	0x35, 0x96, 0xd1, 0xd3, 0x73, 0x79, 0xed, 0xf9, 0xb9, 0xbc, 0xf6, 0xe2, 0x5c, 0x5e, 0x7b, 0xdc,
+	0xb4, 0x9d, 0xf0, 0x34, 0xea, 0xa8, 0x5d, 0x3a, 0xd0, 0x3e, 0x61, 0x57, 0x1e, 0xd3, 0xc8, 0xb5,
+	0xd8, 0x53, 0x91, 0x68, 0x0c, 0x75, 0xed, 0x4c, 0x08, 0xc5, 0xff, 0xd2, 0x06, 0x9d, 0x0d, 0xb6,
+	0xfb, 0x9a, 0xff, 0x0e, 0x00, 0x75, 0x08, 0x42, 0x54, 0xad, 0x0c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries the parameters of x/delay module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// DelayedItems returns the pending delayed items ordered by the execution time.
+	DelayedItems(ctx context.Context, in *QueryDelayedItemsRequest, opts ...grpc.CallOption) (*QueryDelayedItemsResponse, error)
+	// DelayedItemsByID returns the pending delayed items stored under the id. Usually there is only one such item, but
+	// the same id might be used by the items executed at different times.
+	DelayedItemsByID(ctx context.Context, in *QueryDelayedItemsByIDRequest, opts ...grpc.CallOption) (*QueryDelayedItemsByIDResponse, error)
+	// FailedDelayedItems returns the delayed items which handlers failed.
+	FailedDelayedItems(ctx context.Context, in *QueryFailedDelayedItemsRequest, opts ...grpc.CallOption) (*QueryFailedDelayedItemsResponse, error)
+	// FailedDelayedItemsByID returns the delayed items stored under the id which handlers failed.
+	FailedDelayedItemsByID(ctx context.Context, in *QueryFailedDelayedItemsByIDRequest, opts ...grpc.CallOption) (*QueryFailedDelayedItemsByIDResponse, error)
+	// RecurringDelayedItems returns the schedules of the recurring delayed items.
+	RecurringDelayedItems(ctx context.Context, in *QueryRecurringDelayedItemsRequest, opts ...grpc.CallOption) (*QueryRecurringDelayedItemsResponse, error)
+	// RecurringDelayedItem returns the schedule of the recurring delayed item stored under the id.
+	RecurringDelayedItem(ctx context.Context, in *QueryRecurringDelayedItemRequest, opts ...grpc.CallOption) (*QueryRecurringDelayedItemResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/coreum.delay.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelayedItems(ctx context.Context, in *QueryDelayedItemsRequest, opts ...grpc.CallOption) (*QueryDelayedItemsResponse, error) {
+	out := new(QueryDelayedItemsResponse)
+	err := c.cc.Invoke(ctx, "/coreum.delay.v1.Query/DelayedItems", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelayedItemsByID(ctx context.Context, in *QueryDelayedItemsByIDRequest, opts ...grpc.CallOption) (*QueryDelayedItemsByIDResponse, error) {
+	out := new(QueryDelayedItemsByIDResponse)
+	err := c.cc.Invoke(ctx, "/coreum.delay.v1.Query/DelayedItemsByID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FailedDelayedItems(ctx context.Context, in *QueryFailedDelayedItemsRequest, opts ...grpc.CallOption) (*QueryFailedDelayedItemsResponse, error) {
+	out := new(QueryFailedDelayedItemsResponse)
+	err := c.cc.Invoke(ctx, "/coreum.delay.v1.Query/FailedDelayedItems", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FailedDelayedItemsByID(ctx context.Context, in *QueryFailedDelayedItemsByIDRequest, opts ...grpc.CallOption) (*QueryFailedDelayedItemsByIDResponse, error) {
+	out := new(QueryFailedDelayedItemsByIDResponse)
+	err := c.cc.Invoke(ctx, "/coreum.delay.v1.Query/FailedDelayedItemsByID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RecurringDelayedItems(ctx context.Context, in *QueryRecurringDelayedItemsRequest, opts ...grpc.CallOption) (*QueryRecurringDelayedItemsResponse, error) {
+	out := new(QueryRecurringDelayedItemsResponse)
+	err := c.cc.Invoke(ctx, "/coreum.delay.v1.Query/RecurringDelayedItems", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RecurringDelayedItem(ctx context.Context, in *QueryRecurringDelayedItemRequest, opts ...grpc.CallOption) (*QueryRecurringDelayedItemResponse, error) {
+	out := new(QueryRecurringDelayedItemResponse)
+	err := c.cc.Invoke(ctx, "/coreum.delay.v1.Query/RecurringDelayedItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries the parameters of x/delay module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// DelayedItems returns the pending delayed items ordered by the execution time.
+	DelayedItems(context.Context, *QueryDelayedItemsRequest) (*QueryDelayedItemsResponse, error)
+	// DelayedItemsByID returns the pending delayed items stored under the id. Usually there is only one such item, but
+	// the same id might be used by the items executed at different times.
+	DelayedItemsByID(context.Context, *QueryDelayedItemsByIDRequest) (*QueryDelayedItemsByIDResponse, error)
+	// FailedDelayedItems returns the delayed items which handlers failed.
+	FailedDelayedItems(context.Context, *QueryFailedDelayedItemsRequest) (*QueryFailedDelayedItemsResponse, error)
+	// FailedDelayedItemsByID returns the delayed items stored under the id which handlers failed.
+	FailedDelayedItemsByID(context.Context, *QueryFailedDelayedItemsByIDRequest) (*QueryFailedDelayedItemsByIDResponse, error)
+	// RecurringDelayedItems returns the schedules of the recurring delayed items.
+	RecurringDelayedItems(context.Context, *QueryRecurringDelayedItemsRequest) (*QueryRecurringDelayedItemsResponse, error)
+	// RecurringDelayedItem returns the schedule of the recurring delayed item stored under the id.
+	RecurringDelayedItem(context.Context, *QueryRecurringDelayedItemRequest) (*QueryRecurringDelayedItemResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) DelayedItems(ctx context.Context, req *QueryDelayedItemsRequest) (*QueryDelayedItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelayedItems not implemented")
+}
+func (*UnimplementedQueryServer) DelayedItemsByID(ctx context.Context, req *QueryDelayedItemsByIDRequest) (*QueryDelayedItemsByIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelayedItemsByID not implemented")
+}
+func (*UnimplementedQueryServer) FailedDelayedItems(ctx context.Context, req *QueryFailedDelayedItemsRequest) (*QueryFailedDelayedItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FailedDelayedItems not implemented")
+}
+func (*UnimplementedQueryServer) FailedDelayedItemsByID(ctx context.Context, req *QueryFailedDelayedItemsByIDRequest) (*QueryFailedDelayedItemsByIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FailedDelayedItemsByID not implemented")
+}
+func (*UnimplementedQueryServer) RecurringDelayedItems(ctx context.Context, req *QueryRecurringDelayedItemsRequest) (*QueryRecurringDelayedItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecurringDelayedItems not implemented")
+}
+func (*UnimplementedQueryServer) RecurringDelayedItem(ctx context.Context, req *QueryRecurringDelayedItemRequest) (*QueryRecurringDelayedItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecurringDelayedItem not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.delay.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelayedItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelayedItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelayedItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.delay.v1.Query/DelayedItems",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelayedItems(ctx, req.(*QueryDelayedItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelayedItemsByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelayedItemsByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelayedItemsByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.delay.v1.Query/DelayedItemsByID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelayedItemsByID(ctx, req.(*QueryDelayedItemsByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FailedDelayedItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFailedDelayedItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FailedDelayedItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.delay.v1.Query/FailedDelayedItems",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FailedDelayedItems(ctx, req.(*QueryFailedDelayedItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FailedDelayedItemsByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFailedDelayedItemsByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FailedDelayedItemsByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.delay.v1.Query/FailedDelayedItemsByID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FailedDelayedItemsByID(ctx, req.(*QueryFailedDelayedItemsByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RecurringDelayedItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecurringDelayedItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecurringDelayedItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.delay.v1.Query/RecurringDelayedItems",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecurringDelayedItems(ctx, req.(*QueryRecurringDelayedItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RecurringDelayedItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecurringDelayedItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecurringDelayedItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.delay.v1.Query/RecurringDelayedItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecurringDelayedItem(ctx, req.(*QueryRecurringDelayedItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "coreum.delay.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "DelayedItems",
+			Handler:    _Query_DelayedItems_Handler,
+		},
+		{
+			MethodName: "DelayedItemsByID",
+			Handler:    _Query_DelayedItemsByID_Handler,
+		},
+		{
+			MethodName: "FailedDelayedItems",
+			Handler:    _Query_FailedDelayedItems_Handler,
+		},
+		{
+			MethodName: "FailedDelayedItemsByID",
+			Handler:    _Query_FailedDelayedItemsByID_Handler,
+		},
+		{
+			MethodName: "RecurringDelayedItems",
+			Handler:    _Query_RecurringDelayedItems_Handler,
+		},
+		{
+			MethodName: "RecurringDelayedItem",
+			Handler:    _Query_RecurringDelayedItem_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "coreum/delay/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelayedItemsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelayedItemsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelayedItemsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToTime != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ToTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ToTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintQuery(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.FromTime != nil {
+		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.FromTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.FromTime):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintQuery(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TypeURL) > 0 {
+		i -= len(m.TypeURL)
+		copy(dAtA[i:], m.TypeURL)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TypeURL)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelayedItemsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelayedItemsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelayedItemsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelayedItems) > 0 {
+		for iNdEx := len(m.DelayedItems) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelayedItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelayedItemsByIDRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelayedItemsByIDRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelayedItemsByIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelayedItemsByIDResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelayedItemsByIDResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelayedItemsByIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelayedItems) > 0 {
+		for iNdEx := len(m.DelayedItems) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelayedItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFailedDelayedItemsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFailedDelayedItemsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFailedDelayedItemsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFailedDelayedItemsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFailedDelayedItemsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFailedDelayedItemsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FailedDelayedItems) > 0 {
+		for iNdEx := len(m.FailedDelayedItems) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedDelayedItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFailedDelayedItemsByIDRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFailedDelayedItemsByIDRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFailedDelayedItemsByIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFailedDelayedItemsByIDResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFailedDelayedItemsByIDResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFailedDelayedItemsByIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FailedDelayedItems) > 0 {
+		for iNdEx := len(m.FailedDelayedItems) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedDelayedItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecurringDelayedItemsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecurringDelayedItemsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecurringDelayedItemsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecurringDelayedItemsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecurringDelayedItemsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecurringDelayedItemsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RecurringDelayedItems) > 0 {
+		for iNdEx := len(m.RecurringDelayedItems) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecurringDelayedItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecurringDelayedItemRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecurringDelayedItemRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecurringDelayedItemRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecurringDelayedItemResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecurringDelayedItemResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecurringDelayedItemResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextExecutionTime != nil {
+		n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.NextExecutionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.NextExecutionTime):])
+		if err10 != nil {
+			return 0, err10
+		}
+		i -= n10
+		i = encodeVarintQuery(dAtA, i, uint64(n10))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.RecurringDelayedItem.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDelayedItemsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TypeURL)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FromTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.FromTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ToTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ToTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelayedItemsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.DelayedItems) > 0 {
+		for _, e := range m.DelayedItems {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDelayedItemsByIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelayedItemsByIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DelayedItems) > 0 {
+		for _, e := range m.DelayedItems {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFailedDelayedItemsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFailedDelayedItemsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.FailedDelayedItems) > 0 {
+		for _, e := range m.FailedDelayedItems {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFailedDelayedItemsByIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFailedDelayedItemsByIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FailedDelayedItems) > 0 {
+		for _, e := range m.FailedDelayedItems {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRecurringDelayedItemsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRecurringDelayedItemsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.RecurringDelayedItems) > 0 {
+		for _, e := range m.RecurringDelayedItems {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRecurringDelayedItemRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRecurringDelayedItemResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RecurringDelayedItem.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.NextExecutionTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.NextExecutionTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelayedItemsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelayedItemsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelayedItemsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FromTime == nil {
+				m.FromTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.FromTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ToTime == nil {
+				m.ToTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ToTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelayedItemsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelayedItemsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelayedItemsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayedItems", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelayedItems = append(m.DelayedItems, DelayedItem{})
+			if err := m.DelayedItems[len(m.DelayedItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelayedItemsByIDRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelayedItemsByIDRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelayedItemsByIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelayedItemsByIDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelayedItemsByIDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelayedItemsByIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayedItems", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelayedItems = append(m.DelayedItems, DelayedItem{})
+			if err := m.DelayedItems[len(m.DelayedItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFailedDelayedItemsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFailedDelayedItemsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFailedDelayedItemsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFailedDelayedItemsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFailedDelayedItemsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFailedDelayedItemsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedDelayedItems", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedDelayedItems = append(m.FailedDelayedItems, FailedDelayedItem{})
+			if err := m.FailedDelayedItems[len(m.FailedDelayedItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFailedDelayedItemsByIDRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFailedDelayedItemsByIDRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFailedDelayedItemsByIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFailedDelayedItemsByIDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFailedDelayedItemsByIDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFailedDelayedItemsByIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedDelayedItems", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedDelayedItems = append(m.FailedDelayedItems, FailedDelayedItem{})
+			if err := m.FailedDelayedItems[len(m.FailedDelayedItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecurringDelayedItemsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecurringDelayedItemsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecurringDelayedItemsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecurringDelayedItemsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecurringDelayedItemsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecurringDelayedItemsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecurringDelayedItems", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecurringDelayedItems = append(m.RecurringDelayedItems, RecurringDelayedItem{})
+			if err := m.RecurringDelayedItems[len(m.RecurringDelayedItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecurringDelayedItemRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecurringDelayedItemRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecurringDelayedItemRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecurringDelayedItemResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecurringDelayedItemResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecurringDelayedItemResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecurringDelayedItem", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RecurringDelayedItem.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextExecutionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NextExecutionTime == nil {
+				m.NextExecutionTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.NextExecutionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -23,7 +23,9 @@ import (
 
 	assetfttypes "github.com/CoreumFoundation/coreum/v2/x/asset/ft/types"
 	assetnfttypes "github.com/CoreumFoundation/coreum/v2/x/asset/nft/types"
+	dextypes "github.com/CoreumFoundation/coreum/v2/x/dex/types"
 	nfttypes "github.com/CoreumFoundation/coreum/v2/x/nft"
+	nfttransfertypes "github.com/CoreumFoundation/coreum/v2/x/nfttransfer/types"
 )
 
 // These constants define gas for messages which have custom calculation logic.
@@ -31,6 +33,10 @@ const (
 	BankSendPerCoinGas            = 24000
 	BankMultiSendPerOperationsGas = 11000
 	AuthzExecOverhead             = 2000
+	AssetNFTMintPerNFTGas         = 39000
+	AssetNFTBurnPerNFTGas         = 16000
+	NFTSendPerNFTGas              = 16000
+	NFTTransferPerNFTGas          = 37000
 )
 
 type (
@@ -64,24 +70,50 @@ func DefaultConfig() Config {
 
 	cfg.gasByMsg = map[MsgURL]gasByMsgFunc{
 		// asset/ft
-		MsgToMsgURL(&assetfttypes.MsgIssue{}):               constantGasFunc(70000),
-		MsgToMsgURL(&assetfttypes.MsgMint{}):                constantGasFunc(11000),
-		MsgToMsgURL(&assetfttypes.MsgBurn{}):                constantGasFunc(23000),
-		MsgToMsgURL(&assetfttypes.MsgFreeze{}):              constantGasFunc(5000),
-		MsgToMsgURL(&assetfttypes.MsgUnfreeze{}):            constantGasFunc(2500),
-		MsgToMsgURL(&assetfttypes.MsgGloballyFreeze{}):      constantGasFunc(5000),
-		MsgToMsgURL(&assetfttypes.MsgGloballyUnfreeze{}):    constantGasFunc(2500),
-		MsgToMsgURL(&assetfttypes.MsgSetWhitelistedLimit{}): constantGasFunc(5000),
-		MsgToMsgURL(&assetfttypes.MsgUpgradeTokenV1{}):      constantGasFunc(25000),
+		MsgToMsgURL(&assetfttypes.MsgIssue{}):                 constantGasFunc(70000),
+		MsgToMsgURL(&assetfttypes.MsgMint{}):                  constantGasFunc(11000),
+		MsgToMsgURL(&assetfttypes.MsgBurn{}):                  constantGasFunc(23000),
+		MsgToMsgURL(&assetfttypes.MsgFreeze{}):                constantGasFunc(5000),
+		MsgToMsgURL(&assetfttypes.MsgUnfreeze{}):              constantGasFunc(2500),
+		MsgToMsgURL(&assetfttypes.MsgGloballyFreeze{}):        constantGasFunc(5000),
+		MsgToMsgURL(&assetfttypes.MsgGloballyUnfreeze{}):      constantGasFunc(2500),
+		MsgToMsgURL(&assetfttypes.MsgSetWhitelistedLimit{}):   constantGasFunc(5000),
+		MsgToMsgURL(&assetfttypes.MsgUpgradeTokenV1{}):        constantGasFunc(25000),
+		MsgToMsgURL(&assetfttypes.MsgTransferAdmin{}):         constantGasFunc(5000),
+		MsgToMsgURL(&assetfttypes.MsgClearAdmin{}):            constantGasFunc(5000),
+		MsgToMsgURL(&assetfttypes.MsgGrantRole{}):             constantGasFunc(7000),
+		MsgToMsgURL(&assetfttypes.MsgRevokeRole{}):            constantGasFunc(5000),
+		MsgToMsgURL(&assetfttypes.MsgUpdateMetadata{}):        constantGasFunc(8000),
+		MsgToMsgURL(&assetfttypes.MsgClawback{}):              constantGasFunc(15000),
+		MsgToMsgURL(&assetfttypes.MsgScheduleMint{}):          constantGasFunc(20000),
+		MsgToMsgURL(&assetfttypes.MsgScheduleUnfreeze{}):      constantGasFunc(10000),
+		MsgToMsgURL(&assetfttypes.MsgCancelScheduledAction{}): constantGasFunc(7000),
+		MsgToMsgURL(&assetfttypes.MsgRetryScheduledAction{}):  constantGasFunc(7000),
+		MsgToMsgURL(&assetfttypes.MsgAddRateExemption{}):      constantGasFunc(7000),
+		MsgToMsgURL(&assetfttypes.MsgRemoveRateExemption{}):   constantGasFunc(5000),
+		MsgToMsgURL(&assetfttypes.MsgUpdateRateRecipients{}):  constantGasFunc(10000),
+		MsgToMsgURL(&assetfttypes.MsgSetTransferLimits{}):     constantGasFunc(7000),
+		MsgToMsgURL(&assetfttypes.MsgLockSupply{}):            constantGasFunc(5000),
 
 		// asset/nft
-		MsgToMsgURL(&assetnfttypes.MsgBurn{}):                constantGasFunc(16000),
-		MsgToMsgURL(&assetnfttypes.MsgIssueClass{}):          constantGasFunc(16000),
-		MsgToMsgURL(&assetnfttypes.MsgMint{}):                constantGasFunc(39000),
-		MsgToMsgURL(&assetnfttypes.MsgFreeze{}):              constantGasFunc(7000),
-		MsgToMsgURL(&assetnfttypes.MsgUnfreeze{}):            constantGasFunc(5000),
-		MsgToMsgURL(&assetnfttypes.MsgAddToWhitelist{}):      constantGasFunc(7000),
-		MsgToMsgURL(&assetnfttypes.MsgRemoveFromWhitelist{}): constantGasFunc(3500),
+		MsgToMsgURL(&assetnfttypes.MsgBurn{}):                     constantGasFunc(AssetNFTBurnPerNFTGas),
+		MsgToMsgURL(&assetnfttypes.MsgIssueClass{}):               constantGasFunc(16000),
+		MsgToMsgURL(&assetnfttypes.MsgMint{}):                     constantGasFunc(AssetNFTMintPerNFTGas),
+		MsgToMsgURL(&assetnfttypes.MsgFreeze{}):                   constantGasFunc(7000),
+		MsgToMsgURL(&assetnfttypes.MsgUnfreeze{}):                 constantGasFunc(5000),
+		MsgToMsgURL(&assetnfttypes.MsgAddToWhitelist{}):           constantGasFunc(7000),
+		MsgToMsgURL(&assetnfttypes.MsgRemoveFromWhitelist{}):      constantGasFunc(3500),
+		MsgToMsgURL(&assetnfttypes.MsgSendWithPayment{}):          constantGasFunc(64000),
+		MsgToMsgURL(&assetnfttypes.MsgUpdateClass{}):              constantGasFunc(8000),
+		MsgToMsgURL(&assetnfttypes.MsgUpdateData{}):               constantGasFunc(8000),
+		MsgToMsgURL(&assetnfttypes.MsgClassFreeze{}):              constantGasFunc(8000),
+		MsgToMsgURL(&assetnfttypes.MsgClassUnfreeze{}):            constantGasFunc(5000),
+		MsgToMsgURL(&assetnfttypes.MsgFreezeClassForAccount{}):    constantGasFunc(8000),
+		MsgToMsgURL(&assetnfttypes.MsgUnfreezeClassForAccount{}):  constantGasFunc(5000),
+		MsgToMsgURL(&assetnfttypes.MsgAddToClassWhitelist{}):      constantGasFunc(7000),
+		MsgToMsgURL(&assetnfttypes.MsgRemoveFromClassWhitelist{}): constantGasFunc(3500),
+		MsgToMsgURL(&assetnfttypes.MsgMintBatch{}):                assetNFTMintBatchMsgGasFunc(AssetNFTMintPerNFTGas),
+		MsgToMsgURL(&assetnfttypes.MsgBurnBatch{}):                assetNFTBurnBatchMsgGasFunc(AssetNFTBurnPerNFTGas),
 
 		// authz
 		MsgToMsgURL(&authz.MsgExec{}):   cfg.authzMsgExecGasFunc(AuthzExecOverhead),
@@ -92,6 +124,10 @@ func DefaultConfig() Config {
 		MsgToMsgURL(&banktypes.MsgSend{}):      bankSendMsgGasFunc(BankSendPerCoinGas),
 		MsgToMsgURL(&banktypes.MsgMultiSend{}): bankMultiSendMsgGasFunc(BankMultiSendPerOperationsGas),
 
+		// dex
+		MsgToMsgURL(&dextypes.MsgPlaceOrder{}):  constantGasFunc(30000),
+		MsgToMsgURL(&dextypes.MsgCancelOrder{}): constantGasFunc(15000),
+
 		// distribution
 		MsgToMsgURL(&distributiontypes.MsgFundCommunityPool{}):           constantGasFunc(15000),
 		MsgToMsgURL(&distributiontypes.MsgSetWithdrawAddress{}):          constantGasFunc(5000),
@@ -108,7 +144,11 @@ func DefaultConfig() Config {
 		MsgToMsgURL(&govtypes.MsgDeposit{}):      constantGasFunc(52000),
 
 		// nft
-		MsgToMsgURL(&nfttypes.MsgSend{}): constantGasFunc(16000),
+		MsgToMsgURL(&nfttypes.MsgSend{}):      constantGasFunc(NFTSendPerNFTGas),
+		MsgToMsgURL(&nfttypes.MsgSendBatch{}): nftSendBatchMsgGasFunc(NFTSendPerNFTGas),
+
+		// nft transfer
+		MsgToMsgURL(&nfttransfertypes.MsgTransfer{}): nftTransferMsgGasFunc(NFTTransferPerNFTGas),
 
 		// slashing
 		MsgToMsgURL(&slashingtypes.MsgUnjail{}): constantGasFunc(25000),
@@ -300,6 +340,50 @@ func bankMultiSendMsgGasFunc(bankMultiSendPerOperationGas uint64) gasByMsgFunc {
 	}
 }
 
+func assetNFTMintBatchMsgGasFunc(assetNFTMintPerNFTGas uint64) gasByMsgFunc {
+	return func(msg sdk.Msg) (uint64, bool) {
+		m, ok := msg.(*assetnfttypes.MsgMintBatch)
+		if !ok {
+			return 0, false
+		}
+
+		return uint64(lo.Max([]int{len(m.NFTs), 1})) * assetNFTMintPerNFTGas, true
+	}
+}
+
+func assetNFTBurnBatchMsgGasFunc(assetNFTBurnPerNFTGas uint64) gasByMsgFunc {
+	return func(msg sdk.Msg) (uint64, bool) {
+		m, ok := msg.(*assetnfttypes.MsgBurnBatch)
+		if !ok {
+			return 0, false
+		}
+
+		return uint64(lo.Max([]int{len(m.IDs), 1})) * assetNFTBurnPerNFTGas, true
+	}
+}
+
+func nftSendBatchMsgGasFunc(nftSendPerNFTGas uint64) gasByMsgFunc {
+	return func(msg sdk.Msg) (uint64, bool) {
+		m, ok := msg.(*nfttypes.MsgSendBatch)
+		if !ok {
+			return 0, false
+		}
+
+		return uint64(lo.Max([]int{len(m.Nfts), 1})) * nftSendPerNFTGas, true
+	}
+}
+
+func nftTransferMsgGasFunc(nftTransferPerNFTGas uint64) gasByMsgFunc {
+	return func(msg sdk.Msg) (uint64, bool) {
+		m, ok := msg.(*nfttransfertypes.MsgTransfer)
+		if !ok {
+			return 0, false
+		}
+
+		return uint64(lo.Max([]int{len(m.TokenIDs), 1})) * nftTransferPerNFTGas, true
+	}
+}
+
 func reportUnknownMessageMetric(msgURL MsgURL) {
 	metrics.IncrCounterWithLabels([]string{"deterministic_gas_unknown_message"}, 1, []metrics.Label{
 		{Name: "msg_name", Value: string(msgURL)},
@@ -14,7 +14,10 @@ import (
 
 	"github.com/CoreumFoundation/coreum/v2/testutil/simapp"
 	assetfttypes "github.com/CoreumFoundation/coreum/v2/x/asset/ft/types"
+	assetnfttypes "github.com/CoreumFoundation/coreum/v2/x/asset/nft/types"
 	"github.com/CoreumFoundation/coreum/v2/x/deterministicgas"
+	nfttypes "github.com/CoreumFoundation/coreum/v2/x/nft"
+	nfttransfertypes "github.com/CoreumFoundation/coreum/v2/x/nfttransfer/types"
 )
 
 // To access private variable from github.com/gogo/protobuf we link it to local variable.
@@ -123,7 +126,7 @@ func TestDeterministicGas_DeterministicMessages(t *testing.T) {
 	// we assert length to be equal to exact number, so each change requires
 	// explicit adjustment of tests.
 	assert.Equal(t, 28, len(nondeterministicMsgs))
-	assert.Equal(t, 41, len(deterministicMsgs))
+	assert.Equal(t, 71, len(deterministicMsgs))
 
 	for _, sdkMsg := range deterministicMsgs {
 		sdkMsg := sdkMsg
@@ -153,6 +156,10 @@ func TestDeterministicGas_GasRequiredByMessage(t *testing.T) {
 		bankSendPerCoinGas           = deterministicgas.BankSendPerCoinGas
 		bankMultiSendPerOperationGas = deterministicgas.BankMultiSendPerOperationsGas
 		authzMsgExecOverhead         = deterministicgas.AuthzExecOverhead
+		assetNFTMintPerNFTGas        = deterministicgas.AssetNFTMintPerNFTGas
+		assetNFTBurnPerNFTGas        = deterministicgas.AssetNFTBurnPerNFTGas
+		nftSendPerNFTGas             = deterministicgas.NFTSendPerNFTGas
+		nftTransferPerNFTGas         = deterministicgas.NFTTransferPerNFTGas
 	)
 
 	cfg := deterministicgas.DefaultConfig()
@@ -277,6 +284,44 @@ func TestDeterministicGas_GasRequiredByMessage(t *testing.T) {
 			expectedGas:             authzMsgExecOverhead + authzMsgExecOverhead + bankSendPerCoinGas + 2*bankMultiSendPerOperationGas + bankSendPerCoinGas,
 			expectedIsDeterministic: true,
 		},
+		{
+			name:                    "assetnft.MsgMintBatch: 0 nfts",
+			msg:                     &assetnfttypes.MsgMintBatch{},
+			expectedGas:             assetNFTMintPerNFTGas,
+			expectedIsDeterministic: true,
+		},
+		{
+			name: "assetnft.MsgMintBatch: 3 nfts",
+			msg: &assetnfttypes.MsgMintBatch{
+				NFTs: make([]assetnfttypes.MintBatchItem, 3),
+			},
+			expectedGas:             3 * assetNFTMintPerNFTGas,
+			expectedIsDeterministic: true,
+		},
+		{
+			name: "assetnft.MsgBurnBatch: 2 nfts",
+			msg: &assetnfttypes.MsgBurnBatch{
+				IDs: []string{"id1", "id2"},
+			},
+			expectedGas:             2 * assetNFTBurnPerNFTGas,
+			expectedIsDeterministic: true,
+		},
+		{
+			name: "nft.MsgSendBatch: 4 nfts",
+			msg: &nfttypes.MsgSendBatch{
+				Nfts: make([]*nfttypes.SendBatchItem, 4),
+			},
+			expectedGas:             4 * nftSendPerNFTGas,
+			expectedIsDeterministic: true,
+		},
+		{
+			name: "nfttransfer.MsgTransfer: 2 nfts",
+			msg: &nfttransfertypes.MsgTransfer{
+				TokenIDs: []string{"id1", "id2"},
+			},
+			expectedGas:             2 * nftTransferPerNFTGas,
+			expectedIsDeterministic: true,
+		},
 		{
 			name: "authz.MsgExec: 1 bank.MsgSend & 1 wasm.MsgExecuteContract",
 			msg: lo.ToPtr(
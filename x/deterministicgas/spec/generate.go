@@ -85,6 +85,10 @@ func main() {
 		BankSendPerCoinGas            uint64
 		BankMultiSendPerOperationsGas uint64
 		AuthzExecOverhead             uint64
+		AssetNFTMintPerNFTGas         uint64
+		AssetNFTBurnPerNFTGas         uint64
+		NFTSendPerNFTGas              uint64
+		NFTTransferPerNFTGas          uint64
 
 		DetermMsgsSpecialCases []deterministicgas.MsgURL
 		DetermMsgs             []determMsg
@@ -104,6 +108,10 @@ func main() {
 		BankSendPerCoinGas:            deterministicgas.BankSendPerCoinGas,
 		BankMultiSendPerOperationsGas: deterministicgas.BankMultiSendPerOperationsGas,
 		AuthzExecOverhead:             deterministicgas.AuthzExecOverhead,
+		AssetNFTMintPerNFTGas:         deterministicgas.AssetNFTMintPerNFTGas,
+		AssetNFTBurnPerNFTGas:         deterministicgas.AssetNFTBurnPerNFTGas,
+		NFTSendPerNFTGas:              deterministicgas.NFTSendPerNFTGas,
+		NFTTransferPerNFTGas:          deterministicgas.NFTTransferPerNFTGas,
 
 		DetermMsgsSpecialCases: determSpeicialCaseMsgURLs,
 		DetermMsgs:             determMsgs,
@@ -0,0 +1,195 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/CoreumFoundation/coreum/v2/pkg/config/constant"
+	"github.com/CoreumFoundation/coreum/v2/x/dex/types"
+)
+
+// GetQueryCmd returns the cli query commands for the module.
+func GetQueryCmd() *cobra.Command {
+	// Group dex queries under a subcommand
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdQueryParams(),
+		CmdQueryOrder(),
+		CmdQueryOrderBook(),
+		CmdQueryAccountOrders(),
+	)
+
+	return cmd
+}
+
+// CmdQueryOrder return the QueryOrder cobra command.
+func CmdQueryOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "order [id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query order",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query order stored in the order book.
+
+Example:
+$ %s query %s order 1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			orderID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return errors.Wrap(err, "invalid order ID")
+			}
+
+			res, err := queryClient.Order(cmd.Context(), &types.QueryOrderRequest{
+				Id: orderID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryOrderBook return the QueryOrderBook cobra command.
+func CmdQueryOrderBook() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "order-book [offered_denom] [desired_denom]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query order book",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query orders offering one denom for another, sorted by the execution priority.
+
+Example:
+$ %s query %s order-book uaaa ubbb
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.OrderBook(cmd.Context(), &types.QueryOrderBookRequest{
+				Pagination:   pageReq,
+				OfferedDenom: args[0],
+				DesiredDenom: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "order book")
+
+	return cmd
+}
+
+// CmdQueryAccountOrders return the QueryAccountOrders cobra command.
+func CmdQueryAccountOrders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-orders [account]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query orders placed by the account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query orders placed by the account and stored in the order books.
+
+Example:
+$ %s query %s account-orders %s
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AccountOrders(cmd.Context(), &types.QueryAccountOrdersRequest{
+				Pagination: pageReq,
+				Account:    args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "account orders")
+
+	return cmd
+}
+
+// CmdQueryParams implements the query params command.
+func CmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "Query the current dex parameters",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query parameters of the dex module.
+
+Example:
+$ %[1]s query %s params
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
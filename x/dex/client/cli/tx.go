@@ -0,0 +1,156 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/CoreumFoundation/coreum/v2/x/dex/types"
+)
+
+// Flags defined on transactions.
+const (
+	MaxPriceFlag = "max-price"
+)
+
+// GetTxCmd returns the transaction commands for this module.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdTxPlaceOrder(),
+		CmdTxCancelOrder(),
+	)
+
+	return cmd
+}
+
+// CmdTxPlaceOrder returns PlaceOrder cobra command.
+func CmdTxPlaceOrder() *cobra.Command {
+	allowedTypes := make([]string, 0, len(types.OrderType_name))
+	for _, n := range types.OrderType_name {
+		allowedTypes = append(allowedTypes, n)
+	}
+	allowedTypesString := strings.Join(allowedTypes, ",")
+
+	cmd := &cobra.Command{
+		Use:   "place-order [type] [offered_amount] [desired_amount] --from [sender]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Place new order",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Place new order, allowed types: %s. For market orders desired amount must be zero
+and the worst accepted price, expressed as the amount of the offered tokens paid for one desired token, must be set.
+
+Example:
+$ %s tx %s place-order limit 100uaaa 1000ubbb --from [sender]
+$ %s tx %s place-order market 100uaaa 0ubbb --%s=0.15 --from [sender]
+`,
+				allowedTypesString,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName, MaxPriceFlag,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			orderType, ok := types.OrderType_value[args[0]]
+			if !ok {
+				return errors.Errorf("unknown order type '%s', allowed types: %s", args[0], allowedTypesString)
+			}
+			offered, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return errors.Wrap(err, "invalid offered amount")
+			}
+			desired, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return errors.Wrap(err, "invalid desired amount")
+			}
+
+			var maxPrice *sdk.Dec
+			maxPriceStr, err := cmd.Flags().GetString(MaxPriceFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			if len(maxPriceStr) > 0 {
+				price, err := sdk.NewDecFromStr(maxPriceStr)
+				if err != nil {
+					return errors.Wrapf(err, "invalid %s", MaxPriceFlag)
+				}
+				maxPrice = &price
+			}
+
+			msg := &types.MsgPlaceOrder{
+				Sender:        clientCtx.GetFromAddress().String(),
+				OrderType:     types.OrderType(orderType),
+				OfferedAmount: offered,
+				DesiredAmount: desired,
+				MaxPrice:      maxPrice,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(MaxPriceFlag, "", "The worst price accepted by the market order, expressed as the amount of the offered tokens paid for one desired token.")
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxCancelOrder returns CancelOrder cobra command.
+func CmdTxCancelOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-order [id] --from [sender]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Cancel the order stored in the order book",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel the order stored in the order book.
+
+Example:
+$ %s tx %s cancel-order 1 --from [sender]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			orderID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return errors.Wrap(err, "invalid order ID")
+			}
+
+			msg := &types.MsgCancelOrder{
+				Sender: clientCtx.GetFromAddress().String(),
+				ID:     orderID,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
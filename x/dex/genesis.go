@@ -0,0 +1,47 @@
+package dex
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/CoreumFoundation/coreum/v2/x/dex/keeper"
+	"github.com/CoreumFoundation/coreum/v2/x/dex/types"
+)
+
+// InitGenesis initializes the dex module's state from a provided genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
+	k.SetOrderSequence(ctx, genState.OrderSequence)
+
+	for _, order := range genState.Orders {
+		if err := k.ImportOrder(ctx, order); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, order := range genState.FailedOrders {
+		if err := k.ImportFailedOrder(ctx, order); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis returns the dex module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	orders, _, err := k.GetOrders(ctx, &query.PageRequest{Limit: query.MaxLimit})
+	if err != nil {
+		panic(err)
+	}
+
+	failedOrders, _, err := k.GetFailedOrders(ctx, &query.PageRequest{Limit: query.MaxLimit})
+	if err != nil {
+		panic(err)
+	}
+
+	return &types.GenesisState{
+		Params:        k.GetParams(ctx),
+		OrderSequence: k.GetOrderSequence(ctx),
+		Orders:        orders,
+		FailedOrders:  failedOrders,
+	}
+}
@@ -0,0 +1,98 @@
+package dex_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/v2/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/v2/x/dex"
+	"github.com/CoreumFoundation/coreum/v2/x/dex/types"
+)
+
+func TestInitAndExportGenesis(t *testing.T) {
+	assertT := assert.New(t)
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	dexKeeper := testApp.DEXKeeper
+
+	account1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	account2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	maxPrice := sdk.MustNewDecFromStr("2")
+	// orders are sorted by the queue, price and ID
+	genState := types.GenesisState{
+		Params: types.Params{
+			MaxMatchesPerBlock: 50,
+		},
+		OrderSequence: 10,
+		Orders: []types.Order{
+			{
+				ID:            3,
+				Account:       account1.String(),
+				Type:          types.OrderType_limit,
+				OfferedAmount: sdk.NewInt64Coin("denom1", 10),
+				DesiredAmount: sdk.NewInt64Coin("denom2", 10),
+			},
+			{
+				ID:            1,
+				Account:       account2.String(),
+				Type:          types.OrderType_limit,
+				OfferedAmount: sdk.NewInt64Coin("denom1", 10),
+				DesiredAmount: sdk.NewInt64Coin("denom2", 20),
+			},
+			{
+				ID:            2,
+				Account:       account1.String(),
+				Type:          types.OrderType_limit,
+				OfferedAmount: sdk.NewInt64Coin("denom2", 10),
+				DesiredAmount: sdk.NewInt64Coin("denom1", 5),
+			},
+		},
+		FailedOrders: []types.Order{
+			{
+				ID:            4,
+				Account:       account2.String(),
+				Type:          types.OrderType_market,
+				OfferedAmount: sdk.NewInt64Coin("denom1", 10),
+				DesiredAmount: sdk.NewInt64Coin("denom2", 0),
+				MaxPrice:      &maxPrice,
+			},
+		},
+	}
+
+	dex.InitGenesis(ctx, dexKeeper, genState)
+
+	failedOrder, err := dexKeeper.GetFailedOrder(ctx, 4)
+	requireT.NoError(err)
+	assertT.Equal(genState.FailedOrders[0], failedOrder)
+
+	order, err := dexKeeper.GetOrder(ctx, 2)
+	requireT.NoError(err)
+	assertT.Equal(genState.Orders[2], order)
+
+	accountOrders, _, err := dexKeeper.GetAccountOrders(ctx, account1, &query.PageRequest{})
+	requireT.NoError(err)
+	assertT.Len(accountOrders, 2)
+
+	// next order gets the ID following the imported sequence
+	requireT.NoError(testApp.FundAccount(ctx, account1, sdk.NewCoins(sdk.NewInt64Coin("denom3", 10))))
+	orderID, err := dexKeeper.PlaceOrder(ctx, account1, types.OrderType_limit, sdk.NewInt64Coin("denom3", 10), sdk.NewInt64Coin("denom1", 10), nil)
+	requireT.NoError(err)
+	assertT.EqualValues(11, orderID)
+
+	// export
+	exportedGenState := dex.ExportGenesis(ctx, dexKeeper)
+	assertT.Equal(genState.Params, exportedGenState.Params)
+	assertT.EqualValues(11, exportedGenState.OrderSequence)
+	assertT.Equal(genState.Orders, exportedGenState.Orders)
+	assertT.Equal(genState.FailedOrders, exportedGenState.FailedOrders)
+}
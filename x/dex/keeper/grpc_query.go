@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/CoreumFoundation/coreum/v2/x/dex/types"
+)
+
+var _ types.QueryServer = QueryService{}
+
+// QueryKeeper defines subscope of keeper methods required by query service.
+type QueryKeeper interface {
+	GetParams(ctx sdk.Context) types.Params
+	GetOrder(ctx sdk.Context, orderID uint64) (types.Order, error)
+	GetOrderBook(
+		ctx sdk.Context,
+		offeredDenom, desiredDenom string,
+		pagination *query.PageRequest,
+	) ([]types.Order, *query.PageResponse, error)
+	GetAccountOrders(
+		ctx sdk.Context,
+		account sdk.AccAddress,
+		pagination *query.PageRequest,
+	) ([]types.Order, *query.PageResponse, error)
+}
+
+// QueryService serves grpc query requests for dex module.
+type QueryService struct {
+	keeper QueryKeeper
+}
+
+// NewQueryService initiates the new instance of query service.
+func NewQueryService(keeper QueryKeeper) QueryService {
+	return QueryService{
+		keeper: keeper,
+	}
+}
+
+// Params queries the parameters of x/dex module.
+func (qs QueryService) Params(ctx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	return &types.QueryParamsResponse{
+		Params: qs.keeper.GetParams(sdk.UnwrapSDKContext(ctx)),
+	}, nil
+}
+
+// Order queries the order stored in the order book.
+func (qs QueryService) Order(ctx context.Context, req *types.QueryOrderRequest) (*types.QueryOrderResponse, error) {
+	order, err := qs.keeper.GetOrder(sdk.UnwrapSDKContext(ctx), req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryOrderResponse{
+		Order: order,
+	}, nil
+}
+
+// OrderBook queries the orders offering one denom for another, sorted by the execution priority.
+func (qs QueryService) OrderBook(
+	ctx context.Context,
+	req *types.QueryOrderBookRequest,
+) (*types.QueryOrderBookResponse, error) {
+	orders, pageRes, err := qs.keeper.GetOrderBook(
+		sdk.UnwrapSDKContext(ctx),
+		req.OfferedDenom,
+		req.DesiredDenom,
+		req.Pagination,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryOrderBookResponse{
+		Pagination: pageRes,
+		Orders:     orders,
+	}, nil
+}
+
+// AccountOrders queries the orders placed by the account.
+func (qs QueryService) AccountOrders(
+	ctx context.Context,
+	req *types.QueryAccountOrdersRequest,
+) (*types.QueryAccountOrdersResponse, error) {
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "account is required and must be valid account address")
+	}
+
+	orders, pageRes, err := qs.keeper.GetAccountOrders(sdk.UnwrapSDKContext(ctx), account, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAccountOrdersResponse{
+		Pagination: pageRes,
+		Orders:     orders,
+	}, nil
+}
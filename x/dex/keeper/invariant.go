@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/CoreumFoundation/coreum/v2/x/dex/types"
+)
+
+// Invariant names.
+const (
+	LockedFundsInvariantName = "locked-funds"
+	OrderBookInvariantName   = "order-book"
+)
+
+// RegisterInvariants registers the dex module invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, LockedFundsInvariantName, LockedFundsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, OrderBookInvariantName, OrderBookInvariant(k))
+}
+
+// LockedFundsInvariant checks that the module account holds enough funds to cover all the orders stored
+// in the order books and the failed orders.
+func LockedFundsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		orders, _, err := k.GetOrders(ctx, &query.PageRequest{Limit: query.MaxLimit})
+		if err != nil {
+			panic(err)
+		}
+
+		failedOrders, _, err := k.GetFailedOrders(ctx, &query.PageRequest{Limit: query.MaxLimit})
+		if err != nil {
+			panic(err)
+		}
+
+		expected := sdk.NewCoins()
+		for _, order := range append(orders, failedOrders...) {
+			expected = expected.Add(order.OfferedAmount)
+		}
+
+		balance := k.bankKeeper.GetAllBalances(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName))
+		broken := !balance.IsAllGTE(expected)
+
+		return sdk.FormatInvariant(
+			types.ModuleName, LockedFundsInvariantName,
+			fmt.Sprintf("\tlocked funds: %s\n\tmodule balance: %s\n", expected, balance),
+		), broken
+	}
+}
+
+// OrderBookInvariant checks that all the orders stored in the order books are valid and correctly indexed.
+func OrderBookInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg             string
+			violationsCount int
+		)
+
+		orders, _, err := k.GetOrders(ctx, &query.PageRequest{Limit: query.MaxLimit})
+		if err != nil {
+			panic(err)
+		}
+
+		for _, order := range orders {
+			if err := order.Validate(); err != nil {
+				violationsCount++
+				msg += fmt.Sprintf("\t order %d is invalid: %s\n", order.ID, err)
+				continue
+			}
+			if order.Type != types.OrderType_limit {
+				violationsCount++
+				msg += fmt.Sprintf("\t order %d stored in the order book is not a limit order\n", order.ID)
+				continue
+			}
+
+			if err := k.checkOrderIndexes(ctx, order); err != nil {
+				violationsCount++
+				msg += fmt.Sprintf("\t order %d is indexed incorrectly: %s\n", order.ID, err)
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, OrderBookInvariantName,
+			fmt.Sprintf("number of invariant violation %d\n%s", violationsCount, msg),
+		), violationsCount != 0
+	}
+}
+
+func (k Keeper) checkOrderIndexes(ctx sdk.Context, order types.Order) error {
+	store := ctx.KVStore(k.storeKey)
+
+	orderBookKey, err := k.orderBookKey(ctx, order)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(store.Get(types.CreateOrderKey(order.ID)), orderBookKey) {
+		return sdkerrors.Wrap(types.ErrInvalidState, "order key doesn't point to the order book key")
+	}
+
+	accountOrderKey, err := types.CreateAccountOrderKey(sdk.MustAccAddressFromBech32(order.Account), order.ID)
+	if err != nil {
+		return err
+	}
+	if !store.Has(accountOrderKey) {
+		return sdkerrors.Wrap(types.ErrInvalidState, "order is not indexed by the account")
+	}
+
+	return nil
+}
@@ -0,0 +1,465 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/CoreumFoundation/coreum/v2/x/asset"
+	"github.com/CoreumFoundation/coreum/v2/x/dex/types"
+)
+
+// ParamSubspace represents a subscope of methods exposed by param module to store and retrieve parameters.
+type ParamSubspace interface {
+	GetParamSet(ctx sdk.Context, ps paramtypes.ParamSet)
+	SetParamSet(ctx sdk.Context, ps paramtypes.ParamSet)
+}
+
+// Keeper is the dex module keeper.
+type Keeper struct {
+	cdc               codec.BinaryCodec
+	paramSubspace     ParamSubspace
+	storeKey          sdk.StoreKey
+	transientStoreKey sdk.StoreKey
+	accountKeeper     types.AccountKeeper
+	bankKeeper        types.BankKeeper
+	assetFTKeeper     types.AssetFTKeeper
+}
+
+// NewKeeper creates a new instance of the Keeper.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	paramSubspace ParamSubspace,
+	storeKey sdk.StoreKey,
+	transientStoreKey sdk.StoreKey,
+	accountKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper,
+	assetFTKeeper types.AssetFTKeeper,
+) Keeper {
+	return Keeper{
+		cdc:               cdc,
+		paramSubspace:     paramSubspace,
+		storeKey:          storeKey,
+		transientStoreKey: transientStoreKey,
+		accountKeeper:     accountKeeper,
+		bankKeeper:        bankKeeper,
+		assetFTKeeper:     assetFTKeeper,
+	}
+}
+
+// GetParams gets the parameters of the module.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	var params types.Params
+	k.paramSubspace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the parameters of the module.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSubspace.SetParamSet(ctx, &params)
+}
+
+// PlaceOrder locks the offered funds and adds the order to the queue of orders executed at the end of the block.
+func (k Keeper) PlaceOrder(
+	ctx sdk.Context,
+	account sdk.AccAddress,
+	orderType types.OrderType,
+	offered, desired sdk.Coin,
+	maxPrice *sdk.Dec,
+) (uint64, error) {
+	if err := types.ValidateOrderAmounts(orderType, offered, desired, maxPrice); err != nil {
+		return 0, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(
+		types.WithPurpose(ctx, types.PurposeLock),
+		account,
+		types.ModuleName,
+		sdk.NewCoins(offered),
+	); err != nil {
+		return 0, sdkerrors.Wrapf(err, "can't lock %s offered by %s", offered, account)
+	}
+
+	order := types.Order{
+		ID:            k.nextOrderID(ctx),
+		Account:       account.String(),
+		Type:          orderType,
+		OfferedAmount: offered,
+		DesiredAmount: desired,
+		MaxPrice:      maxPrice,
+	}
+
+	offeredDenomID := k.getOrCreateDenomID(ctx, offered.Denom)
+	desiredDenomID := k.getOrCreateDenomID(ctx, desired.Denom)
+	ctx.TransientStore(k.transientStoreKey).Set(
+		types.CreateTransientOrderKey(offeredDenomID, desiredDenomID, k.nextTransientSequence(ctx)),
+		k.cdc.MustMarshal(&order),
+	)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventOrderPlaced{
+		ID:            order.ID,
+		Account:       order.Account,
+		Type:          order.Type,
+		OfferedAmount: order.OfferedAmount,
+		DesiredAmount: order.DesiredAmount,
+		MaxPrice:      order.MaxPrice,
+	}); err != nil {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventOrderPlaced event: %s", err)
+	}
+
+	return order.ID, nil
+}
+
+// CancelOrder removes the order from the order book and refunds the locked funds.
+// Only orders already stored in the order book or failed orders might be canceled.
+func (k Keeper) CancelOrder(ctx sdk.Context, account sdk.AccAddress, orderID uint64) error {
+	order, err := k.GetOrder(ctx, orderID)
+	isFailed := false
+	if types.ErrOrderNotFound.Is(err) {
+		order, err = k.GetFailedOrder(ctx, orderID)
+		isFailed = true
+	}
+	if err != nil {
+		return err
+	}
+
+	if order.Account != account.String() {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "order %d doesn't belong to %s", orderID, account)
+	}
+
+	if isFailed {
+		ctx.KVStore(k.storeKey).Delete(types.CreateFailedOrderKey(order.ID))
+	} else if err := k.removeOrder(ctx, order); err != nil {
+		return err
+	}
+
+	if err := k.unlock(ctx, order); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventOrderCanceled{
+		ID:             order.ID,
+		Account:        order.Account,
+		RefundedAmount: order.OfferedAmount,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventOrderCanceled event: %s", err)
+	}
+
+	return nil
+}
+
+// GetOrder returns the order stored in the order book.
+func (k Keeper) GetOrder(ctx sdk.Context, orderID uint64) (types.Order, error) {
+	store := ctx.KVStore(k.storeKey)
+	orderBookKey := store.Get(types.CreateOrderKey(orderID))
+	if orderBookKey == nil {
+		return types.Order{}, sdkerrors.Wrapf(types.ErrOrderNotFound, "order ID: %d", orderID)
+	}
+
+	bz := store.Get(orderBookKey)
+	if bz == nil {
+		return types.Order{}, sdkerrors.Wrapf(types.ErrInvalidState, "order %d is indexed but not stored in the order book", orderID)
+	}
+
+	var order types.Order
+	k.cdc.MustUnmarshal(bz, &order)
+	return order, nil
+}
+
+// GetFailedOrder returns the order whose execution failed and whose funds couldn't be refunded.
+func (k Keeper) GetFailedOrder(ctx sdk.Context, orderID uint64) (types.Order, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.CreateFailedOrderKey(orderID))
+	if bz == nil {
+		return types.Order{}, sdkerrors.Wrapf(types.ErrOrderNotFound, "order ID: %d", orderID)
+	}
+
+	var order types.Order
+	k.cdc.MustUnmarshal(bz, &order)
+	return order, nil
+}
+
+// GetFailedOrders returns the orders whose execution failed and whose funds couldn't be refunded.
+func (k Keeper) GetFailedOrders(
+	ctx sdk.Context,
+	pagination *query.PageRequest,
+) ([]types.Order, *query.PageResponse, error) {
+	return k.getOrders(ctx, types.FailedOrderKeyPrefix, pagination)
+}
+
+// GetOrderBook returns the orders offering offeredDenom for desiredDenom sorted by the execution priority.
+func (k Keeper) GetOrderBook(
+	ctx sdk.Context,
+	offeredDenom, desiredDenom string,
+	pagination *query.PageRequest,
+) ([]types.Order, *query.PageResponse, error) {
+	offeredDenomID, offeredFound := k.getDenomID(ctx, offeredDenom)
+	desiredDenomID, desiredFound := k.getDenomID(ctx, desiredDenom)
+	if !offeredFound || !desiredFound {
+		// nobody has ever traded the pair, so the order book is empty
+		return []types.Order{}, &query.PageResponse{}, nil
+	}
+
+	return k.getOrders(ctx, types.CreateQueuePrefix(offeredDenomID, desiredDenomID), pagination)
+}
+
+// GetOrders returns all the orders stored in the order books.
+func (k Keeper) GetOrders(ctx sdk.Context, pagination *query.PageRequest) ([]types.Order, *query.PageResponse, error) {
+	return k.getOrders(ctx, types.OrderBookKeyPrefix, pagination)
+}
+
+// GetAccountOrders returns the orders placed by the account and stored in the order books.
+func (k Keeper) GetAccountOrders(
+	ctx sdk.Context,
+	account sdk.AccAddress,
+	pagination *query.PageRequest,
+) ([]types.Order, *query.PageResponse, error) {
+	accountPrefix, err := types.CreateAccountOrdersPrefix(account)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	orders := make([]types.Order, 0)
+	pageRes, err := query.Paginate(
+		prefix.NewStore(ctx.KVStore(k.storeKey), accountPrefix),
+		pagination,
+		func(key, _ []byte) error {
+			orderID, err := types.BytesToUint64(key)
+			if err != nil {
+				return err
+			}
+			order, err := k.GetOrder(ctx, orderID)
+			if err != nil {
+				return err
+			}
+			orders = append(orders, order)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrapf(types.ErrInvalidInput, "failed to paginate: %s", err)
+	}
+
+	return orders, pageRes, nil
+}
+
+// GetOrderSequence returns the last assigned order ID.
+func (k Keeper) GetOrderSequence(ctx sdk.Context) uint64 {
+	return k.getSequence(ctx.KVStore(k.storeKey), types.OrderSequenceKey)
+}
+
+// SetOrderSequence sets the last assigned order ID, should not be used outside the module except for genesis.
+func (k Keeper) SetOrderSequence(ctx sdk.Context, sequence uint64) {
+	ctx.KVStore(k.storeKey).Set(types.OrderSequenceKey, types.Uint64ToBytes(sequence))
+}
+
+// ImportOrder stores the order in the order book without locking the funds,
+// should not be used directly outside the module except for genesis.
+func (k Keeper) ImportOrder(ctx sdk.Context, order types.Order) error {
+	if err := order.Validate(); err != nil {
+		return err
+	}
+	if _, err := k.GetOrder(ctx, order.ID); err == nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "order %d already exists", order.ID)
+	}
+
+	k.getOrCreateDenomID(ctx, order.OfferedAmount.Denom)
+	k.getOrCreateDenomID(ctx, order.DesiredAmount.Denom)
+
+	return k.saveOrder(ctx, order)
+}
+
+// ImportFailedOrder stores the failed order without locking the funds,
+// should not be used directly outside the module except for genesis.
+func (k Keeper) ImportFailedOrder(ctx sdk.Context, order types.Order) error {
+	if err := order.Validate(); err != nil {
+		return err
+	}
+	if _, err := k.GetFailedOrder(ctx, order.ID); err == nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "failed order %d already exists", order.ID)
+	}
+	if _, err := k.GetOrder(ctx, order.ID); err == nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "order %d already exists", order.ID)
+	}
+
+	k.saveFailedOrder(ctx, order)
+	return nil
+}
+
+func (k Keeper) getOrders(
+	ctx sdk.Context,
+	keyPrefix []byte,
+	pagination *query.PageRequest,
+) ([]types.Order, *query.PageResponse, error) {
+	ordersPointers, pageRes, err := query.GenericFilteredPaginate(
+		k.cdc,
+		prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix),
+		pagination,
+		// builder
+		func(key []byte, order *types.Order) (*types.Order, error) {
+			return order, nil
+		},
+		// constructor
+		func() *types.Order {
+			return &types.Order{}
+		},
+	)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrapf(types.ErrInvalidInput, "failed to paginate: %s", err)
+	}
+
+	orders := make([]types.Order, 0, len(ordersPointers))
+	for _, order := range ordersPointers {
+		orders = append(orders, *order)
+	}
+
+	return orders, pageRes, nil
+}
+
+// saveOrder stores the order in the order book under the key computed from its current price.
+func (k Keeper) saveOrder(ctx sdk.Context, order types.Order) error {
+	if order.Type != types.OrderType_limit {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "only limit orders might be stored in the order book, order ID: %d", order.ID)
+	}
+
+	orderBookKey, err := k.orderBookKey(ctx, order)
+	if err != nil {
+		return err
+	}
+
+	account, err := sdk.AccAddressFromBech32(order.Account)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "invalid account address: %s", err)
+	}
+	accountOrderKey, err := types.CreateAccountOrderKey(account, order.ID)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(orderBookKey, k.cdc.MustMarshal(&order))
+	store.Set(types.CreateOrderKey(order.ID), orderBookKey)
+	store.Set(accountOrderKey, asset.StoreTrue)
+
+	return nil
+}
+
+// saveFailedOrder stores the order whose execution failed and whose funds couldn't be refunded.
+func (k Keeper) saveFailedOrder(ctx sdk.Context, order types.Order) {
+	ctx.KVStore(k.storeKey).Set(types.CreateFailedOrderKey(order.ID), k.cdc.MustMarshal(&order))
+}
+
+// removeOrder removes the order stored in the order book.
+func (k Keeper) removeOrder(ctx sdk.Context, order types.Order) error {
+	store := ctx.KVStore(k.storeKey)
+	orderKey := types.CreateOrderKey(order.ID)
+	orderBookKey := store.Get(orderKey)
+	if orderBookKey == nil {
+		return sdkerrors.Wrapf(types.ErrOrderNotFound, "order ID: %d", order.ID)
+	}
+
+	account, err := sdk.AccAddressFromBech32(order.Account)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "invalid account address: %s", err)
+	}
+	accountOrderKey, err := types.CreateAccountOrderKey(account, order.ID)
+	if err != nil {
+		return err
+	}
+
+	store.Delete(orderBookKey)
+	store.Delete(orderKey)
+	store.Delete(accountOrderKey)
+
+	return nil
+}
+
+func (k Keeper) orderBookKey(ctx sdk.Context, order types.Order) ([]byte, error) {
+	offeredDenomID, found := k.getDenomID(ctx, order.OfferedAmount.Denom)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidState, "denom %s is not registered", order.OfferedAmount.Denom)
+	}
+	desiredDenomID, found := k.getDenomID(ctx, order.DesiredAmount.Denom)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidState, "denom %s is not registered", order.DesiredAmount.Denom)
+	}
+
+	priceKey, err := types.ComputePriceKey(order.OfferedAmount.Amount, order.DesiredAmount.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.CreateOrderBookKey(offeredDenomID, desiredDenomID, priceKey, order.ID), nil
+}
+
+// unlock returns the offered amount of the order to its owner.
+func (k Keeper) unlock(ctx sdk.Context, order types.Order) error {
+	if order.OfferedAmount.IsZero() {
+		return nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(
+		types.WithPurpose(ctx, types.PurposeUnlock),
+		types.ModuleName,
+		sdk.MustAccAddressFromBech32(order.Account),
+		sdk.NewCoins(order.OfferedAmount),
+	); err != nil {
+		return sdkerrors.Wrapf(err, "can't unlock %s for order %d", order.OfferedAmount, order.ID)
+	}
+
+	return nil
+}
+
+func (k Keeper) getDenomID(ctx sdk.Context, denom string) (uint64, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.CreateDenomKey(denom))
+	if bz == nil {
+		return 0, false
+	}
+	denomID, err := types.BytesToUint64(bz)
+	if err != nil {
+		panic(err)
+	}
+	return denomID, true
+}
+
+// getOrCreateDenomID returns the ID of the denom used in the store keys, new ID is assigned
+// whenever denom is used for the first time.
+func (k Keeper) getOrCreateDenomID(ctx sdk.Context, denom string) uint64 {
+	if denomID, found := k.getDenomID(ctx, denom); found {
+		return denomID
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	denomID := k.getSequence(store, types.DenomSequenceKey) + 1
+	store.Set(types.DenomSequenceKey, types.Uint64ToBytes(denomID))
+	store.Set(types.CreateDenomKey(denom), types.Uint64ToBytes(denomID))
+
+	return denomID
+}
+
+func (k Keeper) nextOrderID(ctx sdk.Context) uint64 {
+	orderID := k.GetOrderSequence(ctx) + 1
+	k.SetOrderSequence(ctx, orderID)
+	return orderID
+}
+
+func (k Keeper) nextTransientSequence(ctx sdk.Context) uint64 {
+	store := ctx.TransientStore(k.transientStoreKey)
+	sequence := k.getSequence(store, types.TransientOrderSequenceKey) + 1
+	store.Set(types.TransientOrderSequenceKey, types.Uint64ToBytes(sequence))
+	return sequence
+}
+
+func (k Keeper) getSequence(store sdk.KVStore, key []byte) uint64 {
+	bz := store.Get(key)
+	if bz == nil {
+		return 0
+	}
+	sequence, err := types.BytesToUint64(bz)
+	if err != nil {
+		panic(err)
+	}
+	return sequence
+}
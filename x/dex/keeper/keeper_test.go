@@ -0,0 +1,850 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/v2/testutil/event"
+	"github.com/CoreumFoundation/coreum/v2/testutil/simapp"
+	assetfttypes "github.com/CoreumFoundation/coreum/v2/x/asset/ft/types"
+	"github.com/CoreumFoundation/coreum/v2/x/dex/keeper"
+	"github.com/CoreumFoundation/coreum/v2/x/dex/types"
+)
+
+const (
+	denom1 = "denom1"
+	denom2 = "denom2"
+)
+
+func TestKeeper_PlaceAndCancelOrder(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	dexKeeper := testApp.DEXKeeper
+	bankKeeper := testApp.BankKeeper
+
+	account := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	requireT.NoError(testApp.FundAccount(ctx, account, sdk.NewCoins(sdk.NewInt64Coin(denom1, 100))))
+
+	// invalid orders are rejected
+	_, err := dexKeeper.PlaceOrder(ctx, account, types.OrderType_limit, sdk.NewInt64Coin(denom1, 10), sdk.NewInt64Coin(denom1, 10), nil)
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+	_, err = dexKeeper.PlaceOrder(ctx, account, types.OrderType_market, sdk.NewInt64Coin(denom1, 10), sdk.NewInt64Coin(denom2, 10), mustNewDec("1"))
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+	_, err = dexKeeper.PlaceOrder(ctx, account, types.OrderType_market, sdk.NewInt64Coin(denom1, 10), sdk.NewInt64Coin(denom2, 0), nil)
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	// placing order without enough funds fails
+	_, err = dexKeeper.PlaceOrder(ctx, account, types.OrderType_limit, sdk.NewInt64Coin(denom1, 101), sdk.NewInt64Coin(denom2, 10), nil)
+	requireT.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	orderID, err := dexKeeper.PlaceOrder(ctx, account, types.OrderType_limit, sdk.NewInt64Coin(denom1, 60), sdk.NewInt64Coin(denom2, 30), nil)
+	requireT.NoError(err)
+	requireT.EqualValues(1, orderID)
+	requireT.Equal("40", bankKeeper.GetBalance(ctx, account, denom1).Amount.String())
+
+	// order is not available before it is executed
+	_, err = dexKeeper.GetOrder(ctx, orderID)
+	requireT.ErrorIs(err, types.ErrOrderNotFound)
+	requireT.ErrorIs(dexKeeper.CancelOrder(ctx, account, orderID), types.ErrOrderNotFound)
+
+	requireT.NoError(dexKeeper.ExecuteOrders(ctx))
+
+	expectedOrder := types.Order{
+		ID:            orderID,
+		Account:       account.String(),
+		Type:          types.OrderType_limit,
+		OfferedAmount: sdk.NewInt64Coin(denom1, 60),
+		DesiredAmount: sdk.NewInt64Coin(denom2, 30),
+	}
+	order, err := dexKeeper.GetOrder(ctx, orderID)
+	requireT.NoError(err)
+	requireT.Equal(expectedOrder, order)
+
+	orders, _, err := dexKeeper.GetOrderBook(ctx, denom1, denom2, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Equal([]types.Order{expectedOrder}, orders)
+	orders, _, err = dexKeeper.GetOrderBook(ctx, denom2, denom1, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Empty(orders)
+	orders, _, err = dexKeeper.GetAccountOrders(ctx, account, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Equal([]types.Order{expectedOrder}, orders)
+
+	// order is not executed twice
+	requireT.NoError(dexKeeper.ExecuteOrders(ctx))
+	orders, _, err = dexKeeper.GetOrders(ctx, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Len(orders, 1)
+
+	// only owner can cancel the order
+	requireT.ErrorIs(
+		dexKeeper.CancelOrder(ctx, sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()), orderID),
+		sdkerrors.ErrUnauthorized,
+	)
+
+	requireT.NoError(dexKeeper.CancelOrder(ctx, account, orderID))
+	requireT.Equal("100", bankKeeper.GetBalance(ctx, account, denom1).Amount.String())
+	_, err = dexKeeper.GetOrder(ctx, orderID)
+	requireT.ErrorIs(err, types.ErrOrderNotFound)
+	orders, _, err = dexKeeper.GetAccountOrders(ctx, account, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Empty(orders)
+
+	assertInvariants(t, ctx, dexKeeper)
+}
+
+func TestKeeper_MatchOrders(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		makerOffered         sdk.Coin
+		makerDesired         sdk.Coin
+		takerType            types.OrderType
+		takerMaxPrice        *sdk.Dec
+		takerOffered         sdk.Coin
+		takerDesired         sdk.Coin
+		expectedMakerBalance sdk.Coins
+		expectedTakerBalance sdk.Coins
+		expectedMakerOrder   *types.Order
+		expectedTakerOrder   *types.Order
+	}{
+		{
+			name:                 "both_fully_executed",
+			makerOffered:         sdk.NewInt64Coin(denom1, 100),
+			makerDesired:         sdk.NewInt64Coin(denom2, 200),
+			takerType:            types.OrderType_limit,
+			takerOffered:         sdk.NewInt64Coin(denom2, 200),
+			takerDesired:         sdk.NewInt64Coin(denom1, 100),
+			expectedMakerBalance: sdk.NewCoins(sdk.NewInt64Coin(denom1, 900), sdk.NewInt64Coin(denom2, 200)),
+			expectedTakerBalance: sdk.NewCoins(sdk.NewInt64Coin(denom1, 100), sdk.NewInt64Coin(denom2, 800)),
+		},
+		{
+			name:                 "taker_gets_better_price",
+			makerOffered:         sdk.NewInt64Coin(denom1, 100),
+			makerDesired:         sdk.NewInt64Coin(denom2, 200),
+			takerType:            types.OrderType_limit,
+			takerOffered:         sdk.NewInt64Coin(denom2, 300),
+			takerDesired:         sdk.NewInt64Coin(denom1, 100),
+			expectedMakerBalance: sdk.NewCoins(sdk.NewInt64Coin(denom1, 900), sdk.NewInt64Coin(denom2, 200)),
+			expectedTakerBalance: sdk.NewCoins(sdk.NewInt64Coin(denom1, 100), sdk.NewInt64Coin(denom2, 800)),
+		},
+		{
+			name:                 "maker_partially_executed",
+			makerOffered:         sdk.NewInt64Coin(denom1, 100),
+			makerDesired:         sdk.NewInt64Coin(denom2, 200),
+			takerType:            types.OrderType_limit,
+			takerOffered:         sdk.NewInt64Coin(denom2, 100),
+			takerDesired:         sdk.NewInt64Coin(denom1, 50),
+			expectedMakerBalance: sdk.NewCoins(sdk.NewInt64Coin(denom1, 900), sdk.NewInt64Coin(denom2, 100)),
+			expectedTakerBalance: sdk.NewCoins(sdk.NewInt64Coin(denom1, 50), sdk.NewInt64Coin(denom2, 900)),
+			expectedMakerOrder: &types.Order{
+				ID:            1,
+				Type:          types.OrderType_limit,
+				OfferedAmount: sdk.NewInt64Coin(denom1, 50),
+				DesiredAmount: sdk.NewInt64Coin(denom2, 100),
+			},
+		},
+		{
+			name:                 "taker_partially_executed",
+			makerOffered:         sdk.NewInt64Coin(denom1, 100),
+			makerDesired:         sdk.NewInt64Coin(denom2, 200),
+			takerType:            types.OrderType_limit,
+			takerOffered:         sdk.NewInt64Coin(denom2, 400),
+			takerDesired:         sdk.NewInt64Coin(denom1, 200),
+			expectedMakerBalance: sdk.NewCoins(sdk.NewInt64Coin(denom1, 900), sdk.NewInt64Coin(denom2, 200)),
+			expectedTakerBalance: sdk.NewCoins(sdk.NewInt64Coin(denom1, 100), sdk.NewInt64Coin(denom2, 600)),
+			expectedTakerOrder: &types.Order{
+				ID:            2,
+				Type:          types.OrderType_limit,
+				OfferedAmount: sdk.NewInt64Coin(denom2, 200),
+				DesiredAmount: sdk.NewInt64Coin(denom1, 100),
+			},
+		},
+		{
+			name:                 "prices_not_matching",
+			makerOffered:         sdk.NewInt64Coin(denom1, 100),
+			makerDesired:         sdk.NewInt64Coin(denom2, 200),
+			takerType:            types.OrderType_limit,
+			takerOffered:         sdk.NewInt64Coin(denom2, 100),
+			takerDesired:         sdk.NewInt64Coin(denom1, 100),
+			expectedMakerBalance: sdk.NewCoins(sdk.NewInt64Coin(denom1, 900)),
+			expectedTakerBalance: sdk.NewCoins(sdk.NewInt64Coin(denom2, 900)),
+			expectedMakerOrder: &types.Order{
+				ID:            1,
+				Type:          types.OrderType_limit,
+				OfferedAmount: sdk.NewInt64Coin(denom1, 100),
+				DesiredAmount: sdk.NewInt64Coin(denom2, 200),
+			},
+			expectedTakerOrder: &types.Order{
+				ID:            2,
+				Type:          types.OrderType_limit,
+				OfferedAmount: sdk.NewInt64Coin(denom2, 100),
+				DesiredAmount: sdk.NewInt64Coin(denom1, 100),
+			},
+		},
+		{
+			name:                 "market_order_partially_executing_maker",
+			makerOffered:         sdk.NewInt64Coin(denom1, 100),
+			makerDesired:         sdk.NewInt64Coin(denom2, 200),
+			takerType:            types.OrderType_market,
+			takerMaxPrice:        mustNewDec("2"),
+			takerOffered:         sdk.NewInt64Coin(denom2, 150),
+			takerDesired:         sdk.NewInt64Coin(denom1, 0),
+			expectedMakerBalance: sdk.NewCoins(sdk.NewInt64Coin(denom1, 900), sdk.NewInt64Coin(denom2, 150)),
+			expectedTakerBalance: sdk.NewCoins(sdk.NewInt64Coin(denom1, 75), sdk.NewInt64Coin(denom2, 850)),
+			expectedMakerOrder: &types.Order{
+				ID:            1,
+				Type:          types.OrderType_limit,
+				OfferedAmount: sdk.NewInt64Coin(denom1, 25),
+				DesiredAmount: sdk.NewInt64Coin(denom2, 50),
+			},
+		},
+		{
+			name:                 "market_order_refunded",
+			makerOffered:         sdk.NewInt64Coin(denom1, 100),
+			makerDesired:         sdk.NewInt64Coin(denom2, 200),
+			takerType:            types.OrderType_market,
+			takerMaxPrice:        mustNewDec("2.5"),
+			takerOffered:         sdk.NewInt64Coin(denom2, 300),
+			takerDesired:         sdk.NewInt64Coin(denom1, 0),
+			expectedMakerBalance: sdk.NewCoins(sdk.NewInt64Coin(denom1, 900), sdk.NewInt64Coin(denom2, 200)),
+			expectedTakerBalance: sdk.NewCoins(sdk.NewInt64Coin(denom1, 100), sdk.NewInt64Coin(denom2, 800)),
+		},
+		{
+			name:                 "market_order_max_price_exceeded",
+			makerOffered:         sdk.NewInt64Coin(denom1, 100),
+			makerDesired:         sdk.NewInt64Coin(denom2, 200),
+			takerType:            types.OrderType_market,
+			takerMaxPrice:        mustNewDec("1.99"),
+			takerOffered:         sdk.NewInt64Coin(denom2, 300),
+			takerDesired:         sdk.NewInt64Coin(denom1, 0),
+			expectedMakerBalance: sdk.NewCoins(sdk.NewInt64Coin(denom1, 900)),
+			expectedTakerBalance: sdk.NewCoins(sdk.NewInt64Coin(denom2, 1000)),
+			expectedMakerOrder: &types.Order{
+				ID:            1,
+				Type:          types.OrderType_limit,
+				OfferedAmount: sdk.NewInt64Coin(denom1, 100),
+				DesiredAmount: sdk.NewInt64Coin(denom2, 200),
+			},
+		},
+		{
+			name:                 "market_order_max_price_exceeded_by_rounding",
+			makerOffered:         sdk.NewInt64Coin(denom1, 3),
+			makerDesired:         sdk.NewInt64Coin(denom2, 10),
+			takerType:            types.OrderType_market,
+			takerMaxPrice:        mustNewDec("3.4"),
+			takerOffered:         sdk.NewInt64Coin(denom2, 5),
+			takerDesired:         sdk.NewInt64Coin(denom1, 0),
+			expectedMakerBalance: sdk.NewCoins(sdk.NewInt64Coin(denom1, 997)),
+			expectedTakerBalance: sdk.NewCoins(sdk.NewInt64Coin(denom2, 1000)),
+			expectedMakerOrder: &types.Order{
+				ID:            1,
+				Type:          types.OrderType_limit,
+				OfferedAmount: sdk.NewInt64Coin(denom1, 3),
+				DesiredAmount: sdk.NewInt64Coin(denom2, 10),
+			},
+		},
+		{
+			name:                 "maker_price_rounded_in_favour_of_maker",
+			makerOffered:         sdk.NewInt64Coin(denom1, 3),
+			makerDesired:         sdk.NewInt64Coin(denom2, 10),
+			takerType:            types.OrderType_limit,
+			takerOffered:         sdk.NewInt64Coin(denom2, 5),
+			takerDesired:         sdk.NewInt64Coin(denom1, 1),
+			expectedMakerBalance: sdk.NewCoins(sdk.NewInt64Coin(denom1, 997), sdk.NewInt64Coin(denom2, 4)),
+			expectedTakerBalance: sdk.NewCoins(sdk.NewInt64Coin(denom1, 1), sdk.NewInt64Coin(denom2, 996)),
+			expectedMakerOrder: &types.Order{
+				ID:            1,
+				Type:          types.OrderType_limit,
+				OfferedAmount: sdk.NewInt64Coin(denom1, 2),
+				DesiredAmount: sdk.NewInt64Coin(denom2, 6),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			requireT := require.New(t)
+
+			testApp := simapp.New()
+			ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+			dexKeeper := testApp.DEXKeeper
+			bankKeeper := testApp.BankKeeper
+
+			maker := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+			taker := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+			requireT.NoError(testApp.FundAccount(ctx, maker, sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000))))
+			requireT.NoError(testApp.FundAccount(ctx, taker, sdk.NewCoins(sdk.NewInt64Coin(denom2, 1000))))
+
+			makerOrderID, err := dexKeeper.PlaceOrder(ctx, maker, types.OrderType_limit, tc.makerOffered, tc.makerDesired, nil)
+			requireT.NoError(err)
+			requireT.NoError(dexKeeper.ExecuteOrders(ctx))
+
+			takerOrderID, err := dexKeeper.PlaceOrder(ctx, taker, tc.takerType, tc.takerOffered, tc.takerDesired, tc.takerMaxPrice)
+			requireT.NoError(err)
+			requireT.NoError(dexKeeper.ExecuteOrders(ctx))
+
+			requireT.Equal(tc.expectedMakerBalance.String(), bankKeeper.GetAllBalances(ctx, maker).String())
+			requireT.Equal(tc.expectedTakerBalance.String(), bankKeeper.GetAllBalances(ctx, taker).String())
+
+			assertOrder(t, ctx, dexKeeper, makerOrderID, maker, tc.expectedMakerOrder)
+			assertOrder(t, ctx, dexKeeper, takerOrderID, taker, tc.expectedTakerOrder)
+			assertInvariants(t, ctx, dexKeeper)
+		})
+	}
+}
+
+func TestKeeper_MatchOrdersPriority(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	dexKeeper := testApp.DEXKeeper
+	bankKeeper := testApp.BankKeeper
+
+	maker1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	maker2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	maker3 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	taker := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	for _, maker := range []sdk.AccAddress{maker1, maker2, maker3} {
+		requireT.NoError(testApp.FundAccount(ctx, maker, sdk.NewCoins(sdk.NewInt64Coin(denom1, 100))))
+	}
+	requireT.NoError(testApp.FundAccount(ctx, taker, sdk.NewCoins(sdk.NewInt64Coin(denom2, 1000))))
+
+	// maker1 and maker2 offer the same price, but maker1 is first, maker3 offers the best price
+	_, err := dexKeeper.PlaceOrder(ctx, maker1, types.OrderType_limit, sdk.NewInt64Coin(denom1, 100), sdk.NewInt64Coin(denom2, 300), nil)
+	requireT.NoError(err)
+	_, err = dexKeeper.PlaceOrder(ctx, maker2, types.OrderType_limit, sdk.NewInt64Coin(denom1, 100), sdk.NewInt64Coin(denom2, 300), nil)
+	requireT.NoError(err)
+	_, err = dexKeeper.PlaceOrder(ctx, maker3, types.OrderType_limit, sdk.NewInt64Coin(denom1, 100), sdk.NewInt64Coin(denom2, 200), nil)
+	requireT.NoError(err)
+	requireT.NoError(dexKeeper.ExecuteOrders(ctx))
+
+	orders, _, err := dexKeeper.GetOrderBook(ctx, denom1, denom2, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Len(orders, 3)
+	requireT.Equal(maker3.String(), orders[0].Account)
+	requireT.Equal(maker1.String(), orders[1].Account)
+	requireT.Equal(maker2.String(), orders[2].Account)
+
+	_, err = dexKeeper.PlaceOrder(ctx, taker, types.OrderType_limit, sdk.NewInt64Coin(denom2, 500), sdk.NewInt64Coin(denom1, 200), nil)
+	requireT.NoError(err)
+	requireT.NoError(dexKeeper.ExecuteOrders(ctx))
+
+	requireT.Equal("200", bankKeeper.GetBalance(ctx, maker3, denom2).Amount.String())
+	requireT.Equal("300", bankKeeper.GetBalance(ctx, maker1, denom2).Amount.String())
+	requireT.True(bankKeeper.GetBalance(ctx, maker2, denom2).IsZero())
+	requireT.Equal("200", bankKeeper.GetBalance(ctx, taker, denom1).Amount.String())
+
+	orders, _, err = dexKeeper.GetOrderBook(ctx, denom1, denom2, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Len(orders, 1)
+	requireT.Equal(maker2.String(), orders[0].Account)
+
+	assertInvariants(t, ctx, dexKeeper)
+}
+
+func TestKeeper_MatchOrdersLimitedPerBlock(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	dexKeeper := testApp.DEXKeeper
+	bankKeeper := testApp.BankKeeper
+
+	params := dexKeeper.GetParams(ctx)
+	params.MaxMatchesPerBlock = 3
+	dexKeeper.SetParams(ctx, params)
+
+	const makersCount = 10
+	makers := make([]sdk.AccAddress, 0, makersCount)
+	for i := 0; i < makersCount; i++ {
+		maker := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+		requireT.NoError(testApp.FundAccount(ctx, maker, sdk.NewCoins(sdk.NewInt64Coin(denom1, 10))))
+		_, err := dexKeeper.PlaceOrder(ctx, maker, types.OrderType_limit, sdk.NewInt64Coin(denom1, 10), sdk.NewInt64Coin(denom2, 10), nil)
+		requireT.NoError(err)
+		makers = append(makers, maker)
+	}
+	requireT.NoError(dexKeeper.ExecuteOrders(ctx))
+
+	taker1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	taker2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	taker3 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	for _, taker := range []sdk.AccAddress{taker1, taker2, taker3} {
+		requireT.NoError(testApp.FundAccount(ctx, taker, sdk.NewCoins(sdk.NewInt64Coin(denom2, 100))))
+	}
+
+	// taker1 crosses all the makers, but only 3 of them are matched, then the taker is closed
+	taker1OrderID, err := dexKeeper.PlaceOrder(ctx, taker1, types.OrderType_limit, sdk.NewInt64Coin(denom2, 100), sdk.NewInt64Coin(denom1, 100), nil)
+	requireT.NoError(err)
+	// taker2 crosses the order book too, but nothing is left to match it, so it is closed
+	taker2OrderID, err := dexKeeper.PlaceOrder(ctx, taker2, types.OrderType_market, sdk.NewInt64Coin(denom2, 100), sdk.NewInt64Coin(denom1, 0), mustNewDec("1"))
+	requireT.NoError(err)
+	// taker3 doesn't cross the order book, so it is stored without matching
+	taker3OrderID, err := dexKeeper.PlaceOrder(ctx, taker3, types.OrderType_limit, sdk.NewInt64Coin(denom2, 10), sdk.NewInt64Coin(denom1, 20), nil)
+	requireT.NoError(err)
+	requireT.NoError(dexKeeper.ExecuteOrders(ctx))
+
+	requireT.Equal(sdk.NewCoins(sdk.NewInt64Coin(denom1, 30), sdk.NewInt64Coin(denom2, 70)).String(),
+		bankKeeper.GetAllBalances(ctx, taker1).String())
+	requireT.Equal(sdk.NewCoins(sdk.NewInt64Coin(denom2, 100)).String(), bankKeeper.GetAllBalances(ctx, taker2).String())
+	for i, maker := range makers {
+		if i < 3 {
+			requireT.Equal(sdk.NewCoins(sdk.NewInt64Coin(denom2, 10)).String(), bankKeeper.GetAllBalances(ctx, maker).String())
+			continue
+		}
+		requireT.True(bankKeeper.GetAllBalances(ctx, maker).IsZero())
+	}
+	assertOrder(t, ctx, dexKeeper, taker1OrderID, taker1, nil)
+	assertOrder(t, ctx, dexKeeper, taker2OrderID, taker2, nil)
+	assertOrder(t, ctx, dexKeeper, taker3OrderID, taker3, &types.Order{
+		ID:            taker3OrderID,
+		Type:          types.OrderType_limit,
+		OfferedAmount: sdk.NewInt64Coin(denom2, 10),
+		DesiredAmount: sdk.NewInt64Coin(denom1, 20),
+	})
+	orders, _, err := dexKeeper.GetOrderBook(ctx, denom1, denom2, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Len(orders, makersCount-3)
+
+	// the limit is reset in the next block
+	_, err = dexKeeper.PlaceOrder(ctx, taker2, types.OrderType_market, sdk.NewInt64Coin(denom2, 100), sdk.NewInt64Coin(denom1, 0), mustNewDec("1"))
+	requireT.NoError(err)
+	requireT.NoError(dexKeeper.ExecuteOrders(ctx))
+	requireT.Equal(sdk.NewCoins(sdk.NewInt64Coin(denom1, 30), sdk.NewInt64Coin(denom2, 70)).String(),
+		bankKeeper.GetAllBalances(ctx, taker2).String())
+
+	assertInvariants(t, ctx, dexKeeper)
+}
+
+func TestKeeper_MatchOrdersWithAssetFTRules(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	dexKeeper := testApp.DEXKeeper
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	maker := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	taker := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	ftDenom, err := ftKeeper.Issue(ctx, assetfttypes.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "ABC",
+		Subunit:       "abc",
+		Precision:     1,
+		InitialAmount: sdk.NewInt(1000),
+		Features:      []assetfttypes.Feature{assetfttypes.Feature_whitelisting},
+		BurnRate:      sdk.MustNewDecFromStr("0.1"),
+	})
+	requireT.NoError(err)
+
+	requireT.NoError(ftKeeper.SetWhitelistedBalance(ctx, issuer, maker, sdk.NewInt64Coin(ftDenom, 110)))
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, maker, sdk.NewCoins(sdk.NewInt64Coin(ftDenom, 110))))
+	requireT.NoError(testApp.FundAccount(ctx, taker, sdk.NewCoins(sdk.NewInt64Coin(denom2, 1000))))
+
+	// burn rate is not charged when funds are locked, the dex module account doesn't need to be whitelisted
+	makerOrderID, err := dexKeeper.PlaceOrder(ctx, maker, types.OrderType_limit, sdk.NewInt64Coin(ftDenom, 100), sdk.NewInt64Coin(denom2, 100), nil)
+	requireT.NoError(err)
+	requireT.Equal("10", bankKeeper.GetBalance(ctx, maker, ftDenom).Amount.String())
+	requireT.NoError(dexKeeper.ExecuteOrders(ctx))
+
+	// taker is not whitelisted, so the taker order is closed and refunded, while the maker order stays untouched
+	takerOrderID, err := dexKeeper.PlaceOrder(ctx, taker, types.OrderType_limit, sdk.NewInt64Coin(denom2, 100), sdk.NewInt64Coin(ftDenom, 100), nil)
+	requireT.NoError(err)
+	requireT.NoError(dexKeeper.ExecuteOrders(ctx))
+	requireT.Equal("1000", bankKeeper.GetBalance(ctx, taker, denom2).Amount.String())
+	requireT.True(bankKeeper.GetBalance(ctx, taker, ftDenom).IsZero())
+	requireT.Equal("10", bankKeeper.GetBalance(ctx, maker, ftDenom).Amount.String())
+	_, err = dexKeeper.GetOrder(ctx, takerOrderID)
+	requireT.ErrorIs(err, types.ErrOrderNotFound)
+	_, err = dexKeeper.GetOrder(ctx, makerOrderID)
+	requireT.NoError(err)
+
+	// after whitelisting the order is executed and the burn rate of the executed amount is charged from the maker
+	requireT.NoError(ftKeeper.SetWhitelistedBalance(ctx, issuer, taker, sdk.NewInt64Coin(ftDenom, 100)))
+	_, err = dexKeeper.PlaceOrder(ctx, taker, types.OrderType_limit, sdk.NewInt64Coin(denom2, 100), sdk.NewInt64Coin(ftDenom, 100), nil)
+	requireT.NoError(err)
+	requireT.NoError(dexKeeper.ExecuteOrders(ctx))
+	requireT.Equal("100", bankKeeper.GetBalance(ctx, taker, ftDenom).Amount.String())
+	requireT.Equal("100", bankKeeper.GetBalance(ctx, maker, denom2).Amount.String())
+	requireT.True(bankKeeper.GetBalance(ctx, maker, ftDenom).IsZero())
+	_, err = dexKeeper.GetOrder(ctx, makerOrderID)
+	requireT.ErrorIs(err, types.ErrOrderNotFound)
+
+	assertInvariants(t, ctx, dexKeeper)
+}
+
+func TestKeeper_PlaceOrderWithAssetFTTransferLimits(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{Time: time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)})
+
+	dexKeeper := testApp.DEXKeeper
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	maker := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	taker := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	ftDenom, err := ftKeeper.Issue(ctx, assetfttypes.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "ABC",
+		Subunit:       "abc",
+		Precision:     1,
+		InitialAmount: sdk.NewInt(1000),
+		Features:      []assetfttypes.Feature{assetfttypes.Feature_transfer_limiting},
+	})
+	requireT.NoError(err)
+	requireT.NoError(ftKeeper.UpdateTransferLimits(ctx, issuer, assetfttypes.TransferLimits{
+		Denom:                 ftDenom,
+		MaxTransferAmount:     sdk.NewInt(50),
+		MaxAccountDailyAmount: sdk.NewInt(80),
+		MaxTokenDailyAmount:   sdk.ZeroInt(),
+	}))
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, maker, sdk.NewCoins(sdk.NewInt64Coin(ftDenom, 200))))
+	requireT.NoError(testApp.FundAccount(ctx, taker, sdk.NewCoins(sdk.NewInt64Coin(denom2, 1000))))
+
+	// the lock exceeding the max transfer amount is rejected the same way as the bank send
+	err = bankKeeper.SendCoins(ctx, maker, recipient, sdk.NewCoins(sdk.NewInt64Coin(ftDenom, 51)))
+	requireT.ErrorIs(err, assetfttypes.ErrTransferLimitExceeded)
+	_, err = dexKeeper.PlaceOrder(ctx, maker, types.OrderType_limit, sdk.NewInt64Coin(ftDenom, 51), sdk.NewInt64Coin(denom2, 51), nil)
+	requireT.ErrorIs(err, assetfttypes.ErrTransferLimitExceeded)
+
+	// the lock and the bank send share the account daily amount
+	requireT.NoError(bankKeeper.SendCoins(ctx, maker, recipient, sdk.NewCoins(sdk.NewInt64Coin(ftDenom, 30))))
+	makerOrderID, err := dexKeeper.PlaceOrder(ctx, maker, types.OrderType_limit, sdk.NewInt64Coin(ftDenom, 50), sdk.NewInt64Coin(denom2, 50), nil)
+	requireT.NoError(err)
+	_, err = dexKeeper.PlaceOrder(ctx, maker, types.OrderType_limit, sdk.NewInt64Coin(ftDenom, 1), sdk.NewInt64Coin(denom2, 1), nil)
+	requireT.ErrorIs(err, assetfttypes.ErrTransferLimitExceeded)
+	err = bankKeeper.SendCoins(ctx, maker, recipient, sdk.NewCoins(sdk.NewInt64Coin(ftDenom, 1)))
+	requireT.ErrorIs(err, assetfttypes.ErrTransferLimitExceeded)
+	requireT.NoError(dexKeeper.ExecuteOrders(ctx))
+
+	// the settlement is not counted again
+	_, err = dexKeeper.PlaceOrder(ctx, taker, types.OrderType_limit, sdk.NewInt64Coin(denom2, 50), sdk.NewInt64Coin(ftDenom, 50), nil)
+	requireT.NoError(err)
+	requireT.NoError(dexKeeper.ExecuteOrders(ctx))
+	requireT.Equal("50", bankKeeper.GetBalance(ctx, taker, ftDenom).Amount.String())
+	_, err = dexKeeper.GetOrder(ctx, makerOrderID)
+	requireT.ErrorIs(err, types.ErrOrderNotFound)
+
+	accountVolume, err := ftKeeper.GetAccountDailyVolume(ctx, ftDenom, maker)
+	requireT.NoError(err)
+	requireT.Equal("80", accountVolume.String())
+
+	assertInvariants(t, ctx, dexKeeper)
+}
+
+func TestKeeper_CancelOrderWithAssetFTRates(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	dexKeeper := testApp.DEXKeeper
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	maker := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	taker := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	ftDenom, err := ftKeeper.Issue(ctx, assetfttypes.IssueSettings{
+		Issuer:             issuer,
+		Symbol:             "ABC",
+		Subunit:            "abc",
+		Precision:          1,
+		InitialAmount:      sdk.NewInt(1000),
+		BurnRate:           sdk.MustNewDecFromStr("0.1"),
+		SendCommissionRate: sdk.MustNewDecFromStr("0.2"),
+	})
+	requireT.NoError(err)
+
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, maker, sdk.NewCoins(sdk.NewInt64Coin(ftDenom, 100))))
+	requireT.NoError(testApp.FundAccount(ctx, taker, sdk.NewCoins(sdk.NewInt64Coin(denom2, 1000))))
+
+	// rates are not charged when the order is cancelled, so the full balance is restored
+	makerOrderID, err := dexKeeper.PlaceOrder(ctx, maker, types.OrderType_limit, sdk.NewInt64Coin(ftDenom, 100), sdk.NewInt64Coin(denom2, 100), nil)
+	requireT.NoError(err)
+	requireT.True(bankKeeper.GetBalance(ctx, maker, ftDenom).IsZero())
+	requireT.NoError(dexKeeper.ExecuteOrders(ctx))
+	requireT.NoError(dexKeeper.CancelOrder(ctx, maker, makerOrderID))
+	requireT.Equal("100", bankKeeper.GetBalance(ctx, maker, ftDenom).Amount.String())
+
+	// rates are charged only for the executed amount, the rest is refunded in full when the order is cancelled
+	makerOrderID, err = dexKeeper.PlaceOrder(ctx, maker, types.OrderType_limit, sdk.NewInt64Coin(ftDenom, 50), sdk.NewInt64Coin(denom2, 50), nil)
+	requireT.NoError(err)
+	requireT.NoError(dexKeeper.ExecuteOrders(ctx))
+	_, err = dexKeeper.PlaceOrder(ctx, taker, types.OrderType_limit, sdk.NewInt64Coin(denom2, 20), sdk.NewInt64Coin(ftDenom, 20), nil)
+	requireT.NoError(err)
+	requireT.NoError(dexKeeper.ExecuteOrders(ctx))
+	requireT.Equal("20", bankKeeper.GetBalance(ctx, taker, ftDenom).Amount.String())
+	// 50 locked, 2 burnt and 4 sent to the issuer as the commission for the 20 executed tokens
+	requireT.Equal("44", bankKeeper.GetBalance(ctx, maker, ftDenom).Amount.String())
+	requireT.Equal("904", bankKeeper.GetBalance(ctx, issuer, ftDenom).Amount.String())
+
+	requireT.NoError(dexKeeper.CancelOrder(ctx, maker, makerOrderID))
+	requireT.Equal("74", bankKeeper.GetBalance(ctx, maker, ftDenom).Amount.String())
+
+	assertInvariants(t, ctx, dexKeeper)
+}
+
+func TestKeeper_MatchOrdersWithFailingMaker(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	dexKeeper := testApp.DEXKeeper
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	maker1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	maker2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	taker := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	ftDenom, err := ftKeeper.Issue(ctx, assetfttypes.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "ABC",
+		Subunit:       "abc",
+		Precision:     1,
+		InitialAmount: sdk.NewInt(1000),
+		Features:      []assetfttypes.Feature{assetfttypes.Feature_freezing},
+	})
+	requireT.NoError(err)
+
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, maker1, sdk.NewCoins(sdk.NewInt64Coin(ftDenom, 100))))
+	requireT.NoError(testApp.FundAccount(ctx, maker2, sdk.NewCoins(sdk.NewInt64Coin(denom1, 100))))
+	requireT.NoError(testApp.FundAccount(ctx, taker, sdk.NewCoins(sdk.NewInt64Coin(denom2, 1000))))
+
+	maker1OrderID, err := dexKeeper.PlaceOrder(ctx, maker1, types.OrderType_limit, sdk.NewInt64Coin(ftDenom, 100), sdk.NewInt64Coin(denom2, 100), nil)
+	requireT.NoError(err)
+	requireT.NoError(dexKeeper.ExecuteOrders(ctx))
+
+	// the funds locked by maker1 can't be sent anymore
+	requireT.NoError(ftKeeper.GloballyFreeze(ctx, issuer, ftDenom))
+
+	// maker1 order fails, so it is closed and refunded, while the taker is still matched with other orders
+	takerOrderID, err := dexKeeper.PlaceOrder(ctx, taker, types.OrderType_limit, sdk.NewInt64Coin(denom2, 200), sdk.NewInt64Coin(ftDenom, 200), nil)
+	requireT.NoError(err)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	requireT.NoError(dexKeeper.ExecuteOrders(ctx))
+
+	failedEvents, err := event.FindTypedEvents[*types.EventOrderFailed](ctx.EventManager().Events().ToABCIEvents())
+	requireT.NoError(err)
+	requireT.Len(failedEvents, 1)
+	requireT.Equal(maker1OrderID, failedEvents[0].ID)
+
+	requireT.Equal("100", bankKeeper.GetBalance(ctx, maker1, ftDenom).Amount.String())
+	requireT.True(bankKeeper.GetBalance(ctx, maker1, denom2).IsZero())
+	assertOrder(t, ctx, dexKeeper, maker1OrderID, maker1, nil)
+	assertOrder(t, ctx, dexKeeper, takerOrderID, taker, &types.Order{
+		ID:            takerOrderID,
+		Type:          types.OrderType_limit,
+		OfferedAmount: sdk.NewInt64Coin(denom2, 200),
+		DesiredAmount: sdk.NewInt64Coin(ftDenom, 200),
+	})
+
+	// the market order too small to buy anything is closed and the maker stays untouched
+	maker2OrderID, err := dexKeeper.PlaceOrder(ctx, maker2, types.OrderType_limit, sdk.NewInt64Coin(denom1, 100), sdk.NewInt64Coin(denom2, 200), nil)
+	requireT.NoError(err)
+	requireT.NoError(dexKeeper.ExecuteOrders(ctx))
+	marketOrderID, err := dexKeeper.PlaceOrder(ctx, taker, types.OrderType_market, sdk.NewInt64Coin(denom2, 1), sdk.NewInt64Coin(denom1, 0), mustNewDec("2"))
+	requireT.NoError(err)
+	requireT.NoError(dexKeeper.ExecuteOrders(ctx))
+
+	requireT.Equal("800", bankKeeper.GetBalance(ctx, taker, denom2).Amount.String())
+	assertOrder(t, ctx, dexKeeper, marketOrderID, taker, nil)
+	assertOrder(t, ctx, dexKeeper, maker2OrderID, maker2, &types.Order{
+		ID:            maker2OrderID,
+		Type:          types.OrderType_limit,
+		OfferedAmount: sdk.NewInt64Coin(denom1, 100),
+		DesiredAmount: sdk.NewInt64Coin(denom2, 200),
+	})
+
+	assertInvariants(t, ctx, dexKeeper)
+}
+
+func TestKeeper_MatchOrdersWithFrozenOwner(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	dexKeeper := testApp.DEXKeeper
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	maker := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	taker := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	ftDenom, err := ftKeeper.Issue(ctx, assetfttypes.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "ABC",
+		Subunit:       "abc",
+		Precision:     1,
+		InitialAmount: sdk.NewInt(1000),
+		Features:      []assetfttypes.Feature{assetfttypes.Feature_freezing},
+	})
+	requireT.NoError(err)
+
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, maker, sdk.NewCoins(sdk.NewInt64Coin(ftDenom, 100))))
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, taker, sdk.NewCoins(sdk.NewInt64Coin(ftDenom, 100))))
+	requireT.NoError(testApp.FundAccount(ctx, maker, sdk.NewCoins(sdk.NewInt64Coin(denom2, 100))))
+	requireT.NoError(testApp.FundAccount(ctx, taker, sdk.NewCoins(sdk.NewInt64Coin(denom2, 100))))
+
+	makerOrderID, err := dexKeeper.PlaceOrder(ctx, maker, types.OrderType_limit, sdk.NewInt64Coin(ftDenom, 100), sdk.NewInt64Coin(denom2, 100), nil)
+	requireT.NoError(err)
+	requireT.NoError(dexKeeper.ExecuteOrders(ctx))
+
+	// the maker is frozen after placing the order, so its locked funds can't be sent to the taker
+	requireT.NoError(ftKeeper.Freeze(ctx, issuer, maker, sdk.NewInt64Coin(ftDenom, 100)))
+
+	takerOrderID, err := dexKeeper.PlaceOrder(ctx, taker, types.OrderType_limit, sdk.NewInt64Coin(denom2, 100), sdk.NewInt64Coin(ftDenom, 100), nil)
+	requireT.NoError(err)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	requireT.NoError(dexKeeper.ExecuteOrders(ctx))
+
+	failedEvents, err := event.FindTypedEvents[*types.EventOrderFailed](ctx.EventManager().Events().ToABCIEvents())
+	requireT.NoError(err)
+	requireT.Len(failedEvents, 1)
+	requireT.Equal(makerOrderID, failedEvents[0].ID)
+
+	// the maker order is refunded despite the freezing, and the taker order waits in the order book
+	requireT.Equal("100", bankKeeper.GetBalance(ctx, maker, ftDenom).Amount.String())
+	requireT.Equal("100", bankKeeper.GetBalance(ctx, maker, denom2).Amount.String())
+	assertOrder(t, ctx, dexKeeper, makerOrderID, maker, nil)
+	assertOrder(t, ctx, dexKeeper, takerOrderID, taker, &types.Order{
+		ID:            takerOrderID,
+		Type:          types.OrderType_limit,
+		OfferedAmount: sdk.NewInt64Coin(denom2, 100),
+		DesiredAmount: sdk.NewInt64Coin(ftDenom, 100),
+	})
+	requireT.NoError(dexKeeper.CancelOrder(ctx, taker, takerOrderID))
+
+	// the taker is frozen in the block the order is placed in, so the taker order fails
+	makerOrderID, err = dexKeeper.PlaceOrder(ctx, maker, types.OrderType_limit, sdk.NewInt64Coin(denom2, 100), sdk.NewInt64Coin(ftDenom, 100), nil)
+	requireT.NoError(err)
+	requireT.NoError(dexKeeper.ExecuteOrders(ctx))
+
+	takerOrderID, err = dexKeeper.PlaceOrder(ctx, taker, types.OrderType_limit, sdk.NewInt64Coin(ftDenom, 100), sdk.NewInt64Coin(denom2, 100), nil)
+	requireT.NoError(err)
+	requireT.NoError(ftKeeper.Freeze(ctx, issuer, taker, sdk.NewInt64Coin(ftDenom, 60)))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	requireT.NoError(dexKeeper.ExecuteOrders(ctx))
+
+	failedEvents, err = event.FindTypedEvents[*types.EventOrderFailed](ctx.EventManager().Events().ToABCIEvents())
+	requireT.NoError(err)
+	requireT.Len(failedEvents, 1)
+	requireT.Equal(takerOrderID, failedEvents[0].ID)
+
+	requireT.Equal("100", bankKeeper.GetBalance(ctx, taker, ftDenom).Amount.String())
+	requireT.Equal("100", bankKeeper.GetBalance(ctx, taker, denom2).Amount.String())
+	assertOrder(t, ctx, dexKeeper, takerOrderID, taker, nil)
+	assertOrder(t, ctx, dexKeeper, makerOrderID, maker, &types.Order{
+		ID:            makerOrderID,
+		Type:          types.OrderType_limit,
+		OfferedAmount: sdk.NewInt64Coin(denom2, 100),
+		DesiredAmount: sdk.NewInt64Coin(ftDenom, 100),
+	})
+
+	assertInvariants(t, ctx, dexKeeper)
+}
+
+func TestKeeper_CancelFailedOrder(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	dexKeeper := testApp.DEXKeeper
+	bankKeeper := testApp.BankKeeper
+
+	account := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	moduleAddr := testApp.AccountKeeper.GetModuleAddress(types.ModuleName)
+	requireT.NoError(testApp.FundAccount(ctx, account, sdk.NewCoins(sdk.NewInt64Coin(denom1, 100))))
+	requireT.NoError(bankKeeper.SendCoins(ctx, account, moduleAddr, sdk.NewCoins(sdk.NewInt64Coin(denom1, 100))))
+
+	failedOrder := types.Order{
+		ID:            1,
+		Account:       account.String(),
+		Type:          types.OrderType_market,
+		OfferedAmount: sdk.NewInt64Coin(denom1, 100),
+		DesiredAmount: sdk.NewInt64Coin(denom2, 0),
+		MaxPrice:      mustNewDec("2"),
+	}
+	requireT.NoError(dexKeeper.ImportFailedOrder(ctx, failedOrder))
+	assertInvariants(t, ctx, dexKeeper)
+
+	// only owner can cancel the failed order
+	requireT.ErrorIs(
+		dexKeeper.CancelOrder(ctx, sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()), failedOrder.ID),
+		sdkerrors.ErrUnauthorized,
+	)
+
+	requireT.NoError(dexKeeper.CancelOrder(ctx, account, failedOrder.ID))
+	requireT.Equal("100", bankKeeper.GetBalance(ctx, account, denom1).Amount.String())
+	_, err := dexKeeper.GetFailedOrder(ctx, failedOrder.ID)
+	requireT.ErrorIs(err, types.ErrOrderNotFound)
+	requireT.ErrorIs(dexKeeper.CancelOrder(ctx, account, failedOrder.ID), types.ErrOrderNotFound)
+
+	assertInvariants(t, ctx, dexKeeper)
+}
+
+func assertOrder(
+	t *testing.T,
+	ctx sdk.Context,
+	dexKeeper keeper.Keeper,
+	orderID uint64,
+	account sdk.AccAddress,
+	expectedOrder *types.Order,
+) {
+	t.Helper()
+
+	order, err := dexKeeper.GetOrder(ctx, orderID)
+	if expectedOrder == nil {
+		require.ErrorIs(t, err, types.ErrOrderNotFound)
+		return
+	}
+
+	require.NoError(t, err)
+	expected := *expectedOrder
+	expected.Account = account.String()
+	require.Equal(t, expected, order)
+}
+
+func assertInvariants(t *testing.T, ctx sdk.Context, dexKeeper keeper.Keeper) {
+	t.Helper()
+
+	msg, broken := keeper.LockedFundsInvariant(dexKeeper)(ctx)
+	require.False(t, broken, msg)
+	msg, broken = keeper.OrderBookInvariant(dexKeeper)(ctx)
+	require.False(t, broken, msg)
+}
+
+func mustNewDec(str string) *sdk.Dec {
+	dec := sdk.MustNewDecFromStr(str)
+	return &dec
+}
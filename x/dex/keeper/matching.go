@@ -0,0 +1,359 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	assetfttypes "github.com/CoreumFoundation/coreum/v2/x/asset/ft/types"
+	"github.com/CoreumFoundation/coreum/v2/x/dex/types"
+)
+
+// ExecuteOrders matches the orders received in the current block against the order books.
+// Orders are processed in the sequence they were received. The not executed part of the limit order is stored
+// in the order book, while the not executed part of the market order is refunded.
+// The orders are executed independently, so if the execution of the order fails, its changes are discarded
+// and the order is closed, without affecting the other orders.
+// The number of matches executed in the block is limited by the module parameters. Once the limit is reached,
+// the orders crossing the order book are closed and their not executed part is refunded.
+func (k Keeper) ExecuteOrders(ctx sdk.Context) error {
+	store := prefix.NewStore(ctx.TransientStore(k.transientStoreKey), types.TransientOrderKeyPrefix)
+
+	var (
+		keys   [][]byte
+		orders []types.Order
+	)
+	iterator := store.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		var order types.Order
+		k.cdc.MustUnmarshal(iterator.Value(), &order)
+		keys = append(keys, iterator.Key())
+		orders = append(orders, order)
+	}
+	// iterator is closed before the store is modified
+	if err := iterator.Close(); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to close iterator: %s", err)
+	}
+
+	remainingMatches := k.GetParams(ctx).MaxMatchesPerBlock
+	for i, order := range orders {
+		store.Delete(keys[i])
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		// the matches done by the failed order are counted too, because they have been executed anyway
+		if err := k.matchOrder(cacheCtx, order, &remainingMatches); err != nil {
+			ctx.Logger().Error("order execution failed", "orderID", order.ID, "err", err)
+			k.failOrder(ctx, order, err)
+			continue
+		}
+		writeCache()
+		// the events are emitted to the new event manager of the cached context, so they must be passed to the original one
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+
+	return nil
+}
+
+// failOrder closes the order whose execution failed. If even the refund fails, the order is stored as failed,
+// so the funds stay locked until the owner cancels the order.
+func (k Keeper) failOrder(ctx sdk.Context, order types.Order, execErr error) {
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventOrderFailed{
+		ID:      order.ID,
+		Account: order.Account,
+		Error:   execErr.Error(),
+	}); err != nil {
+		ctx.Logger().Error("failed to emit EventOrderFailed event", "orderID", order.ID, "err", err)
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.closeOrder(cacheCtx, order); err != nil {
+		ctx.Logger().Error("failed order can't be closed", "orderID", order.ID, "err", err)
+		k.saveFailedOrder(ctx, order)
+		return
+	}
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+}
+
+// matchOrder reduces the taker order using the orders from the opposite queue, as long as the prices match.
+// Each order taken from the opposite queue consumes one of the remaining matches. If none of them is left,
+// the taker crossing the order book is closed.
+func (k Keeper) matchOrder(ctx sdk.Context, taker types.Order, remainingMatches *uint32) error {
+	offeredDenomID, _ := k.getDenomID(ctx, taker.OfferedAmount.Denom)
+	desiredDenomID, _ := k.getDenomID(ctx, taker.DesiredAmount.Denom)
+	oppositeQueuePrefix := types.CreateQueuePrefix(desiredDenomID, offeredDenomID)
+
+	for !taker.IsCleared() {
+		maker, found := k.topOrder(ctx, oppositeQueuePrefix)
+		if !found || !isMatching(taker, maker) {
+			break
+		}
+
+		if *remainingMatches == 0 {
+			// The limit of matches in the block is reached. Storing the taker would cross the order book,
+			// so it is closed instead.
+			return k.closeOrder(ctx, taker)
+		}
+		*remainingMatches--
+
+		takerSends, makerSends, ok := computeReduction(taker, maker)
+		if !ok {
+			// The exchange can't be executed without violating the price requested by any of the orders.
+			// Leaving both orders untouched would cross the order book, so the one which can't be executed
+			// is closed. If the taker is a market order or it can't afford a single unit offered by the maker,
+			// the taker is closed, otherwise the maker is removed and matching continues with the next order.
+			if taker.Type == types.OrderType_market ||
+				taker.OfferedAmount.Amount.LT(ceilQuo(maker.DesiredAmount.Amount, maker.OfferedAmount.Amount)) {
+				return k.closeOrder(ctx, taker)
+			}
+			if err := k.removeOrder(ctx, maker); err != nil {
+				return err
+			}
+			if err := k.closeOrder(ctx, maker); err != nil {
+				return err
+			}
+			continue
+		}
+
+		takerErr, makerErr, err := k.settle(ctx, taker, maker, takerSends, makerSends)
+		if err != nil {
+			return err
+		}
+		if takerErr != nil {
+			k.failOrder(ctx, taker, takerErr)
+			return nil
+		}
+		if makerErr != nil {
+			if err := k.removeOrder(ctx, maker); err != nil {
+				return err
+			}
+			k.failOrder(ctx, maker, makerErr)
+			continue
+		}
+
+		taker = reduceOrder(taker, takerSends, makerSends)
+		maker = reduceOrder(maker, makerSends, takerSends)
+
+		if err := k.removeOrder(ctx, maker); err != nil {
+			return err
+		}
+		if err := k.storeOrCloseOrder(ctx, maker); err != nil {
+			return err
+		}
+	}
+
+	if taker.Type == types.OrderType_market {
+		return k.closeOrder(ctx, taker)
+	}
+
+	return k.storeOrCloseOrder(ctx, taker)
+}
+
+// settle sends the exchanged funds locked by the orders to their counterparties.
+// The transfers are executed through the bank keeper, so the asset ft rules are applied. If any of the transfers
+// is rejected, nothing is exchanged and the error is returned for the order responsible for the failure.
+// If the recipient rejects the funds, the order owned by the recipient fails, otherwise the order whose locked
+// funds can't be sent fails. The funds are sent from the dex module account, so before each transfer the freezing
+// rules are checked against the owner of the locked funds, as if they were sent from the owner's account, and
+// the burn rate and the send commission of the executed amount are charged from the owner's balance.
+func (k Keeper) settle(
+	ctx sdk.Context,
+	taker, maker types.Order,
+	takerSends, makerSends sdk.Int,
+) (error, error, error) {
+	cacheCtx, writeCache := ctx.CacheContext()
+	settleCtx := types.WithPurpose(cacheCtx, types.PurposeSettle)
+
+	makerAddr := sdk.MustAccAddressFromBech32(maker.Account)
+	takerAddr := sdk.MustAccAddressFromBech32(taker.Account)
+	makerCoin := sdk.NewCoin(maker.OfferedAmount.Denom, makerSends)
+	takerCoin := sdk.NewCoin(taker.OfferedAmount.Denom, takerSends)
+
+	// maker -> taker
+	if err := k.assetFTKeeper.ValidateLockedCoinSpendable(cacheCtx, makerAddr, maker.OfferedAmount, makerSends); err != nil {
+		return nil, err, nil
+	}
+	if err := k.assetFTKeeper.ChargeLockedCoinRates(cacheCtx, makerAddr, takerAddr, makerCoin); err != nil {
+		return nil, err, nil
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(
+		settleCtx, types.ModuleName, takerAddr, sdk.NewCoins(makerCoin),
+	); err != nil {
+		if isRecipientError(err) {
+			return err, nil, nil
+		}
+		return nil, err, nil
+	}
+
+	// taker -> maker
+	if err := k.assetFTKeeper.ValidateLockedCoinSpendable(cacheCtx, takerAddr, taker.OfferedAmount, takerSends); err != nil {
+		return err, nil, nil
+	}
+	if err := k.assetFTKeeper.ChargeLockedCoinRates(cacheCtx, takerAddr, makerAddr, takerCoin); err != nil {
+		return err, nil, nil
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(
+		settleCtx, types.ModuleName, makerAddr, sdk.NewCoins(takerCoin),
+	); err != nil {
+		if isRecipientError(err) {
+			return nil, err, nil
+		}
+		return err, nil, nil
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	for _, event := range []*types.EventOrderReduced{
+		{
+			ID:           taker.ID,
+			Account:      taker.Account,
+			SentCoin:     takerCoin,
+			ReceivedCoin: makerCoin,
+		},
+		{
+			ID:           maker.ID,
+			Account:      maker.Account,
+			SentCoin:     makerCoin,
+			ReceivedCoin: takerCoin,
+		},
+	} {
+		if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+			return nil, nil, sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventOrderReduced event: %s", err)
+		}
+	}
+
+	return nil, nil, nil
+}
+
+// storeOrCloseOrder stores the partially executed order in the order book. If the order can't be executed
+// anymore, it is closed instead.
+func (k Keeper) storeOrCloseOrder(ctx sdk.Context, order types.Order) error {
+	if order.IsCleared() {
+		return k.closeOrder(ctx, order)
+	}
+
+	// Price changes after each reduction due to rounding, so the order is stored under the new key.
+	// It might happen that the new price can't be represented anymore.
+	if _, err := types.ComputePriceKey(order.OfferedAmount.Amount, order.DesiredAmount.Amount); err != nil {
+		if types.ErrPriceOutOfRange.Is(err) {
+			return k.closeOrder(ctx, order)
+		}
+		return err
+	}
+
+	return k.saveOrder(ctx, order)
+}
+
+// closeOrder refunds the not executed part of the order, which is not stored in the order book.
+func (k Keeper) closeOrder(ctx sdk.Context, order types.Order) error {
+	if err := k.unlock(ctx, order); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventOrderClosed{
+		ID:             order.ID,
+		Account:        order.Account,
+		RefundedAmount: order.OfferedAmount,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventOrderClosed event: %s", err)
+	}
+
+	return nil
+}
+
+// topOrder returns the order with the highest priority in the queue.
+func (k Keeper) topOrder(ctx sdk.Context, queuePrefix []byte) (types.Order, bool) {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), queuePrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return types.Order{}, false
+	}
+
+	var order types.Order
+	k.cdc.MustUnmarshal(iterator.Value(), &order)
+	return order, true
+}
+
+// isMatching checks if the price offered by the maker is acceptable for the taker.
+func isMatching(taker, maker types.Order) bool {
+	if taker.Type == types.OrderType_market {
+		// makerDesired / makerOffered <= maxPrice
+		return taker.MaxPrice.MulInt(maker.OfferedAmount.Amount).GTE(sdk.NewDecFromInt(maker.DesiredAmount.Amount))
+	}
+
+	// takerDesired / takerOffered <= makerOffered / makerDesired
+	return taker.DesiredAmount.Amount.Mul(maker.DesiredAmount.Amount).
+		LTE(taker.OfferedAmount.Amount.Mul(maker.OfferedAmount.Amount))
+}
+
+// computeReduction computes the amounts exchanged by the matching orders. The price of the maker order is used
+// because it was placed earlier. Exactly one of the orders is cleared by the reduction, unless rounding makes it
+// impossible to respect the prices requested by both orders, in which case false is returned.
+func computeReduction(taker, maker types.Order) (sdk.Int, sdk.Int, bool) {
+	makerOffered := maker.OfferedAmount.Amount
+	makerDesired := maker.DesiredAmount.Amount
+	takerOffered := taker.OfferedAmount.Amount
+	takerDesired := taker.DesiredAmount.Amount
+	isMarket := taker.Type == types.OrderType_market
+
+	isTakerPriceRespected := func(takerSends, makerSends sdk.Int) bool {
+		if isMarket {
+			// takerSends / makerSends <= maxPrice
+			return taker.MaxPrice.MulInt(makerSends).GTE(sdk.NewDecFromInt(takerSends))
+		}
+		// takerSends / makerSends <= takerOffered / takerDesired
+		return takerSends.Mul(takerDesired).LTE(makerSends.Mul(takerOffered))
+	}
+
+	// Case 1: taker's offered amount or maker's desired amount is fully exchanged.
+	// Amount sent by the maker is rounded down, so the maker never receives less than requested,
+	// then the taker sends only the amount required to buy it.
+	takerSends := sdk.MinInt(takerOffered, makerDesired)
+	makerSends := makerOffered
+	if !takerSends.Equal(makerDesired) {
+		makerSends = takerSends.Mul(makerOffered).Quo(makerDesired)
+		takerSends = ceilQuo(makerSends.Mul(makerDesired), makerOffered)
+	}
+	if makerSends.IsPositive() && takerSends.IsPositive() && (isMarket || makerSends.LTE(takerDesired)) &&
+		isTakerPriceRespected(takerSends, makerSends) {
+		return takerSends, makerSends, true
+	}
+
+	// Case 2: taker's desired amount or maker's offered amount is fully exchanged.
+	// Amount sent by the taker is rounded up, so the maker never receives less than requested.
+	makerSends = makerOffered
+	if !isMarket {
+		makerSends = sdk.MinInt(takerDesired, makerOffered)
+	}
+	takerSends = makerDesired
+	if !makerSends.Equal(makerOffered) {
+		takerSends = ceilQuo(makerSends.Mul(makerDesired), makerOffered)
+	}
+	if makerSends.IsPositive() && takerSends.IsPositive() && takerSends.LTE(takerOffered) &&
+		isTakerPriceRespected(takerSends, makerSends) {
+		return takerSends, makerSends, true
+	}
+
+	return sdk.Int{}, sdk.Int{}, false
+}
+
+// isRecipientError returns true if the transfer is rejected because of the recipient, not because of the sent funds.
+func isRecipientError(err error) bool {
+	return sdkerrors.IsOf(err, assetfttypes.ErrWhitelistedLimitExceeded, sdkerrors.ErrUnauthorized)
+}
+
+// ceilQuo divides the positive numbers rounding the result up.
+func ceilQuo(numerator, denominator sdk.Int) sdk.Int {
+	return numerator.Add(denominator).SubRaw(1).Quo(denominator)
+}
+
+// reduceOrder subtracts the exchanged amounts from the order.
+func reduceOrder(order types.Order, sent, received sdk.Int) types.Order {
+	order.OfferedAmount.Amount = order.OfferedAmount.Amount.Sub(sent)
+	if order.Type == types.OrderType_limit {
+		order.DesiredAmount.Amount = order.DesiredAmount.Amount.Sub(received)
+	}
+	return order
+}
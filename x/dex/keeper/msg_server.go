@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CoreumFoundation/coreum/v2/x/dex/types"
+)
+
+var _ types.MsgServer = MsgServer{}
+
+// MsgKeeper defines subscope of keeper methods required by msg service.
+type MsgKeeper interface {
+	PlaceOrder(
+		ctx sdk.Context,
+		account sdk.AccAddress,
+		orderType types.OrderType,
+		offered, desired sdk.Coin,
+		maxPrice *sdk.Dec,
+	) (uint64, error)
+	CancelOrder(ctx sdk.Context, account sdk.AccAddress, orderID uint64) error
+}
+
+// MsgServer serves grpc tx requests for dex module.
+type MsgServer struct {
+	keeper MsgKeeper
+}
+
+// NewMsgServer returns a new instance of the MsgServer.
+func NewMsgServer(keeper MsgKeeper) MsgServer {
+	return MsgServer{
+		keeper: keeper,
+	}
+}
+
+// PlaceOrder places a new order.
+func (ms MsgServer) PlaceOrder(goCtx context.Context, req *types.MsgPlaceOrder) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, err := ms.keeper.PlaceOrder(ctx, sender, req.OrderType, req.OfferedAmount, req.DesiredAmount, req.MaxPrice); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// CancelOrder cancels the order stored in the order book.
+func (ms MsgServer) CancelOrder(goCtx context.Context, req *types.MsgCancelOrder) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if err := ms.keeper.CancelOrder(ctx, sender, req.ID); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
@@ -0,0 +1,18 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	// ErrInvalidInput defines the common error for the invalid input.
+	ErrInvalidInput = sdkerrors.Register(ModuleName, 1, "invalid input")
+	// ErrOrderNotFound is returned when the order is not found in the store.
+	ErrOrderNotFound = sdkerrors.Register(ModuleName, 2, "order not found")
+	// ErrInvalidKey is returned when the provided store key is invalid.
+	ErrInvalidKey = sdkerrors.Register(ModuleName, 3, "invalid key")
+	// ErrInvalidState is returned when state of the module is invalid.
+	ErrInvalidState = sdkerrors.Register(ModuleName, 4, "invalid state")
+	// ErrPriceOutOfRange is returned when the price of the order can't be represented in the order book.
+	ErrPriceOutOfRange = sdkerrors.Register(ModuleName, 5, "price out of range")
+)
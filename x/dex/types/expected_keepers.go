@@ -0,0 +1,26 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// AccountKeeper defines the expected account keeper.
+type AccountKeeper interface {
+	GetModuleAddress(moduleName string) sdk.AccAddress
+	GetModuleAccount(ctx sdk.Context, moduleName string) authtypes.ModuleAccountI
+}
+
+// BankKeeper defines the expected bank interface.
+// It must be the wrapped bank keeper, so the asset ft rules are applied to the transfers.
+type BankKeeper interface {
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+}
+
+// AssetFTKeeper defines the expected asset ft keeper.
+type AssetFTKeeper interface {
+	ValidateLockedCoinSpendable(ctx sdk.Context, addr sdk.AccAddress, locked sdk.Coin, amount sdk.Int) error
+	ChargeLockedCoinRates(ctx sdk.Context, owner, recipient sdk.AccAddress, coin sdk.Coin) error
+}
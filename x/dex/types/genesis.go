@@ -0,0 +1,51 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DefaultGenesis returns the default dex genesis state.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.ValidateBasic(); err != nil {
+		return err
+	}
+
+	ids := map[uint64]struct{}{}
+	for _, order := range gs.Orders {
+		if err := order.Validate(); err != nil {
+			return err
+		}
+		if order.Type != OrderType_limit {
+			return sdkerrors.Wrapf(ErrInvalidInput, "only limit orders might be stored in the order book, order ID: %d", order.ID)
+		}
+		if order.ID > gs.OrderSequence {
+			return sdkerrors.Wrapf(ErrInvalidInput, "order ID %d is greater than the order sequence %d", order.ID, gs.OrderSequence)
+		}
+		if _, exists := ids[order.ID]; exists {
+			return sdkerrors.Wrapf(ErrInvalidInput, "duplicated order ID: %d", order.ID)
+		}
+		ids[order.ID] = struct{}{}
+	}
+
+	for _, order := range gs.FailedOrders {
+		if err := order.Validate(); err != nil {
+			return err
+		}
+		if order.ID > gs.OrderSequence {
+			return sdkerrors.Wrapf(ErrInvalidInput, "order ID %d is greater than the order sequence %d", order.ID, gs.OrderSequence)
+		}
+		if _, exists := ids[order.ID]; exists {
+			return sdkerrors.Wrapf(ErrInvalidInput, "duplicated order ID: %d", order.ID)
+		}
+		ids[order.ID] = struct{}{}
+	}
+
+	return nil
+}
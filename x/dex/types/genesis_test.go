@@ -0,0 +1,143 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/CoreumFoundation/coreum/v2/x/dex/types"
+)
+
+func TestGenesisState_Validate(t *testing.T) {
+	account := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	maxPrice := sdk.MustNewDecFromStr("2")
+	validOrder := types.Order{
+		ID:            1,
+		Account:       account,
+		Type:          types.OrderType_limit,
+		OfferedAmount: sdk.NewInt64Coin("denom1", 10),
+		DesiredAmount: sdk.NewInt64Coin("denom2", 20),
+	}
+
+	testCases := []struct {
+		name    string
+		genesis types.GenesisState
+		wantErr bool
+	}{
+		{
+			name:    "default",
+			genesis: *types.DefaultGenesis(),
+		},
+		{
+			name: "valid",
+			genesis: types.GenesisState{
+				Params:        types.DefaultParams(),
+				OrderSequence: 1,
+				Orders:        []types.Order{validOrder},
+			},
+		},
+		{
+			name: "invalid params",
+			genesis: types.GenesisState{
+				Params: types.Params{
+					MaxMatchesPerBlock: 0,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "order ID greater than sequence",
+			genesis: types.GenesisState{
+				Params: types.DefaultParams(),
+				Orders: []types.Order{validOrder},
+			},
+			wantErr: true,
+		},
+		{
+			name: "duplicated order ID",
+			genesis: types.GenesisState{
+				Params:        types.DefaultParams(),
+				OrderSequence: 1,
+				Orders:        []types.Order{validOrder, validOrder},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid failed order",
+			genesis: types.GenesisState{
+				Params:        types.DefaultParams(),
+				OrderSequence: 2,
+				Orders:        []types.Order{validOrder},
+				FailedOrders: []types.Order{
+					{
+						ID:            2,
+						Account:       account,
+						Type:          types.OrderType_market,
+						OfferedAmount: sdk.NewInt64Coin("denom1", 10),
+						DesiredAmount: sdk.NewInt64Coin("denom2", 0),
+						MaxPrice:      &maxPrice,
+					},
+				},
+			},
+		},
+		{
+			name: "failed order duplicating the order ID",
+			genesis: types.GenesisState{
+				Params:        types.DefaultParams(),
+				OrderSequence: 1,
+				Orders:        []types.Order{validOrder},
+				FailedOrders:  []types.Order{validOrder},
+			},
+			wantErr: true,
+		},
+		{
+			name: "market order",
+			genesis: types.GenesisState{
+				Params:        types.DefaultParams(),
+				OrderSequence: 1,
+				Orders: []types.Order{
+					{
+						ID:            1,
+						Account:       account,
+						Type:          types.OrderType_market,
+						OfferedAmount: sdk.NewInt64Coin("denom1", 10),
+						DesiredAmount: sdk.NewInt64Coin("denom2", 0),
+						MaxPrice:      &maxPrice,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid account",
+			genesis: types.GenesisState{
+				Params:        types.DefaultParams(),
+				OrderSequence: 1,
+				Orders: []types.Order{
+					{
+						ID:            1,
+						Account:       "invalid",
+						Type:          types.OrderType_limit,
+						OfferedAmount: sdk.NewInt64Coin("denom1", 10),
+						DesiredAmount: sdk.NewInt64Coin("denom2", 20),
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genesis.Validate()
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package types
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CoreumFoundation/coreum/v2/pkg/store"
+)
+
+const (
+	// ModuleName defines the module name.
+	ModuleName = "dex"
+
+	// StoreKey defines the primary module store key.
+	StoreKey = ModuleName
+
+	// TransientStoreKey defines the transient module store key.
+	TransientStoreKey = "transient_" + ModuleName
+
+	// QuerierRoute defines the module's query routing key.
+	QuerierRoute = ModuleName
+
+	// RouterKey is the message route for module.
+	RouterKey = ModuleName
+)
+
+// Store key prefixes.
+var (
+	// OrderSequenceKey defines the key to store the last assigned order ID.
+	OrderSequenceKey = []byte{0x01}
+	// DenomSequenceKey defines the key to store the last assigned denom ID.
+	DenomSequenceKey = []byte{0x02}
+	// DenomKeyPrefix defines the key prefix to map denoms to their IDs.
+	DenomKeyPrefix = []byte{0x03}
+	// OrderBookKeyPrefix defines the key prefix for the orders stored in the order book queues.
+	OrderBookKeyPrefix = []byte{0x04}
+	// OrderKeyPrefix defines the key prefix to map order ID to its key in the order book.
+	OrderKeyPrefix = []byte{0x05}
+	// AccountOrderKeyPrefix defines the key prefix to index orders by the account.
+	AccountOrderKeyPrefix = []byte{0x06}
+	// FailedOrderKeyPrefix defines the key prefix for the orders whose execution failed and whose funds couldn't
+	// be refunded.
+	FailedOrderKeyPrefix = []byte{0x07}
+)
+
+// Transient store key prefixes.
+var (
+	// TransientOrderSequenceKey defines the key to store the sequence of orders received in the current block.
+	TransientOrderSequenceKey = []byte{0x01}
+	// TransientOrderKeyPrefix defines the key prefix for the orders received in the current block.
+	TransientOrderKeyPrefix = []byte{0x02}
+)
+
+const (
+	uint64Length = 8
+	// PriceKeyLength is the length of the execution price prefix.
+	PriceKeyLength = 2 * uint64Length
+)
+
+// Uint64ToBytes encodes uint64 using big endian so the values are sortable lexicographically.
+func Uint64ToBytes(v uint64) []byte {
+	b := make([]byte, uint64Length)
+	binary.BigEndian.PutUint64(b, v)
+	return b
+}
+
+// BytesToUint64 decodes big endian encoded uint64.
+func BytesToUint64(b []byte) (uint64, error) {
+	if len(b) != uint64Length {
+		return 0, sdkerrors.Wrapf(ErrInvalidKey, "expected %d bytes, got %d", uint64Length, len(b))
+	}
+	return binary.BigEndian.Uint64(b), nil
+}
+
+// CreateDenomKey constructs the key for the denom to denom ID mapping.
+func CreateDenomKey(denom string) []byte {
+	return store.JoinKeys(DenomKeyPrefix, []byte(denom))
+}
+
+// CreateQueuePrefix constructs the prefix of the order book queue containing orders offering
+// the denom with offeredDenomID for the denom with desiredDenomID.
+func CreateQueuePrefix(offeredDenomID, desiredDenomID uint64) []byte {
+	return store.JoinKeys(OrderBookKeyPrefix, Uint64ToBytes(offeredDenomID), Uint64ToBytes(desiredDenomID))
+}
+
+// CreateOrderBookKey constructs the key of the order in the order book queue.
+func CreateOrderBookKey(offeredDenomID, desiredDenomID uint64, priceKey []byte, orderID uint64) []byte {
+	return store.JoinKeys(CreateQueuePrefix(offeredDenomID, desiredDenomID), priceKey, Uint64ToBytes(orderID))
+}
+
+// CreateOrderKey constructs the key of the order ID to order book key mapping.
+func CreateOrderKey(orderID uint64) []byte {
+	return store.JoinKeys(OrderKeyPrefix, Uint64ToBytes(orderID))
+}
+
+// CreateFailedOrderKey constructs the key of the failed order.
+func CreateFailedOrderKey(orderID uint64) []byte {
+	return store.JoinKeys(FailedOrderKeyPrefix, Uint64ToBytes(orderID))
+}
+
+// CreateAccountOrdersPrefix constructs the prefix of the orders placed by the account.
+func CreateAccountOrdersPrefix(account sdk.AccAddress) ([]byte, error) {
+	accountKey, err := store.JoinKeysWithLength(account)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidKey, "can't join account key with length, account:%s, err:%s", account, err)
+	}
+	return store.JoinKeys(AccountOrderKeyPrefix, accountKey), nil
+}
+
+// CreateAccountOrderKey constructs the key of the order in the account index.
+func CreateAccountOrderKey(account sdk.AccAddress, orderID uint64) ([]byte, error) {
+	prefix, err := CreateAccountOrdersPrefix(account)
+	if err != nil {
+		return nil, err
+	}
+	return store.JoinKeys(prefix, Uint64ToBytes(orderID)), nil
+}
+
+// CreatePairPrefix constructs the prefix grouping orders of both queues of the trading pair in the transient store.
+// Denom IDs are sorted, so the prefix is the same for both queues.
+func CreatePairPrefix(denomID1, denomID2 uint64) []byte {
+	if denomID1 > denomID2 {
+		denomID1, denomID2 = denomID2, denomID1
+	}
+	return store.JoinKeys(TransientOrderKeyPrefix, Uint64ToBytes(denomID1), Uint64ToBytes(denomID2))
+}
+
+// CreateTransientOrderKey constructs the key of the order in the transient FIFO queue.
+func CreateTransientOrderKey(offeredDenomID, desiredDenomID, sequence uint64) []byte {
+	return store.JoinKeys(CreatePairPrefix(offeredDenomID, desiredDenomID), Uint64ToBytes(sequence))
+}
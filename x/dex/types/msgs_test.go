@@ -0,0 +1,239 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v2/pkg/config"
+	"github.com/CoreumFoundation/coreum/v2/pkg/config/constant"
+	"github.com/CoreumFoundation/coreum/v2/x/dex/types"
+)
+
+func TestMain(m *testing.M) {
+	n, err := config.NetworkConfigByChainID(constant.ChainIDDev)
+	if err != nil {
+		panic(err)
+	}
+	n.SetSDKConfig()
+	m.Run()
+}
+
+func TestMsgPlaceOrder_ValidateBasic(t *testing.T) {
+	maxPrice := sdk.MustNewDecFromStr("0.1")
+	acc := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	validMessage := types.MsgPlaceOrder{
+		Sender:        acc.String(),
+		OrderType:     types.OrderType_limit,
+		OfferedAmount: sdk.NewInt64Coin("denom1", 100),
+		DesiredAmount: sdk.NewInt64Coin("denom2", 10),
+	}
+
+	testCases := []struct {
+		name          string
+		messageFunc   func(types.MsgPlaceOrder) types.MsgPlaceOrder
+		expectedError error
+	}{
+		{
+			name: "valid",
+			messageFunc: func(msg types.MsgPlaceOrder) types.MsgPlaceOrder {
+				return msg
+			},
+		},
+		{
+			name: "valid market order",
+			messageFunc: func(msg types.MsgPlaceOrder) types.MsgPlaceOrder {
+				msg.OrderType = types.OrderType_market
+				msg.DesiredAmount = sdk.NewInt64Coin("denom2", 0)
+				msg.MaxPrice = &maxPrice
+				return msg
+			},
+		},
+		{
+			name: "invalid sender address",
+			messageFunc: func(msg types.MsgPlaceOrder) types.MsgPlaceOrder {
+				msg.Sender = "invalid"
+				return msg
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "unknown order type",
+			messageFunc: func(msg types.MsgPlaceOrder) types.MsgPlaceOrder {
+				msg.OrderType = 5
+				return msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "zero offered amount",
+			messageFunc: func(msg types.MsgPlaceOrder) types.MsgPlaceOrder {
+				msg.OfferedAmount = sdk.NewInt64Coin("denom1", 0)
+				return msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "same denoms",
+			messageFunc: func(msg types.MsgPlaceOrder) types.MsgPlaceOrder {
+				msg.DesiredAmount = sdk.NewInt64Coin("denom1", 10)
+				return msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "zero desired amount of limit order",
+			messageFunc: func(msg types.MsgPlaceOrder) types.MsgPlaceOrder {
+				msg.DesiredAmount = sdk.NewInt64Coin("denom2", 0)
+				return msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "positive desired amount of market order",
+			messageFunc: func(msg types.MsgPlaceOrder) types.MsgPlaceOrder {
+				msg.OrderType = types.OrderType_market
+				msg.MaxPrice = &maxPrice
+				return msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "market order without max price",
+			messageFunc: func(msg types.MsgPlaceOrder) types.MsgPlaceOrder {
+				msg.OrderType = types.OrderType_market
+				msg.DesiredAmount = sdk.NewInt64Coin("denom2", 0)
+				return msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "market order with zero max price",
+			messageFunc: func(msg types.MsgPlaceOrder) types.MsgPlaceOrder {
+				zeroPrice := sdk.ZeroDec()
+				msg.OrderType = types.OrderType_market
+				msg.DesiredAmount = sdk.NewInt64Coin("denom2", 0)
+				msg.MaxPrice = &zeroPrice
+				return msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "limit order with max price",
+			messageFunc: func(msg types.MsgPlaceOrder) types.MsgPlaceOrder {
+				msg.MaxPrice = &maxPrice
+				return msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "price too low",
+			messageFunc: func(msg types.MsgPlaceOrder) types.MsgPlaceOrder {
+				msg.OfferedAmount = sdk.NewCoin("denom1", sdk.NewIntWithDecimal(1, 20))
+				msg.DesiredAmount = sdk.NewInt64Coin("denom2", 1)
+				return msg
+			},
+			expectedError: types.ErrPriceOutOfRange,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.messageFunc(validMessage).ValidateBasic()
+			if tc.expectedError == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tc.expectedError)
+			}
+		})
+	}
+}
+
+func TestMsgCancelOrder_ValidateBasic(t *testing.T) {
+	acc := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	testCases := []struct {
+		name          string
+		message       types.MsgCancelOrder
+		expectedError error
+	}{
+		{
+			name: "valid",
+			message: types.MsgCancelOrder{
+				Sender: acc.String(),
+				ID:     1,
+			},
+		},
+		{
+			name: "invalid sender address",
+			message: types.MsgCancelOrder{
+				Sender: "invalid",
+				ID:     1,
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "zero order ID",
+			message: types.MsgCancelOrder{
+				Sender: acc.String(),
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tc.expectedError)
+			}
+		})
+	}
+}
+
+func TestAmino(t *testing.T) {
+	const address = "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"
+	maxPrice := sdk.MustNewDecFromStr("2.5")
+
+	tests := []struct {
+		name          string
+		msg           legacytx.LegacyMsg
+		wantAminoJSON string
+	}{
+		{
+			name: types.TypeMsgPlaceOrder,
+			msg: &types.MsgPlaceOrder{
+				Sender:        address,
+				OrderType:     types.OrderType_market,
+				OfferedAmount: sdk.NewInt64Coin("denom1", 1),
+				DesiredAmount: sdk.NewInt64Coin("denom2", 0),
+				MaxPrice:      &maxPrice,
+			},
+			wantAminoJSON: `{"type":"dex/MsgPlaceOrder","value":{"desired_amount":{"amount":"0","denom":"denom2"},"max_price":"2.500000000000000000","offered_amount":{"amount":"1","denom":"denom1"},"order_type":1,"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: types.TypeMsgCancelOrder,
+			msg: &types.MsgCancelOrder{
+				Sender: address,
+				ID:     1,
+			},
+			wantAminoJSON: `{"type":"dex/MsgCancelOrder","value":{"id":"1","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.wantAminoJSON, string(tt.msg.GetSignBytes()))
+		})
+	}
+}
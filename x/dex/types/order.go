@@ -0,0 +1,102 @@
+package types
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CoreumFoundation/coreum/v2/pkg/store"
+)
+
+// priceDecimalPrecision is the number of decimal places of the price stored in the execution price prefix.
+const priceDecimalPrecision = 19
+
+var priceDecimalMultiplier = new(big.Int).Exp(big.NewInt(10), big.NewInt(priceDecimalPrecision), nil)
+
+// ValidateOrderAmounts checks that the offered and desired amounts and the max price are valid for the order type.
+func ValidateOrderAmounts(orderType OrderType, offered, desired sdk.Coin, maxPrice *sdk.Dec) error {
+	if _, ok := OrderType_name[int32(orderType)]; !ok {
+		return sdkerrors.Wrapf(ErrInvalidInput, "unknown order type: %d", orderType)
+	}
+
+	if err := offered.Validate(); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid offered amount: %s", err)
+	}
+	if !offered.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalidInput, "offered amount must be positive")
+	}
+
+	if err := desired.Validate(); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid desired amount: %s", err)
+	}
+	if offered.Denom == desired.Denom {
+		return sdkerrors.Wrap(ErrInvalidInput, "offered and desired denoms must be different")
+	}
+
+	switch orderType {
+	case OrderType_limit:
+		if !desired.IsPositive() {
+			return sdkerrors.Wrap(ErrInvalidInput, "desired amount of the limit order must be positive")
+		}
+		if _, err := ComputePriceKey(offered.Amount, desired.Amount); err != nil {
+			return err
+		}
+		if maxPrice != nil {
+			return sdkerrors.Wrap(ErrInvalidInput, "max price of the limit order must not be set")
+		}
+	case OrderType_market:
+		if !desired.IsZero() {
+			return sdkerrors.Wrap(ErrInvalidInput, "desired amount of the market order must be zero")
+		}
+		if maxPrice == nil || maxPrice.IsNil() || !maxPrice.IsPositive() {
+			return sdkerrors.Wrap(ErrInvalidInput, "max price of the market order must be positive")
+		}
+	}
+
+	return nil
+}
+
+// ComputePriceKey computes the execution price prefix used to sort orders in the order book queue.
+// The price is computed as desired/offered, so the orders offering the best price for the counterparty come first.
+// It is encoded as the big endian whole part followed by the big endian decimal part containing 19 decimal places.
+// The price is used only to sort the orders, it must never be used to compute the exchanged amounts.
+func ComputePriceKey(offered, desired sdk.Int) ([]byte, error) {
+	if !offered.IsPositive() || !desired.IsPositive() {
+		return nil, sdkerrors.Wrap(ErrInvalidInput, "amounts used to compute the price must be positive")
+	}
+
+	scaledPrice := new(big.Int).Mul(desired.BigInt(), priceDecimalMultiplier)
+	scaledPrice.Quo(scaledPrice, offered.BigInt())
+	if scaledPrice.Sign() == 0 {
+		return nil, sdkerrors.Wrapf(ErrPriceOutOfRange, "price %s/%s is too low", desired, offered)
+	}
+
+	whole, decimal := new(big.Int).QuoRem(scaledPrice, priceDecimalMultiplier, new(big.Int))
+	if !whole.IsUint64() {
+		return nil, sdkerrors.Wrapf(ErrPriceOutOfRange, "price %s/%s is too high", desired, offered)
+	}
+
+	return store.JoinKeys(Uint64ToBytes(whole.Uint64()), Uint64ToBytes(decimal.Uint64())), nil
+}
+
+// Validate performs basic validation on the fields of Order.
+func (o Order) Validate() error {
+	if o.ID == 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "order ID must be positive")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(o.Account); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid account address: %s", err)
+	}
+
+	return ValidateOrderAmounts(o.Type, o.OfferedAmount, o.DesiredAmount, o.MaxPrice)
+}
+
+// IsCleared returns true if nothing more can be exchanged by the order.
+func (o Order) IsCleared() bool {
+	if o.OfferedAmount.IsZero() {
+		return true
+	}
+	return o.Type == OrderType_limit && o.DesiredAmount.IsZero()
+}
@@ -0,0 +1,597 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: coreum/dex/v1/order.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// OrderType defines the type of the order.
+type OrderType int32
+
+const (
+	// limit order is executed only if the price is acceptable, the not executed part is kept in the order book.
+	OrderType_limit OrderType = 0
+	// market order is executed at any price not worse than its max price, the not executed part is refunded at the end
+	// of the block.
+	OrderType_market OrderType = 1
+)
+
+var OrderType_name = map[int32]string{
+	0: "limit",
+	1: "market",
+}
+
+var OrderType_value = map[string]int32{
+	"limit":  0,
+	"market": 1,
+}
+
+func (x OrderType) String() string {
+	return proto.EnumName(OrderType_name, int32(x))
+}
+
+func (OrderType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_302bb6c9a553771c, []int{0}
+}
+
+// Order is the order placed on the DEX.
+type Order struct {
+	// id is the unique sequential identifier of the order.
+	ID uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// account is the address of the order owner.
+	Account string    `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Type    OrderType `protobuf:"varint,3,opt,name=type,proto3,enum=coreum.dex.v1.OrderType" json:"type,omitempty"`
+	// offered_amount is the amount of tokens still offered (and locked) by the order.
+	OfferedAmount types.Coin `protobuf:"bytes,4,opt,name=offered_amount,json=offeredAmount,proto3" json:"offered_amount"`
+	// desired_amount is the amount of tokens still desired by the order, it is zero for the market orders.
+	DesiredAmount types.Coin `protobuf:"bytes,5,opt,name=desired_amount,json=desiredAmount,proto3" json:"desired_amount"`
+	// max_price is the worst price accepted by the market order, expressed as the amount of the offered tokens paid
+	// for one desired token. It is not set for the limit orders.
+	MaxPrice *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=max_price,json=maxPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_price,omitempty"`
+}
+
+func (m *Order) Reset()         { *m = Order{} }
+func (m *Order) String() string { return proto.CompactTextString(m) }
+func (*Order) ProtoMessage()    {}
+func (*Order) Descriptor() ([]byte, []int) {
+	return fileDescriptor_302bb6c9a553771c, []int{0}
+}
+func (m *Order) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Order) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Order.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Order) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Order.Merge(m, src)
+}
+func (m *Order) XXX_Size() int {
+	return m.Size()
+}
+func (m *Order) XXX_DiscardUnknown() {
+	xxx_messageInfo_Order.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Order proto.InternalMessageInfo
+
+func (m *Order) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *Order) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *Order) GetType() OrderType {
+	if m != nil {
+		return m.Type
+	}
+	return OrderType_limit
+}
+
+func (m *Order) GetOfferedAmount() types.Coin {
+	if m != nil {
+		return m.OfferedAmount
+	}
+	return types.Coin{}
+}
+
+func (m *Order) GetDesiredAmount() types.Coin {
+	if m != nil {
+		return m.DesiredAmount
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterEnum("coreum.dex.v1.OrderType", OrderType_name, OrderType_value)
+	proto.RegisterType((*Order)(nil), "coreum.dex.v1.Order")
+}
+
+func init() { proto.RegisterFile("coreum/dex/v1/order.proto", fileDescriptor_302bb6c9a553771c) }
+
+var fileDescriptor_302bb6c9a553771c = []byte{
+	// 384 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0x8e, 0xd3, 0x30,
+	0x10, 0x86, 0xe3, 0x90, 0x06, 0x62, 0xb4, 0xab, 0x55, 0x84, 0x50, 0x76, 0x0f, 0x69, 0xb4, 0x07,
+	0x14, 0xad, 0xc0, 0x56, 0xca, 0x13, 0x90, 0x5d, 0x15, 0x21, 0x0e, 0xa0, 0x88, 0x13, 0x97, 0xca,
+	0xb1, 0xdd, 0x62, 0x15, 0xc7, 0x51, 0xe2, 0x44, 0xe9, 0x5b, 0xf0, 0x48, 0x1c, 0x7b, 0xec, 0x11,
+	0x71, 0xa8, 0x50, 0xfa, 0x22, 0x28, 0x4e, 0x40, 0xe5, 0xc6, 0xc9, 0xb6, 0x66, 0xfe, 0x6f, 0xfe,
+	0x99, 0x31, 0xbc, 0xa6, 0xaa, 0xe2, 0x8d, 0xc4, 0x8c, 0x77, 0xb8, 0x4d, 0xb0, 0xaa, 0x18, 0xaf,
+	0x50, 0x59, 0x29, 0xad, 0xfc, 0x8b, 0x31, 0x84, 0x18, 0xef, 0x50, 0x9b, 0xdc, 0x3c, 0xdb, 0xa8,
+	0x8d, 0x32, 0x11, 0x3c, 0xdc, 0xc6, 0xa4, 0x9b, 0x90, 0xaa, 0x5a, 0xaa, 0x1a, 0xe7, 0xa4, 0xe6,
+	0xb8, 0x4d, 0x72, 0xae, 0x49, 0x82, 0xa9, 0x12, 0xc5, 0x18, 0xbf, 0xfd, 0x6e, 0xc3, 0xd9, 0x87,
+	0x01, 0xea, 0x3f, 0x87, 0xb6, 0x60, 0x01, 0x88, 0x40, 0xec, 0xa4, 0x6e, 0x7f, 0x9c, 0xdb, 0xef,
+	0x1e, 0x32, 0x5b, 0x30, 0x3f, 0x80, 0x8f, 0x09, 0xa5, 0xaa, 0x29, 0x74, 0x60, 0x47, 0x20, 0xf6,
+	0xb2, 0x3f, 0x4f, 0xff, 0x25, 0x74, 0xf4, 0xae, 0xe4, 0xc1, 0xa3, 0x08, 0xc4, 0x97, 0x8b, 0x00,
+	0xfd, 0xe3, 0x07, 0x19, 0xea, 0xa7, 0x5d, 0xc9, 0x33, 0x93, 0xe5, 0x2f, 0xe1, 0xa5, 0x5a, 0xaf,
+	0x79, 0xc5, 0xd9, 0x8a, 0x48, 0x83, 0x73, 0x22, 0x10, 0x3f, 0x5d, 0x5c, 0xa3, 0xd1, 0x22, 0x1a,
+	0x2c, 0xa2, 0xc9, 0x22, 0xba, 0x57, 0xa2, 0x48, 0x9d, 0xfd, 0x71, 0x6e, 0x65, 0x17, 0x93, 0xec,
+	0x8d, 0x51, 0x0d, 0x1c, 0xc6, 0x6b, 0x71, 0xc6, 0x99, 0xfd, 0x27, 0x67, 0x92, 0x4d, 0x9c, 0xb7,
+	0xd0, 0x93, 0xa4, 0x5b, 0x95, 0x95, 0xa0, 0x3c, 0x70, 0x87, 0xce, 0xd2, 0xbb, 0x9f, 0xc7, 0xf9,
+	0x8b, 0x8d, 0xd0, 0x5f, 0x9a, 0x1c, 0x51, 0x25, 0xf1, 0x34, 0xbb, 0xf1, 0x78, 0x55, 0xb3, 0x2d,
+	0x1e, 0x3a, 0xa9, 0xd1, 0x03, 0xa7, 0xd9, 0x13, 0x49, 0xba, 0x8f, 0x83, 0xf6, 0xee, 0x16, 0x7a,
+	0x7f, 0x7b, 0xf5, 0x3d, 0x38, 0xfb, 0x2a, 0xa4, 0xd0, 0x57, 0x96, 0x0f, 0xa1, 0x2b, 0x49, 0xb5,
+	0xe5, 0xfa, 0x0a, 0xa4, 0xef, 0xf7, 0x7d, 0x08, 0x0e, 0x7d, 0x08, 0x7e, 0xf5, 0x21, 0xf8, 0x76,
+	0x0a, 0xad, 0xc3, 0x29, 0xb4, 0x7e, 0x9c, 0x42, 0xeb, 0x73, 0x72, 0x56, 0xef, 0xde, 0x0c, 0x70,
+	0xa9, 0x9a, 0x82, 0x11, 0x2d, 0x54, 0x81, 0xa7, 0xe5, 0xb7, 0x0b, 0xdc, 0x99, 0x1f, 0x60, 0xca,
+	0xe7, 0xae, 0x59, 0xdd, 0xeb, 0xdf, 0x03, 0x00, 0x7c, 0xb8, 0x06, 0x3a, 0x1c, 0x02, 0x00, 0x00,
+}
+
+func (m *Order) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Order) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxPrice != nil {
+		{
+			size := m.MaxPrice.Size()
+			i -= size
+			if _, err := m.MaxPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOrder(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size, err := m.DesiredAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.OfferedAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Type != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOrder(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOrder(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Order) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovOrder(uint64(m.ID))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovOrder(uint64(m.Type))
+	}
+	l = m.OfferedAmount.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	l = m.DesiredAmount.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	if m.MaxPrice != nil {
+		l = m.MaxPrice.Size()
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	return n
+}
+
+func sovOrder(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOrder(x uint64) (n int) {
+	return sovOrder(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Order) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Order: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Order: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= OrderType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferedAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OfferedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DesiredAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxPrice = &v
+			if err := m.MaxPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipOrder(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthOrder
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupOrder
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthOrder
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthOrder        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowOrder          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupOrder = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,65 @@
+package types_test
+
+import (
+	"bytes"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v2/x/dex/types"
+)
+
+func TestComputePriceKey(t *testing.T) {
+	requireT := require.New(t)
+
+	// prices sorted from the best to the worst for the counterparty
+	amounts := [][2]sdk.Int{
+		{sdk.NewIntWithDecimal(1, 18), sdk.OneInt()},
+		{sdk.NewInt(3), sdk.NewInt(1)},
+		{sdk.NewInt(2), sdk.NewInt(1)},
+		{sdk.NewInt(100), sdk.NewInt(99)},
+		{sdk.NewInt(1), sdk.NewInt(1)},
+		{sdk.NewInt(99), sdk.NewInt(100)},
+		{sdk.NewInt(1), sdk.NewInt(3)},
+		{sdk.OneInt(), sdk.NewIntWithDecimal(1, 18)},
+	}
+
+	var previousKey []byte
+	for _, amount := range amounts {
+		key, err := types.ComputePriceKey(amount[0], amount[1])
+		requireT.NoError(err)
+		requireT.Len(key, types.PriceKeyLength)
+		if previousKey != nil {
+			requireT.Equal(-1, bytes.Compare(previousKey, key), "offered: %s, desired: %s", amount[0], amount[1])
+		}
+		previousKey = key
+	}
+
+	_, err := types.ComputePriceKey(sdk.NewIntWithDecimal(1, 20), sdk.OneInt())
+	requireT.ErrorIs(err, types.ErrPriceOutOfRange)
+	_, err = types.ComputePriceKey(sdk.OneInt(), sdk.NewIntWithDecimal(1, 20))
+	requireT.ErrorIs(err, types.ErrPriceOutOfRange)
+	_, err = types.ComputePriceKey(sdk.ZeroInt(), sdk.OneInt())
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+}
+
+func TestOrder_IsCleared(t *testing.T) {
+	requireT := require.New(t)
+
+	order := types.Order{
+		Type:          types.OrderType_limit,
+		OfferedAmount: sdk.NewInt64Coin("denom1", 10),
+		DesiredAmount: sdk.NewInt64Coin("denom2", 10),
+	}
+	requireT.False(order.IsCleared())
+
+	order.DesiredAmount.Amount = sdk.ZeroInt()
+	requireT.True(order.IsCleared())
+
+	order.Type = types.OrderType_market
+	requireT.False(order.IsCleared())
+
+	order.OfferedAmount.Amount = sdk.ZeroInt()
+	requireT.True(order.IsCleared())
+}
@@ -0,0 +1,43 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// DefaultMaxMatchesPerBlock is the default maximum number of order matches executed in a single block.
+const DefaultMaxMatchesPerBlock = 1000
+
+// KeyMaxMatchesPerBlock represents the max matches per block param key.
+var KeyMaxMatchesPerBlock = []byte("MaxMatchesPerBlock")
+
+// DefaultParams returns params with default values.
+func DefaultParams() Params {
+	return Params{
+		MaxMatchesPerBlock: DefaultMaxMatchesPerBlock,
+	}
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
+// of module parameters.
+func (m *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMaxMatchesPerBlock, &m.MaxMatchesPerBlock, validateMaxMatchesPerBlock),
+	}
+}
+
+// ValidateBasic validates parameters.
+func (m Params) ValidateBasic() error {
+	return validateMaxMatchesPerBlock(m.MaxMatchesPerBlock)
+}
+
+func validateMaxMatchesPerBlock(i interface{}) error {
+	maxMatches, ok := i.(uint32)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid parameter type: %T", i)
+	}
+	if maxMatches == 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "max matches per block must be greater than 0")
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Purpose is the purpose of the transfer executed by the dex module.
+type Purpose string
+
+const (
+	// PurposeLock is used when funds offered by the order are locked on the dex module account.
+	PurposeLock Purpose = "dexLock"
+	// PurposeSettle is used when locked funds are sent to the counterparty of the executed order.
+	PurposeSettle Purpose = "dexSettle"
+	// PurposeUnlock is used when the not executed part of the locked funds is returned to the order owner.
+	PurposeUnlock Purpose = "dexUnlock"
+)
+
+type purposeKey struct{}
+
+// WithPurpose stores dex transfer purpose inside SDK context.
+func WithPurpose(ctx sdk.Context, purpose Purpose) sdk.Context {
+	return ctx.WithValue(purposeKey{}, purpose)
+}
+
+// IsPurposeLock returns true if context is tagged with the fund locking transfer.
+func IsPurposeLock(ctx sdk.Context) bool {
+	p, ok := getPurpose(ctx.Context())
+	return ok && p == PurposeLock
+}
+
+// IsPurposeSettle returns true if context is tagged with the order settlement transfer.
+func IsPurposeSettle(ctx sdk.Context) bool {
+	p, ok := getPurpose(ctx.Context())
+	return ok && p == PurposeSettle
+}
+
+// IsPurposeUnlock returns true if context is tagged with the fund unlocking transfer.
+func IsPurposeUnlock(ctx sdk.Context) bool {
+	p, ok := getPurpose(ctx.Context())
+	return ok && p == PurposeUnlock
+}
+
+func getPurpose(ctx context.Context) (Purpose, bool) {
+	purpose, ok := ctx.Value(purposeKey{}).(Purpose)
+	return purpose, ok
+}
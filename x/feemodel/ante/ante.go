@@ -4,25 +4,41 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/ibc-go/v4/testing/simapp/helpers"
+
+	assetfttypes "github.com/CoreumFoundation/coreum/v2/x/asset/ft/types"
+	"github.com/CoreumFoundation/coreum/v2/x/feemodel/types"
 )
 
 // Keeper interface exposes methods required by ante handler decorator of fee model.
 type Keeper interface {
 	TrackGas(ctx sdk.Context, gas int64)
 	GetMinGasPrice(ctx sdk.Context) sdk.DecCoin
+	GetParams(ctx sdk.Context) types.Params
+}
+
+// AssetFTKeeper interface exposes methods of the asset ft keeper required by ante handler decorator of fee model.
+type AssetFTKeeper interface {
+	GetDefinition(ctx sdk.Context, denom string) (assetfttypes.Definition, error)
 }
 
 // FeeDecorator will check if the gas price offered by transaction's fee is at least as large
 // as the current minimum gas price required by the network and computd by our fee model.
+// The fee might be paid in one of the alternate fee denoms approved by the governance, then it is converted
+// to the fee model denom using the conversion rate before the comparison. The fee is sent to the fee collector
+// through the asset ft rules, so the asset ft token with features, burn rate or send commission can't be used to pay it.
+// If the gas price multipliers are set for the types of the messages included in the transaction, including the ones
+// executed by authz.MsgExec, the highest one is applied to the minimum gas price.
 // CONTRACT: Tx must implement FeeTx to use FeeDecorator.
 type FeeDecorator struct {
-	keeper Keeper
+	keeper        Keeper
+	assetFTKeeper AssetFTKeeper
 }
 
 // NewFeeDecorator creates ante decorator refusing transactions which does not offer minimum gas price.
-func NewFeeDecorator(keeper Keeper) FeeDecorator {
+func NewFeeDecorator(keeper Keeper, assetFTKeeper AssetFTKeeper) FeeDecorator {
 	return FeeDecorator{
-		keeper: keeper,
+		keeper:        keeper,
+		assetFTKeeper: assetFTKeeper,
 	}
 }
 
@@ -54,13 +70,34 @@ func (fd FeeDecorator) actOnFeeModelOutput(ctx sdk.Context, feeTx sdk.FeeTx) err
 	}
 
 	minGasPrice := fd.keeper.GetMinGasPrice(ctx)
-	if len(fees) > 1 || fees[0].Denom != minGasPrice.Denom {
-		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "fee must be paid in '%s' coin only", minGasPrice.Denom)
+	if len(fees) > 1 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "fee must be paid in single coin only")
+	}
+
+	params := fd.keeper.GetParams(ctx)
+	conversionRate := sdk.OneDec()
+	if fees[0].Denom != minGasPrice.Denom {
+		var ok bool
+		conversionRate, ok = params.FeeDenomConversionRate(fees[0].Denom)
+		if !ok {
+			return sdkerrors.Wrapf(
+				sdkerrors.ErrInvalidCoins,
+				"fee must be paid in '%s' coin or one of the approved fee denoms", minGasPrice.Denom,
+			)
+		}
+		if err := fd.validateAssetFTFeeDenom(ctx, fees[0].Denom); err != nil {
+			return err
+		}
+	}
+
+	multiplier, err := params.MsgsGasPriceMultiplier(feeTx.GetMsgs())
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrTxDecode, err.Error())
 	}
 
 	gasDeclared := sdk.NewDecFromInt(sdk.NewIntFromUint64(feeTx.GetGas()))
-	feeOffered := sdk.NewDecCoin(minGasPrice.Denom, fees.AmountOf(minGasPrice.Denom))
-	feeRequired := sdk.NewDecCoinFromDec(minGasPrice.Denom, gasDeclared.Mul(minGasPrice.Amount))
+	feeOffered := sdk.NewDecCoinFromDec(minGasPrice.Denom, sdk.NewDecFromInt(fees[0].Amount).Mul(conversionRate))
+	feeRequired := sdk.NewDecCoinFromDec(minGasPrice.Denom, gasDeclared.Mul(minGasPrice.Amount).Mul(multiplier))
 
 	if feeOffered.IsLT(feeRequired) {
 		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeOffered, feeRequired)
@@ -68,6 +105,27 @@ func (fd FeeDecorator) actOnFeeModelOutput(ctx sdk.Context, feeTx sdk.FeeTx) err
 	return nil
 }
 
+// validateAssetFTFeeDenom rejects the fee paid in the asset ft token which rules would apply to the fee payment.
+func (fd FeeDecorator) validateAssetFTFeeDenom(ctx sdk.Context, denom string) error {
+	// the denom which is not the asset ft denom is not affected by the asset ft rules
+	if _, _, err := assetfttypes.DeconstructDenom(denom); err != nil {
+		return nil
+	}
+
+	def, err := fd.assetFTKeeper.GetDefinition(ctx, denom)
+	if err != nil {
+		return err
+	}
+	if len(def.Features) > 0 || def.BurnRate.IsPositive() || def.SendCommissionRate.IsPositive() {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidCoins,
+			"fee can't be paid in the asset ft token %q with features, burn rate or send commission", denom,
+		)
+	}
+
+	return nil
+}
+
 func (fd FeeDecorator) collectFeeModelInput(ctx sdk.Context, feeTx sdk.FeeTx) {
 	fd.keeper.TrackGas(ctx, int64(feeTx.GetGas()))
 }
@@ -2,15 +2,21 @@ package cli
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/spf13/cobra"
 
 	"github.com/CoreumFoundation/coreum/v2/x/feemodel/types"
 )
 
-const afterFlag = "after"
+const (
+	afterFlag  = "after"
+	withinFlag = "within"
+	priceFlag  = "price"
+)
 
 // GetQueryCmd returns the parent command for all x/feemodel CLI query commands. The
 // provided clientCtx should have, at a minimum, a verifier, Tendermint RPC client,
@@ -26,7 +32,10 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetMinGasPriceCmd(),
+		GetMsgMinGasPriceCmd(),
 		GetRecommendedGasPriceCmd(),
+		GetGasPriceForecastCmd(),
+		GetGasPriceHistoryCmd(),
 	)
 
 	return cmd
@@ -57,6 +66,43 @@ func GetMinGasPriceCmd() *cobra.Command {
 	return cmd
 }
 
+// GetMsgMinGasPriceCmd returns command for getting minimum gas price required by the network for the message type.
+func GetMsgMinGasPriceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "msg-min-gas-price [msg-url]",
+		Short: "Query for minimum gas price for current block required by the network for the message type",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for minimum gas price for current block required by the network for the message type, taking its gas price multiplier into account.
+
+Example:
+$ %[1]s query %[2]s msg-min-gas-price /cosmos.bank.v1beta1.MsgSend
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MsgMinGasPrice(cmd.Context(), &types.QueryMsgMinGasPriceRequest{
+				MsgURL: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // QueryGasPrice queries the gas price.
 func QueryGasPrice(cmd *cobra.Command) (*types.QueryMinGasPriceResponse, error) {
 	clientCtx, err := client.GetClientQueryContext(cmd)
@@ -115,3 +161,102 @@ func GetRecommendedGasPriceCmd() *cobra.Command {
 
 	return cmd
 }
+
+// GetGasPriceForecastCmd returns command for getting the forecast of the gas price.
+func GetGasPriceForecastCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gas-price-forecast",
+		Short: fmt.Sprintf("Query for the estimates of the minimum gas price `%s` blocks in future", afterFlag),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for the estimates of the minimum gas price required in the future blocks, computed by simulating the fee model forward using the block gas observed in the latest blocks.
+The estimates of the gas price accepted within the blocks with the probability of the percentile are returned too.
+If the price is provided, the probability that it is accepted within the blocks is returned as well.
+
+Example:
+$ %[1]s query %[2]s gas-price-forecast --%[3]s 10 --%[4]s 0.0625 --%[5]s 5
+`,
+				version.AppName, types.ModuleName, afterFlag, priceFlag, withinFlag,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			after, err := cmd.Flags().GetUint32(afterFlag)
+			if err != nil {
+				return err
+			}
+			within, err := cmd.Flags().GetUint32(withinFlag)
+			if err != nil {
+				return err
+			}
+			price, err := cmd.Flags().GetString(priceFlag)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.GasPriceForecast(cmd.Context(), &types.QueryGasPriceForecastRequest{
+				AfterBlocks:  after,
+				Price:        price,
+				WithinBlocks: within,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().Uint32(afterFlag, 10, "how many blocks in future to estimate gas price for.")
+	cmd.Flags().Uint32(withinFlag, 0, "within how many blocks the price must be accepted, defaults to the after value.")
+	cmd.Flags().String(priceFlag, "", "gas price, in the denom of the minimum gas price, to compute the acceptance probability for.")
+
+	return cmd
+}
+
+// GetGasPriceHistoryCmd returns command for getting the gas price history of the latest blocks.
+func GetGasPriceHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "Query for minimum gas price and block gas recorded for the latest blocks",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for minimum gas price and block gas recorded for the latest blocks, ordered by the block height.
+
+Example:
+$ %[1]s query %[2]s history --limit 10 --reverse
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.GasPriceHistory(cmd.Context(), &types.QueryGasPriceHistoryRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "gas price history")
+
+	return cmd
+}
@@ -44,3 +44,94 @@ func TestRecommendedGasPrice(t *testing.T) {
 	assert.Greater(t, resp.Med.Amount.MustFloat64(), sdk.ZeroDec().MustFloat64())
 	assert.Greater(t, resp.High.Amount.MustFloat64(), sdk.ZeroDec().MustFloat64())
 }
+
+func TestMsgMinGasPrice(t *testing.T) {
+	requireT := require.New(t)
+
+	msgURL := "/cosmos.bank.v1beta1.MsgSend"
+	multiplier := sdk.MustNewDecFromStr("1.5")
+
+	cfg := network.DefaultConfig()
+	var genesis types.GenesisState
+	requireT.NoError(cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &genesis))
+	genesis.Params.MsgGasPriceMultipliers = []types.MsgGasPriceMultiplier{
+		{
+			MsgURL:     msgURL,
+			Multiplier: multiplier,
+		},
+	}
+	cfg.GenesisState[types.ModuleName] = cfg.Codec.MustMarshalJSON(&genesis)
+	testNetwork := network.New(t, cfg)
+
+	ctx := testNetwork.Validators[0].ClientCtx
+
+	buf, err := clitestutil.ExecTestCLICmd(ctx, cli.GetQueryCmd(), []string{"min-gas-price", "--output", "json"})
+	requireT.NoError(err)
+	var minGasPrice sdk.DecCoin
+	requireT.NoError(json.Unmarshal(buf.Bytes(), &minGasPrice))
+
+	buf, err = clitestutil.ExecTestCLICmd(ctx, cli.GetQueryCmd(), []string{"msg-min-gas-price", msgURL, "--output", "json"})
+	requireT.NoError(err)
+	var resp types.QueryMsgMinGasPriceResponse
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
+	requireT.Equal(multiplier.String(), resp.Multiplier.String())
+	requireT.Equal(minGasPrice.Denom, resp.MinGasPrice.Denom)
+	requireT.Equal(minGasPrice.Amount.Mul(multiplier).String(), resp.MinGasPrice.Amount.String())
+
+	buf, err = clitestutil.ExecTestCLICmd(ctx, cli.GetQueryCmd(), []string{
+		"msg-min-gas-price", "/cosmos.bank.v1beta1.MsgMultiSend", "--output", "json",
+	})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
+	requireT.Equal(sdk.OneDec().String(), resp.Multiplier.String())
+	requireT.Equal(minGasPrice.Amount.String(), resp.MinGasPrice.Amount.String())
+}
+
+func TestGasPriceHistory(t *testing.T) {
+	requireT := require.New(t)
+
+	testNetwork := network.New(t)
+	_, err := testNetwork.WaitForHeight(3)
+	requireT.NoError(err)
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	buf, err := clitestutil.ExecTestCLICmd(ctx, cli.GetQueryCmd(), []string{"history", "--limit", "2", "--output", "json"})
+	requireT.NoError(err)
+
+	var resp types.QueryGasPriceHistoryResponse
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
+	requireT.Len(resp.Entries, 2)
+	requireT.EqualValues(1, resp.Entries[0].Height)
+	requireT.EqualValues(2, resp.Entries[1].Height)
+	requireT.Equal(testNetwork.Config.BondDenom, resp.Entries[0].MinGasPrice.Denom)
+	requireT.True(resp.Entries[0].MinGasPrice.Amount.IsPositive())
+	requireT.NotNil(resp.Pagination.NextKey)
+}
+
+func TestGasPriceForecast(t *testing.T) {
+	requireT := require.New(t)
+
+	testNetwork := network.New(t)
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	buf, err := clitestutil.ExecTestCLICmd(ctx, cli.GetQueryCmd(), []string{"min-gas-price", "--output", "json"})
+	requireT.NoError(err)
+	var minGasPrice sdk.DecCoin
+	requireT.NoError(json.Unmarshal(buf.Bytes(), &minGasPrice))
+
+	buf, err = clitestutil.ExecTestCLICmd(ctx, cli.GetQueryCmd(), []string{
+		"gas-price-forecast", "--after", "10", "--within", "5", "--price", minGasPrice.Amount.String(), "--output", "json",
+	})
+	requireT.NoError(err)
+
+	var resp types.QueryGasPriceForecastResponse
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
+	requireT.NotEmpty(resp.Percentiles)
+	for _, percentile := range resp.Percentiles {
+		requireT.Equal(testNetwork.Config.BondDenom, percentile.MinGasPrice.Denom)
+		requireT.True(percentile.MinGasPrice.Amount.IsPositive())
+	}
+	requireT.Len(resp.AcceptancePercentiles, len(resp.Percentiles))
+	requireT.NotNil(resp.AcceptanceProbability)
+	requireT.Equal(sdk.OneDec().String(), resp.AcceptanceProbability.String())
+}
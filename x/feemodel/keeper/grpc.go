@@ -4,6 +4,7 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -15,6 +16,15 @@ type QueryKeeper interface {
 	GetParams(ctx sdk.Context) types.Params
 	GetMinGasPrice(ctx sdk.Context) sdk.DecCoin
 	CalculateEdgeGasPriceAfterBlocks(ctx sdk.Context, after uint32) (sdk.DecCoin, sdk.DecCoin, error)
+	ForecastGasPrice(
+		ctx sdk.Context,
+		afterBlocks, withinBlocks uint32,
+		price *sdk.Dec,
+	) ([]types.GasPriceForecastPercentile, []types.GasPriceForecastPercentile, *sdk.Dec, error)
+	GetGasPriceHistory(
+		ctx sdk.Context,
+		pagination *query.PageRequest,
+	) ([]types.GasPriceHistoryEntry, *query.PageResponse, error)
 }
 
 // NewQueryService creates query service.
@@ -40,6 +50,28 @@ func (qs QueryService) MinGasPrice(ctx context.Context, req *types.QueryMinGasPr
 	}, nil
 }
 
+// MsgMinGasPrice returns current minimum gas price required by the network for the message type.
+func (qs QueryService) MsgMinGasPrice(
+	ctx context.Context,
+	req *types.QueryMsgMinGasPriceRequest,
+) (*types.QueryMsgMinGasPriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.MsgURL == "" {
+		return nil, status.Error(codes.InvalidArgument, "msg url must be set")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	minGasPrice := qs.keeper.GetMinGasPrice(sdkCtx)
+	multiplier := qs.keeper.GetParams(sdkCtx).MsgGasPriceMultiplier(req.MsgURL)
+
+	return &types.QueryMsgMinGasPriceResponse{
+		MinGasPrice: sdk.NewDecCoinFromDec(minGasPrice.Denom, minGasPrice.Amount.Mul(multiplier)),
+		Multiplier:  multiplier,
+	}, nil
+}
+
 // RecommendedGasPrice returns an estimation of gas in the future blocks.
 func (qs QueryService) RecommendedGasPrice(ctx context.Context, req *types.QueryRecommendedGasPriceRequest) (*types.QueryRecommendedGasPriceResponse, error) {
 	if req == nil {
@@ -59,6 +91,61 @@ func (qs QueryService) RecommendedGasPrice(ctx context.Context, req *types.Query
 	}, nil
 }
 
+// GasPriceForecast returns the estimates of the minimum gas price required in the future blocks.
+func (qs QueryService) GasPriceForecast(
+	ctx context.Context,
+	req *types.QueryGasPriceForecastRequest,
+) (*types.QueryGasPriceForecastResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var price *sdk.Dec
+	if req.Price != "" {
+		p, err := sdk.NewDecFromStr(req.Price)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid price: %s", err)
+		}
+		price = &p
+	}
+
+	percentiles, acceptancePercentiles, probability, err := qs.keeper.ForecastGasPrice(
+		sdk.UnwrapSDKContext(ctx),
+		req.AfterBlocks,
+		req.WithinBlocks,
+		price,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGasPriceForecastResponse{
+		Percentiles:           percentiles,
+		AcceptanceProbability: probability,
+		AcceptancePercentiles: acceptancePercentiles,
+	}, nil
+}
+
+// GasPriceHistory returns the minimum gas price and the block gas recorded for the latest blocks.
+func (qs QueryService) GasPriceHistory(
+	ctx context.Context,
+	req *types.QueryGasPriceHistoryRequest,
+) (*types.QueryGasPriceHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	entries, pageRes, err := qs.keeper.GetGasPriceHistory(sdk.UnwrapSDKContext(ctx), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGasPriceHistoryResponse{
+		Entries:    entries,
+		Pagination: pageRes,
+	}, nil
+}
+
 // Params returns params of fee model.
 func (qs QueryService) Params(ctx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	if req == nil {
@@ -1,13 +1,24 @@
 package keeper
 
 import (
+	"math/rand"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	"github.com/CoreumFoundation/coreum/v2/x/feemodel/types"
 )
 
+// gasPriceForecastSimulations is the number of simulations run to forecast the gas price.
+const gasPriceForecastSimulations = 500
+
+// gasPriceForecastPercentiles are the percentiles of the simulations for which the minimum gas price is estimated.
+var gasPriceForecastPercentiles = []uint32{10, 25, 50, 75, 90}
+
 // ParamSubspace represents a subscope of methods exposed by param module to store and retrieve parameters.
 type ParamSubspace interface {
 	GetParamSet(ctx sdk.Context, ps paramtypes.ParamSet)
@@ -153,6 +164,59 @@ func (k Keeper) SetMinGasPrice(ctx sdk.Context, minGasPrice sdk.DecCoin) {
 	store.Set(gasPriceKey, bz)
 }
 
+// AddGasPriceHistoryEntry stores the gas price history entry of the block. Entries older than the number of blocks
+// defined by the GasPriceHistoryLength param are removed.
+func (k Keeper) AddGasPriceHistoryEntry(ctx sdk.Context, entry types.GasPriceHistoryEntry) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := entry.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(gasPriceHistoryKey(entry.Height), bz)
+
+	lastRemovedHeight := entry.Height - int64(k.GetParams(ctx).GasPriceHistoryLength)
+	if lastRemovedHeight < 0 {
+		return
+	}
+
+	// entries are returned from this iterator in the height ascending order, and only the ones out of the history
+	// window are visited. Usually there is only one such entry, but there are more if the history length
+	// has been decreased.
+	historyStore := prefix.NewStore(store, gasPriceHistoryKeyPrefix)
+	iter := historyStore.Iterator(nil, sdk.Uint64ToBigEndian(uint64(lastRemovedHeight+1)))
+	defer iter.Close()
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	for _, key := range keys {
+		historyStore.Delete(key)
+	}
+}
+
+// GetGasPriceHistory returns the gas price history entries ordered by the block height.
+func (k Keeper) GetGasPriceHistory(
+	ctx sdk.Context,
+	pagination *query.PageRequest,
+) ([]types.GasPriceHistoryEntry, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), gasPriceHistoryKeyPrefix)
+	entries := make([]types.GasPriceHistoryEntry, 0)
+	pageRes, err := query.Paginate(store, pagination, func(key, value []byte) error {
+		var entry types.GasPriceHistoryEntry
+		if err := entry.Unmarshal(value); err != nil {
+			return err
+		}
+		entries = append(entries, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return entries, pageRes, nil
+}
+
 // CalculateEdgeGasPriceAfterBlocks returns the smallest and highest possible values for min gas price in future blocks.
 func (k Keeper) CalculateEdgeGasPriceAfterBlocks(ctx sdk.Context, after uint32) (sdk.DecCoin, sdk.DecCoin, error) {
 	shortEMABlockLength := k.GetParams(ctx).Model.ShortEmaBlockLength
@@ -205,3 +269,132 @@ func (k Keeper) CalculateEdgeGasPriceAfterBlocks(ctx sdk.Context, after uint32)
 		sdk.NewDecCoinFromDec(denom, highMinGasPrice),
 		nil
 }
+
+// ForecastGasPrice estimates the minimum gas price required after the number of blocks by simulating the fee model
+// forward, using the block gas observed in the blocks kept in the gas price history. It also estimates the gas price
+// accepted within the number of blocks, meaning the price not lower than the minimum gas price required by at least
+// one of the simulated blocks, with the probability of the percentile. If the price is provided, the probability that
+// it is accepted within the number of blocks is returned as well. The simulations are seeded with the block height,
+// so the same state always produces the same forecast.
+func (k Keeper) ForecastGasPrice(
+	ctx sdk.Context,
+	afterBlocks, withinBlocks uint32,
+	price *sdk.Dec,
+) ([]types.GasPriceForecastPercentile, []types.GasPriceForecastPercentile, *sdk.Dec, error) {
+	params := k.GetParams(ctx)
+	shortEMABlockLength := params.Model.ShortEmaBlockLength
+	if afterBlocks > shortEMABlockLength {
+		return nil, nil, nil, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "after blocks must be lower than or equal to %d", shortEMABlockLength,
+		)
+	}
+	if withinBlocks > shortEMABlockLength {
+		return nil, nil, nil, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "within blocks must be lower than or equal to %d", shortEMABlockLength,
+		)
+	}
+	if price != nil && price.IsNegative() {
+		return nil, nil, nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "price must not be negative")
+	}
+
+	// if no after value is provided shortEMABlockLength is taken as default value
+	if afterBlocks == 0 {
+		afterBlocks = shortEMABlockLength
+	}
+	if withinBlocks == 0 {
+		withinBlocks = afterBlocks
+	}
+
+	shortEMA := k.GetShortEMAGas(ctx)
+	blockGasSamples, err := k.getBlockGasSamples(ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	// if there is no history yet, the current short average is the best approximation of the block gas
+	if len(blockGasSamples) == 0 {
+		blockGasSamples = []int64{shortEMA}
+	}
+
+	simulatedBlocks := afterBlocks
+	if withinBlocks > simulatedBlocks {
+		simulatedBlocks = withinBlocks
+	}
+
+	denom := k.GetMinGasPrice(ctx).Denom
+	//nolint:gosec // the forecast is not a part of the consensus, it only must be reproducible
+	rnd := rand.New(rand.NewSource(ctx.BlockHeight()))
+	simulatedPrices := types.NewModel(params.Model).SimulateGasPrices(
+		shortEMA,
+		k.GetLongEMAGas(ctx),
+		blockGasSamples,
+		simulatedBlocks,
+		gasPriceForecastSimulations,
+		rnd,
+	)
+
+	pricesAfterBlocks := make([]sdk.Dec, 0, len(simulatedPrices))
+	// the price is accepted within the blocks if it is not lower than the lowest minimum gas price required by them
+	acceptedPrices := make([]sdk.Dec, 0, len(simulatedPrices))
+	for _, prices := range simulatedPrices {
+		pricesAfterBlocks = append(pricesAfterBlocks, prices[afterBlocks-1])
+
+		acceptedPrice := prices[0]
+		for _, blockPrice := range prices[1:withinBlocks] {
+			acceptedPrice = sdk.MinDec(acceptedPrice, blockPrice)
+		}
+		acceptedPrices = append(acceptedPrices, acceptedPrice)
+	}
+
+	percentiles := gasPricePercentiles(denom, pricesAfterBlocks)
+	acceptancePercentiles := gasPricePercentiles(denom, acceptedPrices)
+	if price == nil {
+		return percentiles, acceptancePercentiles, nil, nil
+	}
+
+	var accepted int64
+	for _, acceptedPrice := range acceptedPrices {
+		if price.GTE(acceptedPrice) {
+			accepted++
+		}
+	}
+	probability := sdk.NewDec(accepted).QuoInt64(int64(len(acceptedPrices)))
+
+	return percentiles, acceptancePercentiles, &probability, nil
+}
+
+// gasPricePercentiles returns the forecast percentiles of the simulated gas prices.
+func gasPricePercentiles(denom string, prices []sdk.Dec) []types.GasPriceForecastPercentile {
+	sortedPrices := make([]sdk.Dec, len(prices))
+	copy(sortedPrices, prices)
+	sort.Slice(sortedPrices, func(i, j int) bool {
+		return sortedPrices[i].LT(sortedPrices[j])
+	})
+
+	percentiles := make([]types.GasPriceForecastPercentile, 0, len(gasPriceForecastPercentiles))
+	for _, percentile := range gasPriceForecastPercentiles {
+		// nearest-rank method
+		index := (int(percentile)*len(sortedPrices)+99)/100 - 1
+		percentiles = append(percentiles, types.GasPriceForecastPercentile{
+			Percentile:  percentile,
+			MinGasPrice: sdk.NewDecCoinFromDec(denom, sortedPrices[index]),
+		})
+	}
+
+	return percentiles
+}
+
+func (k Keeper) getBlockGasSamples(ctx sdk.Context) ([]int64, error) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), gasPriceHistoryKeyPrefix).Iterator(nil, nil)
+	defer iter.Close()
+
+	var samples []int64
+	for ; iter.Valid(); iter.Next() {
+		var entry types.GasPriceHistoryEntry
+		if err := entry.Unmarshal(iter.Value()); err != nil {
+			return nil, err
+		}
+		samples = append(samples, entry.TrackedGas)
+	}
+
+	return samples, nil
+}
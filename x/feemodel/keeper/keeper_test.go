@@ -6,6 +6,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -294,3 +296,163 @@ func TestEstimateGasPriceInFuture(t *testing.T) {
 		})
 	}
 }
+
+func TestGasPriceHistory(t *testing.T) {
+	requireT := require.New(t)
+	ctx, keeper := setup()
+
+	params := types.DefaultParams()
+	params.GasPriceHistoryLength = 5
+	keeper.SetParams(ctx, params)
+
+	for height := int64(1); height <= 8; height++ {
+		keeper.AddGasPriceHistoryEntry(ctx, types.GasPriceHistoryEntry{
+			Height:      height,
+			MinGasPrice: sdk.NewDecCoin("coin", sdk.NewInt(height)),
+			TrackedGas:  height * 10,
+			ShortEMAGas: height * 20,
+			LongEMAGas:  height * 30,
+		})
+	}
+
+	// only the latest entries are kept
+	entries, pageRes, err := keeper.GetGasPriceHistory(ctx, nil)
+	requireT.NoError(err)
+	requireT.EqualValues(5, pageRes.Total)
+	requireT.Len(entries, 5)
+	for i, entry := range entries {
+		height := int64(i + 4)
+		requireT.Equal(height, entry.Height)
+		requireT.Equal(sdk.NewDecCoin("coin", sdk.NewInt(height)).String(), entry.MinGasPrice.String())
+		requireT.Equal(height*10, entry.TrackedGas)
+		requireT.Equal(height*20, entry.ShortEMAGas)
+		requireT.Equal(height*30, entry.LongEMAGas)
+	}
+
+	// pagination
+	entries, pageRes, err = keeper.GetGasPriceHistory(ctx, &query.PageRequest{Limit: 2, Reverse: true})
+	requireT.NoError(err)
+	requireT.NotNil(pageRes.NextKey)
+	requireT.Len(entries, 2)
+	requireT.EqualValues(8, entries[0].Height)
+	requireT.EqualValues(7, entries[1].Height)
+
+	// decreasing the history length removes all the entries out of the new window
+	params.GasPriceHistoryLength = 2
+	keeper.SetParams(ctx, params)
+	keeper.AddGasPriceHistoryEntry(ctx, types.GasPriceHistoryEntry{
+		Height:      9,
+		MinGasPrice: sdk.NewDecCoin("coin", sdk.NewInt(9)),
+	})
+
+	entries, _, err = keeper.GetGasPriceHistory(ctx, nil)
+	requireT.NoError(err)
+	requireT.Len(entries, 2)
+	requireT.EqualValues(8, entries[0].Height)
+	requireT.EqualValues(9, entries[1].Height)
+}
+
+func TestForecastGasPrice(t *testing.T) {
+	requireT := require.New(t)
+	ctx, keeper := setup()
+	ctx = ctx.WithBlockHeight(100)
+
+	params := types.DefaultParams()
+	keeper.SetParams(ctx, params)
+	model := types.NewModel(params.Model)
+	minGasPrice := sdk.NewDecCoinFromDec("coin", model.CalculateNextGasPrice(0, 0))
+	keeper.SetMinGasPrice(ctx, minGasPrice)
+
+	_, _, _, err := keeper.ForecastGasPrice(ctx, params.Model.ShortEmaBlockLength+1, 0, nil)
+	requireT.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	_, _, _, err = keeper.ForecastGasPrice(ctx, 0, params.Model.ShortEmaBlockLength+1, nil)
+	requireT.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	negativePrice := sdk.NewDec(-1)
+	_, _, _, err = keeper.ForecastGasPrice(ctx, 0, 0, &negativePrice)
+	requireT.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	// without the history and the load the price doesn't change
+	percentiles, acceptancePercentiles, probability, err := keeper.ForecastGasPrice(ctx, 10, 10, nil)
+	requireT.NoError(err)
+	requireT.Nil(probability)
+	requireT.Len(percentiles, 5)
+	requireT.Len(acceptancePercentiles, 5)
+	for _, percentile := range append(percentiles, acceptancePercentiles...) {
+		requireT.Equal(minGasPrice.String(), percentile.MinGasPrice.String())
+	}
+
+	// the price goes up or down depending on the load of the simulated blocks
+	shortEMA := params.Model.MaxBlockGas * 9 / 10
+	longEMA := params.Model.MaxBlockGas / 10
+	keeper.SetShortEMAGas(ctx, shortEMA)
+	keeper.SetLongEMAGas(ctx, longEMA)
+	minGasPrice = sdk.NewDecCoinFromDec("coin", model.CalculateNextGasPrice(shortEMA, longEMA))
+	keeper.SetMinGasPrice(ctx, minGasPrice)
+	for height := int64(1); height <= 10; height++ {
+		var trackedGas int64
+		if height%2 == 0 {
+			trackedGas = params.Model.MaxBlockGas
+		}
+		keeper.AddGasPriceHistoryEntry(ctx, types.GasPriceHistoryEntry{
+			Height:      height,
+			MinGasPrice: minGasPrice,
+			TrackedGas:  trackedGas,
+		})
+	}
+
+	percentiles, acceptancePercentiles, probability, err = keeper.ForecastGasPrice(ctx, 10, 10, nil)
+	requireT.NoError(err)
+	requireT.Nil(probability)
+	requireT.Len(percentiles, 5)
+	requireT.Len(acceptancePercentiles, 5)
+	for _, forecast := range [][]types.GasPriceForecastPercentile{percentiles, acceptancePercentiles} {
+		for i, percentile := range forecast {
+			requireT.Equal(minGasPrice.Denom, percentile.MinGasPrice.Denom)
+			requireT.True(percentile.MinGasPrice.Amount.GTE(model.CalculateGasPriceWithMaxDiscount()))
+			requireT.True(percentile.MinGasPrice.Amount.LTE(model.CalculateMaxGasPrice()))
+			if i > 0 {
+				requireT.Greater(percentile.Percentile, forecast[i-1].Percentile)
+				requireT.True(percentile.MinGasPrice.Amount.GTE(forecast[i-1].MinGasPrice.Amount))
+			}
+		}
+	}
+	requireT.True(percentiles[0].MinGasPrice.Amount.LT(percentiles[len(percentiles)-1].MinGasPrice.Amount))
+
+	// the forecast is reproducible
+	percentiles2, acceptancePercentiles2, _, err := keeper.ForecastGasPrice(ctx, 10, 10, nil)
+	requireT.NoError(err)
+	requireT.Equal(percentiles, percentiles2)
+	requireT.Equal(acceptancePercentiles, acceptancePercentiles2)
+
+	// the price of the acceptance percentile is accepted at least in that percentage of the simulations
+	for _, percentile := range acceptancePercentiles {
+		price := percentile.MinGasPrice.Amount
+		_, _, probability, err = keeper.ForecastGasPrice(ctx, 10, 10, &price)
+		requireT.NoError(err)
+		requireT.True(probability.GTE(sdk.NewDec(int64(percentile.Percentile)).QuoInt64(100)))
+	}
+
+	// the max gas price is always accepted
+	maxPrice := model.CalculateMaxGasPrice()
+	_, _, probability, err = keeper.ForecastGasPrice(ctx, 10, 10, &maxPrice)
+	requireT.NoError(err)
+	requireT.Equal(sdk.OneDec().String(), probability.String())
+
+	// price lower than any possible min gas price is never accepted
+	lowPrice := model.CalculateGasPriceWithMaxDiscount().QuoInt64(2)
+	_, _, probability, err = keeper.ForecastGasPrice(ctx, 10, 10, &lowPrice)
+	requireT.NoError(err)
+	requireT.Equal(sdk.ZeroDec().String(), probability.String())
+
+	// the chance that the lower price is accepted grows with the number of blocks
+	medianPrice := percentiles[2].MinGasPrice.Amount
+	_, acceptancePercentiles, probability, err = keeper.ForecastGasPrice(ctx, 10, 1, &medianPrice)
+	requireT.NoError(err)
+	_, acceptancePercentiles2, probability2, err := keeper.ForecastGasPrice(ctx, 10, 10, &medianPrice)
+	requireT.NoError(err)
+	requireT.True(probability2.GT(*probability))
+	requireT.True(probability2.LT(sdk.OneDec()))
+	for i := range acceptancePercentiles {
+		requireT.True(acceptancePercentiles2[i].MinGasPrice.Amount.LTE(acceptancePercentiles[i].MinGasPrice.Amount))
+	}
+}
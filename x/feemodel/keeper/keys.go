@@ -1,8 +1,17 @@
 package keeper
 
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
 var (
 	gasTrackingKey = []byte{0x00}
 	gasPriceKey    = []byte{0x01}
 	shortEMAGasKey = []byte{0x02}
 	longEMAGasKey  = []byte{0x03}
+
+	gasPriceHistoryKeyPrefix = []byte{0x04}
 )
+
+// gasPriceHistoryKey returns the key of the gas price history entry recorded at the height.
+func gasPriceHistoryKey(height int64) []byte {
+	return append(append([]byte{}, gasPriceHistoryKeyPrefix...), sdk.Uint64ToBigEndian(uint64(height))...)
+}
@@ -0,0 +1,31 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/pkg/errors"
+
+	"github.com/CoreumFoundation/coreum/v2/x/feemodel/types"
+)
+
+// ParamsKeeper specifies methods of params keeper required by the migration.
+type ParamsKeeper interface {
+	GetSubspace(s string) (paramstypes.Subspace, bool)
+}
+
+// MigrateParams migrates feemodel params state from v1 to v2.
+// Alternate fee denoms and gas price multipliers of the message types were introduced in v2, so initially none of them
+// is set. The gas price history length, also introduced in v2, is set to the default value.
+func MigrateParams(ctx sdk.Context, paramsKeeper ParamsKeeper) error {
+	feemodelSubspace, ok := paramsKeeper.GetSubspace(types.ModuleName)
+	if !ok {
+		return errors.New("params subspace does not exist")
+	}
+
+	defaultParams := types.DefaultParams()
+	feemodelSubspace.Set(ctx, types.KeyFeeDenoms, defaultParams.FeeDenoms)
+	feemodelSubspace.Set(ctx, types.KeyMsgGasPriceMultipliers, defaultParams.MsgGasPriceMultipliers)
+	feemodelSubspace.Set(ctx, types.KeyGasPriceHistoryLength, defaultParams.GasPriceHistoryLength)
+
+	return nil
+}
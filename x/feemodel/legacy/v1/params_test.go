@@ -0,0 +1,45 @@
+package v1_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/v2/testutil/simapp"
+	v1 "github.com/CoreumFoundation/coreum/v2/x/feemodel/legacy/v1"
+	"github.com/CoreumFoundation/coreum/v2/x/feemodel/types"
+)
+
+func TestMigrateParams(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+
+	keeper := testApp.FeeModelKeeper
+	params := keeper.GetParams(ctx)
+	params.FeeDenoms = []types.FeeDenom{
+		{
+			Denom:          "denom",
+			ConversionRate: sdk.OneDec(),
+		},
+	}
+	params.MsgGasPriceMultipliers = []types.MsgGasPriceMultiplier{
+		{
+			MsgURL:     "/cosmos.bank.v1beta1.MsgSend",
+			Multiplier: sdk.OneDec(),
+		},
+	}
+	params.GasPriceHistoryLength = 1
+	keeper.SetParams(ctx, params)
+
+	requireT.NoError(v1.MigrateParams(ctx, testApp.ParamsKeeper))
+
+	migratedParams := keeper.GetParams(ctx)
+	requireT.Empty(migratedParams.FeeDenoms)
+	requireT.Empty(migratedParams.MsgGasPriceMultipliers)
+	requireT.Equal(types.DefaultParams().GasPriceHistoryLength, migratedParams.GasPriceHistoryLength)
+	requireT.Equal(params.Model, migratedParams.Model)
+}
@@ -11,6 +11,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/gorilla/mux"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -20,6 +21,7 @@ import (
 
 	"github.com/CoreumFoundation/coreum/v2/x/feemodel/client/cli"
 	"github.com/CoreumFoundation/coreum/v2/x/feemodel/keeper"
+	v1 "github.com/CoreumFoundation/coreum/v2/x/feemodel/legacy/v1"
 	"github.com/CoreumFoundation/coreum/v2/x/feemodel/types"
 )
 
@@ -41,6 +43,16 @@ type Keeper interface {
 	GetMinGasPrice(ctx sdk.Context) sdk.DecCoin
 	SetMinGasPrice(ctx sdk.Context, minGasPrice sdk.DecCoin)
 	CalculateEdgeGasPriceAfterBlocks(ctx sdk.Context, after uint32) (sdk.DecCoin, sdk.DecCoin, error)
+	ForecastGasPrice(
+		ctx sdk.Context,
+		afterBlocks, withinBlocks uint32,
+		price *sdk.Dec,
+	) ([]types.GasPriceForecastPercentile, []types.GasPriceForecastPercentile, *sdk.Dec, error)
+	AddGasPriceHistoryEntry(ctx sdk.Context, entry types.GasPriceHistoryEntry)
+	GetGasPriceHistory(
+		ctx sdk.Context,
+		pagination *query.PageRequest,
+	) ([]types.GasPriceHistoryEntry, *query.PageResponse, error)
 }
 
 // AppModuleBasic defines the basic application module used by the fee module.
@@ -93,18 +105,25 @@ func (AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry)
 type AppModule struct {
 	AppModuleBasic
 
-	keeper Keeper
+	keeper       Keeper
+	paramsKeeper v1.ParamsKeeper
 }
 
 // RegisterServices registers module services.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryService(am.keeper))
+
+	m := keeper.NewMigrator(am.paramsKeeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(err)
+	}
 }
 
 // NewAppModule creates a new AppModule object.
-func NewAppModule(keeper Keeper) AppModule {
+func NewAppModule(keeper Keeper, paramsKeeper v1.ParamsKeeper) AppModule {
 	return AppModule{
-		keeper: keeper,
+		keeper:       keeper,
+		paramsKeeper: paramsKeeper,
 	}
 }
 
@@ -146,7 +165,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (AppModule) ConsensusVersion() uint64 { return 2 }
 
 // BeginBlock performs a no-op.
 func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
@@ -169,6 +188,13 @@ func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.Val
 	am.keeper.SetShortEMAGas(ctx, newShortEMA)
 	am.keeper.SetLongEMAGas(ctx, newLongEMA)
 	am.keeper.SetMinGasPrice(ctx, sdk.NewDecCoinFromDec(previousMinGasPrice.Denom, newMinGasPrice))
+	am.keeper.AddGasPriceHistoryEntry(ctx, types.GasPriceHistoryEntry{
+		Height:      ctx.BlockHeight(),
+		MinGasPrice: previousMinGasPrice,
+		TrackedGas:  currentGasUsage,
+		ShortEMAGas: newShortEMA,
+		LongEMAGas:  newLongEMA,
+	})
 	metrics.SetGauge([]string{"min_gas_price"}, float32(newMinGasPrice.MustFloat64()))
 
 	return []abci.ValidatorUpdate{}
@@ -6,6 +6,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -22,7 +23,8 @@ func newKeeperMock(genesisState types.GenesisState) *keeperMock {
 }
 
 type keeperMock struct {
-	state types.GenesisState
+	state   types.GenesisState
+	history []types.GasPriceHistoryEntry
 }
 
 func (k *keeperMock) TrackedGas(ctx sdk.Context) int64 {
@@ -61,6 +63,25 @@ func (k *keeperMock) CalculateEdgeGasPriceAfterBlocks(ctx sdk.Context, after uin
 	return sdk.NewDecCoin("", sdk.ZeroInt()), sdk.NewDecCoin("", sdk.ZeroInt()), nil
 }
 
+func (k *keeperMock) ForecastGasPrice(
+	ctx sdk.Context,
+	afterBlocks, withinBlocks uint32,
+	price *sdk.Dec,
+) ([]types.GasPriceForecastPercentile, []types.GasPriceForecastPercentile, *sdk.Dec, error) {
+	return nil, nil, nil, nil
+}
+
+func (k *keeperMock) AddGasPriceHistoryEntry(ctx sdk.Context, entry types.GasPriceHistoryEntry) {
+	k.history = append(k.history, entry)
+}
+
+func (k *keeperMock) GetGasPriceHistory(
+	ctx sdk.Context,
+	pagination *query.PageRequest,
+) ([]types.GasPriceHistoryEntry, *query.PageResponse, error) {
+	return k.history, &query.PageResponse{}, nil
+}
+
 func setup() (feemodel.AppModule, feemodel.Keeper, types.GenesisState, codec.Codec) {
 	genesisState := types.GenesisState{
 		Params: types.Params{
@@ -73,12 +94,25 @@ func setup() (feemodel.AppModule, feemodel.Keeper, types.GenesisState, codec.Cod
 				ShortEmaBlockLength:     1,
 				LongEmaBlockLength:      3,
 			},
+			FeeDenoms: []types.FeeDenom{
+				{
+					Denom:          "coin3",
+					ConversionRate: sdk.MustNewDecFromStr("0.5"),
+				},
+			},
+			MsgGasPriceMultipliers: []types.MsgGasPriceMultiplier{
+				{
+					MsgURL:     "/cosmos.bank.v1beta1.MsgSend",
+					Multiplier: sdk.MustNewDecFromStr("2"),
+				},
+			},
+			GasPriceHistoryLength: 10,
 		},
 		MinGasPrice: sdk.NewDecCoin("coin", sdk.NewInt(155)),
 	}
 	cdc := config.NewEncodingConfig(module.NewBasicManager()).Codec
 	keeper := newKeeperMock(genesisState)
-	module := feemodel.NewAppModule(keeper)
+	module := feemodel.NewAppModule(keeper, nil)
 
 	return module, keeper, genesisState, cdc
 }
@@ -124,10 +158,20 @@ func TestExport(t *testing.T) {
 func TestEndBlock(t *testing.T) {
 	module, keeper, state, _ := setup()
 
-	module.EndBlock(sdk.Context{}, abci.RequestEndBlock{})
+	ctx := sdk.Context{}.WithBlockHeight(5)
+	module.EndBlock(ctx, abci.RequestEndBlock{})
 
 	model := types.NewModel(state.Params.Model)
-	minGasPrice := keeper.GetMinGasPrice(sdk.Context{})
+	minGasPrice := keeper.GetMinGasPrice(ctx)
 	assert.True(t, minGasPrice.Amount.Equal(model.CalculateGasPriceWithMaxDiscount()))
 	assert.Equal(t, minGasPrice.Denom, state.MinGasPrice.Denom)
+
+	history, _, err := keeper.GetGasPriceHistory(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	assert.EqualValues(t, 5, history[0].Height)
+	assert.Equal(t, state.MinGasPrice.String(), history[0].MinGasPrice.String())
+	assert.EqualValues(t, 1, history[0].TrackedGas)
+	assert.Equal(t, types.CalculateEMA(0, 1, state.Params.Model.ShortEmaBlockLength), history[0].ShortEMAGas)
+	assert.Equal(t, types.CalculateEMA(0, 1, state.Params.Model.LongEmaBlockLength), history[0].LongEMAGas)
 }
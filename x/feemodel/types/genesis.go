@@ -22,5 +22,8 @@ func (m *GenesisState) Validate() error {
 	if !m.MinGasPrice.IsPositive() {
 		return errors.New("min gas price must be positive")
 	}
+	if _, ok := m.Params.FeeDenomConversionRate(m.MinGasPrice.Denom); ok {
+		return errors.Errorf("fee denom %q is the denom of the minimum gas price", m.MinGasPrice.Denom)
+	}
 	return m.Params.ValidateBasic()
 }
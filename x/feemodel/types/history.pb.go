@@ -0,0 +1,476 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: coreum/feemodel/v1/history.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GasPriceHistoryEntry defines the state of the fee model recorded at the end of the block.
+type GasPriceHistoryEntry struct {
+	// height is the height of the block.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// min_gas_price is the minimum gas price required by the network in the block.
+	MinGasPrice types.DecCoin `protobuf:"bytes,2,opt,name=min_gas_price,json=minGasPrice,proto3" json:"min_gas_price"`
+	// tracked_gas is the gas declared by the transactions included in the block.
+	TrackedGas int64 `protobuf:"varint,3,opt,name=tracked_gas,json=trackedGas,proto3" json:"tracked_gas,omitempty"`
+	// short_ema_gas is the short average block gas computed after the block.
+	ShortEMAGas int64 `protobuf:"varint,4,opt,name=short_ema_gas,json=shortEmaGas,proto3" json:"short_ema_gas,omitempty"`
+	// long_ema_gas is the long average block gas computed after the block.
+	LongEMAGas int64 `protobuf:"varint,5,opt,name=long_ema_gas,json=longEmaGas,proto3" json:"long_ema_gas,omitempty"`
+}
+
+func (m *GasPriceHistoryEntry) Reset()         { *m = GasPriceHistoryEntry{} }
+func (m *GasPriceHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*GasPriceHistoryEntry) ProtoMessage()    {}
+func (*GasPriceHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff14b79ad4bf116c, []int{0}
+}
+func (m *GasPriceHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasPriceHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasPriceHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasPriceHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasPriceHistoryEntry.Merge(m, src)
+}
+func (m *GasPriceHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasPriceHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasPriceHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasPriceHistoryEntry proto.InternalMessageInfo
+
+func (m *GasPriceHistoryEntry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *GasPriceHistoryEntry) GetMinGasPrice() types.DecCoin {
+	if m != nil {
+		return m.MinGasPrice
+	}
+	return types.DecCoin{}
+}
+
+func (m *GasPriceHistoryEntry) GetTrackedGas() int64 {
+	if m != nil {
+		return m.TrackedGas
+	}
+	return 0
+}
+
+func (m *GasPriceHistoryEntry) GetShortEMAGas() int64 {
+	if m != nil {
+		return m.ShortEMAGas
+	}
+	return 0
+}
+
+func (m *GasPriceHistoryEntry) GetLongEMAGas() int64 {
+	if m != nil {
+		return m.LongEMAGas
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*GasPriceHistoryEntry)(nil), "coreum.feemodel.v1.GasPriceHistoryEntry")
+}
+
+func init() { proto.RegisterFile("coreum/feemodel/v1/history.proto", fileDescriptor_ff14b79ad4bf116c) }
+
+var fileDescriptor_ff14b79ad4bf116c = []byte{
+	// 345 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x91, 0x41, 0x4f, 0xf2, 0x30,
+	0x18, 0xc7, 0xb7, 0x17, 0x5e, 0x0e, 0x9d, 0x68, 0xb2, 0x10, 0x43, 0x88, 0xd9, 0x88, 0x27, 0x4e,
+	0xad, 0x83, 0xc4, 0xbb, 0x20, 0xe0, 0x41, 0x13, 0x82, 0x37, 0x2f, 0xa4, 0x1b, 0x75, 0x6b, 0xa4,
+	0x7d, 0xc8, 0x5a, 0x16, 0xf9, 0x16, 0x7e, 0x2c, 0x8e, 0x1c, 0x3d, 0x11, 0x33, 0xbe, 0x87, 0x31,
+	0xdd, 0x86, 0xde, 0xda, 0xfe, 0x7f, 0xff, 0xfe, 0x92, 0xe7, 0x41, 0xdd, 0x08, 0x52, 0xb6, 0x11,
+	0xe4, 0x95, 0x31, 0x01, 0x4b, 0xb6, 0x22, 0x59, 0x40, 0x12, 0xae, 0x34, 0xa4, 0x5b, 0xbc, 0x4e,
+	0x41, 0x83, 0xeb, 0x96, 0x04, 0x3e, 0x11, 0x38, 0x0b, 0x3a, 0xad, 0x18, 0x62, 0x28, 0x62, 0x62,
+	0x4e, 0x25, 0xd9, 0xf1, 0x22, 0x50, 0x02, 0x14, 0x09, 0xa9, 0x62, 0x24, 0x0b, 0x42, 0xa6, 0x69,
+	0x40, 0x22, 0xe0, 0xb2, 0xcc, 0xaf, 0xbf, 0x6d, 0xd4, 0x9a, 0x52, 0x35, 0x4b, 0x79, 0xc4, 0x1e,
+	0x4a, 0xc7, 0x58, 0xea, 0x74, 0xeb, 0x5e, 0xa2, 0x46, 0xc2, 0x78, 0x9c, 0xe8, 0xb6, 0xdd, 0xb5,
+	0x7b, 0xb5, 0x79, 0x75, 0x73, 0x27, 0xa8, 0x29, 0xb8, 0x5c, 0xc4, 0x54, 0x2d, 0xd6, 0xa6, 0xd4,
+	0xfe, 0xd7, 0xb5, 0x7b, 0x4e, 0xff, 0x0a, 0x97, 0x22, 0x6c, 0x44, 0xb8, 0x12, 0xe1, 0x7b, 0x16,
+	0x8d, 0x80, 0xcb, 0x61, 0x7d, 0x77, 0xf0, 0xad, 0xb9, 0x23, 0xb8, 0x3c, 0xb9, 0x5c, 0x1f, 0x39,
+	0x3a, 0xa5, 0xd1, 0x1b, 0x5b, 0x9a, 0xbf, 0xda, 0xb5, 0x42, 0x82, 0xaa, 0xa7, 0x29, 0x55, 0xee,
+	0x00, 0x35, 0x55, 0x02, 0xa9, 0x5e, 0x30, 0x41, 0x0b, 0xa4, 0x6e, 0x90, 0xe1, 0x45, 0x7e, 0xf0,
+	0x9d, 0x67, 0x13, 0x8c, 0x9f, 0xee, 0xa6, 0x54, 0xcd, 0x9d, 0x82, 0x1a, 0x0b, 0x6a, 0x4a, 0x37,
+	0xe8, 0x6c, 0x05, 0x32, 0xfe, 0xed, 0xfc, 0x2f, 0x3a, 0xe7, 0xf9, 0xc1, 0x47, 0x8f, 0x20, 0xe3,
+	0xaa, 0x82, 0x0c, 0x53, 0x36, 0x86, 0xb3, 0x5d, 0xee, 0xd9, 0xfb, 0xdc, 0xb3, 0xbf, 0x72, 0xcf,
+	0xfe, 0x38, 0x7a, 0xd6, 0xfe, 0xe8, 0x59, 0x9f, 0x47, 0xcf, 0x7a, 0xb9, 0x8d, 0xb9, 0x4e, 0x36,
+	0x21, 0x8e, 0x40, 0x90, 0x51, 0x31, 0xef, 0x09, 0x6c, 0xe4, 0x92, 0x6a, 0x0e, 0x92, 0x54, 0x2b,
+	0xca, 0xfa, 0xe4, 0xfd, 0x6f, 0x4f, 0x7a, 0xbb, 0x66, 0x2a, 0x6c, 0x14, 0x93, 0x1d, 0xfc, 0x0c,
+	0x00, 0x49, 0x4b, 0xe7, 0xea, 0xc7, 0x01, 0x00, 0x00,
+}
+
+func (m *GasPriceHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasPriceHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasPriceHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LongEMAGas != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.LongEMAGas))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ShortEMAGas != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.ShortEMAGas))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TrackedGas != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.TrackedGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.MinGasPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintHistory(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintHistory(dAtA []byte, offset int, v uint64) int {
+	offset -= sovHistory(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GasPriceHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovHistory(uint64(m.Height))
+	}
+	l = m.MinGasPrice.Size()
+	n += 1 + l + sovHistory(uint64(l))
+	if m.TrackedGas != 0 {
+		n += 1 + sovHistory(uint64(m.TrackedGas))
+	}
+	if m.ShortEMAGas != 0 {
+		n += 1 + sovHistory(uint64(m.ShortEMAGas))
+	}
+	if m.LongEMAGas != 0 {
+		n += 1 + sovHistory(uint64(m.LongEMAGas))
+	}
+	return n
+}
+
+func sovHistory(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozHistory(x uint64) (n int) {
+	return sovHistory(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GasPriceHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasPriceHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasPriceHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrackedGas", wireType)
+			}
+			m.TrackedGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrackedGas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortEMAGas", wireType)
+			}
+			m.ShortEMAGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShortEMAGas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LongEMAGas", wireType)
+			}
+			m.LongEMAGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LongEMAGas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipHistory(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthHistory
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupHistory
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthHistory
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthHistory        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowHistory          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupHistory = fmt.Errorf("proto: unexpected end of group")
+)
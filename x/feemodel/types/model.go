@@ -1,6 +1,10 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // DefaultModel returns model with default params.
 func DefaultModel() Model {
@@ -87,6 +91,36 @@ func (m Model) calculateNextGasPriceInDiscountRegion(shortEMA, longEMA int64) sd
 	return gasPriceWithMaxDiscount.Add(offset)
 }
 
+// SimulateGasPrices simulates the model forward for the number of blocks, starting from the EMA values. In each
+// simulated block the block gas is drawn at random from the samples of the gas observed in the previous blocks.
+// The minimum gas prices required in the consecutive simulated blocks are returned for each simulation.
+func (m Model) SimulateGasPrices(
+	shortEMA, longEMA int64,
+	blockGasSamples []int64,
+	blocks uint32,
+	simulations int,
+	rnd *rand.Rand,
+) [][]sdk.Dec {
+	prices := make([][]sdk.Dec, 0, simulations)
+	for i := 0; i < simulations; i++ {
+		simShortEMA := shortEMA
+		simLongEMA := longEMA
+		simPrices := make([]sdk.Dec, 0, blocks)
+		for j := uint32(0); j < blocks; j++ {
+			var blockGas int64
+			if len(blockGasSamples) > 0 {
+				blockGas = blockGasSamples[rnd.Intn(len(blockGasSamples))]
+			}
+			simShortEMA = CalculateEMA(simShortEMA, blockGas, m.params.ShortEmaBlockLength)
+			simLongEMA = CalculateEMA(simLongEMA, blockGas, m.params.LongEmaBlockLength)
+			simPrices = append(simPrices, m.CalculateNextGasPrice(simShortEMA, simLongEMA))
+		}
+		prices = append(prices, simPrices)
+	}
+
+	return prices
+}
+
 // CalculateEMA calculates next EMA value.
 func CalculateEMA(previousEMA, newValue int64, numOfBlocks uint32) int64 {
 	return int64((uint64(numOfBlocks-1)*uint64(previousEMA) + uint64(newValue)) / uint64(numOfBlocks))
@@ -99,6 +99,37 @@ func TestShapeInEscalationRegion(t *testing.T) {
 	}
 }
 
+func TestSimulateGasPrices(t *testing.T) {
+	// with a single sample all the simulations are the same and the price goes up under full blocks
+
+	prices := feeModel.SimulateGasPrices(100, 100, []int64{feeModel.params.MaxBlockGas}, 20, 5, rand.New(rand.NewSource(1)))
+	assert.Len(t, prices, 5)
+	for _, simPrices := range prices {
+		assert.Len(t, simPrices, 20)
+		assert.Equal(t, prices[0], simPrices)
+	}
+	lastPrice := gasPriceWithMaxDiscount
+	for _, price := range prices[0] {
+		assert.True(t, price.GTE(lastPrice))
+		lastPrice = price
+	}
+	assert.True(t, lastPrice.GT(gasPriceWithMaxDiscount))
+	assert.True(t, lastPrice.LT(feeModel.CalculateMaxGasPrice()))
+
+	// with different samples the simulations differ, but they are reproducible using the same seed
+
+	samples := []int64{0, feeModel.params.MaxBlockGas}
+	prices = feeModel.SimulateGasPrices(100, 500, samples, 20, 5, rand.New(rand.NewSource(1)))
+	assert.Equal(t, prices, feeModel.SimulateGasPrices(100, 500, samples, 20, 5, rand.New(rand.NewSource(1))))
+	differ := false
+	for _, simPrices := range prices[1:] {
+		if !assert.ObjectsAreEqual(prices[0], simPrices) {
+			differ = true
+		}
+	}
+	assert.True(t, differ)
+}
+
 func TestWithRandomModels(t *testing.T) {
 	t.Parallel()
 
@@ -1,19 +1,46 @@
 package types
 
 import (
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/pkg/errors"
+
+	"github.com/CoreumFoundation/coreum/v2/pkg/config/constant"
+	"github.com/CoreumFoundation/coreum/v2/x/deterministicgas"
 )
 
-// KeyModel represents the Model param key with which the ModelParams will be stored.
-var KeyModel = []byte("Model")
+// minGasPriceDenoms are the denoms of the minimum gas price used by the networks, they can't be the alternate
+// fee denoms.
+var minGasPriceDenoms = map[string]struct{}{
+	constant.DenomDev:  {},
+	constant.DenomTest: {},
+	constant.DenomMain: {},
+}
+
+var (
+	// KeyModel represents the Model param key with which the ModelParams will be stored.
+	KeyModel = []byte("Model")
+	// KeyFeeDenoms represents the FeeDenoms param key with which the alternate fee denoms will be stored.
+	KeyFeeDenoms = []byte("FeeDenoms")
+	// KeyMsgGasPriceMultipliers represents the MsgGasPriceMultipliers param key with which the gas price multipliers
+	// of the message types will be stored.
+	KeyMsgGasPriceMultipliers = []byte("MsgGasPriceMultipliers")
+	// KeyGasPriceHistoryLength represents the GasPriceHistoryLength param key with which the number of blocks kept in
+	// the gas price history will be stored.
+	KeyGasPriceHistoryLength = []byte("GasPriceHistoryLength")
+)
 
 // ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
 // of model's parameters.
 func (m *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyModel, &m.Model, validateModelParams),
+		paramtypes.NewParamSetPair(KeyFeeDenoms, &m.FeeDenoms, validateFeeDenoms),
+		paramtypes.NewParamSetPair(KeyMsgGasPriceMultipliers, &m.MsgGasPriceMultipliers, validateMsgGasPriceMultipliers),
+		paramtypes.NewParamSetPair(KeyGasPriceHistoryLength, &m.GasPriceHistoryLength, validateGasPriceHistoryLength),
 	}
 }
 
@@ -29,12 +56,74 @@ func DefaultParams() Params {
 			ShortEmaBlockLength:     50,
 			LongEmaBlockLength:      1000,
 		},
+		FeeDenoms:              []FeeDenom{},
+		MsgGasPriceMultipliers: []MsgGasPriceMultiplier{},
+		GasPriceHistoryLength:  1000,
 	}
 }
 
 // ValidateBasic validates parameters of the model.
 func (m Params) ValidateBasic() error {
-	return validateModelParams(m.Model)
+	if err := validateModelParams(m.Model); err != nil {
+		return err
+	}
+	if err := validateFeeDenoms(m.FeeDenoms); err != nil {
+		return err
+	}
+	if err := validateMsgGasPriceMultipliers(m.MsgGasPriceMultipliers); err != nil {
+		return err
+	}
+	return validateGasPriceHistoryLength(m.GasPriceHistoryLength)
+}
+
+// FeeDenomConversionRate returns the conversion rate of the alternate fee denom.
+func (m Params) FeeDenomConversionRate(denom string) (sdk.Dec, bool) {
+	for _, feeDenom := range m.FeeDenoms {
+		if feeDenom.Denom == denom {
+			return feeDenom.ConversionRate, true
+		}
+	}
+	return sdk.Dec{}, false
+}
+
+// MsgGasPriceMultiplier returns the gas price multiplier of the message type. If the multiplier is not set for the type,
+// one is returned.
+func (m Params) MsgGasPriceMultiplier(msgURL string) sdk.Dec {
+	for _, msgMultiplier := range m.MsgGasPriceMultipliers {
+		if msgMultiplier.MsgURL == msgURL {
+			return msgMultiplier.Multiplier
+		}
+	}
+	return sdk.OneDec()
+}
+
+// MsgsGasPriceMultiplier returns the highest gas price multiplier of the messages, so the discounted messages can't be
+// used to decrease the price of the other ones included in the same transaction. The authz.MsgExec is priced as
+// the messages it executes, so wrapping the message doesn't change its price. The multipliers are looked up by
+// the message URLs used by the deterministic gas config.
+func (m Params) MsgsGasPriceMultiplier(msgs []sdk.Msg) (sdk.Dec, error) {
+	var multiplier sdk.Dec
+	for _, msg := range msgs {
+		var msgMultiplier sdk.Dec
+		if execMsg, ok := msg.(*authz.MsgExec); ok {
+			execMsgs, err := execMsg.GetMessages()
+			if err != nil {
+				return sdk.Dec{}, errors.Wrap(err, "failed to get messages of MsgExec")
+			}
+			if msgMultiplier, err = m.MsgsGasPriceMultiplier(execMsgs); err != nil {
+				return sdk.Dec{}, err
+			}
+		} else {
+			msgMultiplier = m.MsgGasPriceMultiplier(string(deterministicgas.MsgToMsgURL(msg)))
+		}
+		if multiplier.IsNil() || msgMultiplier.GT(multiplier) {
+			multiplier = msgMultiplier
+		}
+	}
+	if multiplier.IsNil() {
+		return sdk.OneDec(), nil
+	}
+	return multiplier, nil
 }
 
 // ValidateBasic validates parameters of the model params.
@@ -93,3 +182,73 @@ func validateModelParams(i interface{}) error {
 
 	return nil
 }
+
+func validateFeeDenoms(i interface{}) error {
+	feeDenoms, ok := i.([]FeeDenom)
+	if !ok {
+		return errors.Errorf("invalid parameter type: %T", i)
+	}
+
+	denoms := map[string]struct{}{}
+	for _, feeDenom := range feeDenoms {
+		if err := sdk.ValidateDenom(feeDenom.Denom); err != nil {
+			return errors.Wrapf(err, "invalid fee denom %q", feeDenom.Denom)
+		}
+		if _, exists := denoms[feeDenom.Denom]; exists {
+			return errors.Errorf("duplicated fee denom %q", feeDenom.Denom)
+		}
+		if _, exists := minGasPriceDenoms[feeDenom.Denom]; exists {
+			return errors.Errorf("fee denom %q is the denom of the minimum gas price", feeDenom.Denom)
+		}
+		denoms[feeDenom.Denom] = struct{}{}
+
+		if feeDenom.ConversionRate.IsNil() {
+			return errors.Errorf("conversion rate of fee denom %q is not set", feeDenom.Denom)
+		}
+		if !feeDenom.ConversionRate.IsPositive() {
+			return errors.Errorf("conversion rate of fee denom %q must be positive", feeDenom.Denom)
+		}
+	}
+
+	return nil
+}
+
+func validateMsgGasPriceMultipliers(i interface{}) error {
+	msgMultipliers, ok := i.([]MsgGasPriceMultiplier)
+	if !ok {
+		return errors.Errorf("invalid parameter type: %T", i)
+	}
+
+	msgURLs := map[string]struct{}{}
+	for _, msgMultiplier := range msgMultipliers {
+		if !strings.HasPrefix(msgMultiplier.MsgURL, "/") {
+			return errors.Errorf("invalid msg url %q, it must start with '/'", msgMultiplier.MsgURL)
+		}
+		if _, exists := msgURLs[msgMultiplier.MsgURL]; exists {
+			return errors.Errorf("duplicated msg url %q", msgMultiplier.MsgURL)
+		}
+		msgURLs[msgMultiplier.MsgURL] = struct{}{}
+
+		if msgMultiplier.Multiplier.IsNil() {
+			return errors.Errorf("gas price multiplier of msg url %q is not set", msgMultiplier.MsgURL)
+		}
+		if !msgMultiplier.Multiplier.IsPositive() {
+			return errors.Errorf("gas price multiplier of msg url %q must be positive", msgMultiplier.MsgURL)
+		}
+	}
+
+	return nil
+}
+
+func validateGasPriceHistoryLength(i interface{}) error {
+	length, ok := i.(uint32)
+	if !ok {
+		return errors.Errorf("invalid parameter type: %T", i)
+	}
+
+	if length == 0 {
+		return errors.New("gas price history length must be greater than 0")
+	}
+
+	return nil
+}
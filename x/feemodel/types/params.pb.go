@@ -106,17 +106,119 @@ func (m *ModelParams) GetLongEmaBlockLength() uint32 {
 	return 0
 }
 
+// FeeDenom defines the denom, other than the one used by the fee model, accepted as the fee.
+type FeeDenom struct {
+	// denom is the denom accepted as the fee.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// conversion_rate is the amount of the fee model denom equivalent to one unit of the denom. It is used to convert the fee offered in the denom before comparing it with the minimum gas price.
+	ConversionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=conversion_rate,json=conversionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"conversion_rate" yaml:"conversion_rate"`
+}
+
+func (m *FeeDenom) Reset()         { *m = FeeDenom{} }
+func (m *FeeDenom) String() string { return proto.CompactTextString(m) }
+func (*FeeDenom) ProtoMessage()    {}
+func (*FeeDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3500559e6fedefd6, []int{1}
+}
+func (m *FeeDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDenom.Merge(m, src)
+}
+func (m *FeeDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeDenom proto.InternalMessageInfo
+
+func (m *FeeDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// MsgGasPriceMultiplier defines the multiplier applied to the minimum gas price required by the transaction containing the message of the type.
+type MsgGasPriceMultiplier struct {
+	// msg_url is the type URL of the message, e.g. "/cosmos.bank.v1beta1.MsgSend".
+	MsgURL string `protobuf:"bytes,1,opt,name=msg_url,json=msgUrl,proto3" json:"msg_url,omitempty" yaml:"msg_url"`
+	// multiplier is applied to the minimum gas price. Values lower than one discount the message type, higher ones make it more expensive.
+	Multiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=multiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"multiplier" yaml:"multiplier"`
+}
+
+func (m *MsgGasPriceMultiplier) Reset()         { *m = MsgGasPriceMultiplier{} }
+func (m *MsgGasPriceMultiplier) String() string { return proto.CompactTextString(m) }
+func (*MsgGasPriceMultiplier) ProtoMessage()    {}
+func (*MsgGasPriceMultiplier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3500559e6fedefd6, []int{2}
+}
+func (m *MsgGasPriceMultiplier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGasPriceMultiplier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGasPriceMultiplier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGasPriceMultiplier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGasPriceMultiplier.Merge(m, src)
+}
+func (m *MsgGasPriceMultiplier) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGasPriceMultiplier) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGasPriceMultiplier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGasPriceMultiplier proto.InternalMessageInfo
+
+func (m *MsgGasPriceMultiplier) GetMsgURL() string {
+	if m != nil {
+		return m.MsgURL
+	}
+	return ""
+}
+
 // Params store gov manageable feemodel parameters.
 type Params struct {
 	// model is a fee model params.
 	Model ModelParams `protobuf:"bytes,1,opt,name=model,proto3" json:"model" yaml:"model"`
+	// fee_denoms are the alternate denoms accepted as the fee together with their conversion rates.
+	FeeDenoms []FeeDenom `protobuf:"bytes,2,rep,name=fee_denoms,json=feeDenoms,proto3" json:"fee_denoms" yaml:"fee_denoms"`
+	// msg_gas_price_multipliers are the multipliers of the minimum gas price for the message types.
+	MsgGasPriceMultipliers []MsgGasPriceMultiplier `protobuf:"bytes,3,rep,name=msg_gas_price_multipliers,json=msgGasPriceMultipliers,proto3" json:"msg_gas_price_multipliers" yaml:"msg_gas_price_multipliers"`
+	// gas_price_history_length is the number of the latest blocks for which the gas price history is kept.
+	GasPriceHistoryLength uint32 `protobuf:"varint,4,opt,name=gas_price_history_length,json=gasPriceHistoryLength,proto3" json:"gas_price_history_length,omitempty" yaml:"gas_price_history_length"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3500559e6fedefd6, []int{1}
+	return fileDescriptor_3500559e6fedefd6, []int{3}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -152,48 +254,84 @@ func (m *Params) GetModel() ModelParams {
 	return ModelParams{}
 }
 
+func (m *Params) GetFeeDenoms() []FeeDenom {
+	if m != nil {
+		return m.FeeDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetMsgGasPriceMultipliers() []MsgGasPriceMultiplier {
+	if m != nil {
+		return m.MsgGasPriceMultipliers
+	}
+	return nil
+}
+
+func (m *Params) GetGasPriceHistoryLength() uint32 {
+	if m != nil {
+		return m.GasPriceHistoryLength
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ModelParams)(nil), "coreum.feemodel.v1.ModelParams")
+	proto.RegisterType((*FeeDenom)(nil), "coreum.feemodel.v1.FeeDenom")
+	proto.RegisterType((*MsgGasPriceMultiplier)(nil), "coreum.feemodel.v1.MsgGasPriceMultiplier")
 	proto.RegisterType((*Params)(nil), "coreum.feemodel.v1.Params")
 }
 
 func init() { proto.RegisterFile("coreum/feemodel/v1/params.proto", fileDescriptor_3500559e6fedefd6) }
 
 var fileDescriptor_3500559e6fedefd6 = []byte{
-	// 514 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x4f, 0x6f, 0xd3, 0x30,
-	0x1c, 0x6d, 0x18, 0x2d, 0xc2, 0xdd, 0x84, 0xf0, 0x3a, 0x08, 0x08, 0xe2, 0xe2, 0x03, 0xea, 0x85,
-	0x44, 0x1b, 0x12, 0x07, 0xc4, 0x29, 0xec, 0x8f, 0x04, 0x4c, 0x2a, 0x99, 0xe0, 0xc0, 0x25, 0x72,
-	0x53, 0x2f, 0x8d, 0x16, 0xc7, 0x51, 0xec, 0x54, 0xdd, 0x57, 0xe0, 0x80, 0xf8, 0x58, 0x3b, 0xee,
-	0x88, 0x38, 0x44, 0xa8, 0xfd, 0x06, 0x39, 0x71, 0x44, 0x76, 0xd2, 0x65, 0xa8, 0xdb, 0xa1, 0xa7,
-	0xe4, 0xf7, 0x7b, 0xcf, 0xef, 0xd9, 0xfe, 0xf9, 0x01, 0x14, 0xf0, 0x8c, 0xe6, 0xcc, 0x39, 0xa5,
-	0x94, 0xf1, 0x31, 0x8d, 0x9d, 0xe9, 0xae, 0x93, 0x92, 0x8c, 0x30, 0x61, 0xa7, 0x19, 0x97, 0x1c,
-	0xc2, 0x8a, 0x60, 0x2f, 0x09, 0xf6, 0x74, 0xf7, 0x69, 0x2f, 0xe4, 0x21, 0xd7, 0xb0, 0xa3, 0xfe,
-	0x2a, 0x26, 0xfe, 0xdb, 0x06, 0xdd, 0x63, 0x45, 0x19, 0xea, 0xf5, 0x70, 0x0a, 0x1e, 0x46, 0x49,
-	0x24, 0x23, 0x12, 0xfb, 0x21, 0x11, 0x7e, 0x9a, 0x45, 0x01, 0x35, 0x8d, 0xbe, 0x31, 0xb8, 0xef,
-	0x7e, 0xb8, 0x28, 0x50, 0xeb, 0x77, 0x81, 0x5e, 0x86, 0x91, 0x9c, 0xe4, 0x23, 0x3b, 0xe0, 0xcc,
-	0x09, 0xb8, 0x60, 0x5c, 0xd4, 0x9f, 0x57, 0x62, 0x7c, 0xe6, 0xc8, 0xf3, 0x94, 0x0a, 0x7b, 0x9f,
-	0x06, 0x65, 0x81, 0xcc, 0x73, 0xc2, 0xe2, 0xb7, 0x78, 0x45, 0x10, 0x7b, 0x0f, 0xea, 0xde, 0x11,
-	0x11, 0x43, 0xd5, 0x81, 0xdf, 0x0d, 0x60, 0x32, 0x32, 0x6b, 0x38, 0x3e, 0xcb, 0x63, 0x19, 0xa5,
-	0x71, 0x44, 0x33, 0xf3, 0x8e, 0xf6, 0xff, 0xbc, 0xb6, 0x3f, 0xaa, 0xfc, 0x6f, 0xd3, 0xc5, 0xde,
-	0x0e, 0x23, 0xb3, 0xe5, 0x16, 0x8e, 0xaf, 0xfa, 0x70, 0x02, 0x36, 0xd5, 0x9a, 0x71, 0x24, 0x02,
-	0x9e, 0x27, 0xd2, 0xdc, 0xd0, 0xfe, 0x07, 0x6b, 0xfb, 0x6f, 0x37, 0xfe, 0x4b, 0x2d, 0xec, 0x75,
-	0x19, 0x99, 0xed, 0xd7, 0x15, 0xfc, 0x61, 0x80, 0x27, 0x54, 0x04, 0x24, 0x26, 0x32, 0xe2, 0x89,
-	0x2f, 0x24, 0xc9, 0xa4, 0x7f, 0x9a, 0x91, 0x40, 0x95, 0xe6, 0x5d, 0xed, 0xeb, 0xad, 0xed, 0xdb,
-	0xaf, 0x7c, 0x6f, 0x15, 0xc6, 0xde, 0xe3, 0x06, 0x3b, 0x51, 0xd0, 0x61, 0x8d, 0xc0, 0x77, 0x60,
-	0x4b, 0x6d, 0x77, 0x14, 0xf3, 0xe0, 0x4c, 0x5d, 0x9a, 0xd9, 0xee, 0x1b, 0x83, 0x0d, 0xd7, 0x2c,
-	0x0b, 0xd4, 0x6b, 0x4e, 0x73, 0x05, 0x57, 0xc7, 0x71, 0x55, 0x79, 0x44, 0x04, 0xfc, 0x0a, 0x1e,
-	0x89, 0x09, 0xcf, 0xa4, 0x4f, 0x19, 0xa9, 0x49, 0x31, 0x4d, 0x42, 0x39, 0x31, 0x3b, 0x7d, 0x63,
-	0xb0, 0xe5, 0xbe, 0x28, 0x0b, 0xf4, 0xbc, 0x92, 0xb9, 0x99, 0x87, 0xbd, 0x6d, 0x0d, 0x1c, 0x30,
-	0xa2, 0x45, 0x3f, 0xe9, 0x2e, 0x3c, 0x01, 0x3b, 0x31, 0x4f, 0xc2, 0x55, 0xd9, 0x7b, 0x5a, 0xb6,
-	0x5f, 0x16, 0xe8, 0x59, 0x25, 0x7b, 0x23, 0x0d, 0x7b, 0x50, 0xf5, 0xff, 0x17, 0xc5, 0x5f, 0x40,
-	0xa7, 0x7e, 0xf4, 0x1f, 0x41, 0x5b, 0xc7, 0x44, 0x3f, 0xf4, 0xee, 0x1e, 0xb2, 0x57, 0xe3, 0x63,
-	0x5f, 0x0b, 0x89, 0xdb, 0x53, 0x13, 0x29, 0x0b, 0xb4, 0x59, 0xdf, 0x88, 0x82, 0xb0, 0x57, 0x69,
-	0xb8, 0xc3, 0x8b, 0xb9, 0x65, 0x5c, 0xce, 0x2d, 0xe3, 0xcf, 0xdc, 0x32, 0x7e, 0x2e, 0xac, 0xd6,
-	0xe5, 0xc2, 0x6a, 0xfd, 0x5a, 0x58, 0xad, 0x6f, 0x6f, 0xae, 0x0d, 0xf0, 0xbd, 0x76, 0x38, 0xe4,
-	0x79, 0x32, 0xd6, 0x53, 0x70, 0xea, 0x48, 0x4f, 0xf7, 0x9c, 0x59, 0x93, 0x6b, 0x3d, 0xd4, 0x51,
-	0x47, 0x47, 0xf5, 0xf5, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb5, 0xdd, 0xf7, 0xaf, 0xf7, 0x03,
-	0x00, 0x00,
+	// 735 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0xc7, 0xe3, 0xa6, 0x49, 0x7f, 0xdd, 0xf4, 0xcf, 0xaf, 0x6e, 0x53, 0x5c, 0x54, 0xe2, 0xb0,
+	0x48, 0x55, 0x38, 0x10, 0xab, 0x45, 0x70, 0x40, 0x9c, 0xdc, 0xbf, 0x82, 0x46, 0x2a, 0x5b, 0xb5,
+	0x07, 0x84, 0x64, 0x6d, 0x9d, 0x8d, 0x63, 0xd5, 0xeb, 0x0d, 0x5e, 0x27, 0x4a, 0x5f, 0x81, 0x03,
+	0xe2, 0x01, 0xb8, 0xf2, 0x04, 0xbc, 0x44, 0x8f, 0x3d, 0x22, 0x0e, 0x16, 0x4a, 0xdf, 0x20, 0x27,
+	0x8e, 0xc8, 0xeb, 0x4d, 0x1d, 0x88, 0x7b, 0xc8, 0x29, 0xf1, 0xcc, 0x77, 0x3f, 0x33, 0xbb, 0x33,
+	0x3b, 0x0b, 0x74, 0x9b, 0x05, 0xa4, 0x4b, 0x8d, 0x16, 0x21, 0x94, 0x35, 0x89, 0x67, 0xf4, 0xb6,
+	0x8d, 0x0e, 0x0e, 0x30, 0xe5, 0xf5, 0x4e, 0xc0, 0x42, 0xa6, 0xaa, 0x89, 0xa0, 0x3e, 0x12, 0xd4,
+	0x7b, 0xdb, 0x0f, 0xd7, 0x1c, 0xe6, 0x30, 0xe1, 0x36, 0xe2, 0x7f, 0x89, 0x12, 0xfe, 0x2e, 0x80,
+	0x52, 0x23, 0x96, 0x9c, 0x88, 0xf5, 0x6a, 0x0f, 0xac, 0xb8, 0xbe, 0x1b, 0xba, 0xd8, 0xb3, 0x1c,
+	0xcc, 0xad, 0x4e, 0xe0, 0xda, 0x44, 0x53, 0xaa, 0x4a, 0x6d, 0xde, 0x7c, 0x73, 0x1d, 0xe9, 0xb9,
+	0x9f, 0x91, 0xbe, 0xe5, 0xb8, 0x61, 0xbb, 0x7b, 0x51, 0xb7, 0x19, 0x35, 0x6c, 0xc6, 0x29, 0xe3,
+	0xf2, 0xe7, 0x19, 0x6f, 0x5e, 0x1a, 0xe1, 0x55, 0x87, 0xf0, 0xfa, 0x1e, 0xb1, 0x87, 0x91, 0xae,
+	0x5d, 0x61, 0xea, 0xbd, 0x82, 0x13, 0x40, 0x88, 0x96, 0xa5, 0xed, 0x10, 0xf3, 0x93, 0xd8, 0xa2,
+	0x7e, 0x52, 0x80, 0x46, 0x71, 0x3f, 0xd5, 0x58, 0xb4, 0xeb, 0x85, 0x6e, 0xc7, 0x73, 0x49, 0xa0,
+	0xcd, 0x88, 0xf8, 0xef, 0xa6, 0x8e, 0xaf, 0x27, 0xf1, 0xef, 0xe3, 0x42, 0x54, 0xa6, 0xb8, 0x3f,
+	0x4a, 0xa1, 0x71, 0x67, 0x57, 0xdb, 0x60, 0x21, 0x5e, 0xd3, 0x74, 0xb9, 0xcd, 0xba, 0x7e, 0xa8,
+	0xe5, 0x45, 0xfc, 0xfd, 0xa9, 0xe3, 0xaf, 0xa6, 0xf1, 0x47, 0x2c, 0x88, 0x4a, 0x14, 0xf7, 0xf7,
+	0xe4, 0x97, 0xfa, 0x59, 0x01, 0x1b, 0x84, 0xdb, 0xd8, 0xc3, 0xa1, 0xcb, 0x7c, 0x8b, 0x87, 0x38,
+	0x08, 0xad, 0x56, 0x80, 0xed, 0xf8, 0x53, 0x9b, 0x15, 0x71, 0xd1, 0xd4, 0x71, 0xab, 0x49, 0xdc,
+	0x7b, 0xc1, 0x10, 0x3d, 0x48, 0x7d, 0xa7, 0xb1, 0xeb, 0x40, 0x7a, 0xd4, 0xd7, 0x60, 0x31, 0x4e,
+	0xf7, 0xc2, 0x63, 0xf6, 0x65, 0x7c, 0x68, 0x5a, 0xa1, 0xaa, 0xd4, 0xf2, 0xa6, 0x36, 0x8c, 0xf4,
+	0xb5, 0x74, 0x37, 0x77, 0xee, 0x64, 0x3b, 0x66, 0xfc, 0x79, 0x88, 0xb9, 0x7a, 0x0e, 0xd6, 0x79,
+	0x9b, 0x05, 0xa1, 0x45, 0x28, 0x96, 0x22, 0x8f, 0xf8, 0x4e, 0xd8, 0xd6, 0x8a, 0x55, 0xa5, 0xb6,
+	0x68, 0x3e, 0x1e, 0x46, 0xfa, 0xa3, 0x04, 0x93, 0xad, 0x83, 0x68, 0x55, 0x38, 0xf6, 0x29, 0x16,
+	0xd0, 0x63, 0x61, 0x55, 0x4f, 0x41, 0xd9, 0x63, 0xbe, 0x33, 0x89, 0x9d, 0x13, 0xd8, 0xea, 0x30,
+	0xd2, 0x37, 0x13, 0x6c, 0xa6, 0x0c, 0x22, 0x35, 0xb6, 0xff, 0x0d, 0x85, 0xdf, 0x14, 0xf0, 0xdf,
+	0x01, 0x21, 0x7b, 0xc4, 0x67, 0x54, 0xdd, 0x02, 0x85, 0x66, 0xfc, 0x47, 0xf6, 0xfa, 0xff, 0xc3,
+	0x48, 0x5f, 0x48, 0x88, 0xc2, 0x0c, 0x51, 0xe2, 0x56, 0x3f, 0x82, 0x65, 0x9b, 0xf9, 0x3d, 0x12,
+	0xf0, 0xf8, 0x58, 0x03, 0x1c, 0x12, 0xd9, 0x9d, 0x47, 0x53, 0x57, 0x69, 0x3d, 0xe1, 0xff, 0x83,
+	0x83, 0x68, 0x29, 0xb5, 0xa0, 0xd8, 0xf0, 0x5d, 0x01, 0xe5, 0x06, 0x77, 0x32, 0xfa, 0xf4, 0x05,
+	0x98, 0xa3, 0xdc, 0xb1, 0xba, 0x81, 0x27, 0xd3, 0xde, 0x1c, 0x44, 0x7a, 0xb1, 0xc1, 0x9d, 0x33,
+	0x74, 0x3c, 0x8c, 0xf4, 0x25, 0x59, 0xb0, 0x44, 0x02, 0x51, 0x91, 0x72, 0xe7, 0x2c, 0xf0, 0x54,
+	0x1b, 0x80, 0x89, 0xcb, 0xb5, 0x3b, 0x75, 0xfa, 0x2b, 0x92, 0x3e, 0x76, 0x9d, 0xc6, 0xb0, 0xf0,
+	0x6b, 0x1e, 0x14, 0xe5, 0x4c, 0x79, 0x0b, 0x0a, 0x62, 0x0a, 0x89, 0x24, 0x4b, 0x3b, 0x7a, 0x7d,
+	0x72, 0x3a, 0xd5, 0xc7, 0x66, 0x90, 0xb9, 0x16, 0xe7, 0x92, 0x16, 0x40, 0x68, 0x20, 0x4a, 0x18,
+	0xea, 0x39, 0x00, 0x2d, 0x42, 0x2c, 0x51, 0x0d, 0xae, 0xcd, 0x54, 0xf3, 0xb5, 0xd2, 0xce, 0x66,
+	0x16, 0x71, 0x54, 0x5a, 0x73, 0x43, 0xe2, 0x64, 0xc2, 0xe9, 0x6a, 0x88, 0xe6, 0x5b, 0x52, 0xc4,
+	0xc5, 0x4d, 0x8c, 0x4f, 0x2a, 0x6b, 0x50, 0x70, 0x2d, 0x2f, 0xe2, 0x3c, 0xcd, 0xcc, 0x3c, 0xab,
+	0x34, 0x66, 0x4d, 0x06, 0xad, 0xa6, 0x35, 0xc8, 0x24, 0x43, 0xb4, 0x4e, 0xb3, 0x00, 0x5c, 0xfd,
+	0x00, 0xb4, 0x74, 0x45, 0xdb, 0xe5, 0x21, 0x0b, 0xae, 0x46, 0x6d, 0x3f, 0x2b, 0xda, 0xfe, 0x49,
+	0x3a, 0xe2, 0xee, 0x53, 0x42, 0x54, 0x76, 0x24, 0xfb, 0x28, 0x71, 0x24, 0xcd, 0x6f, 0x9e, 0x5c,
+	0x0f, 0x2a, 0xca, 0xcd, 0xa0, 0xa2, 0xfc, 0x1a, 0x54, 0x94, 0x2f, 0xb7, 0x95, 0xdc, 0xcd, 0x6d,
+	0x25, 0xf7, 0xe3, 0xb6, 0x92, 0x7b, 0xff, 0x72, 0xac, 0x03, 0x76, 0xc5, 0x76, 0x0f, 0x58, 0xd7,
+	0x6f, 0x8a, 0x59, 0x61, 0xc8, 0x87, 0xa7, 0xb7, 0x63, 0xf4, 0xd3, 0xd7, 0x47, 0x74, 0xc5, 0x45,
+	0x51, 0x3c, 0x28, 0xcf, 0xff, 0x0c, 0x00, 0x55, 0x86, 0xe5, 0xe6, 0x9d, 0x06, 0x00, 0x00,
 }
 
 func (m *ModelParams) Marshal() (dAtA []byte, err error) {
@@ -274,6 +412,86 @@ func (m *ModelParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeeDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ConversionRate.Size()
+		i -= size
+		if _, err := m.ConversionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGasPriceMultiplier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGasPriceMultiplier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGasPriceMultiplier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Multiplier.Size()
+		i -= size
+		if _, err := m.Multiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MsgURL) > 0 {
+		i -= len(m.MsgURL)
+		copy(dAtA[i:], m.MsgURL)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.MsgURL)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -294,6 +512,39 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.GasPriceHistoryLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.GasPriceHistoryLength))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.MsgGasPriceMultipliers) > 0 {
+		for iNdEx := len(m.MsgGasPriceMultipliers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgGasPriceMultipliers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.FeeDenoms) > 0 {
+		for iNdEx := len(m.FeeDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Model.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -344,6 +595,36 @@ func (m *ModelParams) Size() (n int) {
 	return n
 }
 
+func (m *FeeDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.ConversionRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *MsgGasPriceMultiplier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgURL)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.Multiplier.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
 func (m *Params) Size() (n int) {
 	if m == nil {
 		return 0
@@ -352,6 +633,21 @@ func (m *Params) Size() (n int) {
 	_ = l
 	l = m.Model.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if len(m.FeeDenoms) > 0 {
+		for _, e := range m.FeeDenoms {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.MsgGasPriceMultipliers) > 0 {
+		for _, e := range m.MsgGasPriceMultipliers {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.GasPriceHistoryLength != 0 {
+		n += 1 + sovParams(uint64(m.GasPriceHistoryLength))
+	}
 	return n
 }
 
@@ -604,6 +900,238 @@ func (m *ModelParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *FeeDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConversionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGasPriceMultiplier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGasPriceMultiplier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGasPriceMultiplier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Multiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -666,6 +1194,93 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenoms = append(m.FeeDenoms, FeeDenom{})
+			if err := m.FeeDenoms[len(m.FeeDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgGasPriceMultipliers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgGasPriceMultipliers = append(m.MsgGasPriceMultipliers, MsgGasPriceMultiplier{})
+			if err := m.MsgGasPriceMultipliers[len(m.MsgGasPriceMultipliers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPriceHistoryLength", wireType)
+			}
+			m.GasPriceHistoryLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPriceHistoryLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
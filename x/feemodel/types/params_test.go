@@ -4,7 +4,14 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v2/pkg/config/constant"
+	"github.com/CoreumFoundation/coreum/v2/x/deterministicgas"
 )
 
 var params = Params{
@@ -17,6 +24,19 @@ var params = Params{
 		ShortEmaBlockLength:     10,
 		LongEmaBlockLength:      1000,
 	},
+	FeeDenoms: []FeeDenom{
+		{
+			Denom:          "denom",
+			ConversionRate: sdk.MustNewDecFromStr("0.5"),
+		},
+	},
+	MsgGasPriceMultipliers: []MsgGasPriceMultiplier{
+		{
+			MsgURL:     "/cosmos.gov.v1beta1.MsgVote",
+			Multiplier: sdk.MustNewDecFromStr("0.5"),
+		},
+	},
+	GasPriceHistoryLength: 100,
 }
 
 func TestParamsValidation(t *testing.T) {
@@ -53,4 +73,121 @@ func TestParamsValidation(t *testing.T) {
 	testParams = params
 	testParams.Model.EscalationStartFraction = sdk.OneDec()
 	assert.Error(t, testParams.ValidateBasic())
+
+	testParams = params
+	testParams.FeeDenoms = []FeeDenom{{Denom: "1invalid", ConversionRate: sdk.OneDec()}}
+	assert.Error(t, testParams.ValidateBasic())
+
+	testParams = params
+	testParams.FeeDenoms = []FeeDenom{{Denom: "denom"}}
+	assert.Error(t, testParams.ValidateBasic())
+
+	testParams = params
+	testParams.FeeDenoms = []FeeDenom{{Denom: "denom", ConversionRate: sdk.ZeroDec()}}
+	assert.Error(t, testParams.ValidateBasic())
+
+	testParams = params
+	testParams.FeeDenoms = []FeeDenom{
+		{Denom: "denom", ConversionRate: sdk.OneDec()},
+		{Denom: "denom", ConversionRate: sdk.OneDec()},
+	}
+	assert.Error(t, testParams.ValidateBasic())
+
+	testParams = params
+	testParams.FeeDenoms = []FeeDenom{{Denom: constant.DenomMain, ConversionRate: sdk.OneDec()}}
+	assert.Error(t, testParams.ValidateBasic())
+
+	testParams = params
+	testParams.GasPriceHistoryLength = 0
+	assert.Error(t, testParams.ValidateBasic())
+}
+
+func TestMsgGasPriceMultipliersValidation(t *testing.T) {
+	testParams := params
+	testParams.MsgGasPriceMultipliers = []MsgGasPriceMultiplier{{MsgURL: "cosmos.gov.v1beta1.MsgVote", Multiplier: sdk.OneDec()}}
+	assert.Error(t, testParams.ValidateBasic())
+
+	testParams = params
+	testParams.MsgGasPriceMultipliers = []MsgGasPriceMultiplier{{MsgURL: "/cosmos.gov.v1beta1.MsgVote"}}
+	assert.Error(t, testParams.ValidateBasic())
+
+	testParams = params
+	testParams.MsgGasPriceMultipliers = []MsgGasPriceMultiplier{
+		{MsgURL: "/cosmos.gov.v1beta1.MsgVote", Multiplier: sdk.ZeroDec()},
+	}
+	assert.Error(t, testParams.ValidateBasic())
+
+	testParams = params
+	testParams.MsgGasPriceMultipliers = []MsgGasPriceMultiplier{
+		{MsgURL: "/cosmos.gov.v1beta1.MsgVote", Multiplier: sdk.OneDec()},
+		{MsgURL: "/cosmos.gov.v1beta1.MsgVote", Multiplier: sdk.OneDec()},
+	}
+	assert.Error(t, testParams.ValidateBasic())
+}
+
+func TestMsgGasPriceMultiplier(t *testing.T) {
+	assert.Equal(t, sdk.MustNewDecFromStr("0.5"), params.MsgGasPriceMultiplier("/cosmos.gov.v1beta1.MsgVote"))
+	assert.Equal(t, sdk.OneDec(), params.MsgGasPriceMultiplier("/cosmos.bank.v1beta1.MsgSend"))
+}
+
+func TestMsgsGasPriceMultiplier(t *testing.T) {
+	testParams := params
+	testParams.MsgGasPriceMultipliers = append([]MsgGasPriceMultiplier{
+		{MsgURL: "/cosmos.bank.v1beta1.MsgSend", Multiplier: sdk.NewDec(2)},
+	}, params.MsgGasPriceMultipliers...)
+
+	vote := &govtypes.MsgVote{}
+	send := &banktypes.MsgSend{}
+
+	multiplier, err := testParams.MsgsGasPriceMultiplier(nil)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.OneDec(), multiplier)
+
+	multiplier, err = testParams.MsgsGasPriceMultiplier([]sdk.Msg{vote})
+	require.NoError(t, err)
+	assert.Equal(t, sdk.MustNewDecFromStr("0.5"), multiplier)
+
+	// the highest multiplier is applied
+	multiplier, err = testParams.MsgsGasPriceMultiplier([]sdk.Msg{vote, send})
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewDec(2), multiplier)
+
+	// the messages executed by MsgExec are priced the same way
+	grantee := sdk.AccAddress("grantee")
+	execVote := authz.NewMsgExec(grantee, []sdk.Msg{vote})
+	multiplier, err = testParams.MsgsGasPriceMultiplier([]sdk.Msg{&execVote})
+	require.NoError(t, err)
+	assert.Equal(t, sdk.MustNewDecFromStr("0.5"), multiplier)
+
+	execSend := authz.NewMsgExec(grantee, []sdk.Msg{vote, send})
+	nestedExec := authz.NewMsgExec(grantee, []sdk.Msg{&execSend})
+	multiplier, err = testParams.MsgsGasPriceMultiplier([]sdk.Msg{vote, &nestedExec})
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewDec(2), multiplier)
+}
+
+func TestMsgsGasPriceMultiplierByDeterministicGasURL(t *testing.T) {
+	testParams := params
+	testParams.MsgGasPriceMultipliers = []MsgGasPriceMultiplier{
+		{MsgURL: string(deterministicgas.MsgToMsgURL(&banktypes.MsgSend{})), Multiplier: sdk.NewDec(3)},
+	}
+	require.NoError(t, testParams.ValidateBasic())
+
+	multiplier, err := testParams.MsgsGasPriceMultiplier([]sdk.Msg{&banktypes.MsgSend{}})
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewDec(3), multiplier)
+
+	execSend := authz.NewMsgExec(sdk.AccAddress("grantee"), []sdk.Msg{&banktypes.MsgSend{}})
+	multiplier, err = testParams.MsgsGasPriceMultiplier([]sdk.Msg{&execSend})
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewDec(3), multiplier)
+}
+
+func TestFeeDenomConversionRate(t *testing.T) {
+	rate, ok := params.FeeDenomConversionRate("denom")
+	assert.True(t, ok)
+	assert.Equal(t, sdk.MustNewDecFromStr("0.5"), rate)
+
+	_, ok = params.FeeDenomConversionRate("unknown")
+	assert.False(t, ok)
 }
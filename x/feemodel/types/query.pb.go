@@ -6,7 +6,9 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -113,6 +115,279 @@ func (m *QueryMinGasPriceResponse) GetMinGasPrice() types.DecCoin {
 	return types.DecCoin{}
 }
 
+// QueryGasPriceForecastRequest is the request type for the Query/GasPriceForecast RPC method.
+type QueryGasPriceForecastRequest struct {
+	// after_blocks is the number of blocks after which the minimum gas price is estimated.
+	AfterBlocks uint32 `protobuf:"varint,1,opt,name=after_blocks,json=afterBlocks,proto3" json:"after_blocks,omitempty"`
+	// price is the optional gas price, in the denom of the minimum gas price, for which the acceptance probability
+	// is computed.
+	Price string `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+	// within_blocks is the number of blocks in which the price must be accepted.
+	WithinBlocks uint32 `protobuf:"varint,3,opt,name=within_blocks,json=withinBlocks,proto3" json:"within_blocks,omitempty"`
+}
+
+func (m *QueryGasPriceForecastRequest) Reset()         { *m = QueryGasPriceForecastRequest{} }
+func (m *QueryGasPriceForecastRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGasPriceForecastRequest) ProtoMessage()    {}
+func (*QueryGasPriceForecastRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d2036651e57006ae, []int{2}
+}
+func (m *QueryGasPriceForecastRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGasPriceForecastRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGasPriceForecastRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGasPriceForecastRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGasPriceForecastRequest.Merge(m, src)
+}
+func (m *QueryGasPriceForecastRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGasPriceForecastRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGasPriceForecastRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGasPriceForecastRequest proto.InternalMessageInfo
+
+func (m *QueryGasPriceForecastRequest) GetAfterBlocks() uint32 {
+	if m != nil {
+		return m.AfterBlocks
+	}
+	return 0
+}
+
+func (m *QueryGasPriceForecastRequest) GetPrice() string {
+	if m != nil {
+		return m.Price
+	}
+	return ""
+}
+
+func (m *QueryGasPriceForecastRequest) GetWithinBlocks() uint32 {
+	if m != nil {
+		return m.WithinBlocks
+	}
+	return 0
+}
+
+// GasPriceForecastPercentile defines the estimate of the minimum gas price for the percentile of the simulations.
+type GasPriceForecastPercentile struct {
+	// percentile is the percentage of the simulations in which the minimum gas price is not higher than the estimate.
+	Percentile uint32 `protobuf:"varint,1,opt,name=percentile,proto3" json:"percentile,omitempty"`
+	// min_gas_price is the estimated minimum gas price.
+	MinGasPrice types.DecCoin `protobuf:"bytes,2,opt,name=min_gas_price,json=minGasPrice,proto3" json:"min_gas_price"`
+}
+
+func (m *GasPriceForecastPercentile) Reset()         { *m = GasPriceForecastPercentile{} }
+func (m *GasPriceForecastPercentile) String() string { return proto.CompactTextString(m) }
+func (*GasPriceForecastPercentile) ProtoMessage()    {}
+func (*GasPriceForecastPercentile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d2036651e57006ae, []int{3}
+}
+func (m *GasPriceForecastPercentile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasPriceForecastPercentile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasPriceForecastPercentile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasPriceForecastPercentile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasPriceForecastPercentile.Merge(m, src)
+}
+func (m *GasPriceForecastPercentile) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasPriceForecastPercentile) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasPriceForecastPercentile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasPriceForecastPercentile proto.InternalMessageInfo
+
+func (m *GasPriceForecastPercentile) GetPercentile() uint32 {
+	if m != nil {
+		return m.Percentile
+	}
+	return 0
+}
+
+func (m *GasPriceForecastPercentile) GetMinGasPrice() types.DecCoin {
+	if m != nil {
+		return m.MinGasPrice
+	}
+	return types.DecCoin{}
+}
+
+// QueryGasPriceForecastResponse is the response type for the Query/GasPriceForecast RPC method.
+type QueryGasPriceForecastResponse struct {
+	// percentiles are the estimates of the minimum gas price required after the blocks.
+	Percentiles []GasPriceForecastPercentile `protobuf:"bytes,1,rep,name=percentiles,proto3" json:"percentiles"`
+	// acceptance_probability is the probability that the price is accepted within the blocks. It is set only if
+	// the price is provided.
+	AcceptanceProbability *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=acceptance_probability,json=acceptanceProbability,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"acceptance_probability,omitempty"`
+	// acceptance_percentiles are the estimates of the gas price accepted within the blocks with the probability
+	// of the percentile.
+	AcceptancePercentiles []GasPriceForecastPercentile `protobuf:"bytes,3,rep,name=acceptance_percentiles,json=acceptancePercentiles,proto3" json:"acceptance_percentiles"`
+}
+
+func (m *QueryGasPriceForecastResponse) Reset()         { *m = QueryGasPriceForecastResponse{} }
+func (m *QueryGasPriceForecastResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGasPriceForecastResponse) ProtoMessage()    {}
+func (*QueryGasPriceForecastResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d2036651e57006ae, []int{4}
+}
+func (m *QueryGasPriceForecastResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGasPriceForecastResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGasPriceForecastResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGasPriceForecastResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGasPriceForecastResponse.Merge(m, src)
+}
+func (m *QueryGasPriceForecastResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGasPriceForecastResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGasPriceForecastResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGasPriceForecastResponse proto.InternalMessageInfo
+
+func (m *QueryGasPriceForecastResponse) GetPercentiles() []GasPriceForecastPercentile {
+	if m != nil {
+		return m.Percentiles
+	}
+	return nil
+}
+
+func (m *QueryGasPriceForecastResponse) GetAcceptancePercentiles() []GasPriceForecastPercentile {
+	if m != nil {
+		return m.AcceptancePercentiles
+	}
+	return nil
+}
+
+// QueryMsgMinGasPriceRequest is the request type for the Query/MsgMinGasPrice RPC method.
+type QueryMsgMinGasPriceRequest struct {
+	// msg_url is the type URL of the message, e.g. "/cosmos.bank.v1beta1.MsgSend".
+	MsgURL string `protobuf:"bytes,1,opt,name=msg_url,json=msgUrl,proto3" json:"msg_url,omitempty"`
+}
+
+func (m *QueryMsgMinGasPriceRequest) Reset()         { *m = QueryMsgMinGasPriceRequest{} }
+func (m *QueryMsgMinGasPriceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMsgMinGasPriceRequest) ProtoMessage()    {}
+func (*QueryMsgMinGasPriceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d2036651e57006ae, []int{5}
+}
+func (m *QueryMsgMinGasPriceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMsgMinGasPriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMsgMinGasPriceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMsgMinGasPriceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMsgMinGasPriceRequest.Merge(m, src)
+}
+func (m *QueryMsgMinGasPriceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMsgMinGasPriceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMsgMinGasPriceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMsgMinGasPriceRequest proto.InternalMessageInfo
+
+func (m *QueryMsgMinGasPriceRequest) GetMsgURL() string {
+	if m != nil {
+		return m.MsgURL
+	}
+	return ""
+}
+
+// QueryMsgMinGasPriceResponse is the response type for the Query/MsgMinGasPrice RPC method.
+type QueryMsgMinGasPriceResponse struct {
+	// min_gas_price is the current minimum gas price required by the network for the message type.
+	MinGasPrice types.DecCoin `protobuf:"bytes,1,opt,name=min_gas_price,json=minGasPrice,proto3" json:"min_gas_price"`
+	// multiplier is the gas price multiplier of the message type.
+	Multiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=multiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"multiplier"`
+}
+
+func (m *QueryMsgMinGasPriceResponse) Reset()         { *m = QueryMsgMinGasPriceResponse{} }
+func (m *QueryMsgMinGasPriceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMsgMinGasPriceResponse) ProtoMessage()    {}
+func (*QueryMsgMinGasPriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d2036651e57006ae, []int{6}
+}
+func (m *QueryMsgMinGasPriceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMsgMinGasPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMsgMinGasPriceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMsgMinGasPriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMsgMinGasPriceResponse.Merge(m, src)
+}
+func (m *QueryMsgMinGasPriceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMsgMinGasPriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMsgMinGasPriceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMsgMinGasPriceResponse proto.InternalMessageInfo
+
+func (m *QueryMsgMinGasPriceResponse) GetMinGasPrice() types.DecCoin {
+	if m != nil {
+		return m.MinGasPrice
+	}
+	return types.DecCoin{}
+}
+
 type QueryRecommendedGasPriceRequest struct {
 	AfterBlocks uint32 `protobuf:"varint,1,opt,name=after_blocks,json=afterBlocks,proto3" json:"after_blocks,omitempty"`
 }
@@ -121,7 +396,7 @@ func (m *QueryRecommendedGasPriceRequest) Reset()         { *m = QueryRecommende
 func (m *QueryRecommendedGasPriceRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRecommendedGasPriceRequest) ProtoMessage()    {}
 func (*QueryRecommendedGasPriceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d2036651e57006ae, []int{2}
+	return fileDescriptor_d2036651e57006ae, []int{7}
 }
 func (m *QueryRecommendedGasPriceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -167,7 +442,7 @@ func (m *QueryRecommendedGasPriceResponse) Reset()         { *m = QueryRecommend
 func (m *QueryRecommendedGasPriceResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRecommendedGasPriceResponse) ProtoMessage()    {}
 func (*QueryRecommendedGasPriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d2036651e57006ae, []int{3}
+	return fileDescriptor_d2036651e57006ae, []int{8}
 }
 func (m *QueryRecommendedGasPriceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -217,6 +492,107 @@ func (m *QueryRecommendedGasPriceResponse) GetHigh() types.DecCoin {
 	return types.DecCoin{}
 }
 
+// QueryGasPriceHistoryRequest is the request type for the Query/GasPriceHistory RPC method.
+type QueryGasPriceHistoryRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGasPriceHistoryRequest) Reset()         { *m = QueryGasPriceHistoryRequest{} }
+func (m *QueryGasPriceHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGasPriceHistoryRequest) ProtoMessage()    {}
+func (*QueryGasPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d2036651e57006ae, []int{9}
+}
+func (m *QueryGasPriceHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGasPriceHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGasPriceHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGasPriceHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGasPriceHistoryRequest.Merge(m, src)
+}
+func (m *QueryGasPriceHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGasPriceHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGasPriceHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGasPriceHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryGasPriceHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryGasPriceHistoryResponse is the response type for the Query/GasPriceHistory RPC method.
+type QueryGasPriceHistoryResponse struct {
+	// entries are the history entries ordered by the block height.
+	Entries []GasPriceHistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGasPriceHistoryResponse) Reset()         { *m = QueryGasPriceHistoryResponse{} }
+func (m *QueryGasPriceHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGasPriceHistoryResponse) ProtoMessage()    {}
+func (*QueryGasPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d2036651e57006ae, []int{10}
+}
+func (m *QueryGasPriceHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGasPriceHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGasPriceHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGasPriceHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGasPriceHistoryResponse.Merge(m, src)
+}
+func (m *QueryGasPriceHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGasPriceHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGasPriceHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGasPriceHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryGasPriceHistoryResponse) GetEntries() []GasPriceHistoryEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *QueryGasPriceHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryParamsRequest defines the request type for querying x/feemodel parameters.
 type QueryParamsRequest struct {
 }
@@ -225,7 +601,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d2036651e57006ae, []int{4}
+	return fileDescriptor_d2036651e57006ae, []int{11}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -263,7 +639,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d2036651e57006ae, []int{5}
+	return fileDescriptor_d2036651e57006ae, []int{12}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -302,8 +678,15 @@ func (m *QueryParamsResponse) GetParams() Params {
 func init() {
 	proto.RegisterType((*QueryMinGasPriceRequest)(nil), "coreum.feemodel.v1.QueryMinGasPriceRequest")
 	proto.RegisterType((*QueryMinGasPriceResponse)(nil), "coreum.feemodel.v1.QueryMinGasPriceResponse")
+	proto.RegisterType((*QueryGasPriceForecastRequest)(nil), "coreum.feemodel.v1.QueryGasPriceForecastRequest")
+	proto.RegisterType((*GasPriceForecastPercentile)(nil), "coreum.feemodel.v1.GasPriceForecastPercentile")
+	proto.RegisterType((*QueryGasPriceForecastResponse)(nil), "coreum.feemodel.v1.QueryGasPriceForecastResponse")
+	proto.RegisterType((*QueryMsgMinGasPriceRequest)(nil), "coreum.feemodel.v1.QueryMsgMinGasPriceRequest")
+	proto.RegisterType((*QueryMsgMinGasPriceResponse)(nil), "coreum.feemodel.v1.QueryMsgMinGasPriceResponse")
 	proto.RegisterType((*QueryRecommendedGasPriceRequest)(nil), "coreum.feemodel.v1.QueryRecommendedGasPriceRequest")
 	proto.RegisterType((*QueryRecommendedGasPriceResponse)(nil), "coreum.feemodel.v1.QueryRecommendedGasPriceResponse")
+	proto.RegisterType((*QueryGasPriceHistoryRequest)(nil), "coreum.feemodel.v1.QueryGasPriceHistoryRequest")
+	proto.RegisterType((*QueryGasPriceHistoryResponse)(nil), "coreum.feemodel.v1.QueryGasPriceHistoryResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "coreum.feemodel.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "coreum.feemodel.v1.QueryParamsResponse")
 }
@@ -311,40 +694,67 @@ func init() {
 func init() { proto.RegisterFile("coreum/feemodel/v1/query.proto", fileDescriptor_d2036651e57006ae) }
 
 var fileDescriptor_d2036651e57006ae = []byte{
-	// 517 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x86, 0xe3, 0xa6, 0xe4, 0xb0, 0xa1, 0x97, 0x6d, 0x25, 0x82, 0x15, 0x39, 0xad, 0x91, 0x80,
-	0xaa, 0xc8, 0xab, 0xa4, 0x55, 0xc5, 0x39, 0xad, 0xca, 0x09, 0x11, 0x72, 0xe4, 0x12, 0xad, 0xed,
-	0xa9, 0x63, 0x91, 0xdd, 0x71, 0xbd, 0x4e, 0xa0, 0x07, 0x2e, 0x3c, 0x01, 0x52, 0x1f, 0x85, 0x77,
-	0x40, 0x3d, 0x56, 0x70, 0xe1, 0x84, 0x50, 0xc2, 0x83, 0x20, 0xaf, 0x37, 0x4a, 0x43, 0x63, 0x91,
-	0xde, 0xa2, 0xfd, 0xe7, 0xff, 0xe7, 0xdb, 0xcc, 0x78, 0x89, 0x13, 0x60, 0x0a, 0x63, 0xc1, 0xce,
-	0x01, 0x04, 0x86, 0x30, 0x62, 0x93, 0x36, 0xbb, 0x18, 0x43, 0x7a, 0xe9, 0x25, 0x29, 0x66, 0x48,
-	0x69, 0xa1, 0x7b, 0x73, 0xdd, 0x9b, 0xb4, 0xed, 0x9d, 0x08, 0x23, 0xd4, 0x32, 0xcb, 0x7f, 0x15,
-	0x95, 0x76, 0x33, 0x42, 0x8c, 0x46, 0xc0, 0x78, 0x12, 0x33, 0x2e, 0x25, 0x66, 0x3c, 0x8b, 0x51,
-	0x2a, 0xa3, 0x3a, 0x01, 0x2a, 0x81, 0x8a, 0xf9, 0x5c, 0x01, 0x9b, 0xb4, 0x7d, 0xc8, 0x78, 0x9b,
-	0x05, 0x18, 0x4b, 0xa3, 0xb7, 0x56, 0x70, 0x24, 0x3c, 0xe5, 0xc2, 0x04, 0xb8, 0x8f, 0xc9, 0xa3,
-	0xb7, 0x39, 0xd7, 0xeb, 0x58, 0xbe, 0xe2, 0xaa, 0x97, 0xc6, 0x01, 0xf4, 0xe1, 0x62, 0x0c, 0x2a,
-	0x73, 0x7d, 0xd2, 0xb8, 0x2b, 0xa9, 0x04, 0xa5, 0x02, 0x7a, 0x46, 0xb6, 0x44, 0x2c, 0x07, 0x11,
-	0x57, 0x83, 0x24, 0x17, 0x1a, 0xd6, 0xae, 0xf5, 0xbc, 0xde, 0x69, 0x7a, 0x05, 0x8f, 0x97, 0xf3,
-	0x78, 0x86, 0xc7, 0x3b, 0x85, 0xe0, 0x04, 0x63, 0xd9, 0xdd, 0xbc, 0xfe, 0xd5, 0xaa, 0xf4, 0xeb,
-	0x62, 0x91, 0xe7, 0x9e, 0x92, 0x96, 0xee, 0xd1, 0x87, 0x00, 0x85, 0x00, 0x19, 0x42, 0xf8, 0x0f,
-	0x06, 0xdd, 0x23, 0x0f, 0xf9, 0x79, 0x06, 0xe9, 0xc0, 0x1f, 0x61, 0xf0, 0x5e, 0xe9, 0x4e, 0x5b,
-	0xfd, 0xba, 0x3e, 0xeb, 0xea, 0x23, 0xf7, 0x9b, 0x45, 0x76, 0xcb, 0x63, 0x0c, 0xf2, 0x11, 0xa9,
-	0x8e, 0xf0, 0xc3, 0x3d, 0x40, 0xf3, 0xf2, 0xdc, 0x25, 0x20, 0x6c, 0x6c, 0xac, 0xef, 0x12, 0x10,
-	0xd2, 0x63, 0xb2, 0x39, 0x8c, 0xa3, 0x61, 0xa3, 0xba, 0xb6, 0x4d, 0xd7, 0xbb, 0x3b, 0x84, 0xea,
-	0x7b, 0xf4, 0xf4, 0x88, 0xe6, 0x83, 0x78, 0x43, 0xb6, 0x97, 0x4e, 0xcd, 0x85, 0x5e, 0x92, 0x5a,
-	0x31, 0x4a, 0x73, 0x27, 0xdb, 0xbb, 0xbb, 0x54, 0x5e, 0xe1, 0x31, 0x4d, 0x4c, 0x7d, 0xe7, 0x7b,
-	0x95, 0x3c, 0xd0, 0x89, 0xf4, 0xca, 0x22, 0xf5, 0x5b, 0xf3, 0xa5, 0x07, 0xab, 0x32, 0x4a, 0x16,
-	0xc4, 0x7e, 0xb1, 0x5e, 0x71, 0x81, 0xeb, 0xee, 0x7f, 0xfe, 0xf1, 0xe7, 0x6a, 0xe3, 0x09, 0xdd,
-	0x63, 0x2b, 0x76, 0x72, 0x69, 0x99, 0xe8, 0x57, 0x8b, 0x6c, 0xaf, 0x18, 0x25, 0x3d, 0x2c, 0x6d,
-	0x58, 0xbe, 0x3f, 0xf6, 0xd1, 0xfd, 0x4c, 0x86, 0xb6, 0xad, 0x69, 0x0f, 0xe8, 0xfe, 0x2a, 0xda,
-	0x74, 0x61, 0xbc, 0x45, 0xfd, 0x89, 0xd4, 0x8a, 0x7f, 0x9b, 0x3e, 0x2d, 0x6d, 0xb9, 0x34, 0x58,
-	0xfb, 0xd9, 0x7f, 0xeb, 0x0c, 0x8d, 0xab, 0x69, 0x9a, 0xd4, 0x66, 0xa5, 0xdf, 0x73, 0xb7, 0x77,
-	0x3d, 0x75, 0xac, 0x9b, 0xa9, 0x63, 0xfd, 0x9e, 0x3a, 0xd6, 0x97, 0x99, 0x53, 0xb9, 0x99, 0x39,
-	0x95, 0x9f, 0x33, 0xa7, 0xf2, 0xee, 0x38, 0x8a, 0xb3, 0xe1, 0xd8, 0xf7, 0x02, 0x14, 0xec, 0x44,
-	0xfb, 0xcf, 0x70, 0x2c, 0x43, 0xfd, 0x90, 0xcc, 0x03, 0x27, 0x1d, 0xf6, 0x71, 0x91, 0x9a, 0x5d,
-	0x26, 0xa0, 0xfc, 0x9a, 0x7e, 0x22, 0x0e, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x88, 0x92, 0x84,
-	0xf9, 0xcd, 0x04, 0x00, 0x00,
+	// 955 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0xc7, 0xe3, 0x6c, 0xbb, 0x51, 0xdf, 0x36, 0x80, 0xa6, 0x01, 0x82, 0x59, 0xbc, 0xa9, 0x23,
+	0x92, 0x6d, 0x4b, 0xed, 0x6e, 0x5a, 0x55, 0x5c, 0xd9, 0x96, 0xb4, 0x07, 0x0a, 0x8b, 0xa5, 0x72,
+	0xe0, 0xb2, 0xf2, 0x7a, 0x27, 0xde, 0x51, 0x6c, 0x8f, 0xeb, 0x99, 0x4d, 0x89, 0x04, 0x17, 0xc4,
+	0x1f, 0x80, 0xd4, 0x7f, 0x00, 0x89, 0x03, 0x67, 0x84, 0xf8, 0x13, 0x80, 0x1e, 0x2b, 0x71, 0x41,
+	0x1c, 0x22, 0x94, 0xf0, 0x87, 0x20, 0xcf, 0x8c, 0x63, 0x3b, 0xb1, 0xd9, 0x5d, 0xe8, 0x29, 0xd9,
+	0x99, 0xf7, 0xe3, 0x33, 0xdf, 0x79, 0xef, 0x8d, 0xc1, 0xf0, 0x68, 0x82, 0xa7, 0xa1, 0xbd, 0x87,
+	0x71, 0x48, 0xc7, 0x38, 0xb0, 0x0f, 0x7a, 0xf6, 0x93, 0x29, 0x4e, 0x0e, 0xad, 0x38, 0xa1, 0x9c,
+	0x22, 0x24, 0xf7, 0xad, 0x6c, 0xdf, 0x3a, 0xe8, 0xe9, 0x6b, 0x3e, 0xf5, 0xa9, 0xd8, 0xb6, 0xd3,
+	0xff, 0xa4, 0xa5, 0xde, 0xf6, 0x29, 0xf5, 0x03, 0x6c, 0xbb, 0x31, 0xb1, 0xdd, 0x28, 0xa2, 0xdc,
+	0xe5, 0x84, 0x46, 0x4c, 0xed, 0x5e, 0xf7, 0x28, 0x0b, 0x29, 0xb3, 0x47, 0x2e, 0xc3, 0x32, 0x81,
+	0x7d, 0xd0, 0x1b, 0x61, 0xee, 0xf6, 0xec, 0xd8, 0xf5, 0x49, 0x24, 0x8c, 0x95, 0xad, 0x51, 0xb4,
+	0xcd, 0xac, 0x3c, 0x4a, 0xb2, 0xfd, 0x8d, 0x0a, 0xe6, 0x09, 0x61, 0x9c, 0x66, 0xd4, 0x7a, 0xa7,
+	0xc2, 0x22, 0x76, 0x13, 0x37, 0x54, 0x38, 0xe6, 0x5b, 0xf0, 0xe6, 0xa7, 0x29, 0xc4, 0x23, 0x12,
+	0x3d, 0x70, 0xd9, 0x20, 0x21, 0x1e, 0x76, 0xf0, 0x93, 0x29, 0x66, 0xdc, 0x1c, 0xc1, 0xfa, 0xf9,
+	0x2d, 0x16, 0xd3, 0x88, 0x61, 0xb4, 0x0b, 0xab, 0x21, 0x89, 0x86, 0xbe, 0xcb, 0x86, 0x71, 0xba,
+	0xb1, 0xae, 0x6d, 0x68, 0xdd, 0xd6, 0x4e, 0xdb, 0x92, 0xc4, 0x56, 0x4a, 0x6c, 0x29, 0x62, 0xeb,
+	0x3e, 0xf6, 0xee, 0x51, 0x12, 0xf5, 0x2f, 0x3c, 0x3f, 0xea, 0x2c, 0x39, 0xad, 0x30, 0x8f, 0x67,
+	0x7e, 0x09, 0x6d, 0x91, 0x23, 0x5b, 0xd8, 0xa5, 0x09, 0xf6, 0x5c, 0xc6, 0x15, 0x03, 0xba, 0x0a,
+	0x97, 0xdd, 0x3d, 0x8e, 0x93, 0xe1, 0x28, 0xa0, 0xde, 0x3e, 0x13, 0x69, 0x56, 0x9d, 0x96, 0x58,
+	0xeb, 0x8b, 0x25, 0xb4, 0x06, 0x17, 0x25, 0xc2, 0xf2, 0x86, 0xd6, 0xbd, 0xe4, 0xc8, 0x1f, 0x68,
+	0x13, 0x56, 0x9f, 0x12, 0x3e, 0x21, 0x51, 0xe6, 0xd9, 0x10, 0x9e, 0x97, 0xe5, 0xa2, 0x74, 0x35,
+	0xbf, 0xd1, 0x40, 0x3f, 0x9b, 0x79, 0x80, 0x13, 0x0f, 0x47, 0x9c, 0x04, 0x18, 0x19, 0x00, 0xf1,
+	0xe9, 0x2f, 0x95, 0xba, 0xb0, 0x72, 0x5e, 0x84, 0xe5, 0xff, 0x26, 0xc2, 0x6f, 0xcb, 0xf0, 0x4e,
+	0x8d, 0x0a, 0x4a, 0xee, 0xcf, 0xa0, 0x95, 0xe7, 0x4d, 0x55, 0x68, 0x74, 0x5b, 0x3b, 0x96, 0x75,
+	0xbe, 0x24, 0xad, 0xfa, 0xe3, 0x64, 0x99, 0x0b, 0x81, 0x90, 0x0b, 0x6f, 0xb8, 0x9e, 0x87, 0x63,
+	0xee, 0x46, 0x1e, 0x1e, 0xc6, 0x09, 0x1d, 0xb9, 0x23, 0x12, 0x10, 0x7e, 0x28, 0xc5, 0xec, 0x5f,
+	0xff, 0xf3, 0xa8, 0xb3, 0xe5, 0x13, 0x3e, 0x99, 0x8e, 0x2c, 0x8f, 0x86, 0xb6, 0xaa, 0x47, 0xf9,
+	0xe7, 0x26, 0x1b, 0xef, 0xdb, 0xfc, 0x30, 0xc6, 0x2c, 0x3d, 0x9c, 0xf3, 0x7a, 0x1e, 0x69, 0x90,
+	0x07, 0x42, 0xfb, 0xe5, 0x14, 0x85, 0x53, 0x34, 0xfe, 0xc7, 0x29, 0x8a, 0xc9, 0xf2, 0x90, 0xe6,
+	0x07, 0xa0, 0xcb, 0x92, 0x65, 0xfe, 0xf9, 0x82, 0x46, 0x9b, 0xb0, 0x12, 0x32, 0x7f, 0x38, 0x4d,
+	0x02, 0x71, 0x99, 0x97, 0xfa, 0x70, 0x7c, 0xd4, 0x69, 0x3e, 0x62, 0xfe, 0x63, 0xe7, 0x23, 0xa7,
+	0x19, 0x32, 0xff, 0x71, 0x12, 0x98, 0x3f, 0x6b, 0xf0, 0x76, 0x65, 0x8c, 0x97, 0x5b, 0xf9, 0xe8,
+	0x63, 0x80, 0x70, 0x1a, 0x70, 0x12, 0x07, 0x04, 0x27, 0x4a, 0x6e, 0x2b, 0x35, 0x5b, 0x40, 0xf2,
+	0x42, 0x04, 0xf3, 0x3e, 0x74, 0x04, 0xb6, 0x83, 0x3d, 0x1a, 0x86, 0x38, 0x1a, 0xe3, 0xf1, 0xd9,
+	0xf3, 0xcf, 0x6e, 0x26, 0xf3, 0x57, 0x0d, 0x36, 0xea, 0xc3, 0x28, 0x09, 0xee, 0x40, 0x23, 0xa0,
+	0x4f, 0x17, 0x38, 0x78, 0x6a, 0x9e, 0x7a, 0x85, 0x78, 0xbc, 0x40, 0x8f, 0xa4, 0xe6, 0xe8, 0x2e,
+	0x5c, 0x98, 0x10, 0x7f, 0xb2, 0xde, 0x98, 0xdb, 0x4d, 0xd8, 0x9b, 0x58, 0xdd, 0x62, 0x06, 0xff,
+	0x50, 0x8e, 0xc5, 0x4c, 0x8a, 0x5d, 0x80, 0x7c, 0xda, 0xaa, 0x93, 0x6c, 0x95, 0x82, 0xcb, 0xd9,
+	0x9f, 0xa5, 0x18, 0xb8, 0x7e, 0x26, 0xa3, 0x53, 0xf0, 0x34, 0x7f, 0xd4, 0xa0, 0x5d, 0x9d, 0x47,
+	0x69, 0xf5, 0x10, 0x56, 0x70, 0xc4, 0x13, 0x72, 0xda, 0xb5, 0xdd, 0x7f, 0xab, 0x77, 0xe5, 0xfd,
+	0x61, 0xc4, 0x93, 0x43, 0x75, 0x9c, 0xcc, 0x1d, 0x3d, 0x28, 0x21, 0x4b, 0x19, 0xb7, 0x67, 0x22,
+	0x4b, 0x8c, 0x12, 0xf3, 0x1a, 0x20, 0x81, 0x3c, 0x10, 0xef, 0x40, 0x36, 0xed, 0x3f, 0x81, 0x2b,
+	0xa5, 0x55, 0xc5, 0xff, 0x3e, 0x34, 0xe5, 0x7b, 0xa1, 0x44, 0xd2, 0xab, 0xf0, 0xa5, 0x8f, 0x02,
+	0x56, 0xf6, 0x3b, 0xbf, 0xac, 0xc0, 0x45, 0x11, 0x11, 0x3d, 0xd3, 0xa0, 0x55, 0x68, 0x25, 0x74,
+	0xa3, 0x2a, 0x46, 0xcd, 0x2b, 0xa4, 0xbf, 0x37, 0x9f, 0xb1, 0xc4, 0x35, 0xaf, 0x7d, 0xfd, 0xfb,
+	0xdf, 0xcf, 0x96, 0x37, 0xd1, 0x55, 0xbb, 0xe2, 0xe1, 0x2b, 0xf5, 0x2d, 0xfa, 0x49, 0x83, 0x2b,
+	0x15, 0x55, 0x8e, 0x6e, 0xd7, 0x26, 0xac, 0x6f, 0x2d, 0xfd, 0xce, 0x62, 0x4e, 0x8a, 0xb6, 0x27,
+	0x68, 0x6f, 0xa0, 0x6b, 0x55, 0xb4, 0x49, 0xee, 0x58, 0xa0, 0xfe, 0x41, 0x83, 0xd7, 0xce, 0x4e,
+	0x47, 0x74, 0xab, 0x36, 0x7b, 0xcd, 0xbb, 0xaa, 0xf7, 0x16, 0xf0, 0x50, 0xb0, 0x96, 0x80, 0xed,
+	0xa2, 0xad, 0x2a, 0xd8, 0x53, 0xc0, 0xe1, 0x5e, 0x06, 0xf5, 0x9d, 0x06, 0xaf, 0x94, 0x67, 0x28,
+	0xb2, 0xea, 0xef, 0xb2, 0x6a, 0x60, 0xeb, 0xf6, 0xdc, 0xf6, 0x8a, 0xf1, 0xa6, 0x60, 0xdc, 0x46,
+	0xef, 0x56, 0x5e, 0x3f, 0xf3, 0x87, 0xe5, 0x12, 0xf8, 0x5e, 0x83, 0x57, 0xcf, 0xb4, 0x1e, 0xb2,
+	0x67, 0x2a, 0x53, 0x1e, 0x25, 0xfa, 0xad, 0xf9, 0x1d, 0xe6, 0xa1, 0xcc, 0x95, 0x54, 0x5f, 0x72,
+	0xe8, 0x2b, 0x68, 0xca, 0x06, 0x43, 0x5b, 0xb5, 0xa9, 0x4a, 0xbd, 0xac, 0x6f, 0xcf, 0xb4, 0x53,
+	0x24, 0xa6, 0x20, 0x69, 0x23, 0xdd, 0xae, 0xfd, 0x4e, 0xec, 0x0f, 0x9e, 0x1f, 0x1b, 0xda, 0x8b,
+	0x63, 0x43, 0xfb, 0xeb, 0xd8, 0xd0, 0xbe, 0x3d, 0x31, 0x96, 0x5e, 0x9c, 0x18, 0x4b, 0x7f, 0x9c,
+	0x18, 0x4b, 0x9f, 0xdf, 0x2d, 0x3c, 0x53, 0xf7, 0x84, 0xff, 0x2e, 0x9d, 0x46, 0x63, 0x31, 0x65,
+	0xb2, 0x80, 0x07, 0x3b, 0xf6, 0x17, 0x79, 0x54, 0xf1, 0x74, 0x8d, 0x9a, 0xe2, 0xd3, 0xf3, 0xf6,
+	0x3f, 0x03, 0x00, 0xe5, 0x94, 0x4c, 0x11, 0x73, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -363,6 +773,14 @@ type QueryClient interface {
 	MinGasPrice(ctx context.Context, in *QueryMinGasPriceRequest, opts ...grpc.CallOption) (*QueryMinGasPriceResponse, error)
 	// RecommendedGasPrice queries the recommended gas price for the next n blocks.
 	RecommendedGasPrice(ctx context.Context, in *QueryRecommendedGasPriceRequest, opts ...grpc.CallOption) (*QueryRecommendedGasPriceResponse, error)
+	// GasPriceForecast queries the estimates of the minimum gas price required in the future blocks, computed by simulating
+	// the fee model forward using the block gas observed in the latest blocks.
+	GasPriceForecast(ctx context.Context, in *QueryGasPriceForecastRequest, opts ...grpc.CallOption) (*QueryGasPriceForecastResponse, error)
+	// MsgMinGasPrice queries the current minimum gas price required by the network for the transaction containing
+	// the message of the type, taking its gas price multiplier into account.
+	MsgMinGasPrice(ctx context.Context, in *QueryMsgMinGasPriceRequest, opts ...grpc.CallOption) (*QueryMsgMinGasPriceResponse, error)
+	// GasPriceHistory queries the minimum gas price and the block gas recorded for the latest blocks.
+	GasPriceHistory(ctx context.Context, in *QueryGasPriceHistoryRequest, opts ...grpc.CallOption) (*QueryGasPriceHistoryResponse, error)
 	// Params queries the parameters of x/feemodel module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -393,6 +811,33 @@ func (c *queryClient) RecommendedGasPrice(ctx context.Context, in *QueryRecommen
 	return out, nil
 }
 
+func (c *queryClient) GasPriceForecast(ctx context.Context, in *QueryGasPriceForecastRequest, opts ...grpc.CallOption) (*QueryGasPriceForecastResponse, error) {
+	out := new(QueryGasPriceForecastResponse)
+	err := c.cc.Invoke(ctx, "/coreum.feemodel.v1.Query/GasPriceForecast", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MsgMinGasPrice(ctx context.Context, in *QueryMsgMinGasPriceRequest, opts ...grpc.CallOption) (*QueryMsgMinGasPriceResponse, error) {
+	out := new(QueryMsgMinGasPriceResponse)
+	err := c.cc.Invoke(ctx, "/coreum.feemodel.v1.Query/MsgMinGasPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GasPriceHistory(ctx context.Context, in *QueryGasPriceHistoryRequest, opts ...grpc.CallOption) (*QueryGasPriceHistoryResponse, error) {
+	out := new(QueryGasPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, "/coreum.feemodel.v1.Query/GasPriceHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/coreum.feemodel.v1.Query/Params", in, out, opts...)
@@ -408,6 +853,14 @@ type QueryServer interface {
 	MinGasPrice(context.Context, *QueryMinGasPriceRequest) (*QueryMinGasPriceResponse, error)
 	// RecommendedGasPrice queries the recommended gas price for the next n blocks.
 	RecommendedGasPrice(context.Context, *QueryRecommendedGasPriceRequest) (*QueryRecommendedGasPriceResponse, error)
+	// GasPriceForecast queries the estimates of the minimum gas price required in the future blocks, computed by simulating
+	// the fee model forward using the block gas observed in the latest blocks.
+	GasPriceForecast(context.Context, *QueryGasPriceForecastRequest) (*QueryGasPriceForecastResponse, error)
+	// MsgMinGasPrice queries the current minimum gas price required by the network for the transaction containing
+	// the message of the type, taking its gas price multiplier into account.
+	MsgMinGasPrice(context.Context, *QueryMsgMinGasPriceRequest) (*QueryMsgMinGasPriceResponse, error)
+	// GasPriceHistory queries the minimum gas price and the block gas recorded for the latest blocks.
+	GasPriceHistory(context.Context, *QueryGasPriceHistoryRequest) (*QueryGasPriceHistoryResponse, error)
 	// Params queries the parameters of x/feemodel module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
@@ -422,6 +875,15 @@ func (*UnimplementedQueryServer) MinGasPrice(ctx context.Context, req *QueryMinG
 func (*UnimplementedQueryServer) RecommendedGasPrice(ctx context.Context, req *QueryRecommendedGasPriceRequest) (*QueryRecommendedGasPriceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RecommendedGasPrice not implemented")
 }
+func (*UnimplementedQueryServer) GasPriceForecast(ctx context.Context, req *QueryGasPriceForecastRequest) (*QueryGasPriceForecastResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GasPriceForecast not implemented")
+}
+func (*UnimplementedQueryServer) MsgMinGasPrice(ctx context.Context, req *QueryMsgMinGasPriceRequest) (*QueryMsgMinGasPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgMinGasPrice not implemented")
+}
+func (*UnimplementedQueryServer) GasPriceHistory(ctx context.Context, req *QueryGasPriceHistoryRequest) (*QueryGasPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GasPriceHistory not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -466,36 +928,102 @@ func _Query_RecommendedGasPrice_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
+func _Query_GasPriceForecast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGasPriceForecastRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
+		return srv.(QueryServer).GasPriceForecast(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/coreum.feemodel.v1.Query/Params",
+		FullMethod: "/coreum.feemodel.v1.Query/GasPriceForecast",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+		return srv.(QueryServer).GasPriceForecast(ctx, req.(*QueryGasPriceForecastRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "coreum.feemodel.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "MinGasPrice",
-			Handler:    _Query_MinGasPrice_Handler,
-		},
-		{
-			MethodName: "RecommendedGasPrice",
-			Handler:    _Query_RecommendedGasPrice_Handler,
-		},
+func _Query_MsgMinGasPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMsgMinGasPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MsgMinGasPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.feemodel.v1.Query/MsgMinGasPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MsgMinGasPrice(ctx, req.(*QueryMsgMinGasPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GasPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGasPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GasPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.feemodel.v1.Query/GasPriceHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GasPriceHistory(ctx, req.(*QueryGasPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.feemodel.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "coreum.feemodel.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "MinGasPrice",
+			Handler:    _Query_MinGasPrice_Handler,
+		},
+		{
+			MethodName: "RecommendedGasPrice",
+			Handler:    _Query_RecommendedGasPrice_Handler,
+		},
+		{
+			MethodName: "GasPriceForecast",
+			Handler:    _Query_GasPriceForecast_Handler,
+		},
+		{
+			MethodName: "MsgMinGasPrice",
+			Handler:    _Query_MsgMinGasPrice_Handler,
+		},
+		{
+			MethodName: "GasPriceHistory",
+			Handler:    _Query_GasPriceHistory_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -561,7 +1089,7 @@ func (m *QueryMinGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryRecommendedGasPriceRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGasPriceForecastRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -571,16 +1099,28 @@ func (m *QueryRecommendedGasPriceRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryRecommendedGasPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGasPriceForecastRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRecommendedGasPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGasPriceForecastRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.WithinBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WithinBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.AfterBlocks != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.AfterBlocks))
 		i--
@@ -589,7 +1129,7 @@ func (m *QueryRecommendedGasPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryRecommendedGasPriceResponse) Marshal() (dAtA []byte, err error) {
+func (m *GasPriceForecastPercentile) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -599,18 +1139,18 @@ func (m *QueryRecommendedGasPriceResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryRecommendedGasPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GasPriceForecastPercentile) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRecommendedGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GasPriceForecastPercentile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.High.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.MinGasPrice.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -618,19 +1158,140 @@ func (m *QueryRecommendedGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (in
 		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
+	dAtA[i] = 0x12
+	if m.Percentile != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Percentile))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGasPriceForecastResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGasPriceForecastResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGasPriceForecastResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AcceptancePercentiles) > 0 {
+		for iNdEx := len(m.AcceptancePercentiles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AcceptancePercentiles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.AcceptanceProbability != nil {
+		{
+			size := m.AcceptanceProbability.Size()
+			i -= size
+			if _, err := m.AcceptanceProbability.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Percentiles) > 0 {
+		for iNdEx := len(m.Percentiles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Percentiles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMsgMinGasPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMsgMinGasPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMsgMinGasPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgURL) > 0 {
+		i -= len(m.MsgURL)
+		copy(dAtA[i:], m.MsgURL)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgURL)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMsgMinGasPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMsgMinGasPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMsgMinGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	{
-		size, err := m.Med.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.Multiplier.Size()
+		i -= size
+		if _, err := m.Multiplier.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x12
 	{
-		size, err := m.Low.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.MinGasPrice.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -642,7 +1303,7 @@ func (m *QueryRecommendedGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryRecommendedGasPriceRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -652,20 +1313,25 @@ func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryRecommendedGasPriceRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryRecommendedGasPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.AfterBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AfterBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryRecommendedGasPriceResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -675,18 +1341,38 @@ func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryRecommendedGasPriceResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryRecommendedGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.High.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Med.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Low.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -698,52 +1384,276 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryMinGasPriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGasPriceHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryMinGasPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.MinGasPrice.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryGasPriceHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRecommendedGasPriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGasPriceHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.AfterBlocks != 0 {
-		n += 1 + sovQuery(uint64(m.AfterBlocks))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryRecommendedGasPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGasPriceHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGasPriceHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGasPriceHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryMinGasPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMinGasPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinGasPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGasPriceForecastRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AfterBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.AfterBlocks))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.WithinBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.WithinBlocks))
+	}
+	return n
+}
+
+func (m *GasPriceForecastPercentile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Percentile != 0 {
+		n += 1 + sovQuery(uint64(m.Percentile))
+	}
+	l = m.MinGasPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGasPriceForecastResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Percentiles) > 0 {
+		for _, e := range m.Percentiles {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.AcceptanceProbability != nil {
+		l = m.AcceptanceProbability.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.AcceptancePercentiles) > 0 {
+		for _, e := range m.AcceptancePercentiles {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryMsgMinGasPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgURL)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMsgMinGasPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinGasPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Multiplier.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRecommendedGasPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AfterBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.AfterBlocks))
+	}
+	return n
+}
+
+func (m *QueryRecommendedGasPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -756,6 +1666,38 @@ func (m *QueryRecommendedGasPriceResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryGasPriceHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGasPriceHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -776,13 +1718,522 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryMinGasPriceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMinGasPriceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMinGasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMinGasPriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMinGasPriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMinGasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGasPriceForecastRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGasPriceForecastRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGasPriceForecastRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AfterBlocks", wireType)
+			}
+			m.AfterBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AfterBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithinBlocks", wireType)
+			}
+			m.WithinBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithinBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasPriceForecastPercentile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasPriceForecastPercentile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasPriceForecastPercentile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentile", wireType)
+			}
+			m.Percentile = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Percentile |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGasPriceForecastResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGasPriceForecastResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGasPriceForecastResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentiles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Percentiles = append(m.Percentiles, GasPriceForecastPercentile{})
+			if err := m.Percentiles[len(m.Percentiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptanceProbability", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.AcceptanceProbability = &v
+			if err := m.AcceptanceProbability.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptancePercentiles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AcceptancePercentiles = append(m.AcceptancePercentiles, GasPriceForecastPercentile{})
+			if err := m.AcceptancePercentiles[len(m.AcceptancePercentiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryMinGasPriceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMsgMinGasPriceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -805,12 +2256,44 @@ func (m *QueryMinGasPriceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMinGasPriceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMsgMinGasPriceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMinGasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMsgMinGasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -832,7 +2315,7 @@ func (m *QueryMinGasPriceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMinGasPriceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMsgMinGasPriceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -855,10 +2338,10 @@ func (m *QueryMinGasPriceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMinGasPriceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMsgMinGasPriceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMinGasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMsgMinGasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -894,6 +2377,40 @@ func (m *QueryMinGasPriceResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Multiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1133,6 +2650,212 @@ func (m *QueryRecommendedGasPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryGasPriceHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGasPriceHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGasPriceHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGasPriceHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGasPriceHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGasPriceHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, GasPriceHistoryEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
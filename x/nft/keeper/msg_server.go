@@ -45,3 +45,19 @@ func (k Keeper) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendRespo
 
 	return &nft.MsgSendResponse{}, nil
 }
+
+// SendBatch implement SendBatch method of the types.MsgServer.
+func (k Keeper) SendBatch(goCtx context.Context, msg *nft.MsgSendBatch) (*nft.MsgSendBatchResponse, error) {
+	for _, item := range msg.Nfts {
+		if _, err := k.Send(goCtx, &nft.MsgSend{
+			ClassId:  item.ClassId,
+			Id:       item.Id,
+			Sender:   msg.Sender,
+			Receiver: item.Receiver,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return &nft.MsgSendBatchResponse{}, nil
+}
@@ -198,5 +198,6 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		am.registry,
 		simState.AppParams, simState.Cdc,
 		am.accountKeeper, am.bankKeeper, am.keeper,
+		nil,
 	)
 }
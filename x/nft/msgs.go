@@ -12,17 +12,24 @@ import (
 
 const (
 	// TypeMsgSend nft message types.
-	TypeMsgSend = "send"
+	TypeMsgSend      = "send"
+	TypeMsgSendBatch = "send-batch"
 )
 
+// MaxSendBatchSize is the maximum number of nfts which might be sent by single MsgSendBatch.
+const MaxSendBatchSize = 100
+
 var (
 	_ sdk.Msg            = &MsgSend{}
 	_ legacytx.LegacyMsg = &MsgSend{}
+	_ sdk.Msg            = &MsgSendBatch{}
+	_ legacytx.LegacyMsg = &MsgSendBatch{}
 )
 
 // RegisterLegacyAminoCodec registers the amino types and interfaces.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSend{}, fmt.Sprintf("%s/MsgSend", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgSendBatch{}, fmt.Sprintf("%s/MsgSendBatch", ModuleName), nil)
 }
 
 // ValidateBasic implements the Msg.ValidateBasic method.
@@ -68,6 +75,63 @@ func (m MsgSend) Type() string {
 	return TypeMsgSend
 }
 
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgSendBatch) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", m.Sender)
+	}
+
+	if len(m.Nfts) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no nfts to send")
+	}
+
+	if len(m.Nfts) > MaxSendBatchSize {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "number of nfts must be less than or equal %d", MaxSendBatchSize)
+	}
+
+	for _, item := range m.Nfts {
+		if item == nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "nft must be set")
+		}
+
+		if err := ValidateClassID(item.ClassId); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidID, "invalid class id (%s)", item.ClassId)
+		}
+
+		if err := ValidateNFTID(item.Id); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidID, "invalid nft id (%s)", item.Id)
+		}
+
+		_, err = sdk.AccAddressFromBech32(item.Receiver)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address (%s)", item.Receiver)
+		}
+	}
+	return nil
+}
+
+// GetSigners implements Msg.
+func (m MsgSendBatch) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{signer}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgSendBatch) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgSendBatch) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgSendBatch) Type() string {
+	return TypeMsgSendBatch
+}
+
 var (
 	amino          = codec.NewLegacyAmino()
 	moduleAminoCdc = codec.NewAminoCodec(amino)
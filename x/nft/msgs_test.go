@@ -27,6 +27,16 @@ func TestAmino(t *testing.T) {
 			},
 			wantAminoJSON: `{"type":"cnft/MsgSend","value":{"class_id":"class1","id":"id1","receiver":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
 		},
+		{
+			name: nft.TypeMsgSendBatch,
+			msg: &nft.MsgSendBatch{
+				Sender: address,
+				Nfts: []*nft.SendBatchItem{
+					{ClassId: "class1", Id: "id1", Receiver: address},
+				},
+			},
+			wantAminoJSON: `{"type":"cnft/MsgSendBatch","value":{"nfts":[{"class_id":"class1","id":"id1","receiver":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}],"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -1,7 +1,9 @@
 package simulation
 
 import (
+	"fmt"
 	"math/rand"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
@@ -14,7 +16,7 @@ func genClasses(r *rand.Rand, accounts []simtypes.Account) []*nft.Class {
 	classes := make([]*nft.Class, len(accounts)-1)
 	for i := 0; i < len(accounts)-1; i++ {
 		classes[i] = &nft.Class{
-			Id:          simtypes.RandStringOfLength(r, 10),
+			Id:          randClassID(r, accounts[i].Address),
 			Name:        simtypes.RandStringOfLength(r, 10),
 			Symbol:      simtypes.RandStringOfLength(r, 10),
 			Description: simtypes.RandStringOfLength(r, 10),
@@ -43,6 +45,12 @@ func genNFT(r *rand.Rand, classID string, accounts []simtypes.Account) []*nft.En
 	return entries
 }
 
+// randClassID returns the random class ID matching the [symbol]-[issuer-address] format required by
+// the wrapping modules.
+func randClassID(r *rand.Rand, issuer fmt.Stringer) string {
+	return fmt.Sprintf("nft%s-%s", strings.ToLower(simtypes.RandStringOfLength(r, 10)), issuer)
+}
+
 // RandomizedGenState generates a random GenesisState for nft.
 func RandomizedGenState(simState *module.SimulationState) {
 	var classes []*nft.Class
@@ -1,7 +1,6 @@
 package simulation
 
 import (
-	"fmt"
 	"math/rand"
 	"strings"
 
@@ -30,6 +29,10 @@ const (
 // TypeMsgSend defines the MsgSend tx message.
 var TypeMsgSend = sdk.MsgTypeURL(&nft.MsgSend{})
 
+// TransferChecker checks if the nft might be transferred to the receiver. It is used by the modules wrapping the nft
+// one to skip the transfers rejected by their rules.
+type TransferChecker func(ctx sdk.Context, classID, nftID string, receiver sdk.AccAddress) error
+
 // WeightedOperations returns all the operations from the module with their respective weights.
 func WeightedOperations(
 	registry cdctypes.InterfaceRegistry,
@@ -38,6 +41,7 @@ func WeightedOperations(
 	ak nft.AccountKeeper,
 	bk nft.BankKeeper,
 	k keeper.Keeper,
+	transferChecker TransferChecker,
 ) simulation.WeightedOperations {
 	var weightMsgSend int
 
@@ -50,17 +54,18 @@ func WeightedOperations(
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgSend,
-			SimulateMsgSend(codec.NewProtoCodec(registry), ak, bk, k),
+			SimulateMsgSend(codec.NewProtoCodec(registry), ak, bk, k, transferChecker),
 		),
 	}
 }
 
-// SimulateMsgSend generates a MsgSend with random values.
+// SimulateMsgSend generates a MsgSend with random values. The transferChecker is optional.
 func SimulateMsgSend(
 	cdc *codec.ProtoCodec,
 	ak nft.AccountKeeper,
 	bk nft.BankKeeper,
 	k keeper.Keeper,
+	transferChecker TransferChecker,
 ) simtypes.Operation {
 	return func(
 		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
@@ -89,6 +94,12 @@ func SimulateMsgSend(
 			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgSend, err.Error()), nil, err
 		}
 
+		if transferChecker != nil {
+			if err := transferChecker(ctx, n.ClassId, n.Id, receiver.Address); err != nil {
+				return simtypes.NoOpMsg(nft.ModuleName, TypeMsgSend, err.Error()), nil, nil
+			}
+		}
+
 		msg := &nft.MsgSend{
 			ClassId:  n.ClassId,
 			Id:       n.Id,
@@ -147,7 +158,7 @@ func randClass(ctx sdk.Context, minter sdk.AccAddress, r *rand.Rand, k keeper.Ke
 	classes := k.GetClasses(ctx)
 	if len(classes) == 0 {
 		c := nft.Class{
-			Id:          fmt.Sprintf("%s-%s", strings.ToLower(simtypes.RandStringOfLength(r, 10)), minter),
+			Id:          randClassID(r, minter),
 			Name:        simtypes.RandStringOfLength(r, 10),
 			Symbol:      strings.ToLower(simtypes.RandStringOfLength(r, 10)),
 			Description: simtypes.RandStringOfLength(r, 10),
@@ -39,6 +39,7 @@ func (suite *SimTestSuite) TestWeightedOperations() {
 		suite.app.AppCodec(),
 		suite.app.AccountKeeper,
 		suite.app.BankKeeper, suite.app.NFTKeeper.Keeper,
+		nil,
 	)
 
 	// setup 3 accounts
@@ -98,7 +99,7 @@ func (suite *SimTestSuite) TestSimulateMsgSend() {
 
 	// execute operation
 	registry := suite.app.InterfaceRegistry()
-	op := simulation.SimulateMsgSend(codec.NewProtoCodec(registry), suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.NFTKeeper.Keeper)
+	op := simulation.SimulateMsgSend(codec.NewProtoCodec(registry), suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.NFTKeeper.Keeper, nil)
 	operationMsg, futureOperations, err := op(r, suite.app.BaseApp, ctx, accounts, "")
 	suite.Require().NoError(err)
 
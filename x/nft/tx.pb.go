@@ -138,33 +138,197 @@ func (m *MsgSendResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSendResponse proto.InternalMessageInfo
 
+// MsgSendBatch represents a message to send multiple nfts from one account to other accounts.
+type MsgSendBatch struct {
+	// sender is the address of the owner of nfts
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// nfts defines the nfts to send together with their receivers
+	Nfts []*SendBatchItem `protobuf:"bytes,2,rep,name=nfts,proto3" json:"nfts,omitempty"`
+}
+
+func (m *MsgSendBatch) Reset()         { *m = MsgSendBatch{} }
+func (m *MsgSendBatch) String() string { return proto.CompactTextString(m) }
+func (*MsgSendBatch) ProtoMessage()    {}
+func (*MsgSendBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9cd688b01965c386, []int{2}
+}
+func (m *MsgSendBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSendBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSendBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSendBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSendBatch.Merge(m, src)
+}
+func (m *MsgSendBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSendBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSendBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSendBatch proto.InternalMessageInfo
+
+func (m *MsgSendBatch) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSendBatch) GetNfts() []*SendBatchItem {
+	if m != nil {
+		return m.Nfts
+	}
+	return nil
+}
+
+// SendBatchItem defines the nft sent by MsgSendBatch.
+type SendBatchItem struct {
+	// class_id defines the unique identifier of the nft classification
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// id defines the unique identification of nft
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// receiver is the receiver address of nft
+	Receiver string `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty"`
+}
+
+func (m *SendBatchItem) Reset()         { *m = SendBatchItem{} }
+func (m *SendBatchItem) String() string { return proto.CompactTextString(m) }
+func (*SendBatchItem) ProtoMessage()    {}
+func (*SendBatchItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9cd688b01965c386, []int{3}
+}
+func (m *SendBatchItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SendBatchItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SendBatchItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SendBatchItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendBatchItem.Merge(m, src)
+}
+func (m *SendBatchItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *SendBatchItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendBatchItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendBatchItem proto.InternalMessageInfo
+
+func (m *SendBatchItem) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *SendBatchItem) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *SendBatchItem) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+// MsgSendBatchResponse defines the Msg/SendBatch response type.
+type MsgSendBatchResponse struct {
+}
+
+func (m *MsgSendBatchResponse) Reset()         { *m = MsgSendBatchResponse{} }
+func (m *MsgSendBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSendBatchResponse) ProtoMessage()    {}
+func (*MsgSendBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9cd688b01965c386, []int{4}
+}
+func (m *MsgSendBatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSendBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSendBatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSendBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSendBatchResponse.Merge(m, src)
+}
+func (m *MsgSendBatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSendBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSendBatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSendBatchResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSend)(nil), "coreum.nft.v1beta1.MsgSend")
 	proto.RegisterType((*MsgSendResponse)(nil), "coreum.nft.v1beta1.MsgSendResponse")
+	proto.RegisterType((*MsgSendBatch)(nil), "coreum.nft.v1beta1.MsgSendBatch")
+	proto.RegisterType((*SendBatchItem)(nil), "coreum.nft.v1beta1.SendBatchItem")
+	proto.RegisterType((*MsgSendBatchResponse)(nil), "coreum.nft.v1beta1.MsgSendBatchResponse")
 }
 
 func init() { proto.RegisterFile("coreum/nft/v1beta1/tx.proto", fileDescriptor_9cd688b01965c386) }
 
 var fileDescriptor_9cd688b01965c386 = []byte{
-	// 281 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4e, 0xce, 0x2f, 0x4a,
-	0x2d, 0xcd, 0xd5, 0xcf, 0x4b, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x2f,
-	0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x82, 0x48, 0xea, 0xe5, 0xa5, 0x95, 0xe8,
-	0x41, 0x25, 0xa5, 0xc4, 0x93, 0xf3, 0x8b, 0x73, 0xf3, 0x8b, 0xf5, 0x73, 0x8b, 0xd3, 0xf5, 0xcb,
-	0x0c, 0x41, 0x14, 0x44, 0xb1, 0x52, 0x29, 0x17, 0xbb, 0x6f, 0x71, 0x7a, 0x70, 0x6a, 0x5e, 0x8a,
-	0x90, 0x24, 0x17, 0x47, 0x72, 0x4e, 0x62, 0x71, 0x71, 0x7c, 0x66, 0x8a, 0x04, 0xa3, 0x02, 0xa3,
-	0x06, 0x67, 0x10, 0x3b, 0x98, 0xef, 0x99, 0x22, 0xc4, 0xc7, 0xc5, 0x94, 0x99, 0x22, 0xc1, 0x04,
-	0x16, 0x64, 0xca, 0x4c, 0x11, 0x12, 0xe3, 0x62, 0x2b, 0x4e, 0xcd, 0x4b, 0x49, 0x2d, 0x92, 0x60,
-	0x06, 0x8b, 0x41, 0x79, 0x42, 0x52, 0x5c, 0x1c, 0x45, 0xa9, 0xc9, 0xa9, 0x99, 0x65, 0xa9, 0x45,
-	0x12, 0x2c, 0x60, 0x19, 0x38, 0xdf, 0x8a, 0xbb, 0xe9, 0xf9, 0x06, 0x2d, 0xa8, 0x42, 0x25, 0x41,
-	0x2e, 0x7e, 0xa8, 0xb5, 0x41, 0xa9, 0xc5, 0x05, 0xf9, 0x79, 0xc5, 0xa9, 0x46, 0xfe, 0x5c, 0xcc,
-	0xbe, 0xc5, 0xe9, 0x42, 0x1e, 0x5c, 0x2c, 0x60, 0xd7, 0x48, 0xeb, 0x61, 0x7a, 0x43, 0x0f, 0xaa,
-	0x47, 0x4a, 0x19, 0x8f, 0x24, 0xcc, 0x40, 0x27, 0xd7, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92,
-	0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c,
-	0x96, 0x63, 0x88, 0xd2, 0x4e, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x77,
-	0x06, 0x1b, 0xe4, 0x96, 0x5f, 0x9a, 0x97, 0x92, 0x58, 0x92, 0x99, 0x9f, 0xa7, 0x0f, 0x0d, 0xda,
-	0x32, 0x23, 0xfd, 0x0a, 0x50, 0xf8, 0x26, 0xb1, 0x81, 0x03, 0xca, 0x18, 0x10, 0x00, 0x00, 0xff,
-	0xff, 0x14, 0x5f, 0xf7, 0xff, 0x74, 0x01, 0x00, 0x00,
+	// 366 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xcd, 0x4a, 0xf3, 0x40,
+	0x14, 0x6d, 0x92, 0xd2, 0x9f, 0xdb, 0xef, 0x07, 0x07, 0xa9, 0x31, 0x85, 0x50, 0xe3, 0xa6, 0x28,
+	0x64, 0x68, 0xc5, 0x8d, 0xcb, 0x8a, 0x62, 0x17, 0xdd, 0x54, 0x50, 0x70, 0x23, 0x69, 0x32, 0x4d,
+	0x23, 0x66, 0xa6, 0x64, 0x26, 0xa1, 0x6b, 0x9f, 0xc0, 0xc7, 0x70, 0xe9, 0x63, 0xb8, 0xec, 0xd2,
+	0xa5, 0xb4, 0x0b, 0x5f, 0x43, 0x3a, 0x4d, 0x6b, 0x83, 0x52, 0x71, 0x35, 0xdc, 0x7b, 0xce, 0x3d,
+	0x67, 0xce, 0xe5, 0x42, 0xcd, 0x65, 0x11, 0x89, 0x43, 0x4c, 0x07, 0x02, 0x27, 0xcd, 0x3e, 0x11,
+	0x4e, 0x13, 0x8b, 0xb1, 0x3d, 0x8a, 0x98, 0x60, 0x08, 0x2d, 0x40, 0x9b, 0x0e, 0x84, 0x9d, 0x82,
+	0xc6, 0x8e, 0xcb, 0x78, 0xc8, 0x38, 0x0e, 0xb9, 0x8f, 0x93, 0xe6, 0xfc, 0x59, 0x90, 0xad, 0x18,
+	0x8a, 0x5d, 0xee, 0x5f, 0x12, 0xea, 0xa1, 0x5d, 0x28, 0xb9, 0xf7, 0x0e, 0xe7, 0xb7, 0x81, 0xa7,
+	0x2b, 0x75, 0xa5, 0x51, 0xee, 0x15, 0x65, 0xdd, 0xf1, 0xd0, 0x3f, 0x50, 0x03, 0x4f, 0x57, 0x65,
+	0x53, 0x0d, 0x3c, 0x54, 0x85, 0x02, 0x27, 0xd4, 0x23, 0x91, 0xae, 0xc9, 0x5e, 0x5a, 0x21, 0x03,
+	0x4a, 0x11, 0x71, 0x49, 0x90, 0x90, 0x48, 0xcf, 0x4b, 0x64, 0x55, 0x9f, 0x54, 0x1e, 0xde, 0x9f,
+	0x0f, 0x52, 0xa2, 0xb5, 0x05, 0xff, 0x53, 0xdb, 0x1e, 0xe1, 0x23, 0x46, 0x39, 0xb1, 0xee, 0xe0,
+	0x4f, 0xda, 0x6a, 0x3b, 0xc2, 0x1d, 0xae, 0x79, 0x28, 0x19, 0x8f, 0x63, 0xc8, 0xd3, 0x81, 0xe0,
+	0xba, 0x5a, 0xd7, 0x1a, 0x95, 0xd6, 0x9e, 0xfd, 0x35, 0xad, 0xbd, 0x12, 0xe9, 0x08, 0x12, 0xf6,
+	0x24, 0x3d, 0x6b, 0x7f, 0x05, 0x7f, 0x33, 0x9c, 0xdf, 0x64, 0x5f, 0xcf, 0xa8, 0x65, 0x33, 0x5a,
+	0x55, 0xd8, 0x5e, 0xcf, 0xb0, 0xcc, 0xd6, 0x7a, 0x52, 0x40, 0xeb, 0x72, 0x1f, 0x5d, 0x40, 0x5e,
+	0xae, 0xba, 0xf6, 0xdd, 0xaf, 0xd3, 0x49, 0x63, 0x7f, 0x03, 0xb8, 0x54, 0x44, 0xd7, 0x50, 0xfe,
+	0x5c, 0x55, 0x7d, 0xc3, 0x84, 0x64, 0x18, 0x8d, 0x9f, 0x18, 0x4b, 0xe1, 0xf6, 0xd9, 0xcb, 0xd4,
+	0x54, 0x26, 0x53, 0x53, 0x79, 0x9b, 0x9a, 0xca, 0xe3, 0xcc, 0xcc, 0x4d, 0x66, 0x66, 0xee, 0x75,
+	0x66, 0xe6, 0x6e, 0x0e, 0xfd, 0x40, 0x0c, 0xe3, 0xbe, 0xed, 0xb2, 0x10, 0x9f, 0x4a, 0xb5, 0x73,
+	0x16, 0x53, 0xcf, 0x11, 0x01, 0xa3, 0x38, 0x3d, 0xc8, 0xa4, 0x85, 0xc7, 0xf3, 0xab, 0xec, 0x17,
+	0xe4, 0x79, 0x1d, 0x7d, 0x0c, 0x00, 0x12, 0x09, 0x8f, 0x76, 0xaa, 0x02, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -181,6 +345,8 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	// Send defines a method to send a nft from one account to another account.
 	Send(ctx context.Context, in *MsgSend, opts ...grpc.CallOption) (*MsgSendResponse, error)
+	// SendBatch defines a method to send multiple nfts from one account to other accounts atomically.
+	SendBatch(ctx context.Context, in *MsgSendBatch, opts ...grpc.CallOption) (*MsgSendBatchResponse, error)
 }
 
 type msgClient struct {
@@ -200,10 +366,21 @@ func (c *msgClient) Send(ctx context.Context, in *MsgSend, opts ...grpc.CallOpti
 	return out, nil
 }
 
+func (c *msgClient) SendBatch(ctx context.Context, in *MsgSendBatch, opts ...grpc.CallOption) (*MsgSendBatchResponse, error) {
+	out := new(MsgSendBatchResponse)
+	err := c.cc.Invoke(ctx, "/coreum.nft.v1beta1.Msg/SendBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Send defines a method to send a nft from one account to another account.
 	Send(context.Context, *MsgSend) (*MsgSendResponse, error)
+	// SendBatch defines a method to send multiple nfts from one account to other accounts atomically.
+	SendBatch(context.Context, *MsgSendBatch) (*MsgSendBatchResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -213,6 +390,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) Send(ctx context.Context, req *MsgSend) (*MsgSendResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Send not implemented")
 }
+func (*UnimplementedMsgServer) SendBatch(ctx context.Context, req *MsgSendBatch) (*MsgSendBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendBatch not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -236,6 +416,24 @@ func _Msg_Send_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SendBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSendBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SendBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.nft.v1beta1.Msg/SendBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SendBatch(ctx, req.(*MsgSendBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "coreum.nft.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -244,6 +442,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Send",
 			Handler:    _Msg_Send_Handler,
 		},
+		{
+			MethodName: "SendBatch",
+			Handler:    _Msg_SendBatch_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "coreum/nft/v1beta1/tx.proto",
@@ -323,6 +525,117 @@ func (m *MsgSendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSendBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Nfts) > 0 {
+		for iNdEx := len(m.Nfts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nfts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SendBatchItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SendBatchItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SendBatchItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSendBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -368,6 +681,55 @@ func (m *MsgSendResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSendBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Nfts) > 0 {
+		for _, e := range m.Nfts {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SendBatchItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSendBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -602,6 +964,318 @@ func (m *MsgSendResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSendBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nfts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nfts = append(m.Nfts, &SendBatchItem{})
+			if err := m.Nfts[len(m.Nfts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SendBatchItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendBatchItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendBatchItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendBatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendBatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
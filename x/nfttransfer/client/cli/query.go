@@ -0,0 +1,147 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/CoreumFoundation/coreum/v2/x/nfttransfer/types"
+)
+
+// GetQueryCmd returns the cli query commands for the module.
+func GetQueryCmd() *cobra.Command {
+	// Group nfttransfer queries under a subcommand
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdQueryClassTrace(),
+		CmdQueryClassTraces(),
+		CmdQueryEscrowAddress(),
+	)
+
+	return cmd
+}
+
+// CmdQueryClassTrace return the QueryClassTrace cobra command.
+func CmdQueryClassTrace() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "class-trace [hash]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query class trace",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query class trace by its hash or by the voucher class ID.
+
+Example:
+$ %s query %s class-trace 27A6394C3F9FF9C9DCF5DFFADF9BB5FE9A37C7E92B006199894CF1824DF9AC7C
+$ %s query %s class-trace ibc/27A6394C3F9FF9C9DCF5DFFADF9BB5FE9A37C7E92B006199894CF1824DF9AC7C
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ClassTrace(cmd.Context(), &types.QueryClassTraceRequest{
+				Hash: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryClassTraces return the QueryClassTraces cobra command.
+func CmdQueryClassTraces() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "class-traces",
+		Args:  cobra.NoArgs,
+		Short: "Query class traces",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the traces of the classes received from the other chains.
+
+Example:
+$ %s query %s class-traces
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ClassTraces(cmd.Context(), &types.QueryClassTracesRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "class traces")
+
+	return cmd
+}
+
+// CmdQueryEscrowAddress return the QueryEscrowAddress cobra command.
+func CmdQueryEscrowAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "escrow-address [port] [channel]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query escrow address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the address holding the non-fungible tokens escrowed on the channel.
+
+Example:
+$ %s query %s escrow-address %s channel-0
+`,
+				version.AppName, types.ModuleName, types.PortID,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EscrowAddress(cmd.Context(), &types.QueryEscrowAddressRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,113 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/CoreumFoundation/coreum/v2/x/nfttransfer/types"
+)
+
+// Flags defined on transactions.
+const (
+	PacketTimeoutHeightFlag    = "packet-timeout-height"
+	PacketTimeoutTimestampFlag = "packet-timeout-timestamp"
+	MemoFlag                   = "memo"
+)
+
+// DefaultPacketTimeout is the default timeout of the packet, relative to the local time of the client.
+const DefaultPacketTimeout = 10 * time.Minute
+
+// GetTxCmd returns the transaction commands for this module.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdTxTransfer(),
+	)
+
+	return cmd
+}
+
+// CmdTxTransfer returns Transfer cobra command.
+func CmdTxTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer [src-port] [src-channel] [receiver] [class_id] [token_ids] --from [sender]",
+		Args:  cobra.ExactArgs(5),
+		Short: "Transfer the non-fungible tokens to the other chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Transfer the non-fungible tokens of the class to the other chain over ICS-721 channel.
+Token IDs are separated by commas. The timeout height is absolute, the timeout timestamp is relative to the local time.
+
+Example:
+$ %s tx %s transfer %s channel-0 [receiver] abc-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8 id1,id2 --from [sender]
+`,
+				version.AppName, types.ModuleName, types.PortID,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			timeoutHeightStr, err := cmd.Flags().GetString(PacketTimeoutHeightFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			timeoutHeight, err := clienttypes.ParseHeight(timeoutHeightStr)
+			if err != nil {
+				return errors.Wrap(err, "invalid timeout height")
+			}
+
+			timeout, err := cmd.Flags().GetDuration(PacketTimeoutTimestampFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			var timeoutTimestamp uint64
+			if timeout > 0 {
+				timeoutTimestamp = uint64(time.Now().Add(timeout).UnixNano())
+			}
+
+			memo, err := cmd.Flags().GetString(MemoFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			msg := &types.MsgTransfer{
+				SourcePort:       args[0],
+				SourceChannel:    args[1],
+				Receiver:         args[2],
+				ClassID:          args[3],
+				TokenIDs:         strings.Split(args[4], ","),
+				Sender:           clientCtx.GetFromAddress().String(),
+				TimeoutHeight:    timeoutHeight,
+				TimeoutTimestamp: timeoutTimestamp,
+				Memo:             memo,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(PacketTimeoutHeightFlag, "0-0", "Packet timeout block height in the format {revision}-{height}, the timeout is disabled when set to 0-0")
+	cmd.Flags().Duration(PacketTimeoutTimestampFlag, DefaultPacketTimeout, "Packet timeout relative to the current time, the timeout is disabled when set to 0")
+	cmd.Flags().String(MemoFlag, "", "Memo delivered with the packet")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
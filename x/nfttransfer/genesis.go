@@ -0,0 +1,41 @@
+package nfttransfer
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/CoreumFoundation/coreum/v2/x/nfttransfer/keeper"
+	"github.com/CoreumFoundation/coreum/v2/x/nfttransfer/types"
+)
+
+// InitGenesis initializes the nfttransfer module's state from a provided genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	k.SetPort(ctx, genState.PortID)
+
+	for _, trace := range genState.ClassTraces {
+		k.SetClassTrace(ctx, trace)
+	}
+
+	// Only try to bind to port if it is not already bound, since we may already own
+	// port capability from capability InitGenesis
+	if !k.IsBound(ctx, genState.PortID) {
+		if err := k.BindPort(ctx, genState.PortID); err != nil {
+			panic(fmt.Sprintf("could not claim port capability: %v", err))
+		}
+	}
+}
+
+// ExportGenesis returns the nfttransfer module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	traces, _, err := k.GetClassTraces(ctx, &query.PageRequest{Limit: query.MaxLimit})
+	if err != nil {
+		panic(err)
+	}
+
+	return &types.GenesisState{
+		PortID:      k.GetPort(ctx),
+		ClassTraces: traces,
+	}
+}
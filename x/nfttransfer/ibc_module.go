@@ -0,0 +1,226 @@
+package nfttransfer
+
+import (
+	"math"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v4/modules/core/exported"
+
+	"github.com/CoreumFoundation/coreum/v2/x/nfttransfer/keeper"
+	"github.com/CoreumFoundation/coreum/v2/x/nfttransfer/types"
+	wibctransfertypes "github.com/CoreumFoundation/coreum/v2/x/wibctransfer/types"
+)
+
+// IBCModule implements the ICS-26 interface for the ICS-721 transfers.
+type IBCModule struct {
+	keeper keeper.Keeper
+}
+
+// NewIBCModule returns a new instance of the IBCModule.
+func NewIBCModule(keeper keeper.Keeper) IBCModule {
+	return IBCModule{
+		keeper: keeper,
+	}
+}
+
+// OnChanOpenInit validates the channel parameters and claims the channel capability.
+func (im IBCModule) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	if err := im.validateChannelParams(ctx, order, portID, channelID); err != nil {
+		return "", err
+	}
+
+	if strings.TrimSpace(version) == "" {
+		version = types.Version
+	}
+	if version != types.Version {
+		return "", sdkerrors.Wrapf(types.ErrInvalidVersion, "got %s, expected %s", version, types.Version)
+	}
+
+	if err := im.keeper.ClaimCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)); err != nil {
+		return "", err
+	}
+
+	return version, nil
+}
+
+// OnChanOpenTry validates the channel parameters and claims the channel capability.
+func (im IBCModule) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	if err := im.validateChannelParams(ctx, order, portID, channelID); err != nil {
+		return "", err
+	}
+
+	if counterpartyVersion != types.Version {
+		return "", sdkerrors.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: got: %s, expected %s",
+			counterpartyVersion, types.Version)
+	}
+
+	if err := im.keeper.ClaimCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)); err != nil {
+		return "", err
+	}
+
+	return types.Version, nil
+}
+
+// OnChanOpenAck validates the counterparty version.
+func (im IBCModule) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyChannelID string,
+	counterpartyVersion string,
+) error {
+	if counterpartyVersion != types.Version {
+		return sdkerrors.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: %s, expected %s",
+			counterpartyVersion, types.Version)
+	}
+	return nil
+}
+
+// OnChanOpenConfirm implements the IBCModule interface.
+func (im IBCModule) OnChanOpenConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return nil
+}
+
+// OnChanCloseInit disallows user-initiated channel closing, the tokens escrowed on the channel would be lost otherwise.
+func (im IBCModule) OnChanCloseInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "user cannot close channel")
+}
+
+// OnChanCloseConfirm implements the IBCModule interface.
+func (im IBCModule) OnChanCloseConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return nil
+}
+
+// OnRecvPacket adds purpose-in to the context and processes the received packet. The error acknowledgement
+// is returned if the packet can't be decoded or processed.
+func (im IBCModule) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	data, err := types.DecodePacketData(packet.GetData())
+	if err == nil {
+		err = im.keeper.OnRecvPacket(wibctransfertypes.WithPurpose(ctx, wibctransfertypes.PurposeIn), packet, data)
+	}
+
+	event := &types.EventReceived{
+		Sender:   data.Sender,
+		Receiver: data.Receiver,
+		ClassID:  data.ClassID,
+		TokenIDs: data.TokenIDs,
+		Success:  err == nil,
+	}
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	if err != nil {
+		event.Error = err.Error()
+		ack = channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+		return channeltypes.NewErrorAcknowledgement(
+			sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit event: %v, err: %s", event, err),
+		)
+	}
+
+	return ack
+}
+
+// OnAcknowledgementPacket adds purpose-ack to the context and refunds the tokens if the transfer has been rejected.
+func (im IBCModule) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-721 packet acknowledgement: %v", err)
+	}
+
+	data, err := types.DecodePacketData(packet.GetData())
+	if err != nil {
+		return err
+	}
+
+	return im.keeper.OnAcknowledgementPacket(
+		wibctransfertypes.WithPurpose(ctx, wibctransfertypes.PurposeAck), packet, data, ack,
+	)
+}
+
+// OnTimeoutPacket adds purpose-timeout to the context and refunds the tokens.
+func (im IBCModule) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	data, err := types.DecodePacketData(packet.GetData())
+	if err != nil {
+		return err
+	}
+
+	return im.keeper.OnTimeoutPacket(wibctransfertypes.WithPurpose(ctx, wibctransfertypes.PurposeTimeout), packet, data)
+}
+
+// validateChannelParams does validation of a newly created channel. The channel must be UNORDERED and use
+// the port the module is bound to. Only 2^32 channels are allowed to be created.
+func (im IBCModule) validateChannelParams(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	portID string,
+	channelID string,
+) error {
+	// for escrow address security only 2^32 channels are allowed to be created
+	channelSequence, err := channeltypes.ParseChannelSequence(channelID)
+	if err != nil {
+		return err
+	}
+	if channelSequence > uint64(math.MaxUint32) {
+		return sdkerrors.Wrapf(types.ErrMaxTransferChannels, "channel sequence %d is greater than max allowed transfer channels %d",
+			channelSequence, uint64(math.MaxUint32))
+	}
+	if order != channeltypes.UNORDERED {
+		return sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s ", channeltypes.UNORDERED, order)
+	}
+
+	if boundPort := im.keeper.GetPort(ctx); boundPort != portID {
+		return sdkerrors.Wrapf(porttypes.ErrInvalidPort, "invalid port: %s, expected %s", portID, boundPort)
+	}
+
+	return nil
+}
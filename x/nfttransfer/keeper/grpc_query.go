@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	"github.com/CoreumFoundation/coreum/v2/x/nfttransfer/types"
+)
+
+var _ types.QueryServer = QueryService{}
+
+// QueryKeeper defines subscope of keeper methods required by query service.
+type QueryKeeper interface {
+	GetClassTrace(ctx sdk.Context, hash tmbytes.HexBytes) (types.ClassTrace, error)
+	GetClassTraces(ctx sdk.Context, pagination *query.PageRequest) ([]types.ClassTrace, *query.PageResponse, error)
+}
+
+// QueryService serves grpc query requests for nfttransfer module.
+type QueryService struct {
+	keeper QueryKeeper
+}
+
+// NewQueryService initiates the new instance of query service.
+func NewQueryService(keeper QueryKeeper) QueryService {
+	return QueryService{
+		keeper: keeper,
+	}
+}
+
+// ClassTrace queries the class trace by its hash.
+func (qs QueryService) ClassTrace(ctx context.Context, req *types.QueryClassTraceRequest) (*types.QueryClassTraceResponse, error) {
+	hash, err := types.ParseHexHash(req.Hash)
+	if err != nil {
+		return nil, err
+	}
+
+	trace, err := qs.keeper.GetClassTrace(sdk.UnwrapSDKContext(ctx), hash)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryClassTraceResponse{
+		ClassTrace: trace,
+	}, nil
+}
+
+// ClassTraces queries all the class traces.
+func (qs QueryService) ClassTraces(ctx context.Context, req *types.QueryClassTracesRequest) (*types.QueryClassTracesResponse, error) {
+	traces, pageRes, err := qs.keeper.GetClassTraces(sdk.UnwrapSDKContext(ctx), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryClassTracesResponse{
+		Pagination:  pageRes,
+		ClassTraces: traces,
+	}, nil
+}
+
+// EscrowAddress returns the address holding the non-fungible tokens escrowed on the channel.
+func (qs QueryService) EscrowAddress(
+	ctx context.Context,
+	req *types.QueryEscrowAddressRequest,
+) (*types.QueryEscrowAddressResponse, error) {
+	if err := host.PortIdentifierValidator(req.PortId); err != nil {
+		return nil, err
+	}
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEscrowAddressResponse{
+		EscrowAddress: types.GetEscrowAddress(req.PortId, req.ChannelId).String(),
+	}, nil
+}
@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	"github.com/CoreumFoundation/coreum/v2/x/nfttransfer/types"
+)
+
+// Keeper is the nfttransfer module keeper.
+type Keeper struct {
+	cdc           codec.BinaryCodec
+	storeKey      sdk.StoreKey
+	ics4Wrapper   types.ICS4Wrapper
+	channelKeeper types.ChannelKeeper
+	portKeeper    types.PortKeeper
+	nftKeeper     types.NFTKeeper
+	scopedKeeper  capabilitykeeper.ScopedKeeper
+}
+
+// NewKeeper creates a new instance of the Keeper.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey sdk.StoreKey,
+	ics4Wrapper types.ICS4Wrapper,
+	channelKeeper types.ChannelKeeper,
+	portKeeper types.PortKeeper,
+	nftKeeper types.NFTKeeper,
+	scopedKeeper capabilitykeeper.ScopedKeeper,
+) Keeper {
+	return Keeper{
+		cdc:           cdc,
+		storeKey:      storeKey,
+		ics4Wrapper:   ics4Wrapper,
+		channelKeeper: channelKeeper,
+		portKeeper:    portKeeper,
+		nftKeeper:     nftKeeper,
+		scopedKeeper:  scopedKeeper,
+	}
+}
+
+// IsBound checks if the module is already bound to the desired port.
+func (k Keeper) IsBound(ctx sdk.Context, portID string) bool {
+	_, ok := k.scopedKeeper.GetCapability(ctx, host.PortPath(portID))
+	return ok
+}
+
+// BindPort binds the module to the port and claims the port capability.
+func (k Keeper) BindPort(ctx sdk.Context, portID string) error {
+	capability := k.portKeeper.BindPort(ctx, portID)
+	return k.ClaimCapability(ctx, capability, host.PortPath(portID))
+}
+
+// GetPort returns the port ID the module is bound to.
+func (k Keeper) GetPort(ctx sdk.Context) string {
+	return string(ctx.KVStore(k.storeKey).Get(types.PortKey))
+}
+
+// SetPort sets the port ID the module is bound to.
+func (k Keeper) SetPort(ctx sdk.Context, portID string) {
+	ctx.KVStore(k.storeKey).Set(types.PortKey, []byte(portID))
+}
+
+// ClaimCapability allows the module to claim a capability that IBC module passes to it.
+func (k Keeper) ClaimCapability(ctx sdk.Context, capability *capabilitytypes.Capability, name string) error {
+	return k.scopedKeeper.ClaimCapability(ctx, capability, name)
+}
+
+// GetClassTrace returns the class trace by its hash.
+func (k Keeper) GetClassTrace(ctx sdk.Context, hash tmbytes.HexBytes) (types.ClassTrace, error) {
+	bz := prefix.NewStore(ctx.KVStore(k.storeKey), types.ClassTraceKeyPrefix).Get(hash)
+	if bz == nil {
+		return types.ClassTrace{}, sdkerrors.Wrapf(types.ErrTraceNotFound, "class trace with hash %s not found", hash)
+	}
+
+	var trace types.ClassTrace
+	k.cdc.MustUnmarshal(bz, &trace)
+	return trace, nil
+}
+
+// HasClassTrace checks if the class trace with the hash exists.
+func (k Keeper) HasClassTrace(ctx sdk.Context, hash tmbytes.HexBytes) bool {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.ClassTraceKeyPrefix).Has(hash)
+}
+
+// SetClassTrace stores the class trace.
+func (k Keeper) SetClassTrace(ctx sdk.Context, trace types.ClassTrace) {
+	prefix.NewStore(ctx.KVStore(k.storeKey), types.ClassTraceKeyPrefix).Set(trace.Hash(), k.cdc.MustMarshal(&trace))
+}
+
+// GetClassTraces returns paginated class traces.
+func (k Keeper) GetClassTraces(ctx sdk.Context, pagination *query.PageRequest) ([]types.ClassTrace, *query.PageResponse, error) {
+	traces := make([]types.ClassTrace, 0)
+	pageRes, err := query.Paginate(
+		prefix.NewStore(ctx.KVStore(k.storeKey), types.ClassTraceKeyPrefix),
+		pagination,
+		func(key []byte, value []byte) error {
+			var trace types.ClassTrace
+			if err := k.cdc.Unmarshal(value, &trace); err != nil {
+				return err
+			}
+			traces = append(traces, trace)
+			return nil
+		})
+	if err != nil {
+		return nil, nil, sdkerrors.Wrapf(types.ErrInvalidInput, "failed to paginate: %s", err)
+	}
+
+	return traces, pageRes, nil
+}
+
+// getFullClassPath returns the full class path of the class, so it can be put into the packet.
+func (k Keeper) getFullClassPath(ctx sdk.Context, classID string) (string, error) {
+	if !types.IsVoucherClassID(classID) {
+		return classID, nil
+	}
+
+	hash, err := types.ParseHexHash(classID)
+	if err != nil {
+		return "", err
+	}
+	trace, err := k.GetClassTrace(ctx, hash)
+	if err != nil {
+		return "", err
+	}
+	return trace.GetFullClassPath(), nil
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CoreumFoundation/coreum/v2/x/nfttransfer/types"
+	wibctransfertypes "github.com/CoreumFoundation/coreum/v2/x/wibctransfer/types"
+)
+
+var _ types.MsgServer = MsgServer{}
+
+// MsgKeeper defines subscope of keeper methods required by msg service.
+type MsgKeeper interface {
+	SendTransfer(ctx sdk.Context, settings TransferSettings) (uint64, error)
+}
+
+// MsgServer serves grpc tx requests for nfttransfer module.
+type MsgServer struct {
+	keeper MsgKeeper
+}
+
+// NewMsgServer returns a new instance of the MsgServer.
+func NewMsgServer(keeper MsgKeeper) MsgServer {
+	return MsgServer{
+		keeper: keeper,
+	}
+}
+
+// Transfer sends the non-fungible tokens to the other chain.
+func (ms MsgServer) Transfer(goCtx context.Context, req *types.MsgTransfer) (*types.MsgTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	sequence, err := ms.keeper.SendTransfer(wibctransfertypes.WithPurpose(ctx, wibctransfertypes.PurposeOut), TransferSettings{
+		SourcePort:       req.SourcePort,
+		SourceChannel:    req.SourceChannel,
+		ClassID:          req.ClassID,
+		TokenIDs:         req.TokenIDs,
+		Sender:           sender,
+		Receiver:         req.Receiver,
+		TimeoutHeight:    req.TimeoutHeight,
+		TimeoutTimestamp: req.TimeoutTimestamp,
+		Memo:             req.Memo,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTransferResponse{
+		Sequence: sequence,
+	}, nil
+}
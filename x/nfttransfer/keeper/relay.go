@@ -0,0 +1,309 @@
+package keeper
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+	"github.com/gogo/protobuf/proto"
+
+	assetnfttypes "github.com/CoreumFoundation/coreum/v2/x/asset/nft/types"
+	"github.com/CoreumFoundation/coreum/v2/x/nft"
+	"github.com/CoreumFoundation/coreum/v2/x/nfttransfer/types"
+)
+
+// TransferSettings defines the settings of the outgoing transfer.
+type TransferSettings struct {
+	SourcePort       string
+	SourceChannel    string
+	ClassID          string
+	TokenIDs         []string
+	Sender           sdk.AccAddress
+	Receiver         string
+	TimeoutHeight    clienttypes.Height
+	TimeoutTimestamp uint64
+	Memo             string
+}
+
+// SendTransfer sends the non-fungible tokens to the other chain. There are 2 possible cases:
+//
+// 1. The class moves away from its origin. The tokens are transferred to the escrow address of the channel and
+// the receiving chain is expected to mint the vouchers.
+//
+// 2. The class moves back to the chain it came from over the channel. The vouchers are burnt and the receiving
+// chain is expected to release the escrowed tokens.
+//
+// The tokens are transferred to the escrow address by the wrapped nft keeper, so the asset nft rules are applied.
+// It is the responsibility of the caller to mark the context with the outgoing transfer purpose.
+func (k Keeper) SendTransfer(ctx sdk.Context, settings TransferSettings) (uint64, error) {
+	sourceChannelEnd, found := k.channelKeeper.GetChannel(ctx, settings.SourcePort, settings.SourceChannel)
+	if !found {
+		return 0, sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)",
+			settings.SourcePort, settings.SourceChannel)
+	}
+
+	sequence, found := k.channelKeeper.GetNextSequenceSend(ctx, settings.SourcePort, settings.SourceChannel)
+	if !found {
+		return 0, sdkerrors.Wrapf(channeltypes.ErrSequenceSendNotFound, "source port: %s, source channel: %s",
+			settings.SourcePort, settings.SourceChannel)
+	}
+
+	channelCap, ok := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(settings.SourcePort, settings.SourceChannel))
+	if !ok {
+		return 0, sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	class, found := k.nftKeeper.GetClass(ctx, settings.ClassID)
+	if !found {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidInput, "class with ID:%s not found", settings.ClassID)
+	}
+
+	fullClassPath, err := k.getFullClassPath(ctx, settings.ClassID)
+	if err != nil {
+		return 0, err
+	}
+
+	classData, err := dataToBytes(class.Data)
+	if err != nil {
+		return 0, err
+	}
+
+	packetData := types.NonFungibleTokenPacketData{
+		ClassID:   fullClassPath,
+		ClassURI:  class.Uri,
+		ClassData: classData,
+		TokenIDs:  settings.TokenIDs,
+		TokenURIs: make([]string, 0, len(settings.TokenIDs)),
+		TokenData: make([][]byte, 0, len(settings.TokenIDs)),
+		Sender:    settings.Sender.String(),
+		Receiver:  settings.Receiver,
+		Memo:      settings.Memo,
+	}
+
+	awayFromOrigin := types.IsAwayFromOrigin(settings.SourcePort, settings.SourceChannel, fullClassPath)
+	escrowAddress := types.GetEscrowAddress(settings.SourcePort, settings.SourceChannel)
+	for _, tokenID := range settings.TokenIDs {
+		token, found := k.nftKeeper.GetNFT(ctx, settings.ClassID, tokenID)
+		if !found {
+			return 0, sdkerrors.Wrapf(types.ErrInvalidInput, "nft with classID:%s and ID:%s not found", settings.ClassID, tokenID)
+		}
+		if !k.nftKeeper.GetOwner(ctx, settings.ClassID, tokenID).Equals(settings.Sender) {
+			return 0, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft with classID:%s and ID:%s",
+				settings.Sender, settings.ClassID, tokenID)
+		}
+
+		tokenData, err := dataToBytes(token.Data)
+		if err != nil {
+			return 0, err
+		}
+		packetData.TokenURIs = append(packetData.TokenURIs, token.Uri)
+		packetData.TokenData = append(packetData.TokenData, tokenData)
+
+		if awayFromOrigin {
+			if err := k.nftKeeper.Transfer(ctx, settings.ClassID, tokenID, escrowAddress); err != nil {
+				return 0, err
+			}
+			continue
+		}
+
+		if err := k.nftKeeper.Burn(ctx, settings.ClassID, tokenID); err != nil {
+			return 0, err
+		}
+	}
+
+	bz, err := packetData.GetBytes()
+	if err != nil {
+		return 0, err
+	}
+
+	packet := channeltypes.NewPacket(
+		bz,
+		sequence,
+		settings.SourcePort,
+		settings.SourceChannel,
+		sourceChannelEnd.GetCounterparty().GetPortID(),
+		sourceChannelEnd.GetCounterparty().GetChannelID(),
+		settings.TimeoutHeight,
+		settings.TimeoutTimestamp,
+	)
+	if err := k.ics4Wrapper.SendPacket(ctx, channelCap, packet); err != nil {
+		return 0, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventSent{
+		Sender:        packetData.Sender,
+		Receiver:      packetData.Receiver,
+		ClassID:       settings.ClassID,
+		TokenIDs:      settings.TokenIDs,
+		SourceChannel: settings.SourceChannel,
+		Sequence:      sequence,
+	}); err != nil {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit event: %v, err: %s", types.EventSent{}, err)
+	}
+
+	return sequence, nil
+}
+
+// OnRecvPacket processes the incoming ICS-721 packet. If the class originates from this chain, the escrowed tokens
+// are released to the receiver, otherwise the vouchers are minted.
+// It is the responsibility of the caller to mark the context with the incoming transfer purpose.
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData) error {
+	if err := data.ValidateBasic(); err != nil {
+		return err
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid receiver address")
+	}
+
+	if !types.IsAwayFromOrigin(packet.GetSourcePort(), packet.GetSourceChannel(), data.ClassID) {
+		// the class came back, so we remove the prefix added by the sender chain and release the escrowed tokens
+		unprefixedClassID := data.ClassID[len(types.GetClassPrefix(packet.GetSourcePort(), packet.GetSourceChannel())):]
+		classID := types.ParseClassTrace(unprefixedClassID).IBCClassID()
+		escrowAddress := types.GetEscrowAddress(packet.GetDestPort(), packet.GetDestChannel())
+		for _, tokenID := range data.TokenIDs {
+			if !k.nftKeeper.GetOwner(ctx, classID, tokenID).Equals(escrowAddress) {
+				return sdkerrors.Wrapf(types.ErrInvalidPacket, "nft with classID:%s and ID:%s is not escrowed", classID, tokenID)
+			}
+			if err := k.nftKeeper.Transfer(ctx, classID, tokenID, receiver); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	trace := types.ParseClassTrace(types.GetClassPrefix(packet.GetDestPort(), packet.GetDestChannel()) + data.ClassID)
+	if !k.HasClassTrace(ctx, trace.Hash()) {
+		k.SetClassTrace(ctx, trace)
+	}
+
+	voucherClassID := trace.IBCClassID()
+	if !k.nftKeeper.HasClass(ctx, voucherClassID) {
+		classData, err := bytesToData(data.ClassData)
+		if err != nil {
+			return err
+		}
+		if err := k.nftKeeper.SaveClass(ctx, nft.Class{
+			Id:   voucherClassID,
+			Uri:  data.ClassURI,
+			Data: classData,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return k.mintVouchers(ctx, voucherClassID, data, receiver)
+}
+
+// OnAcknowledgementPacket refunds the tokens to the sender if the transfer has been rejected by the other chain.
+// It is the responsibility of the caller to mark the context with the acknowledgement purpose.
+func (k Keeper) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data types.NonFungibleTokenPacketData,
+	ack channeltypes.Acknowledgement,
+) error {
+	if _, ok := ack.Response.(*channeltypes.Acknowledgement_Error); ok {
+		return k.refundPacketTokens(ctx, packet, data)
+	}
+	return nil
+}
+
+// OnTimeoutPacket refunds the tokens to the sender because the packet has timed out.
+// It is the responsibility of the caller to mark the context with the timeout purpose.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData) error {
+	return k.refundPacketTokens(ctx, packet, data)
+}
+
+// refundPacketTokens releases the escrowed tokens back to the sender, or mints back the burnt vouchers.
+func (k Keeper) refundPacketTokens(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData) error {
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	classID := types.ParseClassTrace(data.ClassID).IBCClassID()
+	if types.IsAwayFromOrigin(packet.GetSourcePort(), packet.GetSourceChannel(), data.ClassID) {
+		escrowAddress := types.GetEscrowAddress(packet.GetSourcePort(), packet.GetSourceChannel())
+		for _, tokenID := range data.TokenIDs {
+			if !k.nftKeeper.GetOwner(ctx, classID, tokenID).Equals(escrowAddress) {
+				return sdkerrors.Wrapf(types.ErrInvalidState, "nft with classID:%s and ID:%s is not escrowed", classID, tokenID)
+			}
+			if err := k.nftKeeper.Transfer(ctx, classID, tokenID, sender); err != nil {
+				return err
+			}
+		}
+	} else if err := k.mintVouchers(ctx, classID, data, sender); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventRefunded{
+		Sender:   data.Sender,
+		ClassID:  classID,
+		TokenIDs: data.TokenIDs,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit event: %v, err: %s", types.EventRefunded{}, err)
+	}
+
+	return nil
+}
+
+func (k Keeper) mintVouchers(
+	ctx sdk.Context,
+	classID string,
+	data types.NonFungibleTokenPacketData,
+	receiver sdk.AccAddress,
+) error {
+	for i, tokenID := range data.TokenIDs {
+		token := nft.NFT{
+			ClassId: classID,
+			Id:      tokenID,
+		}
+		if len(data.TokenURIs) != 0 {
+			token.Uri = data.TokenURIs[i]
+		}
+		if len(data.TokenData) != 0 {
+			tokenData, err := bytesToData(data.TokenData[i])
+			if err != nil {
+				return err
+			}
+			token.Data = tokenData
+		}
+		if err := k.nftKeeper.Mint(ctx, token, receiver); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// dataToBytes extracts the bytes to be put into the packet. Data of the asset nft is always stored as DataBytes,
+// for the other types the raw value is used.
+func dataToBytes(data *codectypes.Any) ([]byte, error) {
+	if data == nil {
+		return nil, nil
+	}
+	if data.TypeUrl != "/"+proto.MessageName((*assetnfttypes.DataBytes)(nil)) {
+		return data.Value, nil
+	}
+
+	var dataBytes assetnfttypes.DataBytes
+	if err := proto.Unmarshal(data.Value, &dataBytes); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidInput, "failed to unmarshal data bytes: %s", err)
+	}
+	return dataBytes.Data, nil
+}
+
+// bytesToData wraps the data received in the packet into DataBytes, the same way asset nft stores it.
+func bytesToData(bz []byte) (*codectypes.Any, error) {
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	data, err := codectypes.NewAnyWithValue(&assetnfttypes.DataBytes{Data: bz})
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidInput, "failed to pack data bytes: %s", err)
+	}
+	return data, nil
+}
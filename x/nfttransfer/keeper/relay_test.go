@@ -0,0 +1,232 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v4/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+	ibctmtypes "github.com/cosmos/ibc-go/v4/modules/light-clients/07-tendermint/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/v2/testutil/simapp"
+	assetnfttypes "github.com/CoreumFoundation/coreum/v2/x/asset/nft/types"
+	"github.com/CoreumFoundation/coreum/v2/x/nft"
+	"github.com/CoreumFoundation/coreum/v2/x/nfttransfer/keeper"
+	"github.com/CoreumFoundation/coreum/v2/x/nfttransfer/types"
+	wibctransfertypes "github.com/CoreumFoundation/coreum/v2/x/wibctransfer/types"
+)
+
+func TestKeeper_OnRecvPacket_Vouchers(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{Time: time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)})
+	inCtx := wibctransfertypes.WithPurpose(ctx, wibctransfertypes.PurposeIn)
+	nftTransferKeeper := testApp.NFTTransferKeeper
+	nftKeeper := testApp.NFTKeeper
+
+	receiver := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	packet := channeltypes.Packet{
+		SourcePort:         types.PortID,
+		SourceChannel:      "channel-7",
+		DestinationPort:    types.PortID,
+		DestinationChannel: "channel-0",
+	}
+	data := types.NonFungibleTokenPacketData{
+		ClassID:   "class",
+		ClassURI:  "https://my-class-meta.invalid/1",
+		TokenIDs:  []string{"nft1", "nft2"},
+		TokenURIs: []string{"https://my-nft-meta.invalid/1", "https://my-nft-meta.invalid/2"},
+		Sender:    "sender",
+		Receiver:  receiver.String(),
+	}
+
+	requireT.NoError(nftTransferKeeper.OnRecvPacket(inCtx, packet, data))
+
+	trace := types.ClassTrace{Path: "nft-transfer/channel-0", BaseClassID: "class"}
+	storedTrace, err := nftTransferKeeper.GetClassTrace(ctx, trace.Hash())
+	requireT.NoError(err)
+	requireT.Equal(trace, storedTrace)
+
+	voucherClassID := trace.IBCClassID()
+	class, found := nftKeeper.GetClass(ctx, voucherClassID)
+	requireT.True(found)
+	requireT.Equal(data.ClassURI, class.Uri)
+	for i, tokenID := range data.TokenIDs {
+		requireT.Equal(receiver, nftKeeper.GetOwner(ctx, voucherClassID, tokenID))
+		token, found := nftKeeper.GetNFT(ctx, voucherClassID, tokenID)
+		requireT.True(found)
+		requireT.Equal(data.TokenURIs[i], token.Uri)
+	}
+
+	// receiving the same tokens again fails
+	requireT.Error(nftTransferKeeper.OnRecvPacket(inCtx, packet, data))
+
+	// the voucher is sent locally
+	recipient := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	for _, tokenID := range data.TokenIDs {
+		_, err := nftKeeper.Send(sdk.WrapSDKContext(ctx), &nft.MsgSend{
+			ClassId:  voucherClassID,
+			Id:       tokenID,
+			Sender:   receiver.String(),
+			Receiver: recipient.String(),
+		})
+		requireT.NoError(err)
+		requireT.Equal(recipient, nftKeeper.GetOwner(ctx, voucherClassID, tokenID))
+	}
+
+	// the voucher is sent back to the chain it came from, so it is burnt
+	setupChannel(ctx, testApp, "channel-0", "channel-7")
+	sendBack := func() channeltypes.Packet {
+		outCtx := wibctransfertypes.WithPurpose(ctx, wibctransfertypes.PurposeOut)
+		sequence, err := nftTransferKeeper.SendTransfer(outCtx, keeper.TransferSettings{
+			SourcePort:       types.PortID,
+			SourceChannel:    "channel-0",
+			ClassID:          voucherClassID,
+			TokenIDs:         data.TokenIDs,
+			Sender:           recipient,
+			Receiver:         "receiver",
+			TimeoutTimestamp: uint64(ctx.BlockTime().Add(time.Hour).UnixNano()),
+		})
+		requireT.NoError(err)
+		for _, tokenID := range data.TokenIDs {
+			requireT.False(nftKeeper.HasNFT(ctx, voucherClassID, tokenID))
+		}
+		return channeltypes.Packet{
+			Sequence:           sequence,
+			SourcePort:         types.PortID,
+			SourceChannel:      "channel-0",
+			DestinationPort:    types.PortID,
+			DestinationChannel: "channel-7",
+		}
+	}
+	sentData := types.NonFungibleTokenPacketData{
+		ClassID:  trace.GetFullClassPath(),
+		TokenIDs: data.TokenIDs,
+		Sender:   recipient.String(),
+		Receiver: "receiver",
+	}
+	requireRefunded := func() {
+		for _, tokenID := range data.TokenIDs {
+			requireT.Equal(recipient, nftKeeper.GetOwner(ctx, voucherClassID, tokenID))
+		}
+	}
+
+	// the transfer is rejected by the other chain, so the voucher is minted again for the sender
+	packet = sendBack()
+	ackCtx := wibctransfertypes.WithPurpose(ctx, wibctransfertypes.PurposeAck)
+	requireT.NoError(nftTransferKeeper.OnAcknowledgementPacket(
+		ackCtx, packet, sentData, channeltypes.NewErrorAcknowledgement(types.ErrInvalidPacket),
+	))
+	requireRefunded()
+
+	// the transfer times out, so the voucher is minted again for the sender
+	packet = sendBack()
+	timeoutCtx := wibctransfertypes.WithPurpose(ctx, wibctransfertypes.PurposeTimeout)
+	requireT.NoError(nftTransferKeeper.OnTimeoutPacket(timeoutCtx, packet, sentData))
+	requireRefunded()
+
+	// the transfer is accepted by the other chain, so the voucher stays burnt
+	packet = sendBack()
+	requireT.NoError(nftTransferKeeper.OnAcknowledgementPacket(
+		ackCtx, packet, sentData, channeltypes.NewResultAcknowledgement([]byte{byte(1)}),
+	))
+	for _, tokenID := range data.TokenIDs {
+		requireT.False(nftKeeper.HasNFT(ctx, voucherClassID, tokenID))
+	}
+}
+
+func TestKeeper_OnRecvPacket_Unescrow(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	nftTransferKeeper := testApp.NFTTransferKeeper
+	assetNFTKeeper := testApp.AssetNFTKeeper
+	nftKeeper := testApp.NFTKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	receiver := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	classID, err := assetNFTKeeper.IssueClass(ctx, assetnfttypes.IssueClassSettings{
+		Issuer: issuer,
+		Symbol: "symbol",
+		Features: []assetnfttypes.ClassFeature{
+			assetnfttypes.ClassFeature_ibc,
+		},
+	})
+	requireT.NoError(err)
+	requireT.NoError(assetNFTKeeper.Mint(ctx, assetnfttypes.MintSettings{
+		Sender:  issuer,
+		ClassID: classID,
+		ID:      "nft1",
+	}))
+
+	escrowAddress := types.GetEscrowAddress(types.PortID, "channel-0")
+	outCtx := wibctransfertypes.WithPurpose(ctx, wibctransfertypes.PurposeOut)
+	requireT.NoError(nftKeeper.Transfer(outCtx, classID, "nft1", escrowAddress))
+
+	// the token not owned by the escrow address can't be released
+	packet := channeltypes.Packet{
+		SourcePort:         types.PortID,
+		SourceChannel:      "channel-7",
+		DestinationPort:    types.PortID,
+		DestinationChannel: "channel-0",
+	}
+	data := types.NonFungibleTokenPacketData{
+		ClassID:  "nft-transfer/channel-7/" + classID,
+		TokenIDs: []string{"nft1"},
+		Sender:   "sender",
+		Receiver: receiver.String(),
+	}
+	inCtx := wibctransfertypes.WithPurpose(ctx, wibctransfertypes.PurposeIn)
+	wrongChannelPacket := packet
+	wrongChannelPacket.DestinationChannel = "channel-1"
+	requireT.ErrorIs(nftTransferKeeper.OnRecvPacket(inCtx, wrongChannelPacket, data), types.ErrInvalidPacket)
+
+	requireT.NoError(nftTransferKeeper.OnRecvPacket(inCtx, packet, data))
+	requireT.Equal(receiver, nftKeeper.GetOwner(ctx, classID, "nft1"))
+}
+
+// setupChannel opens the channel of the nft-transfer port with the client state stored directly, so the packets
+// might be sent without the counterparty chain.
+func setupChannel(ctx sdk.Context, testApp *simapp.App, channelID, counterpartyChannelID string) {
+	const (
+		clientID     = "07-tendermint-0"
+		connectionID = "connection-0"
+	)
+
+	ibcKeeper := testApp.IBCKeeper
+	latestHeight := clienttypes.NewHeight(0, 1)
+	ibcKeeper.ClientKeeper.SetClientState(ctx, clientID, &ibctmtypes.ClientState{
+		TrustingPeriod: time.Hour,
+		LatestHeight:   latestHeight,
+	})
+	ibcKeeper.ClientKeeper.SetClientConsensusState(ctx, clientID, latestHeight, &ibctmtypes.ConsensusState{
+		Timestamp: ctx.BlockTime(),
+	})
+	ibcKeeper.ConnectionKeeper.SetConnection(ctx, connectionID, connectiontypes.ConnectionEnd{
+		ClientId: clientID,
+		State:    connectiontypes.OPEN,
+	})
+	ibcKeeper.ChannelKeeper.SetChannel(ctx, types.PortID, channelID, channeltypes.NewChannel(
+		channeltypes.OPEN,
+		channeltypes.UNORDERED,
+		channeltypes.NewCounterparty(types.PortID, counterpartyChannelID),
+		[]string{connectionID},
+		types.Version,
+	))
+	ibcKeeper.ChannelKeeper.SetNextSequenceSend(ctx, types.PortID, channelID, 1)
+
+	capabilityPath := host.ChannelCapabilityPath(types.PortID, channelID)
+	channelCap, err := testApp.ScopedIBCKeeper.NewCapability(ctx, capabilityPath)
+	if err != nil {
+		panic(err)
+	}
+	if err := testApp.ScopedNFTTransferKeeper.ClaimCapability(ctx, channelCap, capabilityPath); err != nil {
+		panic(err)
+	}
+}
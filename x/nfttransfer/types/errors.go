@@ -0,0 +1,20 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	// ErrInvalidInput defines the common error for the invalid input.
+	ErrInvalidInput = sdkerrors.Register(ModuleName, 1, "invalid input")
+	// ErrInvalidPacket is returned when the packet data is invalid.
+	ErrInvalidPacket = sdkerrors.Register(ModuleName, 2, "invalid packet")
+	// ErrInvalidVersion is returned when the channel version is not supported.
+	ErrInvalidVersion = sdkerrors.Register(ModuleName, 3, "invalid ICS-721 version")
+	// ErrTraceNotFound is returned when the class trace is not found.
+	ErrTraceNotFound = sdkerrors.Register(ModuleName, 4, "class trace not found")
+	// ErrMaxTransferChannels is returned when the number of channels exceeds the limit.
+	ErrMaxTransferChannels = sdkerrors.Register(ModuleName, 5, "max nft transfer channels")
+	// ErrInvalidState is returned when state of the module is invalid.
+	ErrInvalidState = sdkerrors.Register(ModuleName, 6, "invalid state")
+)
@@ -0,0 +1,39 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v4/modules/core/exported"
+
+	"github.com/CoreumFoundation/coreum/v2/x/nft"
+)
+
+// NFTKeeper defines the expected NFT interface.
+// It must be the wrapped nft keeper, so the asset nft rules are applied to the transfers.
+type NFTKeeper interface {
+	GetClass(ctx sdk.Context, classID string) (nft.Class, bool)
+	HasClass(ctx sdk.Context, classID string) bool
+	SaveClass(ctx sdk.Context, class nft.Class) error
+	GetNFT(ctx sdk.Context, classID, nftID string) (nft.NFT, bool)
+	GetOwner(ctx sdk.Context, classID, nftID string) sdk.AccAddress
+	Mint(ctx sdk.Context, token nft.NFT, receiver sdk.AccAddress) error
+	Burn(ctx sdk.Context, classID, nftID string) error
+	Transfer(ctx sdk.Context, classID, nftID string, receiver sdk.AccAddress) error
+}
+
+// ICS4Wrapper defines the expected ICS4Wrapper to send packets.
+type ICS4Wrapper interface {
+	SendPacket(ctx sdk.Context, channelCap *capabilitytypes.Capability, packet ibcexported.PacketI) error
+}
+
+// ChannelKeeper defines the expected IBC channel keeper.
+type ChannelKeeper interface {
+	GetChannel(ctx sdk.Context, srcPort, srcChan string) (channel channeltypes.Channel, found bool)
+	GetNextSequenceSend(ctx sdk.Context, portID, channelID string) (uint64, bool)
+}
+
+// PortKeeper defines the expected IBC port keeper.
+type PortKeeper interface {
+	BindPort(ctx sdk.Context, portID string) *capabilitytypes.Capability
+}
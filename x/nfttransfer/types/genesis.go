@@ -0,0 +1,34 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+)
+
+// DefaultGenesis returns the default nfttransfer genesis state.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		PortID: PortID,
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any failure.
+func (gs GenesisState) Validate() error {
+	if err := host.PortIdentifierValidator(gs.PortID); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid port ID: %s", err)
+	}
+
+	hashes := make(map[string]struct{}, len(gs.ClassTraces))
+	for _, trace := range gs.ClassTraces {
+		if err := trace.Validate(); err != nil {
+			return err
+		}
+		hash := trace.Hash().String()
+		if _, exists := hashes[hash]; exists {
+			return sdkerrors.Wrapf(ErrInvalidInput, "duplicated class trace %s", trace.GetFullClassPath())
+		}
+		hashes[hash] = struct{}{}
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/CoreumFoundation/coreum/v2/x/nfttransfer/types"
+)
+
+func TestGenesisState_Validate(t *testing.T) {
+	validTrace := types.ClassTrace{
+		Path:        "nft-transfer/channel-0",
+		BaseClassID: "class",
+	}
+
+	testCases := []struct {
+		name    string
+		genesis types.GenesisState
+		wantErr bool
+	}{
+		{
+			name:    "default",
+			genesis: *types.DefaultGenesis(),
+		},
+		{
+			name: "valid",
+			genesis: types.GenesisState{
+				PortID:      types.PortID,
+				ClassTraces: []types.ClassTrace{validTrace},
+			},
+		},
+		{
+			name: "invalid port ID",
+			genesis: types.GenesisState{
+				PortID: "",
+			},
+			wantErr: true,
+		},
+		{
+			name: "duplicated class trace",
+			genesis: types.GenesisState{
+				PortID:      types.PortID,
+				ClassTraces: []types.ClassTrace{validTrace, validTrace},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid class trace",
+			genesis: types.GenesisState{
+				PortID: types.PortID,
+				ClassTraces: []types.ClassTrace{
+					{
+						Path:        "nft-transfer",
+						BaseClassID: "class",
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genesis.Validate()
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name.
+	ModuleName = "nfttransfer"
+
+	// StoreKey defines the primary module store key.
+	StoreKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key.
+	QuerierRoute = ModuleName
+
+	// RouterKey is the message route for module.
+	RouterKey = ModuleName
+
+	// PortID is the default port the module binds to.
+	PortID = "nft-transfer"
+
+	// Version defines the current version of the ICS-721 protocol supported by the module.
+	Version = "ics721-1"
+
+	// ClassPrefix is the prefix of the voucher class IDs.
+	ClassPrefix = "ibc"
+)
+
+// Store key prefixes.
+var (
+	// PortKey defines the key to store the port ID.
+	PortKey = []byte{0x01}
+	// ClassTraceKeyPrefix defines the key prefix for the class traces.
+	ClassTraceKeyPrefix = []byte{0x02}
+)
+
+// GetEscrowAddress returns the escrow address for the specified channel.
+// The escrow address follows the format as outlined in ADR 028:
+// https://github.com/cosmos/cosmos-sdk/blob/master/docs/architecture/adr-028-public-key-addresses.md
+func GetEscrowAddress(portID, channelID string) sdk.AccAddress {
+	contents := fmt.Sprintf("%s/%s", portID, channelID)
+
+	// ADR 028 AddressHash construction
+	preImage := []byte(Version)
+	preImage = append(preImage, 0)
+	preImage = append(preImage, contents...)
+	hash := sha256.Sum256(preImage)
+	return hash[:20]
+}
@@ -0,0 +1,114 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+
+	"github.com/CoreumFoundation/coreum/v2/x/nft"
+)
+
+// Type of messages for amino.
+const (
+	TypeMsgTransfer = "transfer"
+)
+
+// MaxTokenIDs is the maximum number of the non-fungible tokens transferred by the single message.
+const MaxTokenIDs = 100
+
+var (
+	_ sdk.Msg            = &MsgTransfer{}
+	_ legacytx.LegacyMsg = &MsgTransfer{}
+)
+
+var (
+	amino          = codec.NewLegacyAmino()
+	moduleAminoCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	cryptocodec.RegisterCrypto(amino)
+	amino.Seal()
+}
+
+// RegisterLegacyAminoCodec registers the amino types and interfaces.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgTransfer{}, fmt.Sprintf("%s/MsgTransfer", ModuleName), nil)
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgTransfer) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(m.SourcePort); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid source port ID: %s", err)
+	}
+
+	if err := host.ChannelIdentifierValidator(m.SourceChannel); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid source channel ID: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if strings.TrimSpace(m.Receiver) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "receiver address cannot be blank")
+	}
+
+	if IsVoucherClassID(m.ClassID) {
+		if _, err := ParseHexHash(m.ClassID); err != nil {
+			return err
+		}
+	} else if err := nft.ValidateClassID(m.ClassID); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+	}
+
+	if len(m.TokenIDs) == 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "token IDs cannot be empty")
+	}
+
+	if len(m.TokenIDs) > MaxTokenIDs {
+		return sdkerrors.Wrapf(ErrInvalidInput, "the number of token IDs must not exceed %d", MaxTokenIDs)
+	}
+
+	ids := make(map[string]struct{}, len(m.TokenIDs))
+	for _, id := range m.TokenIDs {
+		if err := nft.ValidateNFTID(id); err != nil {
+			return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+		}
+		if _, ok := ids[id]; ok {
+			return sdkerrors.Wrapf(ErrInvalidInput, "duplicated token ID %q", id)
+		}
+		ids[id] = struct{}{}
+	}
+
+	return nil
+}
+
+// GetSigners returns the required signers of this message type.
+func (m MsgTransfer) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgTransfer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgTransfer) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgTransfer) Type() string {
+	return TypeMsgTransfer
+}
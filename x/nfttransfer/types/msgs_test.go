@@ -0,0 +1,190 @@
+package types_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v2/pkg/config"
+	"github.com/CoreumFoundation/coreum/v2/pkg/config/constant"
+	"github.com/CoreumFoundation/coreum/v2/x/nfttransfer/types"
+)
+
+func TestMain(m *testing.M) {
+	n, err := config.NetworkConfigByChainID(constant.ChainIDDev)
+	if err != nil {
+		panic(err)
+	}
+	n.SetSDKConfig()
+	m.Run()
+}
+
+func TestMsgTransfer_ValidateBasic(t *testing.T) {
+	acc := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	validMessage := types.MsgTransfer{
+		SourcePort:    types.PortID,
+		SourceChannel: "channel-0",
+		ClassID:       "abc-" + acc.String(),
+		TokenIDs:      []string{"nft1", "nft2"},
+		Sender:        acc.String(),
+		Receiver:      "receiver",
+		TimeoutHeight: clienttypes.NewHeight(1, 100),
+	}
+
+	testCases := []struct {
+		name          string
+		messageFunc   func(types.MsgTransfer) types.MsgTransfer
+		expectedError error
+	}{
+		{
+			name: "valid",
+			messageFunc: func(msg types.MsgTransfer) types.MsgTransfer {
+				return msg
+			},
+		},
+		{
+			name: "valid voucher class",
+			messageFunc: func(msg types.MsgTransfer) types.MsgTransfer {
+				msg.ClassID = types.ClassTrace{
+					Path:        "nft-transfer/channel-1",
+					BaseClassID: "class",
+				}.IBCClassID()
+				return msg
+			},
+		},
+		{
+			name: "invalid source port",
+			messageFunc: func(msg types.MsgTransfer) types.MsgTransfer {
+				msg.SourcePort = ""
+				return msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid source channel",
+			messageFunc: func(msg types.MsgTransfer) types.MsgTransfer {
+				msg.SourceChannel = "-"
+				return msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid sender address",
+			messageFunc: func(msg types.MsgTransfer) types.MsgTransfer {
+				msg.Sender = "invalid"
+				return msg
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "blank receiver",
+			messageFunc: func(msg types.MsgTransfer) types.MsgTransfer {
+				msg.Receiver = " "
+				return msg
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid class ID",
+			messageFunc: func(msg types.MsgTransfer) types.MsgTransfer {
+				msg.ClassID = "?"
+				return msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid voucher class hash",
+			messageFunc: func(msg types.MsgTransfer) types.MsgTransfer {
+				msg.ClassID = "ibc/abc"
+				return msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "no token IDs",
+			messageFunc: func(msg types.MsgTransfer) types.MsgTransfer {
+				msg.TokenIDs = nil
+				return msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "too many token IDs",
+			messageFunc: func(msg types.MsgTransfer) types.MsgTransfer {
+				msg.TokenIDs = make([]string, 0, types.MaxTokenIDs+1)
+				for i := 0; i <= types.MaxTokenIDs; i++ {
+					msg.TokenIDs = append(msg.TokenIDs, fmt.Sprintf("nft%d", i))
+				}
+				return msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid token ID",
+			messageFunc: func(msg types.MsgTransfer) types.MsgTransfer {
+				msg.TokenIDs = []string{strings.Repeat("x", 200)}
+				return msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "duplicated token ID",
+			messageFunc: func(msg types.MsgTransfer) types.MsgTransfer {
+				msg.TokenIDs = []string{"nft1", "nft1"}
+				return msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.messageFunc(validMessage).ValidateBasic()
+			if tc.expectedError == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tc.expectedError)
+			}
+		})
+	}
+}
+
+func TestAmino(t *testing.T) {
+	const address = "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"
+
+	tests := []struct {
+		name          string
+		msg           legacytx.LegacyMsg
+		wantAminoJSON string
+	}{
+		{
+			name: types.TypeMsgTransfer,
+			msg: &types.MsgTransfer{
+				SourcePort:       types.PortID,
+				SourceChannel:    "channel-0",
+				ClassID:          "classID",
+				TokenIDs:         []string{"nftID"},
+				Sender:           address,
+				Receiver:         "receiver",
+				TimeoutTimestamp: 1,
+			},
+			wantAminoJSON: `{"type":"nfttransfer/MsgTransfer","value":{"class_id":"classID","receiver":"receiver","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","source_channel":"channel-0","source_port":"nft-transfer","timeout_height":{},"timeout_timestamp":"1","token_ids":["nftID"]}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.wantAminoJSON, string(tt.msg.GetSignBytes()))
+		})
+	}
+}
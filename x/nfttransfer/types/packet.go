@@ -0,0 +1,89 @@
+package types
+
+import (
+	"encoding/json"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NonFungibleTokenPacketData defines the ICS-721 packet data. It is encoded to JSON using the field names
+// defined by the ICS-721 specification, so it is compatible with the implementations on the other chains.
+type NonFungibleTokenPacketData struct {
+	// ClassID is the class ID prefixed by the trace path of the class.
+	ClassID string `json:"classId"`
+	// ClassURI is the optional URI of the class.
+	ClassURI string `json:"classUri,omitempty"`
+	// ClassData is the optional data of the class.
+	ClassData []byte `json:"classData,omitempty"`
+	// TokenIDs are the IDs of the transferred non-fungible tokens.
+	TokenIDs []string `json:"tokenIds"`
+	// TokenURIs are the optional URIs of the transferred non-fungible tokens, in the order of TokenIDs.
+	TokenURIs []string `json:"tokenUris,omitempty"`
+	// TokenData are the optional data of the transferred non-fungible tokens, in the order of TokenIDs.
+	TokenData [][]byte `json:"tokenData,omitempty"`
+	Sender    string   `json:"sender"`
+	Receiver  string   `json:"receiver"`
+	Memo      string   `json:"memo,omitempty"`
+}
+
+// ValidateBasic checks that packet data fields are valid.
+func (d NonFungibleTokenPacketData) ValidateBasic() error {
+	if strings.TrimSpace(d.ClassID) == "" {
+		return sdkerrors.Wrap(ErrInvalidPacket, "class ID cannot be blank")
+	}
+
+	if len(d.TokenIDs) == 0 {
+		return sdkerrors.Wrap(ErrInvalidPacket, "token IDs cannot be empty")
+	}
+
+	if len(d.TokenURIs) != 0 && len(d.TokenURIs) != len(d.TokenIDs) {
+		return sdkerrors.Wrapf(ErrInvalidPacket, "the number of token URIs %d doesn't match the number of token IDs %d",
+			len(d.TokenURIs), len(d.TokenIDs))
+	}
+
+	if len(d.TokenData) != 0 && len(d.TokenData) != len(d.TokenIDs) {
+		return sdkerrors.Wrapf(ErrInvalidPacket, "the number of token data %d doesn't match the number of token IDs %d",
+			len(d.TokenData), len(d.TokenIDs))
+	}
+
+	ids := make(map[string]struct{}, len(d.TokenIDs))
+	for _, id := range d.TokenIDs {
+		if strings.TrimSpace(id) == "" {
+			return sdkerrors.Wrap(ErrInvalidPacket, "token ID cannot be blank")
+		}
+		if _, ok := ids[id]; ok {
+			return sdkerrors.Wrapf(ErrInvalidPacket, "duplicated token ID %q", id)
+		}
+		ids[id] = struct{}{}
+	}
+
+	if strings.TrimSpace(d.Sender) == "" {
+		return sdkerrors.Wrap(ErrInvalidPacket, "sender cannot be blank")
+	}
+
+	if strings.TrimSpace(d.Receiver) == "" {
+		return sdkerrors.Wrap(ErrInvalidPacket, "receiver cannot be blank")
+	}
+
+	return nil
+}
+
+// GetBytes returns the sorted JSON encoding of the packet data.
+func (d NonFungibleTokenPacketData) GetBytes() ([]byte, error) {
+	bz, err := json.Marshal(d)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidPacket, "failed to marshal packet data: %s", err)
+	}
+	return sdk.SortJSON(bz)
+}
+
+// DecodePacketData decodes the ICS-721 packet data from JSON.
+func DecodePacketData(bz []byte) (NonFungibleTokenPacketData, error) {
+	var data NonFungibleTokenPacketData
+	if err := json.Unmarshal(bz, &data); err != nil {
+		return NonFungibleTokenPacketData{}, sdkerrors.Wrapf(ErrInvalidPacket, "cannot unmarshal ICS-721 packet data: %s", err)
+	}
+	return data, nil
+}
@@ -0,0 +1,114 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+)
+
+// ParseClassTrace parses the class ID received in the ICS-721 packet into the ClassTrace.
+// The class ID might be prefixed by the chain of port/channel pairs the class travelled through.
+//
+// Examples:
+//
+// - "nft-transfer/channel-0/class" => ClassTrace{Path: "nft-transfer/channel-0", BaseClassID: "class"}
+// - "nft-transfer/channel-0/nft-transfer/channel-1/class" => ClassTrace{Path: "nft-transfer/channel-0/nft-transfer/channel-1", BaseClassID: "class"}
+// - "class" => ClassTrace{Path: "", BaseClassID: "class"}
+func ParseClassTrace(rawClassID string) ClassTrace {
+	items := strings.Split(rawClassID, "/")
+
+	var path []string
+	for i := 0; i+2 < len(items); i += 2 {
+		if !channeltypes.IsValidChannelID(items[i+1]) {
+			break
+		}
+		path = append(path, items[i], items[i+1])
+	}
+
+	return ClassTrace{
+		Path:        strings.Join(path, "/"),
+		BaseClassID: strings.Join(items[len(path):], "/"),
+	}
+}
+
+// Hash returns the hex bytes of the SHA256 hash of the full class path.
+func (ct ClassTrace) Hash() tmbytes.HexBytes {
+	hash := sha256.Sum256([]byte(ct.GetFullClassPath()))
+	return hash[:]
+}
+
+// GetFullClassPath returns the full class path, it is the base class ID prefixed by the trace path.
+func (ct ClassTrace) GetFullClassPath() string {
+	if ct.Path == "" {
+		return ct.BaseClassID
+	}
+	return ct.Path + "/" + ct.BaseClassID
+}
+
+// IBCClassID returns the class ID of the voucher class in the format 'ibc/{hash(tracePath + baseClassID)}'.
+// If the trace is empty, the base class ID is returned.
+func (ct ClassTrace) IBCClassID() string {
+	if ct.Path == "" {
+		return ct.BaseClassID
+	}
+	return fmt.Sprintf("%s/%s", ClassPrefix, ct.Hash())
+}
+
+// Validate performs a basic validation of the ClassTrace fields.
+func (ct ClassTrace) Validate() error {
+	if strings.TrimSpace(ct.BaseClassID) == "" {
+		return sdkerrors.Wrap(ErrInvalidInput, "base class ID cannot be blank")
+	}
+	if ct.Path == "" {
+		return nil
+	}
+
+	identifiers := strings.Split(ct.Path, "/")
+	if len(identifiers)%2 != 0 {
+		return sdkerrors.Wrapf(ErrInvalidInput, "trace path must come in pairs of port and channel identifiers, got %s", ct.Path)
+	}
+	for i := 0; i < len(identifiers); i += 2 {
+		if err := host.PortIdentifierValidator(identifiers[i]); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidInput, "invalid port ID at position %d: %s", i, err)
+		}
+		if err := host.ChannelIdentifierValidator(identifiers[i+1]); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidInput, "invalid channel ID at position %d: %s", i, err)
+		}
+	}
+	return nil
+}
+
+// GetClassPrefix returns the prefix added to the class ID when the class is received over the channel.
+func GetClassPrefix(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s/", portID, channelID)
+}
+
+// IsAwayFromOrigin returns true if the class sent over the port and channel moves forward in its timeline,
+// meaning the class did not come to this chain over the same channel.
+func IsAwayFromOrigin(sourcePort, sourceChannel, fullClassPath string) bool {
+	return !strings.HasPrefix(fullClassPath, GetClassPrefix(sourcePort, sourceChannel))
+}
+
+// IsVoucherClassID returns true if the class ID is the ID of the voucher class.
+func IsVoucherClassID(classID string) bool {
+	return strings.HasPrefix(classID, ClassPrefix+"/")
+}
+
+// ParseHexHash parses the hash of the class trace, it accepts the voucher class ID too.
+func ParseHexHash(hash string) (tmbytes.HexBytes, error) {
+	hash = strings.TrimPrefix(hash, ClassPrefix+"/")
+	bz, err := hex.DecodeString(hash)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidInput, "invalid class trace hash %s: %s", hash, err)
+	}
+	if len(bz) != sha256.Size {
+		return nil, sdkerrors.Wrapf(ErrInvalidInput, "invalid class trace hash %s, expected length %d", hash, sha256.Size)
+	}
+	return bz, nil
+}
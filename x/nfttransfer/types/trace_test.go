@@ -0,0 +1,113 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v2/x/nfttransfer/types"
+)
+
+func TestParseClassTrace(t *testing.T) {
+	testCases := []struct {
+		classID  string
+		expected types.ClassTrace
+	}{
+		{
+			classID:  "class",
+			expected: types.ClassTrace{BaseClassID: "class"},
+		},
+		{
+			classID:  "nft-transfer/channel-0/class",
+			expected: types.ClassTrace{Path: "nft-transfer/channel-0", BaseClassID: "class"},
+		},
+		{
+			classID: "nft-transfer/channel-0/nft-transfer/channel-1/class",
+			expected: types.ClassTrace{
+				Path:        "nft-transfer/channel-0/nft-transfer/channel-1",
+				BaseClassID: "class",
+			},
+		},
+		{
+			classID:  "nft-transfer/channel-0/a/b",
+			expected: types.ClassTrace{Path: "nft-transfer/channel-0", BaseClassID: "a/b"},
+		},
+		{
+			classID:  "a/b",
+			expected: types.ClassTrace{BaseClassID: "a/b"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.classID, func(t *testing.T) {
+			trace := types.ParseClassTrace(tc.classID)
+			assert.Equal(t, tc.expected, trace)
+			assert.Equal(t, tc.classID, trace.GetFullClassPath())
+			assert.NoError(t, trace.Validate())
+		})
+	}
+}
+
+func TestClassTrace_IBCClassID(t *testing.T) {
+	requireT := require.New(t)
+
+	trace := types.ClassTrace{BaseClassID: "class"}
+	requireT.Equal("class", trace.IBCClassID())
+
+	trace = types.ParseClassTrace("nft-transfer/channel-0/class")
+	classID := trace.IBCClassID()
+	requireT.True(types.IsVoucherClassID(classID))
+	hash, err := types.ParseHexHash(classID)
+	requireT.NoError(err)
+	requireT.Equal(trace.Hash(), hash)
+}
+
+func TestIsAwayFromOrigin(t *testing.T) {
+	requireT := require.New(t)
+
+	requireT.True(types.IsAwayFromOrigin(types.PortID, "channel-0", "class"))
+	requireT.True(types.IsAwayFromOrigin(types.PortID, "channel-0", "nft-transfer/channel-1/class"))
+	requireT.False(types.IsAwayFromOrigin(types.PortID, "channel-0", "nft-transfer/channel-0/class"))
+}
+
+func TestNonFungibleTokenPacketData(t *testing.T) {
+	requireT := require.New(t)
+
+	data := types.NonFungibleTokenPacketData{
+		ClassID:   "class",
+		TokenIDs:  []string{"nft1"},
+		TokenURIs: []string{"https://my-nft-meta.invalid/1"},
+		TokenData: [][]byte{{0x01}},
+		Sender:    "sender",
+		Receiver:  "receiver",
+	}
+	requireT.NoError(data.ValidateBasic())
+
+	bz, err := data.GetBytes()
+	requireT.NoError(err)
+	requireT.Equal(
+		`{"classId":"class","receiver":"receiver","sender":"sender","tokenData":["AQ=="],"tokenIds":["nft1"],"tokenUris":["https://my-nft-meta.invalid/1"]}`,
+		string(bz),
+	)
+
+	decoded, err := types.DecodePacketData(bz)
+	requireT.NoError(err)
+	requireT.Equal(data, decoded)
+
+	invalid := data
+	invalid.TokenURIs = []string{"uri1", "uri2"}
+	requireT.ErrorIs(invalid.ValidateBasic(), types.ErrInvalidPacket)
+
+	invalid = data
+	invalid.TokenIDs = nil
+	requireT.ErrorIs(invalid.ValidateBasic(), types.ErrInvalidPacket)
+
+	invalid = data
+	invalid.Receiver = ""
+	requireT.ErrorIs(invalid.ValidateBasic(), types.ErrInvalidPacket)
+
+	_, err = types.DecodePacketData([]byte("invalid"))
+	requireT.ErrorIs(err, types.ErrInvalidPacket)
+}
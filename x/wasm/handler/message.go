@@ -17,15 +17,30 @@ import (
 //
 //nolint:tagliatelle // we keep the name same as consume
 type assetFTMsg struct {
-	Issue               *assetfttypes.MsgIssue               `json:"Issue"`
-	Mint                *assetfttypes.MsgMint                `json:"Mint"`
-	Burn                *assetfttypes.MsgBurn                `json:"Burn"`
-	Freeze              *assetfttypes.MsgFreeze              `json:"Freeze"`
-	Unfreeze            *assetfttypes.MsgUnfreeze            `json:"Unfreeze"`
-	GloballyFreeze      *assetfttypes.MsgGloballyFreeze      `json:"GloballyFreeze"`
-	GloballyUnfreeze    *assetfttypes.MsgGloballyUnfreeze    `json:"GloballyUnfreeze"`
-	SetWhitelistedLimit *assetfttypes.MsgSetWhitelistedLimit `json:"SetWhitelistedLimit"`
-	UpgradeTokenV1      *assetfttypes.MsgUpgradeTokenV1      `json:"UpgradeTokenV1"`
+	Issue                 *assetfttypes.MsgIssue                 `json:"Issue"`
+	Mint                  *assetfttypes.MsgMint                  `json:"Mint"`
+	Burn                  *assetfttypes.MsgBurn                  `json:"Burn"`
+	Freeze                *assetfttypes.MsgFreeze                `json:"Freeze"`
+	Unfreeze              *assetfttypes.MsgUnfreeze              `json:"Unfreeze"`
+	GloballyFreeze        *assetfttypes.MsgGloballyFreeze        `json:"GloballyFreeze"`
+	GloballyUnfreeze      *assetfttypes.MsgGloballyUnfreeze      `json:"GloballyUnfreeze"`
+	SetWhitelistedLimit   *assetfttypes.MsgSetWhitelistedLimit   `json:"SetWhitelistedLimit"`
+	UpgradeTokenV1        *assetfttypes.MsgUpgradeTokenV1        `json:"UpgradeTokenV1"`
+	TransferAdmin         *assetfttypes.MsgTransferAdmin         `json:"TransferAdmin"`
+	ClearAdmin            *assetfttypes.MsgClearAdmin            `json:"ClearAdmin"`
+	GrantRole             *assetfttypes.MsgGrantRole             `json:"GrantRole"`
+	RevokeRole            *assetfttypes.MsgRevokeRole            `json:"RevokeRole"`
+	UpdateMetadata        *assetfttypes.MsgUpdateMetadata        `json:"UpdateMetadata"`
+	Clawback              *assetfttypes.MsgClawback              `json:"Clawback"`
+	ScheduleMint          *assetfttypes.MsgScheduleMint          `json:"ScheduleMint"`
+	ScheduleUnfreeze      *assetfttypes.MsgScheduleUnfreeze      `json:"ScheduleUnfreeze"`
+	CancelScheduledAction *assetfttypes.MsgCancelScheduledAction `json:"CancelScheduledAction"`
+	RetryScheduledAction  *assetfttypes.MsgRetryScheduledAction  `json:"RetryScheduledAction"`
+	AddRateExemption      *assetfttypes.MsgAddRateExemption      `json:"AddRateExemption"`
+	RemoveRateExemption   *assetfttypes.MsgRemoveRateExemption   `json:"RemoveRateExemption"`
+	UpdateRateRecipients  *assetfttypes.MsgUpdateRateRecipients  `json:"UpdateRateRecipients"`
+	SetTransferLimits     *assetfttypes.MsgSetTransferLimits     `json:"SetTransferLimits"`
+	LockSupply            *assetfttypes.MsgLockSupply            `json:"LockSupply"`
 }
 
 // assetNFTMsgIssueClass defines message for the IssueClass method with string represented data field.
@@ -53,24 +68,74 @@ type assetNFTMsgMint struct {
 	Data    string `json:"data"`
 }
 
+// assetNFTMsgMintBatch defines message for the MintBatch method with string represented data fields.
+//
+//nolint:tagliatelle // we keep the name same as consume
+type assetNFTMsgMintBatch struct {
+	ClassID string                  `json:"class_id"`
+	NFTs    []assetNFTMintBatchItem `json:"nfts"`
+}
+
+// assetNFTMintBatchItem defines the NFT minted by the MintBatch method with string represented data field.
+//
+//nolint:tagliatelle // we keep the name same as consume
+type assetNFTMintBatchItem struct {
+	ID      string `json:"id"`
+	URI     string `json:"uri"`
+	URIHash string `json:"uri_hash"`
+	Data    string `json:"data"`
+}
+
+// assetNFTMsgUpdateClass defines message for the UpdateClass method with string represented data field.
+//
+//nolint:tagliatelle // we keep the name same as consume
+type assetNFTMsgUpdateClass struct {
+	ClassID string `json:"class_id"`
+	URI     string `json:"uri"`
+	URIHash string `json:"uri_hash"`
+	Data    string `json:"data"`
+}
+
+// assetNFTMsgUpdateData defines message for the UpdateData method with string represented data field.
+//
+//nolint:tagliatelle // we keep the name same as consume
+type assetNFTMsgUpdateData struct {
+	ClassID string `json:"class_id"`
+	ID      string `json:"id"`
+	URI     string `json:"uri"`
+	URIHash string `json:"uri_hash"`
+	Data    string `json:"data"`
+}
+
 // assetNFTMsg represents asset nft module messages integrated with the wasm handler.
 //
 //nolint:tagliatelle // we keep the name same as consume
 type assetNFTMsg struct {
-	IssueClass          *assetNFTMsgIssueClass                `json:"IssueClass"`
-	Mint                *assetNFTMsgMint                      `json:"Mint"`
-	Burn                *assetnfttypes.MsgBurn                `json:"Burn"`
-	Freeze              *assetnfttypes.MsgFreeze              `json:"Freeze"`
-	Unfreeze            *assetnfttypes.MsgUnfreeze            `json:"Unfreeze"`
-	AddToWhitelist      *assetnfttypes.MsgAddToWhitelist      `json:"AddToWhitelist"`
-	RemoveFromWhitelist *assetnfttypes.MsgRemoveFromWhitelist `json:"RemoveFromWhitelist"`
+	IssueClass               *assetNFTMsgIssueClass                     `json:"IssueClass"`
+	Mint                     *assetNFTMsgMint                           `json:"Mint"`
+	Burn                     *assetnfttypes.MsgBurn                     `json:"Burn"`
+	Freeze                   *assetnfttypes.MsgFreeze                   `json:"Freeze"`
+	Unfreeze                 *assetnfttypes.MsgUnfreeze                 `json:"Unfreeze"`
+	AddToWhitelist           *assetnfttypes.MsgAddToWhitelist           `json:"AddToWhitelist"`
+	RemoveFromWhitelist      *assetnfttypes.MsgRemoveFromWhitelist      `json:"RemoveFromWhitelist"`
+	UpdateClass              *assetNFTMsgUpdateClass                    `json:"UpdateClass"`
+	UpdateData               *assetNFTMsgUpdateData                     `json:"UpdateData"`
+	ClassFreeze              *assetnfttypes.MsgClassFreeze              `json:"ClassFreeze"`
+	ClassUnfreeze            *assetnfttypes.MsgClassUnfreeze            `json:"ClassUnfreeze"`
+	FreezeClassForAccount    *assetnfttypes.MsgFreezeClassForAccount    `json:"FreezeClassForAccount"`
+	UnfreezeClassForAccount  *assetnfttypes.MsgUnfreezeClassForAccount  `json:"UnfreezeClassForAccount"`
+	AddToClassWhitelist      *assetnfttypes.MsgAddToClassWhitelist      `json:"AddToClassWhitelist"`
+	RemoveFromClassWhitelist *assetnfttypes.MsgRemoveFromClassWhitelist `json:"RemoveFromClassWhitelist"`
+	MintBatch                *assetNFTMsgMintBatch                      `json:"MintBatch"`
+	BurnBatch                *assetnfttypes.MsgBurnBatch                `json:"BurnBatch"`
 }
 
 // nftMsg represents nft module messages integrated with the wasm handler.
 //
 //nolint:tagliatelle // we keep the name same as consume
 type nftMsg struct {
-	Send *nfttypes.MsgSend `json:"Send"`
+	Send      *nfttypes.MsgSend      `json:"Send"`
+	SendBatch *nfttypes.MsgSendBatch `json:"SendBatch"`
 }
 
 // coreumMsg represents all supported custom messages integrated with the wasm handler.
@@ -160,6 +225,66 @@ func decodeAssetFTMessage(assetFTMsg *assetFTMsg, sender string) (sdk.Msg, error
 		assetFTMsg.UpgradeTokenV1.Sender = sender
 		return assetFTMsg.UpgradeTokenV1, nil
 	}
+	if assetFTMsg.TransferAdmin != nil {
+		assetFTMsg.TransferAdmin.Sender = sender
+		return assetFTMsg.TransferAdmin, nil
+	}
+	if assetFTMsg.ClearAdmin != nil {
+		assetFTMsg.ClearAdmin.Sender = sender
+		return assetFTMsg.ClearAdmin, nil
+	}
+	if assetFTMsg.GrantRole != nil {
+		assetFTMsg.GrantRole.Sender = sender
+		return assetFTMsg.GrantRole, nil
+	}
+	if assetFTMsg.RevokeRole != nil {
+		assetFTMsg.RevokeRole.Sender = sender
+		return assetFTMsg.RevokeRole, nil
+	}
+	if assetFTMsg.UpdateMetadata != nil {
+		assetFTMsg.UpdateMetadata.Sender = sender
+		return assetFTMsg.UpdateMetadata, nil
+	}
+	if assetFTMsg.Clawback != nil {
+		assetFTMsg.Clawback.Sender = sender
+		return assetFTMsg.Clawback, nil
+	}
+	if assetFTMsg.ScheduleMint != nil {
+		assetFTMsg.ScheduleMint.Sender = sender
+		return assetFTMsg.ScheduleMint, nil
+	}
+	if assetFTMsg.ScheduleUnfreeze != nil {
+		assetFTMsg.ScheduleUnfreeze.Sender = sender
+		return assetFTMsg.ScheduleUnfreeze, nil
+	}
+	if assetFTMsg.CancelScheduledAction != nil {
+		assetFTMsg.CancelScheduledAction.Sender = sender
+		return assetFTMsg.CancelScheduledAction, nil
+	}
+	if assetFTMsg.RetryScheduledAction != nil {
+		assetFTMsg.RetryScheduledAction.Sender = sender
+		return assetFTMsg.RetryScheduledAction, nil
+	}
+	if assetFTMsg.AddRateExemption != nil {
+		assetFTMsg.AddRateExemption.Sender = sender
+		return assetFTMsg.AddRateExemption, nil
+	}
+	if assetFTMsg.RemoveRateExemption != nil {
+		assetFTMsg.RemoveRateExemption.Sender = sender
+		return assetFTMsg.RemoveRateExemption, nil
+	}
+	if assetFTMsg.UpdateRateRecipients != nil {
+		assetFTMsg.UpdateRateRecipients.Sender = sender
+		return assetFTMsg.UpdateRateRecipients, nil
+	}
+	if assetFTMsg.SetTransferLimits != nil {
+		assetFTMsg.SetTransferLimits.Sender = sender
+		return assetFTMsg.SetTransferLimits, nil
+	}
+	if assetFTMsg.LockSupply != nil {
+		assetFTMsg.LockSupply.Sender = sender
+		return assetFTMsg.LockSupply, nil
+	}
 
 	return nil, nil
 }
@@ -208,10 +333,40 @@ func decodeAssetNFTMessage(assetNFTMsg *assetNFTMsg, sender string) (sdk.Msg, er
 			Data:    data,
 		}, nil
 	}
+	if assetNFTMsg.MintBatch != nil {
+		nfts := make([]assetnfttypes.MintBatchItem, 0, len(assetNFTMsg.MintBatch.NFTs))
+		for _, item := range assetNFTMsg.MintBatch.NFTs {
+			var (
+				data *codectypes.Any
+				err  error
+			)
+			if item.Data != "" {
+				data, err = convertStringToDataBytes(item.Data)
+				if err != nil {
+					return nil, err
+				}
+			}
+			nfts = append(nfts, assetnfttypes.MintBatchItem{
+				ID:      item.ID,
+				URI:     item.URI,
+				URIHash: item.URIHash,
+				Data:    data,
+			})
+		}
+		return &assetnfttypes.MsgMintBatch{
+			Sender:  sender,
+			ClassID: assetNFTMsg.MintBatch.ClassID,
+			NFTs:    nfts,
+		}, nil
+	}
 	if assetNFTMsg.Burn != nil {
 		assetNFTMsg.Burn.Sender = sender
 		return assetNFTMsg.Burn, nil
 	}
+	if assetNFTMsg.BurnBatch != nil {
+		assetNFTMsg.BurnBatch.Sender = sender
+		return assetNFTMsg.BurnBatch, nil
+	}
 	if assetNFTMsg.Freeze != nil {
 		assetNFTMsg.Freeze.Sender = sender
 		return assetNFTMsg.Freeze, nil
@@ -228,6 +383,69 @@ func decodeAssetNFTMessage(assetNFTMsg *assetNFTMsg, sender string) (sdk.Msg, er
 		assetNFTMsg.RemoveFromWhitelist.Sender = sender
 		return assetNFTMsg.RemoveFromWhitelist, nil
 	}
+	if assetNFTMsg.ClassFreeze != nil {
+		assetNFTMsg.ClassFreeze.Sender = sender
+		return assetNFTMsg.ClassFreeze, nil
+	}
+	if assetNFTMsg.ClassUnfreeze != nil {
+		assetNFTMsg.ClassUnfreeze.Sender = sender
+		return assetNFTMsg.ClassUnfreeze, nil
+	}
+	if assetNFTMsg.FreezeClassForAccount != nil {
+		assetNFTMsg.FreezeClassForAccount.Sender = sender
+		return assetNFTMsg.FreezeClassForAccount, nil
+	}
+	if assetNFTMsg.UnfreezeClassForAccount != nil {
+		assetNFTMsg.UnfreezeClassForAccount.Sender = sender
+		return assetNFTMsg.UnfreezeClassForAccount, nil
+	}
+	if assetNFTMsg.AddToClassWhitelist != nil {
+		assetNFTMsg.AddToClassWhitelist.Sender = sender
+		return assetNFTMsg.AddToClassWhitelist, nil
+	}
+	if assetNFTMsg.RemoveFromClassWhitelist != nil {
+		assetNFTMsg.RemoveFromClassWhitelist.Sender = sender
+		return assetNFTMsg.RemoveFromClassWhitelist, nil
+	}
+	if assetNFTMsg.UpdateClass != nil {
+		var (
+			data *codectypes.Any
+			err  error
+		)
+		if assetNFTMsg.UpdateClass.Data != "" {
+			data, err = convertStringToDataBytes(assetNFTMsg.UpdateClass.Data)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return &assetnfttypes.MsgUpdateClass{
+			Sender:  sender,
+			ClassID: assetNFTMsg.UpdateClass.ClassID,
+			URI:     assetNFTMsg.UpdateClass.URI,
+			URIHash: assetNFTMsg.UpdateClass.URIHash,
+			Data:    data,
+		}, nil
+	}
+	if assetNFTMsg.UpdateData != nil {
+		var (
+			data *codectypes.Any
+			err  error
+		)
+		if assetNFTMsg.UpdateData.Data != "" {
+			data, err = convertStringToDataBytes(assetNFTMsg.UpdateData.Data)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return &assetnfttypes.MsgUpdateData{
+			Sender:  sender,
+			ClassID: assetNFTMsg.UpdateData.ClassID,
+			ID:      assetNFTMsg.UpdateData.ID,
+			URI:     assetNFTMsg.UpdateData.URI,
+			URIHash: assetNFTMsg.UpdateData.URIHash,
+			Data:    data,
+		}, nil
+	}
 
 	return nil, nil
 }
@@ -237,6 +455,10 @@ func decodeNFTMessage(nftMsg *nftMsg, sender string) (sdk.Msg, error) {
 		nftMsg.Send.Sender = sender
 		return nftMsg.Send, nil
 	}
+	if nftMsg.SendBatch != nil {
+		nftMsg.SendBatch.Sender = sender
+		return nftMsg.SendBatch, nil
+	}
 
 	return nil, nil
 }
@@ -73,8 +73,13 @@ type assetNFTQuery struct {
 	Class                     *assetnfttypes.QueryClassRequest                     `json:"Class"`
 	Classes                   *assetnfttypes.QueryClassesRequest                   `json:"Classes"`
 	Frozen                    *assetnfttypes.QueryFrozenRequest                    `json:"Frozen"`
+	ClassFrozen               *assetnfttypes.QueryClassFrozenRequest               `json:"ClassFrozen"`
+	ClassFrozenForAccount     *assetnfttypes.QueryClassFrozenForAccountRequest     `json:"ClassFrozenForAccount"`
+	ClassFrozenAccounts       *assetnfttypes.QueryClassFrozenAccountsRequest       `json:"ClassFrozenAccounts"`
 	Whitelisted               *assetnfttypes.QueryWhitelistedRequest               `json:"Whitelisted"`
 	WhitelistedAccountsforNFT *assetnfttypes.QueryWhitelistedAccountsForNFTRequest `json:"WhitelistedAccountsforNft"`
+	ClassWhitelisted          *assetnfttypes.QueryClassWhitelistedRequest          `json:"ClassWhitelisted"`
+	ClassWhitelistedAccounts  *assetnfttypes.QueryClassWhitelistedAccountsRequest  `json:"ClassWhitelistedAccounts"`
 }
 
 // nft is the nft with string data.
@@ -317,6 +322,21 @@ func processAssetNFTQuery(ctx sdk.Context, assetNFTQuery *assetNFTQuery, assetNF
 			return assetNFTQueryServer.Frozen(ctx, req)
 		})
 	}
+	if assetNFTQuery.ClassFrozen != nil {
+		return executeQuery(ctx, assetNFTQuery.ClassFrozen, func(ctx context.Context, req *assetnfttypes.QueryClassFrozenRequest) (*assetnfttypes.QueryClassFrozenResponse, error) {
+			return assetNFTQueryServer.ClassFrozen(ctx, req)
+		})
+	}
+	if assetNFTQuery.ClassFrozenForAccount != nil {
+		return executeQuery(ctx, assetNFTQuery.ClassFrozenForAccount, func(ctx context.Context, req *assetnfttypes.QueryClassFrozenForAccountRequest) (*assetnfttypes.QueryClassFrozenForAccountResponse, error) {
+			return assetNFTQueryServer.ClassFrozenForAccount(ctx, req)
+		})
+	}
+	if assetNFTQuery.ClassFrozenAccounts != nil {
+		return executeQuery(ctx, assetNFTQuery.ClassFrozenAccounts, func(ctx context.Context, req *assetnfttypes.QueryClassFrozenAccountsRequest) (*assetnfttypes.QueryClassFrozenAccountsResponse, error) {
+			return assetNFTQueryServer.ClassFrozenAccounts(ctx, req)
+		})
+	}
 	if assetNFTQuery.Whitelisted != nil {
 		return executeQuery(ctx, assetNFTQuery.Whitelisted, func(ctx context.Context, req *assetnfttypes.QueryWhitelistedRequest) (*assetnfttypes.QueryWhitelistedResponse, error) {
 			return assetNFTQueryServer.Whitelisted(ctx, req)
@@ -327,6 +347,16 @@ func processAssetNFTQuery(ctx sdk.Context, assetNFTQuery *assetNFTQuery, assetNF
 			return assetNFTQueryServer.WhitelistedAccountsForNFT(ctx, req)
 		})
 	}
+	if assetNFTQuery.ClassWhitelisted != nil {
+		return executeQuery(ctx, assetNFTQuery.ClassWhitelisted, func(ctx context.Context, req *assetnfttypes.QueryClassWhitelistedRequest) (*assetnfttypes.QueryClassWhitelistedResponse, error) {
+			return assetNFTQueryServer.ClassWhitelisted(ctx, req)
+		})
+	}
+	if assetNFTQuery.ClassWhitelistedAccounts != nil {
+		return executeQuery(ctx, assetNFTQuery.ClassWhitelistedAccounts, func(ctx context.Context, req *assetnfttypes.QueryClassWhitelistedAccountsRequest) (*assetnfttypes.QueryClassWhitelistedAccountsResponse, error) {
+			return assetNFTQueryServer.ClassWhitelistedAccounts(ctx, req)
+		})
+	}
 
 	return nil, nil
 }
@@ -61,11 +61,32 @@ func (wk Wrapper) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendRes
 	return &nft.MsgSendResponse{}, nil
 }
 
+// SendBatch overwrites SendBatch method of the original keeper, so each nft is sent using the intercepted Send.
+func (wk Wrapper) SendBatch(goCtx context.Context, msg *nft.MsgSendBatch) (*nft.MsgSendBatchResponse, error) {
+	for _, item := range msg.Nfts {
+		if _, err := wk.Send(goCtx, &nft.MsgSend{
+			ClassId:  item.ClassId,
+			Id:       item.Id,
+			Sender:   msg.Sender,
+			Receiver: item.Receiver,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return &nft.MsgSendBatchResponse{}, nil
+}
+
 // Transfer overwrites the original transfer function to include our custom interceptor.
 func (wk Wrapper) Transfer(ctx sdk.Context, classID, nftID string, receiver sdk.AccAddress) error {
-	if err := wk.nonFungibleTokenProvider.BeforeTransfer(ctx, classID, nftID, receiver); err != nil {
+	if err := wk.BeforeTransfer(ctx, classID, nftID, receiver); err != nil {
 		return err
 	}
 
 	return wk.Keeper.Transfer(ctx, classID, nftID, receiver)
 }
+
+// BeforeTransfer runs the custom interceptor checking if the nft might be transferred to the receiver.
+func (wk Wrapper) BeforeTransfer(ctx sdk.Context, classID, nftID string, receiver sdk.AccAddress) error {
+	return wk.nonFungibleTokenProvider.BeforeTransfer(ctx, classID, nftID, receiver)
+}
@@ -4,9 +4,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 
 	"github.com/CoreumFoundation/coreum/v2/x/nft"
 	nftmodule "github.com/CoreumFoundation/coreum/v2/x/nft/module"
+	"github.com/CoreumFoundation/coreum/v2/x/nft/simulation"
 	"github.com/CoreumFoundation/coreum/v2/x/wnft/keeper"
 )
 
@@ -18,15 +20,21 @@ type AppModuleBasic struct {
 // AppModule implements an application module for the wnft module.
 type AppModule struct {
 	nftmodule.AppModule
-	keeper keeper.Wrapper
+	keeper        keeper.Wrapper
+	accountKeeper nft.AccountKeeper
+	bankKeeper    nft.BankKeeper
+	registry      codectypes.InterfaceRegistry
 }
 
 // NewAppModule creates a new wnft AppModule object.
 func NewAppModule(cdc codec.Codec, keeper keeper.Wrapper, ak nft.AccountKeeper, bk nft.BankKeeper, registry codectypes.InterfaceRegistry) AppModule {
 	nftModule := nftmodule.NewAppModule(cdc, keeper.Keeper, ak, bk, registry)
 	return AppModule{
-		AppModule: nftModule,
-		keeper:    keeper,
+		AppModule:     nftModule,
+		keeper:        keeper,
+		accountKeeper: ak,
+		bankKeeper:    bk,
+		registry:      registry,
 	}
 }
 
@@ -35,3 +43,14 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	nft.RegisterMsgServer(cfg.MsgServer(), am.keeper)
 	nft.RegisterQueryServer(cfg.QueryServer(), am.keeper)
 }
+
+// WeightedOperations returns the all the nft module operations with their respective weights.
+// The transfers rejected by the wrapping rules are skipped.
+func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	return simulation.WeightedOperations(
+		am.registry,
+		simState.AppParams, simState.Cdc,
+		am.accountKeeper, am.bankKeeper, am.keeper.Keeper,
+		am.keeper.BeforeTransfer,
+	)
+}